@@ -0,0 +1,232 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/api/requester"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/utils"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/instrumentation/instracer"
+)
+
+func unmarshalBatch(response http.ResponseWriter, req *http.Request, maxSize int, maxBodySize int64) ([]requester.BatchItem, error) {
+	reader := req.Body
+	if maxBodySize > 0 {
+		reader = http.MaxBytesReader(response, req.Body, maxBodySize)
+	}
+	body, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ unmarshalBatch ] Can't read body")
+	}
+	if len(body) == 0 {
+		return nil, errors.New("[ unmarshalBatch ] Empty body")
+	}
+
+	var items []requester.BatchItem
+	err = json.Unmarshal(body, &items)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ unmarshalBatch ] Can't unmarshal batch")
+	}
+	if len(items) == 0 {
+		return nil, errors.New("[ unmarshalBatch ] Empty batch")
+	}
+	if maxSize > 0 && len(items) > maxSize {
+		return nil, errors.Errorf("[ unmarshalBatch ] Batch size %d exceeds limit %d", len(items), maxSize)
+	}
+	return items, nil
+}
+
+// batchRequest is an item of the batch with parsed request, err is set if request can't be parsed.
+type batchRequest struct {
+	item    requester.BatchItem
+	request *requester.Request
+	err     error
+}
+
+func parseBatch(items []requester.BatchItem) []batchRequest {
+	requests := make([]batchRequest, len(items))
+	for i, item := range items {
+		requests[i].item = item
+		requests[i].request = &requester.Request{}
+		err := json.Unmarshal(item.Request, requests[i].request)
+		if err != nil {
+			requests[i].err = errors.Wrap(err, "failed to unmarshal request")
+		}
+	}
+	return requests
+}
+
+// checkBatchSeed checks that all requests of the batch are signed with the same seed and uses the seed once,
+// so requests of the batch can't be replayed neither in the batch nor in other calls.
+func (ar *Runner) checkBatchSeed(requests []batchRequest) (insolar.PulseNumber, error) {
+	var seed *string
+	bodies := make(map[string]struct{}, len(requests))
+	for _, r := range requests {
+		if r.err != nil {
+			continue
+		}
+		if seed == nil {
+			seed = &r.request.Params.Seed
+		} else if *seed != r.request.Params.Seed {
+			return 0, errors.New("[ checkBatchSeed ] All requests of the batch should be signed with the same seed")
+		}
+
+		if _, ok := bodies[string(r.item.Request)]; ok {
+			return 0, errors.New("[ checkBatchSeed ] Batch contains duplicate requests")
+		}
+		bodies[string(r.item.Request)] = struct{}{}
+	}
+	if seed == nil {
+		// nothing to call, every request is answered with its own error
+		return 0, nil
+	}
+	return ar.checkSeed(*seed)
+}
+
+func writeBatchResponse(insLog insolar.Logger, response http.ResponseWriter, answers interface{}) {
+	res, err := json.MarshalIndent(answers, "", "    ")
+	if err != nil {
+		res, _ = json.MarshalIndent(requester.ContractAnswer{
+			JSONRPC: "2.0",
+			Error:   &requester.Error{Message: fmt.Sprintf("can't marshal batch answers to json; error: '%v'", err.Error())},
+		}, "", "    ")
+	}
+	response.Header().Add("Content-Type", "application/json")
+	_, err = response.Write(res)
+	if err != nil {
+		insLog.Errorf("Can't write response\n")
+	}
+}
+
+// callBatchItem processes single request of the batch, it's answered with timeout error if ctx is done first.
+// Seed of the batch is already checked.
+func (ar *Runner) callBatchItem(
+	ctx context.Context, r batchRequest, seedPulse insolar.PulseNumber, contractAnswer *requester.ContractAnswer,
+) {
+	traceID := utils.RandTraceID()
+	ctx, insLog := inslogger.WithTraceField(ctx, traceID)
+
+	contractRequest := r.request
+	startTime := time.Now()
+	defer func() {
+		observeResultStatus(contractRequest.Method, contractAnswer, startTime)
+	}()
+
+	if r.err != nil {
+		processError(r.err, r.err.Error(), contractAnswer, insLog, traceID)
+		return
+	}
+	contractAnswer.JSONRPC = contractRequest.JSONRPC
+	contractAnswer.ID = contractRequest.ID
+
+	signature, err := checkSignedRequest(contractRequest, r.item.Request, r.item.Digest, r.item.Signature)
+	if err != nil {
+		processError(err, err.Error(), contractAnswer, insLog, traceID)
+		return
+	}
+
+	var result *requester.Result
+	ch := make(chan struct{})
+	go func() {
+		result, err = ar.call(ctx, *contractRequest, r.item.Request, signature, seedPulse)
+		close(ch)
+	}()
+	select {
+	case <-ch:
+		if err != nil {
+			processError(err, err.Error(), contractAnswer, insLog, traceID)
+			return
+		}
+		result.TraceID = traceID
+		contractAnswer.Result = result
+	case <-ctx.Done():
+		contractAnswer.Error = &requester.Error{Message: "API timeout exceeded", Code: TimeoutError, Data: requester.Data{TraceID: traceID}}
+	}
+}
+
+// batchHandler accepts array of individually signed requests, calls them concurrently
+// and answers with array of results in the same order. All requests of the batch are signed
+// with the same seed, the seed is checked once for the whole batch.
+//
+//   Request structure:
+//   [
+//     {
+//       "request": { "jsonrpc": "2.0", "method": "api.call", "id": int, "params": { ... } },
+//       "digest": "SHA-256=<hash of request>",
+//       "signature": "keyId=\"member-pub-key\", algorithm=\"ecdsa\", headers=\"digest\", signature=<signature of request>"
+//     },
+//     ...
+//   ]
+func (ar *Runner) batchHandler() func(http.ResponseWriter, *http.Request) {
+	return func(response http.ResponseWriter, req *http.Request) {
+		traceID := utils.RandTraceID()
+		ctx, insLog := inslogger.WithTraceField(context.Background(), traceID)
+
+		ctx, span := instracer.StartSpan(ctx, "batchHandler")
+		defer span.End()
+
+		insLog.Infof("[ batchHandler ] Incoming batch: %s", req.RequestURI)
+
+		items, err := unmarshalBatch(response, req, ar.cfg.BatchMaxSize, ar.cfg.BatchMaxBodySize)
+		if err != nil {
+			contractAnswer := &requester.ContractAnswer{JSONRPC: "2.0"}
+			processError(err, err.Error(), contractAnswer, insLog, traceID)
+			writeBatchResponse(insLog, response, contractAnswer)
+			return
+		}
+
+		requests := parseBatch(items)
+		seedPulse, err := ar.checkBatchSeed(requests)
+		if err != nil {
+			contractAnswer := &requester.ContractAnswer{JSONRPC: "2.0"}
+			processError(err, err.Error(), contractAnswer, insLog, traceID)
+			writeBatchResponse(insLog, response, contractAnswer)
+			return
+		}
+
+		timeout := ar.cfg.BatchTimeout
+		if timeout <= 0 {
+			timeout = ar.timeout
+		}
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		answers := make([]requester.ContractAnswer, len(requests))
+		var wg sync.WaitGroup
+		wg.Add(len(requests))
+		for i := range requests {
+			go func(i int) {
+				defer wg.Done()
+				ar.callBatchItem(ctx, requests[i], seedPulse, &answers[i])
+			}(i)
+		}
+		wg.Wait()
+
+		writeBatchResponse(insLog, response, answers)
+	}
+}
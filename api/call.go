@@ -37,6 +37,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/insolar/insolar/api/seedmanager"
+	"github.com/insolar/insolar/application/callsite"
 	"github.com/insolar/insolar/application/extractor"
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/insolar/reply"
@@ -46,8 +47,16 @@ import (
 )
 
 const (
-	TimeoutError = 215
-	ResultError  = 217
+	TimeoutError    = 215
+	ResultError     = 217
+	ValidationError = 218
+)
+
+const (
+	// CallMethod waits for results of the call
+	CallMethod = "api.call"
+	// CallAsyncMethod returns reference of incoming request immediately, status is checked by request.getStatus
+	CallAsyncMethod = "api.callAsync"
 )
 
 // UnmarshalRequest unmarshals request to api
@@ -88,14 +97,9 @@ func (ar *Runner) makeCall(ctx context.Context, request requester.Request, rawBo
 	ctx, span := instracer.StartSpan(ctx, "SendRequest "+request.Method)
 	defer span.End()
 
-	reference, err := insolar.NewReferenceFromBase58(request.Params.Reference)
+	reference, requestArgs, err := makeCallArgs(request, rawBody, signature, pulseTimeStamp)
 	if err != nil {
-		return nil, errors.Wrap(err, "[ makeCall ] failed to parse params.Reference")
-	}
-
-	requestArgs, err := insolar.MarshalArgs(rawBody, signature, pulseTimeStamp)
-	if err != nil {
-		return nil, errors.Wrap(err, "[ makeCall ] failed to marshal arguments")
+		return nil, errors.Wrap(err, "[ makeCall ]")
 	}
 
 	res, err := ar.ContractRequester.SendRequestWithPulse(
@@ -123,8 +127,66 @@ func (ar *Runner) makeCall(ctx context.Context, request requester.Request, rawBo
 	return result, nil
 }
 
+// makeAsyncCall registers request and returns reference of incoming request without waiting for results
+func (ar *Runner) makeAsyncCall(ctx context.Context, request requester.Request, rawBody []byte, signature string, pulseTimeStamp int64, seedPulse insolar.PulseNumber) (*insolar.Reference, error) {
+	ctx, span := instracer.StartSpan(ctx, "SendRequestAsync "+request.Method)
+	defer span.End()
+
+	reference, requestArgs, err := makeCallArgs(request, rawBody, signature, pulseTimeStamp)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ makeAsyncCall ]")
+	}
+
+	requestRef, err := ar.ContractRequester.SendRequestWithPulseAsync(
+		ctx,
+		reference,
+		"Call",
+		[]interface{}{requestArgs},
+		seedPulse,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ makeAsyncCall ] Can't send request")
+	}
+
+	return requestRef, nil
+}
+
+func makeCallArgs(request requester.Request, rawBody []byte, signature string, pulseTimeStamp int64) (*insolar.Reference, insolar.Arguments, error) {
+	reference, err := insolar.NewReferenceFromBase58(request.Params.Reference)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to parse params.Reference")
+	}
+
+	requestArgs, err := insolar.MarshalArgs(rawBody, signature, pulseTimeStamp)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to marshal arguments")
+	}
+	return reference, requestArgs, nil
+}
+
+// call makes sync or async call depending on method of the request
+func (ar *Runner) call(ctx context.Context, request requester.Request, rawBody []byte, signature string, seedPulse insolar.PulseNumber) (*requester.Result, error) {
+	if request.Method == CallAsyncMethod {
+		requestRef, err := ar.makeAsyncCall(ctx, request, rawBody, signature, 0, seedPulse)
+		if err != nil {
+			return nil, err
+		}
+		return &requester.Result{RequestReference: requestRef.String()}, nil
+	}
+
+	result, err := ar.makeCall(ctx, request, rawBody, signature, 0, seedPulse)
+	if err != nil {
+		return nil, err
+	}
+	return &requester.Result{ContractResult: result}, nil
+}
+
 func processError(err error, extraMsg string, resp *requester.ContractAnswer, insLog insolar.Logger, traceID string) {
-	errResponse := &requester.Error{Message: extraMsg, Code: ResultError, Data: requester.Data{TraceID: traceID}}
+	code := ResultError
+	if _, ok := errors.Cause(err).(*callsite.ValidationError); ok {
+		code = ValidationError
+	}
+	errResponse := &requester.Error{Message: extraMsg, Code: code, Data: requester.Data{TraceID: traceID}}
 	resp.Error = errResponse
 	insLog.Error(errors.Wrapf(err, "[ CallHandler ] %s", extraMsg))
 }
@@ -195,6 +257,48 @@ func setRootReferenceIfNeeded(request *requester.Request) {
 	}
 }
 
+// validateCallParams checks callParams of member's call sites. Other contracts have own call sites, they aren't checked.
+func validateCallParams(params requester.Params) error {
+	if _, ok := callsite.Get(params.CallSite); !ok {
+		return nil
+	}
+	return callsite.ValidateParams(params.CallSite, params.CallParams)
+}
+
+// checkRequest checks method, signature headers and seed of the request, returns signature and pulse of the seed
+func (ar *Runner) checkRequest(contractRequest *requester.Request, rawBody []byte, digest string, richSignature string) (string, insolar.PulseNumber, error) {
+	signature, err := checkSignedRequest(contractRequest, rawBody, digest, richSignature)
+	if err != nil {
+		return "", 0, err
+	}
+
+	seedPulse, err := ar.checkSeed(contractRequest.Params.Seed)
+	if err != nil {
+		return "", 0, err
+	}
+	return signature, seedPulse, nil
+}
+
+// checkSignedRequest checks method, signature headers and params of the request, returns signature
+func checkSignedRequest(contractRequest *requester.Request, rawBody []byte, digest string, richSignature string) (string, error) {
+	if contractRequest.Method != CallMethod && contractRequest.Method != CallAsyncMethod {
+		return "", errors.New("rpc method does not exist")
+	}
+
+	signature, err := validateRequestHeaders(digest, richSignature, rawBody)
+	if err != nil {
+		return "", err
+	}
+
+	err = validateCallParams(contractRequest.Params)
+	if err != nil {
+		return "", err
+	}
+
+	setRootReferenceIfNeeded(contractRequest)
+	return signature, nil
+}
+
 func (ar *Runner) callHandler() func(http.ResponseWriter, *http.Request) {
 	return func(response http.ResponseWriter, req *http.Request) {
 		traceID := utils.RandTraceID()
@@ -222,30 +326,16 @@ func (ar *Runner) callHandler() func(http.ResponseWriter, *http.Request) {
 			insLog.Infof("Request related to %s", contractRequest.Test)
 		}
 
-		if contractRequest.Method != "api.call" {
-			err := errors.New("rpc method does not exist")
-			processError(err, err.Error(), contractAnswer, insLog, traceID)
-			return
-		}
-
-		signature, err := validateRequestHeaders(req.Header.Get(requester.Digest), req.Header.Get(requester.Signature), rawBody)
+		signature, seedPulse, err := ar.checkRequest(contractRequest, rawBody, req.Header.Get(requester.Digest), req.Header.Get(requester.Signature))
 		if err != nil {
 			processError(err, err.Error(), contractAnswer, insLog, traceID)
 			return
 		}
 
-		seedPulse, err := ar.checkSeed(contractRequest.Params.Seed)
-		if err != nil {
-			processError(err, err.Error(), contractAnswer, insLog, traceID)
-			return
-		}
-
-		setRootReferenceIfNeeded(contractRequest)
-
-		var result interface{}
+		var result *requester.Result
 		ch := make(chan interface{}, 1)
 		go func() {
-			result, err = ar.makeCall(ctx, *contractRequest, rawBody, signature, 0, seedPulse)
+			result, err = ar.call(ctx, *contractRequest, rawBody, signature, seedPulse)
 			ch <- nil
 		}()
 		select {
@@ -255,8 +345,8 @@ func (ar *Runner) callHandler() func(http.ResponseWriter, *http.Request) {
 				processError(err, err.Error(), contractAnswer, insLog, traceID)
 				return
 			}
-			contractResult := &requester.Result{ContractResult: result, TraceID: traceID}
-			contractAnswer.Result = contractResult
+			result.TraceID = traceID
+			contractAnswer.Result = result
 			return
 
 		case <-time.After(ar.timeout):
@@ -40,6 +40,7 @@ import (
 )
 
 const CallUrl = "http://localhost:19192/api/call"
+const BatchUrl = "http://localhost:19192/api/batch"
 
 type TimeoutSuite struct {
 	suite.Suite
@@ -108,6 +109,158 @@ func (suite *TimeoutSuite) TestRunner_callHandler_Timeout() {
 	suite.Nil(result.Result)
 }
 
+func (suite *TimeoutSuite) TestRunner_callHandler_InvalidParams() {
+	seed, err := suite.api.SeedGenerator.Next()
+	suite.NoError(err)
+	suite.api.SeedManager.Add(*seed, 0)
+	seedString := base64.StdEncoding.EncodeToString(seed[:])
+
+	resp, err := requester.SendWithSeed(
+		suite.ctx,
+		CallUrl,
+		suite.user,
+		&requester.Request{
+			JSONRPC: "2.0",
+			ID:      1,
+			Method:  "api.call",
+			Params: requester.Params{
+				CallSite:   "member.transfer",
+				CallParams: map[string]interface{}{"amount": 100, "toMemberReference": testutils.RandomRef().String()},
+				Reference:  suite.user.Caller,
+				PublicKey:  suite.user.PublicKey,
+			},
+		},
+		seedString,
+	)
+	suite.NoError(err)
+
+	var result requester.ContractAnswer
+	err = json.Unmarshal(resp, &result)
+	suite.NoError(err)
+	suite.Nil(result.Result)
+	suite.Require().NotNil(result.Error)
+	suite.Equal(ValidationError, result.Error.Code)
+	suite.Equal("callParams.amount: expected string, got number", result.Error.Message)
+}
+
+func (suite *TimeoutSuite) newSeed() string {
+	seed, err := suite.api.SeedGenerator.Next()
+	suite.NoError(err)
+	suite.api.SeedManager.Add(*seed, 0)
+	return base64.StdEncoding.EncodeToString(seed[:])
+}
+
+func (suite *TimeoutSuite) newBatchItem(seed string, id int) *requester.BatchItem {
+	item, err := requester.NewBatchItem(
+		suite.user,
+		&requester.Request{
+			JSONRPC: "2.0",
+			ID:      id,
+			Method:  "api.call",
+			Params:  requester.Params{CallSite: "member.create", CallParams: map[string]interface{}{}, PublicKey: suite.user.PublicKey},
+		},
+		seed,
+	)
+	suite.NoError(err)
+	return item
+}
+
+func (suite *TimeoutSuite) TestRunner_batchHandler() {
+	close(suite.delay)
+	suite.api.cfg.BatchTimeout = 60 * time.Second
+
+	seed := suite.newSeed()
+	broken := suite.newBatchItem(seed, 2)
+	broken.Signature = ""
+	answers, err := requester.SendBatch(BatchUrl, []*requester.BatchItem{
+		suite.newBatchItem(seed, 1),
+		broken,
+		suite.newBatchItem(seed, 3),
+	})
+	suite.NoError(err)
+	suite.Require().Len(answers, 3)
+
+	suite.Nil(answers[0].Error)
+	suite.Equal("OK", answers[0].Result.ContractResult)
+	suite.Nil(answers[1].Result)
+	suite.NotNil(answers[1].Error)
+	suite.Nil(answers[2].Error)
+	suite.Equal("OK", answers[2].Result.ContractResult)
+
+	// seed is used by the batch
+	_, err = requester.SendBatch(BatchUrl, []*requester.BatchItem{suite.newBatchItem(seed, 4)})
+	suite.Error(err)
+	suite.Contains(err.Error(), "Incorrect seed")
+}
+
+func (suite *TimeoutSuite) TestRunner_batchHandler_Seeds() {
+	close(suite.delay)
+	suite.api.cfg.BatchTimeout = 60 * time.Second
+
+	_, err := requester.SendBatch(BatchUrl, []*requester.BatchItem{
+		suite.newBatchItem(suite.newSeed(), 1),
+		suite.newBatchItem(suite.newSeed(), 2),
+	})
+	suite.Error(err)
+	suite.Contains(err.Error(), "same seed")
+
+	seed := suite.newSeed()
+	_, err = requester.SendBatch(BatchUrl, []*requester.BatchItem{
+		suite.newBatchItem(seed, 1),
+		suite.newBatchItem(seed, 1),
+	})
+	suite.Error(err)
+	suite.Contains(err.Error(), "duplicate requests")
+
+	unknown := base64.StdEncoding.EncodeToString(make([]byte, seedmanager.SeedSize))
+	_, err = requester.SendBatch(BatchUrl, []*requester.BatchItem{suite.newBatchItem(unknown, 1)})
+	suite.Error(err)
+	suite.Contains(err.Error(), "Incorrect seed")
+}
+
+func (suite *TimeoutSuite) TestRunner_batchHandler_Timeout() {
+	suite.api.cfg.BatchTimeout = 1 * time.Second
+
+	seed := suite.newSeed()
+	answers, err := requester.SendBatch(BatchUrl, []*requester.BatchItem{
+		suite.newBatchItem(seed, 1),
+		suite.newBatchItem(seed, 2),
+	})
+	suite.NoError(err)
+
+	close(suite.delay)
+
+	suite.Require().Len(answers, 2)
+	for _, answer := range answers {
+		suite.Equal("API timeout exceeded", answer.Error.Message)
+		suite.Nil(answer.Result)
+	}
+}
+
+func (suite *TimeoutSuite) TestRunner_batchHandler_SizeLimit() {
+	close(suite.delay)
+	suite.api.cfg.BatchMaxSize = 1
+	defer func() { suite.api.cfg.BatchMaxSize = 100 }()
+
+	seed := suite.newSeed()
+	_, err := requester.SendBatch(BatchUrl, []*requester.BatchItem{
+		suite.newBatchItem(seed, 1),
+		suite.newBatchItem(seed, 2),
+	})
+	suite.Error(err)
+	suite.Contains(err.Error(), "exceeds limit")
+}
+
+func (suite *TimeoutSuite) TestRunner_batchHandler_BodySizeLimit() {
+	close(suite.delay)
+	suite.api.cfg.BatchMaxBodySize = 100
+	defer func() { suite.api.cfg.BatchMaxBodySize = configuration.NewAPIRunner().BatchMaxBodySize }()
+
+	_, err := requester.SendBatch(BatchUrl, []*requester.BatchItem{suite.newBatchItem(suite.newSeed(), 1)})
+	suite.Error(err)
+	suite.Contains(err.Error(), "Can't read body")
+}
+
 func TestTimeoutSuite(t *testing.T) {
 	timeoutSuite := new(TimeoutSuite)
 	timeoutSuite.ctx, _ = inslogger.WithTraceField(context.Background(), "APItests")
@@ -34,14 +34,13 @@ import (
 	"github.com/insolar/insolar/insolar/utils"
 	"github.com/insolar/insolar/instrumentation/inslogger"
 	"github.com/insolar/insolar/logicrunner/goplugin/foundation"
-	"github.com/insolar/insolar/logicrunner/goplugin/goplugintestutils"
 	"github.com/insolar/insolar/testutils"
 )
 
-// ContractService is a service that provides ability to add custom contracts
+// ContractService is a service that provides ability to call custom contracts,
+// contracts are uploaded through DeployService
 type ContractService struct {
 	runner *Runner
-	cb     *goplugintestutils.ContractsBuilder
 }
 
 // NewContractService creates new Contract service instance.
@@ -49,54 +48,6 @@ func NewContractService(runner *Runner) *ContractService {
 	return &ContractService{runner: runner}
 }
 
-// UploadArgs is arguments that Contract.Upload accepts.
-type UploadArgs struct {
-	Code string
-	Name string
-}
-
-// UploadReply is reply that Contract.Upload returns
-type UploadReply struct {
-	PrototypeRef string `json:"PrototypeRef"`
-	TraceID      string `json:"TraceID"`
-}
-
-// Upload builds code and return prototype ref
-func (s *ContractService) Upload(r *http.Request, args *UploadArgs, reply *UploadReply) error {
-	ctx, inslog := inslogger.WithTraceField(context.Background(), utils.RandTraceID())
-	reply.TraceID = utils.TraceID(ctx)
-
-	inslog.Infof("[ ContractService.Upload ] Incoming request: %s", r.RequestURI)
-
-	if len(args.Name) == 0 {
-		return errors.New("params.name is missing")
-	}
-
-	if len(args.Code) == 0 {
-		return errors.New("params.code is missing")
-	}
-
-	if s.cb == nil {
-		insgocc, err := goplugintestutils.BuildPreprocessor()
-		if err != nil {
-			inslog.Infof("[ ContractService.Upload ] can't build preprocessor %#v", err)
-			return errors.Wrap(err, "can't build preprocessor")
-		}
-		s.cb = goplugintestutils.NewContractBuilder(s.runner.ArtifactManager, insgocc, s.runner.PulseAccessor)
-	}
-
-	contractMap := make(map[string]string)
-	contractMap[args.Name] = args.Code
-
-	err := s.cb.Build(ctx, contractMap)
-	if err != nil {
-		return errors.Wrap(err, "can't build contract")
-	}
-	reference := *s.cb.Prototypes[args.Name]
-	reply.PrototypeRef = reference.String()
-	return nil
-}
-
 // CallConstructorArgs is arguments that Contract.CallConstructor accepts.
 type CallConstructorArgs struct {
 	PrototypeRefString string
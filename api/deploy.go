@@ -0,0 +1,169 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/utils"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/logicrunner/deploy"
+)
+
+// DeployService is a service that deploys contracts uploaded by members.
+type DeployService struct {
+	runner *Runner
+
+	once     sync.Once
+	deployer *deploy.Deployer
+}
+
+// NewDeployService creates new Deploy service instance.
+func NewDeployService(runner *Runner) *DeployService {
+	return &DeployService{runner: runner}
+}
+
+// memberKeys provides public keys of members from their contracts.
+type memberKeys struct {
+	runner *Runner
+}
+
+func (k memberKeys) PublicKey(ctx context.Context, member insolar.Reference) (string, error) {
+	return k.runner.fetchMemberPubKey(ctx, member)
+}
+
+// getDeployer creates deployer on first use, components are injected into runner after the service is created.
+func (s *DeployService) getDeployer() *deploy.Deployer {
+	s.once.Do(func() {
+		cfg := s.runner.cfg.Deploy
+		var builder deploy.Builder
+		if cfg.BuilderURL != "" {
+			builder = deploy.NewHTTPBuilder(cfg.BuilderURL, cfg.BuildTimeout)
+		}
+		s.deployer = deploy.NewDeployer(cfg, s.runner.ArtifactManager, s.runner.PulseAccessor, memberKeys{runner: s.runner}, builder)
+	})
+	return s.deployer
+}
+
+// DeployArgs is arguments that Deploy.Upload accepts.
+type DeployArgs struct {
+	Name    string
+	Version string
+	// Member is a reference of uploading member.
+	Member string
+	// MachineType is "goplugin" or "wasm".
+	MachineType string
+	// Code is pre-built wasm code, it's exclusive with Source.
+	Code []byte
+	// Source is compiled by the builder service.
+	Source []byte
+	// Signature is a signature of deployment by member key, see deploy.Request.SignedData.
+	Signature string
+}
+
+// DeployReply is reply that Deploy.Upload returns.
+type DeployReply struct {
+	PrototypeRef string `json:"PrototypeRef"`
+	CodeRef      string `json:"CodeRef"`
+	Version      string `json:"Version"`
+	TraceID      string `json:"TraceID"`
+}
+
+// Upload verifies signed code or sources of contract and deploys them as a new version of the contract.
+func (s *DeployService) Upload(r *http.Request, args *DeployArgs, reply *DeployReply) error {
+	ctx, inslog := inslogger.WithTraceField(context.Background(), utils.RandTraceID())
+	reply.TraceID = utils.TraceID(ctx)
+
+	inslog.Infof("[ DeployService.Upload ] Incoming request: %s", r.RequestURI)
+
+	member, err := insolar.NewReferenceFromBase58(args.Member)
+	if err != nil {
+		return errors.Wrap(err, "[ DeployService.Upload ] failed to parse params.member")
+	}
+	machineType, err := deploy.ParseMachineType(args.MachineType)
+	if err != nil {
+		return errors.Wrap(err, "[ DeployService.Upload ] bad params.machineType")
+	}
+
+	deployment, err := s.getDeployer().Deploy(ctx, &deploy.Request{
+		Name:        args.Name,
+		Version:     args.Version,
+		Member:      *member,
+		MachineType: machineType,
+		Code:        args.Code,
+		Source:      args.Source,
+		Signature:   args.Signature,
+	})
+	if err != nil {
+		return errors.Wrap(err, "[ DeployService.Upload ]")
+	}
+
+	reply.PrototypeRef = deployment.Prototype.String()
+	reply.CodeRef = deployment.Code.String()
+	reply.Version = deployment.Version
+	return nil
+}
+
+// VersionsArgs is arguments that Deploy.Versions accepts.
+type VersionsArgs struct {
+	Name string
+}
+
+// VersionsReply is reply that Deploy.Versions returns.
+type VersionsReply struct {
+	Versions []DeployedVersion `json:"Versions"`
+}
+
+// DeployedVersion is a deployed version of contract.
+type DeployedVersion struct {
+	Version      string `json:"Version"`
+	Member       string `json:"Member"`
+	PrototypeRef string `json:"PrototypeRef"`
+	CodeRef      string `json:"CodeRef"`
+}
+
+// Versions returns deployed versions of contract, the latest one is the last.
+func (s *DeployService) Versions(r *http.Request, args *VersionsArgs, reply *VersionsReply) error {
+	ctx, inslog := inslogger.WithTraceField(context.Background(), utils.RandTraceID())
+
+	inslog.Infof("[ DeployService.Versions ] Incoming request: %s", r.RequestURI)
+
+	if len(args.Name) == 0 {
+		return errors.New("params.name is missing")
+	}
+
+	deployments, err := s.getDeployer().Versions(ctx, args.Name)
+	if err != nil {
+		return errors.Wrap(err, "[ DeployService.Versions ]")
+	}
+
+	reply.Versions = []DeployedVersion{}
+	for _, d := range deployments {
+		reply.Versions = append(reply.Versions, DeployedVersion{
+			Version:      d.Version,
+			Member:       d.Member.String(),
+			PrototypeRef: d.Prototype.String(),
+			CodeRef:      d.Code.String(),
+		})
+	}
+	return nil
+}
@@ -18,10 +18,12 @@ package api
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 
 	"github.com/pkg/errors"
 
+	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/insolar/utils"
 	"github.com/insolar/insolar/instrumentation/inslogger"
 )
@@ -146,3 +148,58 @@ func (s *InfoService) GetInfo(r *http.Request, args *InfoArgs, reply *InfoReply)
 
 	return nil
 }
+
+// CloudHashArgs is arguments that GetCloudHash accepts.
+type CloudHashArgs struct {
+	PulseNumber insolar.PulseNumber `json:"pulseNumber"`
+}
+
+// CloudHashReply is reply for GetCloudHash requests.
+type CloudHashReply struct {
+	PulseNumber insolar.PulseNumber `json:"pulseNumber"`
+	CloudHash   []byte              `json:"cloudHash"`
+	TraceID     string              `json:"traceID"`
+}
+
+// GetCloudHash returns cloud hash agreed by the network in the given pulse.
+//
+//   Request structure:
+//   {
+//     "jsonrpc": "2.0",
+//     "method": "network.getCloudHash",
+//     "id": str|int|null
+//     "params": {
+//       "pulseNumber": int // pulse number
+//     }
+//   }
+//
+//     Response structure:
+// 	{
+// 		"jsonrpc": "2.0",
+// 		"result": {
+// 			"pulseNumber": int, // pulse number
+// 			"cloudHash": str, // base64 encoded cloud hash
+// 			"traceID": str // traceID for request
+// 		},
+// 		"id": str|int|null // same as in request
+// 	}
+//
+func (s *InfoService) GetCloudHash(r *http.Request, args *CloudHashArgs, reply *CloudHashReply) error {
+	traceID := utils.RandTraceID()
+	ctx, inslog := inslogger.WithTraceField(context.Background(), traceID)
+
+	inslog.Infof("[ INFO ] Incoming request: %s", r.RequestURI)
+
+	cloudHash, err := s.runner.CloudHashAccessor.ForPulseNumber(ctx, args.PulseNumber)
+	if err != nil {
+		msg := fmt.Sprintf("[ INFO ] Can't get cloud hash for pulse %v", args.PulseNumber)
+		inslog.Error(errors.Wrap(err, msg))
+		return errors.Wrap(err, msg)
+	}
+
+	reply.PulseNumber = args.PulseNumber
+	reply.CloudHash = cloudHash
+	reply.TraceID = traceID
+
+	return nil
+}
@@ -26,6 +26,7 @@ import (
 	"time"
 
 	"github.com/insolar/insolar/network"
+	"github.com/insolar/insolar/network/storage"
 
 	"github.com/insolar/insolar/application/extractor"
 	"github.com/insolar/insolar/insolar/jet"
@@ -35,6 +36,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/insolar/insolar/api/seedmanager"
+	"github.com/insolar/insolar/api/subscription"
 
 	"github.com/insolar/insolar/configuration"
 	"github.com/insolar/insolar/insolar"
@@ -55,8 +57,12 @@ type Runner struct {
 	PulseAccessor       pulse.Accessor              `inject:""`
 	ArtifactManager     artifacts.Client            `inject:""`
 	JetCoordinator      jet.Coordinator             `inject:""`
+	CloudHashAccessor   storage.CloudHashAccessor   `inject:""`
+	TerminationHandler  insolar.TerminationHandler  `inject:""`
+	Subscriber          subscription.Subscriber     `inject:""`
 	server              *http.Server
 	rpcServer           *rpc.Server
+	services            []rpcService
 	cfg                 *configuration.APIRunner
 	keyCache            map[string]crypto.PublicKey
 	cacheLock           *sync.RWMutex
@@ -82,27 +88,47 @@ func checkConfig(cfg *configuration.APIRunner) error {
 	return nil
 }
 
-func (ar *Runner) registerServices(rpcServer *rpc.Server) error {
-	err := rpcServer.RegisterService(NewNodeService(ar), "node")
+func (ar *Runner) registerServices() error {
+	err := ar.registerService(NewNodeService(ar), "node")
 	if err != nil {
 		return errors.Wrap(err, "[ registerServices ] Can't RegisterService: node")
 	}
 
-	err = rpcServer.RegisterService(NewInfoService(ar), "network")
+	err = ar.registerService(NewInfoService(ar), "network")
 	if err != nil {
 		return errors.Wrap(err, "[ registerServices ] Can't RegisterService: network")
 	}
 
-	err = rpcServer.RegisterService(NewNodeCertService(ar), "cert")
+	err = ar.registerService(NewNodeCertService(ar), "cert")
 	if err != nil {
 		return errors.Wrap(err, "[ registerServices ] Can't RegisterService: cert")
 	}
 
-	err = rpcServer.RegisterService(NewContractService(ar), "contract")
+	err = ar.registerService(NewContractService(ar), "contract")
 	if err != nil {
 		return errors.Wrap(err, "[ registerServices ] Can't RegisterService: contract")
 	}
 
+	err = ar.registerService(NewRequestService(ar), "request")
+	if err != nil {
+		return errors.Wrap(err, "[ registerServices ] Can't RegisterService: request")
+	}
+
+	err = ar.registerService(NewDeployService(ar), "deploy")
+	if err != nil {
+		return errors.Wrap(err, "[ registerServices ] Can't RegisterService: deploy")
+	}
+
+	return nil
+}
+
+// registerService registers service in JSON-RPC server and keeps it for OpenAPI document.
+func (ar *Runner) registerService(service interface{}, name string) error {
+	err := ar.rpcServer.RegisterService(service, name)
+	if err != nil {
+		return err
+	}
+	ar.services = append(ar.services, rpcService{name: name, service: service})
 	return nil
 }
 
@@ -126,13 +152,23 @@ func NewRunner(cfg *configuration.APIRunner) (*Runner, error) {
 
 	rpcServer.RegisterCodec(jsonrpc.NewCodec(), "application/json")
 
-	if err := ar.registerServices(rpcServer); err != nil {
+	if err := ar.registerServices(); err != nil {
 		return nil, errors.Wrap(err, "[ NewAPIRunner ] Can't register services:")
 	}
 
 	return &ar, nil
 }
 
+// RegisterService registers additional JSON-RPC service provided by role specific components (e.g. exporter on heavy).
+// Should be called before Start.
+func (ar *Runner) RegisterService(service interface{}, name string) error {
+	err := ar.registerService(service, name)
+	if err != nil {
+		return errors.Wrapf(err, "[ RegisterService ] Can't RegisterService: %s", name)
+	}
+	return nil
+}
+
 // IsAPIRunner is implementation of APIRunner interface for component manager
 func (ar *Runner) IsAPIRunner() bool {
 	return true
@@ -149,6 +185,19 @@ func (ar *Runner) Start(ctx context.Context) error {
 	router.HandleFunc("/healthcheck", hc.CheckHandler)
 	router.HandleFunc(ar.cfg.Call, ar.callHandler())
 	router.Handle(ar.cfg.RPC, ar.rpcServer)
+	if ar.cfg.BatchCall != "" {
+		router.HandleFunc(ar.cfg.BatchCall, ar.batchHandler())
+	}
+	if ar.cfg.WebSocket != "" && ar.Subscriber != nil {
+		router.Handle(ar.cfg.WebSocket, ar.wsHandler())
+	}
+	if ar.cfg.OpenAPI != "" {
+		handler, err := ar.openAPIHandler()
+		if err != nil {
+			return errors.Wrap(err, "Can't build OpenAPI document")
+		}
+		router.HandleFunc(ar.cfg.OpenAPI, handler)
+	}
 
 	inslog := inslogger.FromContext(ctx)
 	inslog.Info("Starting ApiRunner ...")
@@ -192,14 +241,9 @@ func (ar *Runner) getMemberPubKey(ctx context.Context, ref string) (crypto.Publi
 	if err != nil {
 		return nil, errors.Wrap(err, "[ getMemberPubKey ] Can't parse ref")
 	}
-	res, err := ar.ContractRequester.SendRequest(ctx, reference, "GetPublicKey", []interface{}{})
-	if err != nil {
-		return nil, errors.Wrap(err, "[ getMemberPubKey ] Can't get public key")
-	}
-
-	publicKeyString, err := extractor.PublicKeyResponse(res.(*reply.CallMethod).Result)
+	publicKeyString, err := ar.fetchMemberPubKey(ctx, *reference)
 	if err != nil {
-		return nil, errors.Wrap(err, "[ getMemberPubKey ] Can't extract response")
+		return nil, errors.Wrap(err, "[ getMemberPubKey ]")
 	}
 
 	kp := platformpolicy.NewKeyProcessor()
@@ -213,3 +257,17 @@ func (ar *Runner) getMemberPubKey(ctx context.Context, ref string) (crypto.Publi
 	ar.cacheLock.Unlock()
 	return publicKey, nil
 }
+
+// fetchMemberPubKey requests public key of member in PEM format from its contract.
+func (ar *Runner) fetchMemberPubKey(ctx context.Context, member insolar.Reference) (string, error) {
+	res, err := ar.ContractRequester.SendRequest(ctx, &member, "GetPublicKey", []interface{}{})
+	if err != nil {
+		return "", errors.Wrap(err, "Can't get public key")
+	}
+
+	publicKeyString, err := extractor.PublicKeyResponse(res.(*reply.CallMethod).Result)
+	if err != nil {
+		return "", errors.Wrap(err, "Can't extract response")
+	}
+	return publicKeyString, nil
+}
@@ -0,0 +1,388 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"encoding"
+	"encoding/json"
+	"net/http"
+	"path"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/api/requester"
+	"github.com/insolar/insolar/application/callsite"
+	"github.com/insolar/insolar/version"
+)
+
+// OpenAPI document objects, only used fields are declared.
+type openAPIDocument struct {
+	OpenAPI    string                 `json:"openapi"`
+	Info       openAPIInfo            `json:"info"`
+	Paths      map[string]openAPIPath `json:"paths"`
+	Components openAPIComponents      `json:"components"`
+}
+
+type openAPIInfo struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Version     string `json:"version"`
+}
+
+type openAPIPath struct {
+	Post openAPIOperation `json:"post"`
+}
+
+type openAPIOperation struct {
+	OperationID string                     `json:"operationId"`
+	Summary     string                     `json:"summary,omitempty"`
+	Tags        []string                   `json:"tags,omitempty"`
+	Parameters  []openAPIParameter         `json:"parameters,omitempty"`
+	RequestBody openAPIBody                `json:"requestBody"`
+	Responses   map[string]openAPIResponse `json:"responses"`
+}
+
+type openAPIParameter struct {
+	Name        string           `json:"name"`
+	In          string           `json:"in"`
+	Description string           `json:"description,omitempty"`
+	Required    bool             `json:"required"`
+	Schema      *callsite.Schema `json:"schema"`
+}
+
+type openAPIBody struct {
+	Required bool                        `json:"required,omitempty"`
+	Content  map[string]openAPIMediaType `json:"content"`
+}
+
+type openAPIResponse struct {
+	Description string                      `json:"description"`
+	Content     map[string]openAPIMediaType `json:"content"`
+}
+
+type openAPIMediaType struct {
+	Schema *callsite.Schema `json:"schema"`
+}
+
+type openAPIComponents struct {
+	Schemas map[string]*callsite.Schema `json:"schemas"`
+}
+
+// rpcService is JSON-RPC service registered in Runner, it's kept for OpenAPI document.
+type rpcService struct {
+	name    string
+	service interface{}
+}
+
+var (
+	typeOfHTTPRequest   = reflect.TypeOf((*http.Request)(nil))
+	typeOfError         = reflect.TypeOf((*error)(nil)).Elem()
+	typeOfTime          = reflect.TypeOf(time.Time{})
+	typeOfTextMarshaler = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	typeOfJSONMarshaler = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+)
+
+func ref(name string) *callsite.Schema {
+	return &callsite.Schema{Ref: "#/components/schemas/" + name}
+}
+
+func jsonBody(schema *callsite.Schema) map[string]openAPIMediaType {
+	return map[string]openAPIMediaType{"application/json": {Schema: schema}}
+}
+
+func constant(value string) *callsite.Schema {
+	return &callsite.Schema{Type: callsite.TypeString, Enum: []string{value}}
+}
+
+// schemaBuilder collects schemas of Go types in components of document.
+type schemaBuilder struct {
+	schemas map[string]*callsite.Schema
+	names   map[reflect.Type]string
+}
+
+// schemaOf returns schema of type the way encoding/json marshals it.
+func (b *schemaBuilder) schemaOf(t reflect.Type) *callsite.Schema {
+	if t.Kind() == reflect.Ptr {
+		s := *b.schemaOf(t.Elem())
+		s.Nullable = true
+		return &s
+	}
+	if t == typeOfTime {
+		return &callsite.Schema{Type: callsite.TypeString, Format: "date-time"}
+	}
+	if t.Implements(typeOfJSONMarshaler) {
+		return &callsite.Schema{}
+	}
+	if t.Implements(typeOfTextMarshaler) {
+		return &callsite.Schema{Type: callsite.TypeString}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &callsite.Schema{Type: callsite.TypeBoolean}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &callsite.Schema{Type: callsite.TypeInteger}
+	case reflect.Float32, reflect.Float64:
+		return &callsite.Schema{Type: callsite.TypeNumber}
+	case reflect.String:
+		return &callsite.Schema{Type: callsite.TypeString}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &callsite.Schema{Type: callsite.TypeString, Format: "byte"}
+		}
+		return &callsite.Schema{Type: callsite.TypeArray, Items: b.schemaOf(t.Elem())}
+	case reflect.Map:
+		return &callsite.Schema{Type: callsite.TypeObject}
+	case reflect.Struct:
+		return b.structSchema(t)
+	default:
+		return &callsite.Schema{}
+	}
+}
+
+// structSchema adds named struct to components and returns reference to it.
+func (b *schemaBuilder) structSchema(t reflect.Type) *callsite.Schema {
+	if t.Name() == "" {
+		return b.fieldsSchema(t)
+	}
+	if name, ok := b.names[t]; ok {
+		return ref(name)
+	}
+
+	name := t.Name()
+	if _, exists := b.schemas[name]; exists {
+		name = path.Base(t.PkgPath()) + "." + name
+	}
+	// name is taken before fields are processed for recursive types
+	b.names[t] = name
+	b.schemas[name] = &callsite.Schema{}
+	*b.schemas[name] = *b.fieldsSchema(t)
+	return ref(name)
+}
+
+func (b *schemaBuilder) fieldsSchema(t reflect.Type) *callsite.Schema {
+	s := &callsite.Schema{Type: callsite.TypeObject, Properties: map[string]*callsite.Schema{}}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" && !field.Anonymous {
+			continue
+		}
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+		if field.Anonymous && name == "" && field.Type.Kind() == reflect.Struct {
+			embedded := b.fieldsSchema(field.Type)
+			for n, p := range embedded.Properties {
+				s.Properties[n] = p
+			}
+			continue
+		}
+		if field.PkgPath != "" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		s.Properties[name] = b.schemaOf(field.Type)
+	}
+	return s
+}
+
+func callRequestSchema(name string, cs callsite.CallSite) *callsite.Schema {
+	params := &callsite.Schema{
+		Type: callsite.TypeObject,
+		Properties: map[string]*callsite.Schema{
+			"seed":      {Type: callsite.TypeString, Description: "Seed from node.getSeed.", Format: "byte"},
+			"callSite":  constant(name),
+			"reference": {Type: callsite.TypeString, Description: "Reference of caller member."},
+			"publicKey": {Type: callsite.TypeString, Description: "Public key of caller in PEM format."},
+		},
+		Required: []string{"seed", "callSite", "publicKey"},
+	}
+	if cs.Params != nil {
+		params.Properties["callParams"] = ref(name + ".Params")
+		params.Required = append(params.Required, "callParams")
+	}
+	if !cs.SelfSigned {
+		params.Required = append(params.Required, "reference")
+	}
+
+	return &callsite.Schema{
+		Type: callsite.TypeObject,
+		Properties: map[string]*callsite.Schema{
+			"jsonrpc":  constant("2.0"),
+			"id":       {Type: callsite.TypeInteger},
+			"method":   {Type: callsite.TypeString, Enum: []string{CallMethod, CallAsyncMethod}},
+			"params":   params,
+			"logLevel": {Type: callsite.TypeString, Description: "Log level for processing of the request."},
+		},
+		Required: []string{"jsonrpc", "method", "params"},
+	}
+}
+
+func callResponseSchema(name string) *callsite.Schema {
+	return &callsite.Schema{
+		Type: callsite.TypeObject,
+		Properties: map[string]*callsite.Schema{
+			"jsonrpc": constant("2.0"),
+			"id":      {Type: callsite.TypeInteger},
+			"result": {
+				Type: callsite.TypeObject,
+				Properties: map[string]*callsite.Schema{
+					"callResult":       ref(name + ".Result"),
+					"requestReference": {Type: callsite.TypeString, Description: "Reference of request, returned by " + CallAsyncMethod + "."},
+					"traceID":          {Type: callsite.TypeString},
+				},
+			},
+			"error": ref("Error"),
+		},
+	}
+}
+
+func rpcRequestSchema(method string, params *callsite.Schema) *callsite.Schema {
+	return &callsite.Schema{
+		Type: callsite.TypeObject,
+		Properties: map[string]*callsite.Schema{
+			"jsonrpc": constant("2.0"),
+			"id":      {Type: callsite.TypeInteger},
+			"method":  constant(method),
+			"params":  params,
+		},
+		Required: []string{"jsonrpc", "method"},
+	}
+}
+
+func rpcResponseSchema(result *callsite.Schema) *callsite.Schema {
+	return &callsite.Schema{
+		Type: callsite.TypeObject,
+		Properties: map[string]*callsite.Schema{
+			"jsonrpc": constant("2.0"),
+			"id":      {Type: callsite.TypeInteger},
+			"result":  result,
+			"error": {
+				Type: callsite.TypeObject,
+				Properties: map[string]*callsite.Schema{
+					"code":    {Type: callsite.TypeInteger},
+					"message": {Type: callsite.TypeString},
+					"data":    {},
+				},
+			},
+		},
+	}
+}
+
+// rpcMethods returns methods of service which are exported by JSON-RPC server.
+func rpcMethods(service interface{}) []reflect.Method {
+	var methods []reflect.Method
+	t := reflect.TypeOf(service)
+	for i := 0; i < t.NumMethod(); i++ {
+		m := t.Method(i)
+		mt := m.Type
+		if mt.NumIn() != 4 || mt.NumOut() != 1 || mt.In(1) != typeOfHTTPRequest ||
+			mt.In(2).Kind() != reflect.Ptr || mt.In(3).Kind() != reflect.Ptr || mt.Out(0) != typeOfError {
+			continue
+		}
+		methods = append(methods, m)
+	}
+	return methods
+}
+
+// openAPI builds OpenAPI 3 document for call and rpc endpoints.
+// Every call site and rpc method is described by path with fragment, e.g. /api/call#member.transfer.
+func (ar *Runner) openAPI() *openAPIDocument {
+	b := &schemaBuilder{
+		schemas: map[string]*callsite.Schema{},
+		names:   map[reflect.Type]string{},
+	}
+	doc := &openAPIDocument{
+		OpenAPI: "3.0.0",
+		Info: openAPIInfo{
+			Title:       "Insolar API",
+			Description: "Signed calls of member contract and JSON-RPC methods of node.",
+			Version:     version.Version,
+		},
+		Paths: map[string]openAPIPath{},
+	}
+	b.schemas["Error"] = b.fieldsSchema(reflect.TypeOf(requester.Error{}))
+
+	for _, name := range callsite.Names() {
+		cs, _ := callsite.Get(name)
+		if cs.Params != nil {
+			b.schemas[name+".Params"] = cs.Params
+		}
+		b.schemas[name+".Result"] = cs.Result
+
+		doc.Paths[ar.cfg.Call+"#"+name] = openAPIPath{Post: openAPIOperation{
+			OperationID: name,
+			Summary:     cs.Description,
+			Tags:        []string{"call"},
+			Parameters: []openAPIParameter{
+				{
+					Name: requester.Digest, In: "header", Required: true,
+					Description: "SHA-256 of request body: SHA-256=<base64 hash>.",
+					Schema:      &callsite.Schema{Type: callsite.TypeString},
+				},
+				{
+					Name: requester.Signature, In: "header", Required: true,
+					Description: "Signature of digest: keyId=\"member-pub-key\", algorithm=\"ecdsa\", headers=\"digest\", signature=<base64 signature>.",
+					Schema:      &callsite.Schema{Type: callsite.TypeString},
+				},
+			},
+			RequestBody: openAPIBody{Required: true, Content: jsonBody(callRequestSchema(name, cs))},
+			Responses: map[string]openAPIResponse{
+				"200": {Description: "Result of the call.", Content: jsonBody(callResponseSchema(name))},
+			},
+		}}
+	}
+
+	for _, s := range ar.services {
+		for _, m := range rpcMethods(s.service) {
+			method := s.name + "." + strings.ToLower(m.Name[:1]) + m.Name[1:]
+			params := b.schemaOf(m.Type.In(2).Elem())
+			result := b.schemaOf(m.Type.In(3).Elem())
+
+			doc.Paths[ar.cfg.RPC+"#"+method] = openAPIPath{Post: openAPIOperation{
+				OperationID: method,
+				Tags:        []string{s.name},
+				RequestBody: openAPIBody{Required: true, Content: jsonBody(rpcRequestSchema(method, params))},
+				Responses: map[string]openAPIResponse{
+					"200": {Description: "Result of the method.", Content: jsonBody(rpcResponseSchema(result))},
+				},
+			}}
+		}
+	}
+
+	doc.Components.Schemas = b.schemas
+	return doc
+}
+
+func (ar *Runner) openAPIHandler() (func(http.ResponseWriter, *http.Request), error) {
+	doc, err := json.MarshalIndent(ar.openAPI(), "", "  ")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal OpenAPI document")
+	}
+	return func(response http.ResponseWriter, req *http.Request) {
+		response.Header().Add("Content-Type", "application/json")
+		_, _ = response.Write(doc)
+	}, nil
+}
@@ -0,0 +1,88 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/configuration"
+)
+
+// collectRefs returns all $ref values of JSON document.
+func collectRefs(v interface{}, refs map[string]bool) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if ref, ok := value.(string); ok && key == "$ref" {
+				refs[ref] = true
+				continue
+			}
+			collectRefs(value, refs)
+		}
+	case []interface{}:
+		for _, value := range v {
+			collectRefs(value, refs)
+		}
+	}
+}
+
+func TestRunner_openAPI(t *testing.T) {
+	cfg := configuration.NewAPIRunner()
+	ar, err := NewRunner(&cfg)
+	require.NoError(t, err)
+
+	data, err := json.Marshal(ar.openAPI())
+	require.NoError(t, err)
+	var doc struct {
+		OpenAPI    string                            `json:"openapi"`
+		Paths      map[string]map[string]interface{} `json:"paths"`
+		Components struct {
+			Schemas map[string]interface{} `json:"schemas"`
+		} `json:"components"`
+	}
+	require.NoError(t, json.Unmarshal(data, &doc))
+
+	require.Equal(t, "3.0.0", doc.OpenAPI)
+	for _, path := range []string{
+		"/api/call#member.transfer",
+		"/api/call#wallet.getBalance",
+		"/api/rpc#node.getSeed",
+		"/api/rpc#network.getInfo",
+		"/api/rpc#request.getStatus",
+	} {
+		require.Contains(t, doc.Paths, path)
+		require.Contains(t, doc.Paths[path], "post")
+	}
+	require.Contains(t, doc.Components.Schemas, "member.transfer.Params")
+	require.Contains(t, doc.Components.Schemas, "member.transfer.Result")
+	require.Contains(t, doc.Components.Schemas, "SeedReply")
+	require.Contains(t, doc.Components.Schemas, "Error")
+
+	var raw interface{}
+	require.NoError(t, json.Unmarshal(data, &raw))
+	refs := map[string]bool{}
+	collectRefs(raw, refs)
+	require.NotEmpty(t, refs)
+	for ref := range refs {
+		name := strings.TrimPrefix(ref, "#/components/schemas/")
+		require.Contains(t, doc.Components.Schemas, name, "unresolved reference %s", ref)
+	}
+}
@@ -0,0 +1,143 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/application/extractor"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/reply"
+	"github.com/insolar/insolar/insolar/utils"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+)
+
+// RequestStatusArgs is arguments that Request service accepts.
+type RequestStatusArgs struct {
+	// Reference is a reference of incoming request returned by api.callAsync.
+	Reference string `json:"reference"`
+	// Object is a reference of called object, it's required to check request on ledger
+	// if the request isn't tracked by this node.
+	Object string `json:"object"`
+}
+
+// RequestStatusReply is reply for Request service requests.
+type RequestStatusReply struct {
+	Status  insolar.RequestStatus `json:"status"`
+	Result  interface{}           `json:"callResult,omitempty"`
+	Error   string                `json:"error,omitempty"`
+	TraceID string                `json:"traceID"`
+}
+
+// RequestService is a service that reports status of requests sent by api.callAsync.
+type RequestService struct {
+	runner *Runner
+}
+
+// NewRequestService creates new Request service instance.
+func NewRequestService(runner *Runner) *RequestService {
+	return &RequestService{runner: runner}
+}
+
+// GetStatus returns status of the request: pending, done or failed with result of the call.
+// Node which accepted the request knows its result, other nodes read the result from ledger.
+//
+//   Request structure:
+//   {
+//     "jsonrpc": "2.0",
+//     "method": "request.getStatus",
+//     "id": str|int|null,
+//     "params": { "reference": str, "object": str }
+//   }
+func (s *RequestService) GetStatus(r *http.Request, args *RequestStatusArgs, reply *RequestStatusReply) error {
+	traceID := utils.RandTraceID()
+	ctx, inslog := inslogger.WithTraceField(context.Background(), traceID)
+
+	inslog.Infof("[ RequestService.GetStatus ] Incoming request: %s", r.RequestURI)
+
+	requestRef, err := insolar.NewReferenceFromBase58(args.Reference)
+	if err != nil {
+		return errors.Wrap(err, "[ RequestService.GetStatus ] failed to parse args.Reference")
+	}
+	reply.TraceID = traceID
+
+	result, ok := s.runner.ContractRequester.GetRequestResult(ctx, *requestRef)
+	if ok {
+		return fillRequestStatus(result, reply)
+	}
+
+	if args.Object == "" {
+		return errors.New("[ RequestService.GetStatus ] request isn't tracked by this node, object is required to check it on ledger")
+	}
+	objectRef, err := insolar.NewReferenceFromBase58(args.Object)
+	if err != nil {
+		return errors.Wrap(err, "[ RequestService.GetStatus ] failed to parse args.Object")
+	}
+	err = s.fillLedgerStatus(ctx, *objectRef, *requestRef, reply)
+	if err != nil {
+		return errors.Wrap(err, "[ RequestService.GetStatus ]")
+	}
+	return nil
+}
+
+func fillRequestStatus(result *insolar.RequestResult, statusReply *RequestStatusReply) error {
+	statusReply.Status = result.Status
+	statusReply.Error = result.Error
+	if result.Status != insolar.RequestStatusDone {
+		return nil
+	}
+
+	callReply, ok := result.Reply.(*reply.CallMethod)
+	if !ok {
+		return errors.Errorf("[ RequestService.GetStatus ] unexpected reply %T", result.Reply)
+	}
+	return fillCallResult(callReply.Result, statusReply)
+}
+
+// fillCallResult extracts result of the contract call, contract errors mark the request as failed.
+func fillCallResult(data []byte, statusReply *RequestStatusReply) error {
+	contractResult, contractErr, err := extractor.CallResponse(data)
+	if err != nil {
+		return errors.Wrap(err, "[ RequestService.GetStatus ] Can't extract response")
+	}
+	if contractErr != nil {
+		statusReply.Status = insolar.RequestStatusFailed
+		statusReply.Error = contractErr.S
+		return nil
+	}
+	statusReply.Status = insolar.RequestStatusDone
+	statusReply.Result = contractResult
+	return nil
+}
+
+// fillLedgerStatus reads result of the request from ledger, request without result is still pending.
+func (s *RequestService) fillLedgerStatus(
+	ctx context.Context, object, request insolar.Reference, statusReply *RequestStatusReply,
+) error {
+	result, err := s.runner.ArtifactManager.GetResult(ctx, object, request)
+	if err != nil {
+		return errors.Wrap(err, "failed to get result")
+	}
+	if result == nil {
+		statusReply.Status = insolar.RequestStatusPending
+		return nil
+	}
+	return fillCallResult(result.Payload, statusReply)
+}
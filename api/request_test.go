@@ -0,0 +1,174 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/gojuno/minimock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/insolar/reply"
+	"github.com/insolar/insolar/logicrunner/artifacts"
+	"github.com/insolar/insolar/logicrunner/goplugin/foundation"
+	"github.com/insolar/insolar/testutils"
+)
+
+func newCallReply(t *testing.T, result interface{}, contractErr *foundation.Error) *reply.CallMethod {
+	data, err := insolar.MarshalArgs(result, contractErr)
+	require.NoError(t, err)
+	return &reply.CallMethod{Result: data}
+}
+
+func TestRequestService_GetStatus_Tracked(t *testing.T) {
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	requestRef := gen.Reference()
+	table := []struct {
+		name   string
+		result *insolar.RequestResult
+		reply  RequestStatusReply
+	}{
+		{
+			name:   "pending",
+			result: &insolar.RequestResult{Status: insolar.RequestStatusPending},
+			reply:  RequestStatusReply{Status: insolar.RequestStatusPending},
+		},
+		{
+			name:   "done",
+			result: &insolar.RequestResult{Status: insolar.RequestStatusDone, Reply: newCallReply(t, "OK", nil)},
+			reply:  RequestStatusReply{Status: insolar.RequestStatusDone, Result: "OK"},
+		},
+		{
+			name:   "contract error",
+			result: &insolar.RequestResult{Status: insolar.RequestStatusDone, Reply: newCallReply(t, nil, &foundation.Error{S: "not enough balance"})},
+			reply:  RequestStatusReply{Status: insolar.RequestStatusFailed, Error: "not enough balance"},
+		},
+		{
+			name:   "failed",
+			result: &insolar.RequestResult{Status: insolar.RequestStatusFailed, Error: "execution failed"},
+			reply:  RequestStatusReply{Status: insolar.RequestStatusFailed, Error: "execution failed"},
+		},
+	}
+
+	for _, test := range table {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			cr := testutils.NewContractRequesterMock(mc)
+			cr.GetRequestResultFunc = func(_ context.Context, ref insolar.Reference) (*insolar.RequestResult, bool) {
+				require.Equal(t, requestRef, ref)
+				return test.result, true
+			}
+
+			var reply RequestStatusReply
+			err := NewRequestService(&Runner{ContractRequester: cr}).GetStatus(
+				&http.Request{}, &RequestStatusArgs{Reference: requestRef.String()}, &reply,
+			)
+			require.NoError(t, err)
+			require.NotEmpty(t, reply.TraceID)
+			reply.TraceID = ""
+			require.Equal(t, test.reply, reply)
+		})
+	}
+}
+
+func TestRequestService_GetStatus_Ledger(t *testing.T) {
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	requestRef := gen.Reference()
+	objectRef := gen.Reference()
+
+	table := []struct {
+		name      string
+		result    *record.Result
+		resultErr error
+		reply     RequestStatusReply
+		err       bool
+	}{
+		{
+			name:  "pending",
+			reply: RequestStatusReply{Status: insolar.RequestStatusPending},
+		},
+		{
+			name:   "done",
+			result: &record.Result{Payload: newCallReply(t, "OK", nil).Result},
+			reply:  RequestStatusReply{Status: insolar.RequestStatusDone, Result: "OK"},
+		},
+		{
+			name:   "contract error",
+			result: &record.Result{Payload: newCallReply(t, nil, &foundation.Error{S: "not enough balance"}).Result},
+			reply:  RequestStatusReply{Status: insolar.RequestStatusFailed, Error: "not enough balance"},
+		},
+		{
+			name:      "not found",
+			resultErr: artifacts.ErrNotFound,
+			err:       true,
+		},
+	}
+
+	for _, test := range table {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			cr := testutils.NewContractRequesterMock(mc)
+			cr.GetRequestResultFunc = func(context.Context, insolar.Reference) (*insolar.RequestResult, bool) {
+				return nil, false
+			}
+			am := artifacts.NewClientMock(mc)
+			am.GetResultFunc = func(_ context.Context, object, request insolar.Reference) (*record.Result, error) {
+				require.Equal(t, objectRef, object)
+				require.Equal(t, requestRef, request)
+				return test.result, test.resultErr
+			}
+
+			var reply RequestStatusReply
+			err := NewRequestService(&Runner{ContractRequester: cr, ArtifactManager: am}).GetStatus(
+				&http.Request{}, &RequestStatusArgs{Reference: requestRef.String(), Object: objectRef.String()}, &reply,
+			)
+			if test.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			reply.TraceID = ""
+			require.Equal(t, test.reply, reply)
+		})
+	}
+}
+
+func TestRequestService_GetStatus_Unknown(t *testing.T) {
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	cr := testutils.NewContractRequesterMock(mc)
+	cr.GetRequestResultFunc = func(context.Context, insolar.Reference) (*insolar.RequestResult, bool) {
+		return nil, false
+	}
+
+	var reply RequestStatusReply
+	err := NewRequestService(&Runner{ContractRequester: cr}).GetStatus(
+		&http.Request{}, &RequestStatusArgs{Reference: gen.Reference().String()}, &reply,
+	)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "object is required")
+}
@@ -38,6 +38,14 @@ type Request struct {
 	Test     string `json:"test,omitempty"`
 }
 
+// BatchItem is a single request of batch call with its own digest and signature headers.
+// Request is kept raw because signature covers exact bytes.
+type BatchItem struct {
+	Request   json.RawMessage `json:"request"`
+	Digest    string          `json:"digest"`
+	Signature string          `json:"signature"`
+}
+
 type Params struct {
 	Seed       string      `json:"seed"`
 	CallSite   string      `json:"callSite"`
@@ -65,7 +73,9 @@ type Data struct {
 
 type Result struct {
 	ContractResult interface{} `json:"callResult,omitempty"`
-	TraceID        string      `json:"traceID,omitempty"`
+	// RequestReference is a reference of incoming request, it's returned by async call
+	RequestReference string `json:"requestReference,omitempty"`
+	TraceID          string `json:"traceID,omitempty"`
 }
 
 // UserConfigJSON holds info about user
@@ -251,6 +251,67 @@ func marshalSig(r, s *big.Int) (string, error) {
 	return base64.StdEncoding.EncodeToString(asnSig), nil
 }
 
+// NewBatchItem signs request with known seed and wraps it into item of batch call,
+// all items of a batch should be signed with the same seed
+func NewBatchItem(userCfg *UserConfigJSON, reqCfg *Request, seed string) (*BatchItem, error) {
+	if userCfg == nil || reqCfg == nil {
+		return nil, errors.New("[ NewBatchItem ] Configs must be initialized")
+	}
+
+	reqCfg.Params.Reference = userCfg.Caller
+	reqCfg.Params.Seed = seed
+
+	body, err := json.Marshal(reqCfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ NewBatchItem ] Config request marshaling failed")
+	}
+	signature, err := Sign(userCfg.privateKeyObject, body)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ NewBatchItem ] Problem with signing request")
+	}
+
+	sha := sha256.Sum256(body)
+	return &BatchItem{
+		Request:   body,
+		Digest:    "SHA-256=" + base64.StdEncoding.EncodeToString(sha[:]),
+		Signature: "keyId=\"member-pub-key\", algorithm=\"ecdsa\", headers=\"digest\", signature=" + signature,
+	}, nil
+}
+
+// SendBatch sends signed requests in one batch call, answers are returned in order of items
+func SendBatch(url string, items []*BatchItem) ([]ContractAnswer, error) {
+	jsonValue, err := json.Marshal(items)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ SendBatch ] Problem with marshaling items")
+	}
+
+	postResp, err := httpClient.Post(url, "application/json", bytes.NewBuffer(jsonValue))
+	if err != nil {
+		return nil, errors.Wrap(err, "[ SendBatch ] Problem with sending request")
+	}
+	defer postResp.Body.Close()
+	if http.StatusOK != postResp.StatusCode {
+		return nil, errors.New("[ SendBatch ] Bad http response code: " + strconv.Itoa(postResp.StatusCode))
+	}
+
+	body, err := ioutil.ReadAll(postResp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ SendBatch ] Problem with reading body")
+	}
+
+	var answers []ContractAnswer
+	err = json.Unmarshal(body, &answers)
+	if err != nil {
+		// whole batch is rejected with single answer
+		answer := ContractAnswer{}
+		if json.Unmarshal(body, &answer) == nil && answer.Error != nil {
+			return nil, errors.New("[ SendBatch ] Batch is rejected: " + answer.Error.Message)
+		}
+		return nil, errors.Wrap(err, "[ SendBatch ] Can't unmarshal")
+	}
+	return answers, nil
+}
+
 // Send first gets seed and after that makes target request
 func Send(ctx context.Context, url string, userCfg *UserConfigJSON, reqCfg *Request) ([]byte, error) {
 	verboseInfo(ctx, "Sending GETSEED request ...")
@@ -320,7 +381,7 @@ func Status(url string) (*StatusResponse, error) {
 	return &statusResp.Result, nil
 }
 
-// LogOff rpc request turns network state to NoNetwork to initiate reconnect sequence.
+// LogOff rpc request makes the node leave the network gracefully and turns its network state to NoNetwork.
 func LogOff(url string) (*StatusResponse, error) {
 	params := getDefaultRPCParams("status.LogOff")
 
@@ -25,6 +25,7 @@ import (
 	"github.com/insolar/insolar/instrumentation/inslogger"
 	"github.com/insolar/insolar/network"
 	"github.com/insolar/insolar/version"
+	"github.com/pkg/errors"
 )
 
 type Node struct {
@@ -44,6 +45,15 @@ type StatusReply struct {
 	Entropy         []byte
 	NodeState       string
 	Version         string
+	Verification    *Verification
+}
+
+// Verification is a progress of stored data verification, it's reported in JetlessNetworkState only.
+type Verification struct {
+	Pulse     uint32
+	Jets      int
+	Confirmed int
+	Error     string
 }
 
 // Get returns status info
@@ -56,6 +66,16 @@ func (s *NodeService) GetStatus(r *http.Request, args *interface{}, reply *Statu
 	reply.NetworkState = s.runner.ServiceNetwork.GetState().String()
 	reply.NodeState = s.runner.NodeNetwork.GetOrigin().GetState().String()
 
+	if verifier, ok := s.runner.Gatewayer.Gateway().(network.DataVerifier); ok {
+		progress := verifier.VerificationProgress()
+		reply.Verification = &Verification{
+			Pulse:     uint32(progress.Pulse),
+			Jets:      progress.Jets,
+			Confirmed: progress.Confirmed,
+			Error:     progress.Error,
+		}
+	}
+
 	activeNodes := s.runner.NodeNetwork.(network.NodeKeeper).GetAccessor().GetActiveNodes()
 	workingNodes := s.runner.NodeNetwork.GetWorkingNodes()
 
@@ -91,9 +111,28 @@ func (s *NodeService) GetStatus(r *http.Request, args *interface{}, reply *Statu
 	return nil
 }
 
+// LogOff requests graceful leave of the node. Node leaves the network one pulse later, so its work is handed off to
+// other nodes on pulse change, and switches to NoNetwork state when the network applies the leave. Reply is sent
+// after the leave is applied, error is returned if it isn't applied in LogOffTimeout.
 func (s *NodeService) LogOff(r *http.Request, args *interface{}, reply *StatusReply) error {
-	g := s.runner.Gatewayer
-	g.SetGateway(g.Gateway().NewGateway(insolar.NoNetworkState))
+	traceID := utils.RandTraceID()
+	ctx, inslog := inslogger.WithTraceField(context.Background(), traceID)
+
+	inslog.Infof("[ NodeService.LogOff ] Incoming request: %s", r.RequestURI)
+
+	waitCtx, cancel := context.WithTimeout(r.Context(), s.runner.cfg.LogOffTimeout)
+	defer cancel()
+
+	approved := s.runner.TerminationHandler.RequestLeave(ctx, insolar.LeaveReasonLogOff, 1)
+	select {
+	case <-approved:
+		inslog.Info("[ NodeService.LogOff ] Leave is applied, switching to NoNetwork state")
+		g := s.runner.Gatewayer
+		g.SetGateway(g.Gateway().NewGateway(insolar.NoNetworkState))
+	case <-waitCtx.Done():
+		inslog.Error("[ NodeService.LogOff ] Leave isn't applied: ", waitCtx.Err())
+		return errors.Wrap(waitCtx.Err(), "[ NodeService.LogOff ] leave isn't applied by network")
+	}
 
 	err := s.GetStatus(r, args, reply)
 	if err != nil {
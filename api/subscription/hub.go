@@ -0,0 +1,167 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package subscription delivers events of the node to API subscribers.
+package subscription
+
+import (
+	"context"
+	"sync"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+)
+
+// Topic is a kind of events.
+type Topic string
+
+const (
+	// TopicPulse is for new pulses.
+	TopicPulse Topic = "pulse"
+	// TopicNetworkState is for changes of network state.
+	TopicNetworkState Topic = "networkState"
+	// TopicObject is for new states of an object.
+	TopicObject Topic = "object"
+)
+
+// eventsBufferSize is a number of events subscription holds for slow reader, next events are dropped.
+const eventsBufferSize = 64
+
+// Event is a single notification for subscribers.
+type Event struct {
+	Topic Topic
+
+	// Pulse is set for TopicPulse.
+	Pulse insolar.Pulse
+	// NetworkState is set for TopicNetworkState.
+	NetworkState insolar.NetworkState
+	// Object and State are set for TopicObject.
+	Object insolar.ID
+	State  insolar.ID
+}
+
+// Filter selects events for subscription.
+type Filter struct {
+	Topic Topic
+	// Object is required for TopicObject.
+	Object insolar.ID
+}
+
+func (f Filter) match(e Event) bool {
+	if f.Topic != e.Topic {
+		return false
+	}
+	if f.Topic == TopicObject {
+		return f.Object.Equal(e.Object)
+	}
+	return true
+}
+
+// Subscriber creates subscriptions for events.
+type Subscriber interface {
+	Subscribe(filter Filter) *Subscription
+}
+
+// Subscription receives events selected by filter until it's closed.
+type Subscription struct {
+	ID     uint64
+	Filter Filter
+
+	hub    *Hub
+	events chan Event
+	once   sync.Once
+}
+
+// Events returns channel of events, it's closed when subscription is closed.
+func (s *Subscription) Events() <-chan Event {
+	return s.events
+}
+
+// Close stops delivering events.
+func (s *Subscription) Close() {
+	s.hub.unsubscribe(s)
+}
+
+// Hub implements insolar.Notifier and delivers events to subscriptions.
+type Hub struct {
+	lock          sync.RWMutex
+	lastID        uint64
+	subscriptions map[uint64]*Subscription
+}
+
+// NewHub creates new Hub.
+func NewHub() *Hub {
+	return &Hub{
+		subscriptions: map[uint64]*Subscription{},
+	}
+}
+
+// Subscribe creates subscription for events selected by filter.
+func (h *Hub) Subscribe(filter Filter) *Subscription {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	h.lastID++
+	s := &Subscription{
+		ID:     h.lastID,
+		Filter: filter,
+		hub:    h,
+		events: make(chan Event, eventsBufferSize),
+	}
+	h.subscriptions[s.ID] = s
+	return s
+}
+
+func (h *Hub) unsubscribe(s *Subscription) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	s.once.Do(func() {
+		delete(h.subscriptions, s.ID)
+		close(s.events)
+	})
+}
+
+// NotifyPulse implements insolar.Notifier.
+func (h *Hub) NotifyPulse(ctx context.Context, pulse insolar.Pulse) {
+	h.publish(ctx, Event{Topic: TopicPulse, Pulse: pulse})
+}
+
+// NotifyNetworkState implements insolar.Notifier.
+func (h *Hub) NotifyNetworkState(ctx context.Context, state insolar.NetworkState) {
+	h.publish(ctx, Event{Topic: TopicNetworkState, NetworkState: state})
+}
+
+// NotifyObjectState implements insolar.Notifier.
+func (h *Hub) NotifyObjectState(ctx context.Context, object insolar.ID, state insolar.ID) {
+	h.publish(ctx, Event{Topic: TopicObject, Object: object, State: state})
+}
+
+func (h *Hub) publish(ctx context.Context, e Event) {
+	h.lock.RLock()
+	defer h.lock.RUnlock()
+
+	for _, s := range h.subscriptions {
+		if !s.Filter.match(e) {
+			continue
+		}
+		select {
+		case s.events <- e:
+		default:
+			inslogger.FromContext(ctx).Warnf("subscription %d is too slow, %s event is dropped", s.ID, e.Topic)
+		}
+	}
+}
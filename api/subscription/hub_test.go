@@ -0,0 +1,76 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package subscription
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+)
+
+func TestHub_Filter(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	hub := NewHub()
+
+	object := gen.ID()
+	pulses := hub.Subscribe(Filter{Topic: TopicPulse})
+	states := hub.Subscribe(Filter{Topic: TopicNetworkState})
+	objects := hub.Subscribe(Filter{Topic: TopicObject, Object: object})
+
+	hub.NotifyPulse(ctx, insolar.Pulse{PulseNumber: insolar.FirstPulseNumber})
+	hub.NotifyNetworkState(ctx, insolar.CompleteNetworkState)
+	hub.NotifyObjectState(ctx, gen.ID(), gen.ID())
+	state := gen.ID()
+	hub.NotifyObjectState(ctx, object, state)
+
+	require.Equal(t, Event{Topic: TopicPulse, Pulse: insolar.Pulse{PulseNumber: insolar.FirstPulseNumber}}, <-pulses.Events())
+	require.Equal(t, Event{Topic: TopicNetworkState, NetworkState: insolar.CompleteNetworkState}, <-states.Events())
+	require.Equal(t, Event{Topic: TopicObject, Object: object, State: state}, <-objects.Events())
+
+	require.Len(t, pulses.Events(), 0)
+	require.Len(t, states.Events(), 0)
+	require.Len(t, objects.Events(), 0)
+}
+
+func TestHub_Close(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	hub := NewHub()
+
+	s := hub.Subscribe(Filter{Topic: TopicPulse})
+	s.Close()
+	s.Close()
+
+	hub.NotifyPulse(ctx, insolar.Pulse{PulseNumber: insolar.FirstPulseNumber})
+	_, ok := <-s.Events()
+	require.False(t, ok)
+}
+
+func TestHub_SlowSubscription(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	hub := NewHub()
+
+	s := hub.Subscribe(Filter{Topic: TopicPulse})
+	for i := 0; i < eventsBufferSize*2; i++ {
+		hub.NotifyPulse(ctx, insolar.Pulse{PulseNumber: insolar.PulseNumber(i)})
+	}
+	require.Len(t, s.Events(), eventsBufferSize)
+	require.Equal(t, insolar.PulseNumber(0), (<-s.Events()).Pulse.PulseNumber)
+}
@@ -0,0 +1,310 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"sync"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/websocket"
+
+	"github.com/insolar/insolar/api/subscription"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/utils"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+)
+
+const (
+	// maxSubscriptions limits number of subscriptions of one connection
+	maxSubscriptions = 100
+
+	subscribeMethod    = "subscribe"
+	unsubscribeMethod  = "unsubscribe"
+	notificationMethod = "subscription"
+)
+
+// wsRequest is a JSON-RPC request sent by client over websocket.
+type wsRequest struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      int             `json:"id"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params"`
+}
+
+// SubscribeArgs is params of subscribe method.
+type SubscribeArgs struct {
+	Topic subscription.Topic `json:"topic"`
+	// Reference of object is required for object topic.
+	Reference string `json:"reference,omitempty"`
+}
+
+// UnsubscribeArgs is params of unsubscribe method.
+type UnsubscribeArgs struct {
+	Subscription string `json:"subscription"`
+}
+
+type wsResponse struct {
+	JSONRPC string      `json:"jsonrpc"`
+	ID      int         `json:"id"`
+	Result  interface{} `json:"result,omitempty"`
+	Error   *wsError    `json:"error,omitempty"`
+}
+
+type wsError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type wsNotification struct {
+	JSONRPC string             `json:"jsonrpc"`
+	Method  string             `json:"method"`
+	Params  wsNotificationBody `json:"params"`
+}
+
+type wsNotificationBody struct {
+	Subscription string      `json:"subscription"`
+	Result       interface{} `json:"result"`
+}
+
+// PulseNotification is sent to subscribers of pulse topic.
+type PulseNotification struct {
+	PulseNumber     insolar.PulseNumber `json:"pulseNumber"`
+	PrevPulseNumber insolar.PulseNumber `json:"prevPulseNumber"`
+	NextPulseNumber insolar.PulseNumber `json:"nextPulseNumber"`
+	PulseTimestamp  int64               `json:"pulseTimestamp"`
+}
+
+// NetworkStateNotification is sent to subscribers of networkState topic.
+type NetworkStateNotification struct {
+	NetworkState string `json:"networkState"`
+}
+
+// ObjectNotification is sent to subscribers of object topic.
+type ObjectNotification struct {
+	Reference   string              `json:"reference"`
+	State       string              `json:"state"`
+	PulseNumber insolar.PulseNumber `json:"pulseNumber"`
+}
+
+func notificationResult(e subscription.Event) interface{} {
+	switch e.Topic {
+	case subscription.TopicPulse:
+		return PulseNotification{
+			PulseNumber:     e.Pulse.PulseNumber,
+			PrevPulseNumber: e.Pulse.PrevPulseNumber,
+			NextPulseNumber: e.Pulse.NextPulseNumber,
+			PulseTimestamp:  e.Pulse.PulseTimestamp,
+		}
+	case subscription.TopicNetworkState:
+		return NetworkStateNotification{NetworkState: e.NetworkState.String()}
+	default:
+		return ObjectNotification{
+			Reference:   insolar.NewReference(e.Object).String(),
+			State:       e.State.String(),
+			PulseNumber: e.State.Pulse(),
+		}
+	}
+}
+
+// wsConnection holds subscriptions of one websocket client.
+type wsConnection struct {
+	ws         *websocket.Conn
+	subscriber subscription.Subscriber
+	logger     insolar.Logger
+
+	sendLock sync.Mutex
+
+	lock          sync.Mutex
+	subscriptions map[string]*subscription.Subscription
+	wg            sync.WaitGroup
+}
+
+func (c *wsConnection) send(v interface{}) {
+	c.sendLock.Lock()
+	defer c.sendLock.Unlock()
+
+	err := websocket.JSON.Send(c.ws, v)
+	if err != nil {
+		c.logger.Debug("[ wsConnection ] failed to send: ", err)
+	}
+}
+
+func (c *wsConnection) subscribe(args SubscribeArgs) (string, error) {
+	filter := subscription.Filter{Topic: args.Topic}
+	switch args.Topic {
+	case subscription.TopicPulse, subscription.TopicNetworkState:
+	case subscription.TopicObject:
+		ref, err := insolar.NewReferenceFromBase58(args.Reference)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to parse reference")
+		}
+		filter.Object = *ref.Record()
+	default:
+		return "", errors.Errorf("unknown topic %q", args.Topic)
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if len(c.subscriptions) >= maxSubscriptions {
+		return "", errors.Errorf("too many subscriptions, limit is %d", maxSubscriptions)
+	}
+
+	s := c.subscriber.Subscribe(filter)
+	id := strconv.FormatUint(s.ID, 10)
+	c.subscriptions[id] = s
+
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		for e := range s.Events() {
+			c.send(wsNotification{
+				JSONRPC: "2.0",
+				Method:  notificationMethod,
+				Params:  wsNotificationBody{Subscription: id, Result: notificationResult(e)},
+			})
+		}
+	}()
+	return id, nil
+}
+
+func (c *wsConnection) unsubscribe(id string) bool {
+	c.lock.Lock()
+	s, ok := c.subscriptions[id]
+	delete(c.subscriptions, id)
+	c.lock.Unlock()
+
+	if ok {
+		s.Close()
+	}
+	return ok
+}
+
+func (c *wsConnection) close() {
+	c.lock.Lock()
+	subscriptions := c.subscriptions
+	c.subscriptions = map[string]*subscription.Subscription{}
+	c.lock.Unlock()
+
+	for _, s := range subscriptions {
+		s.Close()
+	}
+	c.wg.Wait()
+}
+
+func (c *wsConnection) handle(req wsRequest) wsResponse {
+	resp := wsResponse{JSONRPC: "2.0", ID: req.ID}
+	fail := func(err error) wsResponse {
+		resp.Error = &wsError{Code: ResultError, Message: err.Error()}
+		return resp
+	}
+
+	switch req.Method {
+	case subscribeMethod:
+		args := SubscribeArgs{}
+		err := json.Unmarshal(req.Params, &args)
+		if err != nil {
+			return fail(errors.Wrap(err, "failed to unmarshal params"))
+		}
+		id, err := c.subscribe(args)
+		if err != nil {
+			return fail(err)
+		}
+		resp.Result = map[string]string{"subscription": id}
+	case unsubscribeMethod:
+		args := UnsubscribeArgs{}
+		err := json.Unmarshal(req.Params, &args)
+		if err != nil {
+			return fail(errors.Wrap(err, "failed to unmarshal params"))
+		}
+		resp.Result = c.unsubscribe(args.Subscription)
+	default:
+		return fail(errors.Errorf("rpc method %q does not exist", req.Method))
+	}
+	return resp
+}
+
+// checkOrigin rejects connections without Origin header and connections from origins which are not allowed.
+// If allowed list is empty, only pages served from the host of API are accepted.
+func checkOrigin(allowed []string) func(*websocket.Config, *http.Request) error {
+	return func(config *websocket.Config, req *http.Request) error {
+		origin, err := websocket.Origin(config, req)
+		if err != nil {
+			return errors.Wrap(err, "failed to parse origin")
+		}
+		if origin == nil {
+			return errors.New("origin is required")
+		}
+		config.Origin = origin
+
+		if len(allowed) == 0 {
+			if origin.Host != req.Host {
+				return errors.Errorf("origin %s is not allowed", origin)
+			}
+			return nil
+		}
+		for _, a := range allowed {
+			if origin.String() == a {
+				return nil
+			}
+		}
+		return errors.Errorf("origin %s is not allowed", origin)
+	}
+}
+
+// wsHandler serves subscriptions over websocket. Client sends JSON-RPC requests:
+//
+//   { "jsonrpc": "2.0", "id": 1, "method": "subscribe", "params": { "topic": "pulse" } }
+//   { "jsonrpc": "2.0", "id": 2, "method": "subscribe", "params": { "topic": "networkState" } }
+//   { "jsonrpc": "2.0", "id": 3, "method": "subscribe", "params": { "topic": "object", "reference": str } }
+//   { "jsonrpc": "2.0", "id": 4, "method": "unsubscribe", "params": { "subscription": str } }
+//
+// and receives notifications:
+//
+//   { "jsonrpc": "2.0", "method": "subscription", "params": { "subscription": str, "result": { ... } } }
+//
+// New states of objects are sent to every virtual node by light nodes which save them.
+func (ar *Runner) wsHandler() http.Handler {
+	return websocket.Server{
+		Handshake: checkOrigin(ar.cfg.WebSocketOrigins),
+		Handler: func(ws *websocket.Conn) {
+			_, logger := inslogger.WithTraceField(context.Background(), utils.RandTraceID())
+			logger.Info("[ wsHandler ] New connection from ", ws.Request().RemoteAddr)
+
+			c := &wsConnection{
+				ws:            ws,
+				subscriber:    ar.Subscriber,
+				logger:        logger,
+				subscriptions: map[string]*subscription.Subscription{},
+			}
+			defer c.close()
+
+			for {
+				var req wsRequest
+				err := websocket.JSON.Receive(ws, &req)
+				if err != nil {
+					logger.Debug("[ wsHandler ] Connection is closed: ", err)
+					return
+				}
+				c.send(c.handle(req))
+			}
+		},
+	}
+}
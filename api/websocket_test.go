@@ -0,0 +1,183 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/websocket"
+
+	"github.com/insolar/insolar/api/subscription"
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+)
+
+type wsTestResponse struct {
+	ID     int             `json:"id"`
+	Method string          `json:"method"`
+	Result json.RawMessage `json:"result"`
+	Error  *wsError        `json:"error"`
+	Params struct {
+		Subscription string          `json:"subscription"`
+		Result       json.RawMessage `json:"result"`
+	} `json:"params"`
+}
+
+func wsCall(t *testing.T, ws *websocket.Conn, id int, method string, params interface{}) wsTestResponse {
+	rawParams, err := json.Marshal(params)
+	require.NoError(t, err)
+	err = websocket.JSON.Send(ws, wsRequest{JSONRPC: "2.0", ID: id, Method: method, Params: rawParams})
+	require.NoError(t, err)
+
+	var resp wsTestResponse
+	err = websocket.JSON.Receive(ws, &resp)
+	require.NoError(t, err)
+	require.Equal(t, id, resp.ID)
+	return resp
+}
+
+func TestRunner_wsHandler(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	hub := subscription.NewHub()
+	ar := &Runner{Subscriber: hub, cfg: &configuration.APIRunner{}}
+
+	server := httptest.NewServer(ar.wsHandler())
+	defer server.Close()
+
+	ws, err := websocket.Dial("ws"+strings.TrimPrefix(server.URL, "http"), "", server.URL)
+	require.NoError(t, err)
+	defer ws.Close()
+
+	resp := wsCall(t, ws, 1, subscribeMethod, SubscribeArgs{Topic: subscription.TopicPulse})
+	require.Nil(t, resp.Error)
+	var subscribed map[string]string
+	require.NoError(t, json.Unmarshal(resp.Result, &subscribed))
+	pulseSubscription := subscribed["subscription"]
+	require.NotEmpty(t, pulseSubscription)
+
+	resp = wsCall(t, ws, 2, subscribeMethod, SubscribeArgs{Topic: subscription.TopicNetworkState})
+	require.Nil(t, resp.Error)
+
+	object := gen.ID()
+	resp = wsCall(t, ws, 3, subscribeMethod, SubscribeArgs{
+		Topic:     subscription.TopicObject,
+		Reference: insolar.NewReference(object).String(),
+	})
+	require.Nil(t, resp.Error)
+
+	hub.NotifyPulse(ctx, insolar.Pulse{PulseNumber: insolar.FirstPulseNumber, PulseTimestamp: 42})
+	var notification wsTestResponse
+	require.NoError(t, websocket.JSON.Receive(ws, &notification))
+	require.Equal(t, notificationMethod, notification.Method)
+	require.Equal(t, pulseSubscription, notification.Params.Subscription)
+	var pulse PulseNotification
+	require.NoError(t, json.Unmarshal(notification.Params.Result, &pulse))
+	require.Equal(t, PulseNotification{PulseNumber: insolar.FirstPulseNumber, PulseTimestamp: 42}, pulse)
+
+	hub.NotifyNetworkState(ctx, insolar.CompleteNetworkState)
+	notification = wsTestResponse{}
+	require.NoError(t, websocket.JSON.Receive(ws, &notification))
+	var state NetworkStateNotification
+	require.NoError(t, json.Unmarshal(notification.Params.Result, &state))
+	require.Equal(t, insolar.CompleteNetworkState.String(), state.NetworkState)
+
+	objectState := gen.ID()
+	hub.NotifyObjectState(ctx, gen.ID(), gen.ID())
+	hub.NotifyObjectState(ctx, object, objectState)
+	notification = wsTestResponse{}
+	require.NoError(t, websocket.JSON.Receive(ws, &notification))
+	var objectNotification ObjectNotification
+	require.NoError(t, json.Unmarshal(notification.Params.Result, &objectNotification))
+	require.Equal(t, ObjectNotification{
+		Reference:   insolar.NewReference(object).String(),
+		State:       objectState.String(),
+		PulseNumber: objectState.Pulse(),
+	}, objectNotification)
+
+	resp = wsCall(t, ws, 4, unsubscribeMethod, UnsubscribeArgs{Subscription: pulseSubscription})
+	require.Equal(t, "true", string(resp.Result))
+	resp = wsCall(t, ws, 5, unsubscribeMethod, UnsubscribeArgs{Subscription: pulseSubscription})
+	require.Equal(t, "false", string(resp.Result))
+}
+
+func TestRunner_wsHandler_BadRequests(t *testing.T) {
+	ar := &Runner{Subscriber: subscription.NewHub(), cfg: &configuration.APIRunner{}}
+
+	server := httptest.NewServer(ar.wsHandler())
+	defer server.Close()
+
+	ws, err := websocket.Dial("ws"+strings.TrimPrefix(server.URL, "http"), "", server.URL)
+	require.NoError(t, err)
+	defer ws.Close()
+
+	resp := wsCall(t, ws, 1, subscribeMethod, SubscribeArgs{Topic: "unknown"})
+	require.NotNil(t, resp.Error)
+
+	resp = wsCall(t, ws, 2, subscribeMethod, SubscribeArgs{Topic: subscription.TopicObject, Reference: "bad"})
+	require.NotNil(t, resp.Error)
+
+	resp = wsCall(t, ws, 3, "wrong", nil)
+	require.NotNil(t, resp.Error)
+}
+
+func TestRunner_wsHandler_Origin(t *testing.T) {
+	dial := func(cfg *configuration.APIRunner, origin string) error {
+		ar := &Runner{Subscriber: subscription.NewHub(), cfg: cfg}
+		server := httptest.NewServer(ar.wsHandler())
+		defer server.Close()
+
+		if origin == "" {
+			origin = server.URL
+		}
+		ws, err := websocket.Dial("ws"+strings.TrimPrefix(server.URL, "http"), "", origin)
+		if err == nil {
+			ws.Close()
+		}
+		return err
+	}
+
+	require.NoError(t, dial(&configuration.APIRunner{}, ""))
+	require.Error(t, dial(&configuration.APIRunner{}, "http://example.com"))
+
+	allowed := &configuration.APIRunner{WebSocketOrigins: []string{"http://example.com"}}
+	require.NoError(t, dial(allowed, "http://example.com"))
+	require.Error(t, dial(allowed, ""))
+}
+
+func TestRunner_wsHandler_NoOrigin(t *testing.T) {
+	ar := &Runner{Subscriber: subscription.NewHub(), cfg: &configuration.APIRunner{}}
+	server := httptest.NewServer(ar.wsHandler())
+	defer server.Close()
+
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+	req.Header.Set("Upgrade", "websocket")
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Sec-WebSocket-Version", "13")
+	req.Header.Set("Sec-WebSocket-Key", "dGhlIHNhbXBsZSBub25jZQ==")
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusForbidden, resp.StatusCode)
+}
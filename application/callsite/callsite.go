@@ -0,0 +1,172 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package callsite
+
+import (
+	"fmt"
+	"sort"
+)
+
+// CallSite describes single call site of member's Call method.
+type CallSite struct {
+	Description string
+	// SelfSigned call sites are signed by the key from request params instead of the key of called member.
+	SelfSigned bool
+	// Params is a schema of callParams, nil if call site ignores callParams.
+	Params *Schema
+	// Result is a schema of result of the call.
+	Result *Schema
+}
+
+const (
+	// referencePattern matches base58 encoded reference.
+	referencePattern = "^[1-9A-HJ-NP-Za-km-z]+\\.[1-9A-HJ-NP-Za-km-z]+$"
+	// amountPattern matches non-negative integer amount in decimal notation.
+	amountPattern = "^[0-9]+$"
+)
+
+var noAdditionalProperties = false
+
+func str(description string) *Schema {
+	return &Schema{Type: TypeString, Description: description}
+}
+
+func reference(description string) *Schema {
+	return &Schema{Type: TypeString, Description: description, Pattern: referencePattern}
+}
+
+func amount(description string) *Schema {
+	return &Schema{Type: TypeString, Description: description, Pattern: amountPattern}
+}
+
+func object(properties map[string]*Schema, required ...string) *Schema {
+	return &Schema{
+		Type:                 TypeObject,
+		Properties:           properties,
+		Required:             required,
+		AdditionalProperties: &noAdditionalProperties,
+	}
+}
+
+var callSites = map[string]CallSite{
+	"CreateHelloWorld": {
+		Description: "Creates new HelloWorld contract.",
+		Result:      reference("Reference of created contract."),
+	},
+	"member.create": {
+		Description: "Creates new member with public key from request params.",
+		SelfSigned:  true,
+		Result: object(map[string]*Schema{
+			"reference": reference("Reference of created member."),
+		}, "reference"),
+	},
+	"member.migrationCreate": {
+		Description: "Creates new member with migration address.",
+		SelfSigned:  true,
+		Result: object(map[string]*Schema{
+			"reference":        reference("Reference of created member."),
+			"migrationAddress": str("Migration address of created member."),
+		}, "reference", "migrationAddress"),
+	},
+	"member.get": {
+		Description: "Returns member by public key from request params.",
+		SelfSigned:  true,
+		Result: object(map[string]*Schema{
+			"reference":        reference("Reference of member."),
+			"migrationAddress": str("Migration address of member."),
+		}, "reference"),
+	},
+	"contract.registerNode": {
+		Description: "Registers new node in node domain.",
+		Params: object(map[string]*Schema{
+			"publicKey": str("Public key of node in PEM format."),
+			"role":      str("Static role of node: virtual, heavy_material or light_material."),
+		}, "publicKey", "role"),
+		Result: str("Certificate of registered node."),
+	},
+	"contract.getNodeRef": {
+		Description: "Returns reference of node by its public key.",
+		Params: object(map[string]*Schema{
+			"publicKey": str("Public key of node in PEM format."),
+		}, "publicKey"),
+		Result: reference("Reference of node."),
+	},
+	"migration.addBurnAddresses": {
+		Description: "Adds migration addresses, available for migration admin only.",
+		Params: object(map[string]*Schema{
+			"burnAddresses": {
+				Type:        TypeArray,
+				Description: "Migration addresses.",
+				Items:       str("Migration address."),
+				MinItems:    1,
+			},
+		}, "burnAddresses"),
+		Result: &Schema{Nullable: true, Description: "Always null."},
+	},
+	"wallet.getBalance": {
+		Description: "Returns balance of member's wallet.",
+		Params: object(map[string]*Schema{
+			"reference": reference("Reference of member."),
+		}, "reference"),
+		Result: amount("Balance."),
+	},
+	"member.transfer": {
+		Description: "Transfers amount from wallet of caller to wallet of another member.",
+		Params: object(map[string]*Schema{
+			"amount":            amount("Amount to transfer."),
+			"toMemberReference": reference("Reference of recipient."),
+		}, "amount", "toMemberReference"),
+		Result: object(map[string]*Schema{
+			"fee": amount("Fee of the transfer."),
+		}, "fee"),
+	},
+	"deposit.migration": {
+		Description: "Confirms migration of tokens by migration daemon.",
+		Params: object(map[string]*Schema{
+			"amount":      amount("Migrated amount."),
+			"currentDate": {Type: TypeString, Description: "Unix timestamp in seconds.", Pattern: "^-?[0-9]+$"},
+			"txId":        str("Hash of migration transaction."),
+			"burnAddress": str("Migration address of token holder."),
+		}, "amount", "currentDate", "txId", "burnAddress"),
+		Result: &Schema{Type: TypeString, Description: "Number of confirmations.", Pattern: "^[0-9]+$"},
+	},
+}
+
+// Names returns names of all call sites in alphabetical order.
+func Names() []string {
+	names := make([]string, 0, len(callSites))
+	for name := range callSites {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Get returns description of call site.
+func Get(name string) (CallSite, bool) {
+	cs, ok := callSites[name]
+	return cs, ok
+}
+
+// ValidateParams checks that call site exists and callParams match its schema.
+func ValidateParams(name string, params interface{}) error {
+	cs, ok := callSites[name]
+	if !ok {
+		return &ValidationError{Path: "callSite", Message: fmt.Sprintf("unknown call site %q", name)}
+	}
+	return cs.Params.Validate("callParams", params)
+}
@@ -0,0 +1,63 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package callsite
+
+import (
+	"encoding/json"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNames(t *testing.T) {
+	names := Names()
+	require.Len(t, names, len(callSites))
+	require.True(t, sort.StringsAreSorted(names))
+	for _, name := range names {
+		cs, ok := Get(name)
+		require.True(t, ok)
+		require.NotNil(t, cs.Result, name)
+
+		// schemas are published as is, so they must be serializable
+		_, err := json.Marshal(cs.Params)
+		require.NoError(t, err)
+	}
+}
+
+func TestValidateParams(t *testing.T) {
+	err := ValidateParams("member.unknown", nil)
+	require.EqualError(t, err, `callSite: unknown call site "member.unknown"`)
+
+	require.NoError(t, ValidateParams("member.create", nil))
+
+	err = ValidateParams("member.transfer", nil)
+	require.EqualError(t, err, "callParams: expected object, got null")
+
+	err = ValidateParams("member.transfer", unmarshal(t, `{"amount": 100, "toMemberReference": "x"}`))
+	require.EqualError(t, err, "callParams.amount: expected string, got number")
+
+	err = ValidateParams("member.transfer", unmarshal(t, `{"amount": "100"}`))
+	require.EqualError(t, err, "callParams.toMemberReference: required field is missing")
+
+	ref := "11tJDt3gnAJYfTqdEnXZF1Yvp1XtGuCWp4rrjmWBYwG.11tJDt3gnAJYfTqdEnXZF1Yvp1XtGuCWp4rrjmWBYwG"
+	err = ValidateParams("member.transfer", unmarshal(t, `{"amount": "100", "toMemberReference": "`+ref+`"}`))
+	require.NoError(t, err)
+
+	err = ValidateParams("contract.getNodeRef", unmarshal(t, `{"publicKey": 123}`))
+	require.EqualError(t, err, "callParams.publicKey: expected string, got number")
+}
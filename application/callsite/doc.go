@@ -0,0 +1,21 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package callsite describes call sites of member's Call method with JSON schemas of their parameters and results.
+//
+// Schemas are shared by API, which validates incoming requests and publishes OpenAPI document,
+// and by builtin member contract, which validates parameters before dispatching the call.
+package callsite
@@ -0,0 +1,239 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package callsite
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+)
+
+// Type is a JSON type of value.
+type Type string
+
+// JSON types, integer is a number without fractional part.
+const (
+	TypeObject  Type = "object"
+	TypeArray   Type = "array"
+	TypeString  Type = "string"
+	TypeInteger Type = "integer"
+	TypeNumber  Type = "number"
+	TypeBoolean Type = "boolean"
+)
+
+// Schema is a subset of JSON schema which is compatible with OpenAPI 3 schema object.
+type Schema struct {
+	Ref         string `json:"$ref,omitempty"`
+	Type        Type   `json:"type,omitempty"`
+	Description string `json:"description,omitempty"`
+	Nullable    bool   `json:"nullable,omitempty"`
+	Example     string `json:"example,omitempty"`
+
+	// Strings.
+	Format  string   `json:"format,omitempty"`
+	Pattern string   `json:"pattern,omitempty"`
+	Enum    []string `json:"enum,omitempty"`
+
+	// Objects.
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties *bool              `json:"additionalProperties,omitempty"`
+
+	// Arrays.
+	Items    *Schema `json:"items,omitempty"`
+	MinItems int     `json:"minItems,omitempty"`
+
+	OneOf []*Schema `json:"oneOf,omitempty"`
+}
+
+// ValidationError describes the first value which doesn't match schema.
+type ValidationError struct {
+	// Path is a path to invalid value, e.g. callParams.burnAddresses[1].
+	Path    string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	if e.Path == "" {
+		return e.Message
+	}
+	return fmt.Sprintf("%s: %s", e.Path, e.Message)
+}
+
+// Validate checks that value unmarshaled from JSON to interface{} matches schema.
+// Path is used as a prefix of path in returned *ValidationError.
+func (s *Schema) Validate(path string, value interface{}) error {
+	if s == nil {
+		return nil
+	}
+	if value == nil {
+		if s.Nullable || (s.Type == "" && len(s.OneOf) == 0) {
+			return nil
+		}
+		return &ValidationError{Path: path, Message: fmt.Sprintf("expected %s, got null", s.expected())}
+	}
+
+	if len(s.OneOf) > 0 {
+		for _, variant := range s.OneOf {
+			if variant.Validate(path, value) == nil {
+				return nil
+			}
+		}
+		return &ValidationError{Path: path, Message: "value doesn't match any of allowed schemas"}
+	}
+
+	switch s.Type {
+	case "":
+		return nil
+	case TypeObject:
+		return s.validateObject(path, value)
+	case TypeArray:
+		return s.validateArray(path, value)
+	case TypeString:
+		return s.validateString(path, value)
+	case TypeInteger:
+		n, ok := value.(float64)
+		if !ok || n != float64(int64(n)) {
+			return typeError(path, s.Type, value)
+		}
+	case TypeNumber:
+		if _, ok := value.(float64); !ok {
+			return typeError(path, s.Type, value)
+		}
+	case TypeBoolean:
+		if _, ok := value.(bool); !ok {
+			return typeError(path, s.Type, value)
+		}
+	default:
+		return &ValidationError{Path: path, Message: fmt.Sprintf("unknown type %q in schema", s.Type)}
+	}
+	return nil
+}
+
+func (s *Schema) validateObject(path string, value interface{}) error {
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		return typeError(path, s.Type, value)
+	}
+
+	for _, name := range s.Required {
+		if _, ok := object[name]; !ok {
+			return &ValidationError{Path: join(path, name), Message: "required field is missing"}
+		}
+	}
+
+	names := make([]string, 0, len(object))
+	for name := range object {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		property, ok := s.Properties[name]
+		if !ok {
+			if s.AdditionalProperties != nil && !*s.AdditionalProperties {
+				return &ValidationError{Path: join(path, name), Message: "unknown field"}
+			}
+			continue
+		}
+		if err := property.Validate(join(path, name), object[name]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Schema) validateArray(path string, value interface{}) error {
+	array, ok := value.([]interface{})
+	if !ok {
+		return typeError(path, s.Type, value)
+	}
+	if len(array) < s.MinItems {
+		return &ValidationError{Path: path, Message: fmt.Sprintf("expected at least %d items, got %d", s.MinItems, len(array))}
+	}
+	for i, item := range array {
+		if err := s.Items.Validate(fmt.Sprintf("%s[%d]", path, i), item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Schema) validateString(path string, value interface{}) error {
+	str, ok := value.(string)
+	if !ok {
+		return typeError(path, s.Type, value)
+	}
+	if len(s.Enum) > 0 && !contains(s.Enum, str) {
+		return &ValidationError{Path: path, Message: fmt.Sprintf("expected one of %q, got %q", s.Enum, str)}
+	}
+	if s.Pattern != "" {
+		re, err := regexp.Compile(s.Pattern)
+		if err != nil {
+			return &ValidationError{Path: path, Message: fmt.Sprintf("bad pattern %q in schema", s.Pattern)}
+		}
+		if !re.MatchString(str) {
+			return &ValidationError{Path: path, Message: fmt.Sprintf("value %q doesn't match pattern %q", str, s.Pattern)}
+		}
+	}
+	return nil
+}
+
+func (s *Schema) expected() string {
+	if s.Type == "" {
+		return "value"
+	}
+	return string(s.Type)
+}
+
+func typeError(path string, expected Type, value interface{}) error {
+	return &ValidationError{Path: path, Message: fmt.Sprintf("expected %s, got %s", expected, typeOf(value))}
+}
+
+func typeOf(value interface{}) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case map[string]interface{}:
+		return string(TypeObject)
+	case []interface{}:
+		return string(TypeArray)
+	case string:
+		return string(TypeString)
+	case float64:
+		return string(TypeNumber)
+	case bool:
+		return string(TypeBoolean)
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
+
+func join(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,84 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package callsite
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func unmarshal(t *testing.T, data string) interface{} {
+	var v interface{}
+	require.NoError(t, json.Unmarshal([]byte(data), &v))
+	return v
+}
+
+func TestSchema_Validate(t *testing.T) {
+	s := object(map[string]*Schema{
+		"name":  str("name"),
+		"count": {Type: TypeInteger},
+		"flag":  {Type: TypeBoolean, Nullable: true},
+		"kind":  {Type: TypeString, Enum: []string{"a", "b"}},
+		"list":  {Type: TypeArray, Items: amount("amount"), MinItems: 1},
+	}, "name")
+
+	table := []struct {
+		name  string
+		value string
+		err   string
+	}{
+		{name: "ok", value: `{"name": "x", "count": 1, "flag": null, "kind": "a", "list": ["1"]}`},
+		{name: "not object", value: `[]`, err: "params: expected object, got array"},
+		{name: "null", value: `null`, err: "params: expected object, got null"},
+		{name: "required", value: `{}`, err: "params.name: required field is missing"},
+		{name: "unknown field", value: `{"name": "x", "other": 1}`, err: "params.other: unknown field"},
+		{name: "wrong type", value: `{"name": 1}`, err: "params.name: expected string, got number"},
+		{name: "not integer", value: `{"name": "x", "count": 1.5}`, err: "params.count: expected integer, got number"},
+		{name: "enum", value: `{"name": "x", "kind": "c"}`, err: `params.kind: expected one of ["a" "b"], got "c"`},
+		{name: "min items", value: `{"name": "x", "list": []}`, err: "params.list: expected at least 1 items, got 0"},
+		{name: "pattern", value: `{"name": "x", "list": ["1", "-1"]}`, err: `params.list[1]: value "-1" doesn't match pattern "^[0-9]+$"`},
+	}
+
+	for _, test := range table {
+		t.Run(test.name, func(t *testing.T) {
+			err := s.Validate("params", unmarshal(t, test.value))
+			if test.err == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			require.IsType(t, &ValidationError{}, err)
+			require.Equal(t, test.err, err.Error())
+		})
+	}
+}
+
+func TestSchema_Validate_OneOf(t *testing.T) {
+	s := &Schema{OneOf: []*Schema{str("string"), {Type: TypeNumber}}}
+
+	require.NoError(t, s.Validate("v", "x"))
+	require.NoError(t, s.Validate("v", 1.0))
+	require.EqualError(t, s.Validate("v", true), "v: value doesn't match any of allowed schemas")
+}
+
+func TestSchema_Validate_Nil(t *testing.T) {
+	var s *Schema
+	require.NoError(t, s.Validate("v", map[string]interface{}{}))
+	require.NoError(t, (&Schema{}).Validate("v", nil))
+}
@@ -44,6 +44,8 @@ func main() {
 	rpcProtocol := pflag.String("rpc-proto", "tcp", "protocol of RPC API")
 	metricsAddress := pflag.String("metrics", "", "address and port of prometheus metrics")
 	code := pflag.String("code", "", "add pre-compiled code to cache (<ref>:</path/to/plugin.so>)")
+	pin := pflag.StringSlice("pin", nil, "references of code whose files are never removed from cache, e.g. genesis contracts")
+	cacheSize := pflag.Int64("cache-size", ginsider.DefaultMaxDiskSize, "max size of plugin files kept in cache directory, in bytes (loaded plugins stay in memory until restart)")
 	logLevel := pflag.String("log-level", "debug", "log level")
 
 	pflag.Parse()
@@ -65,6 +67,16 @@ func main() {
 	}
 
 	insider := ginsider.NewGoInsider(*path, *rpcProtocol, *rpcAddress)
+	insider.SetCacheLimit(*cacheSize)
+
+	for _, refString := range *pin {
+		ref, err := insolar.NewReferenceFromBase58(refString)
+		if err != nil {
+			log.Fatalf("Couldn't parse pinned ref: %s", err.Error())
+			os.Exit(1)
+		}
+		insider.Pin(*ref)
+	}
 
 	if *code != "" {
 		codeSlice := strings.Split(*code, ":")
@@ -79,7 +91,7 @@ func main() {
 		}
 		pluginPath := codeSlice[1]
 
-		err = insider.AddPlugin(*ref, pluginPath)
+		err = insider.AddPlugin(context.Background(), *ref, pluginPath)
 		if err != nil {
 			log.Fatalf("Couldn't add plugin by ref %s with .so from %s, err: %s ", ref, pluginPath, err.Error())
 			os.Exit(1)
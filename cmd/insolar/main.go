@@ -69,7 +69,7 @@ func main() {
 
 	rootCmd.AddCommand(&cobra.Command{
 		Use:   "logoff",
-		Short: "Stop network consensus on this node",
+		Short: "Leave the network gracefully and stop network consensus on this node",
 		Run: func(cmd *cobra.Command, args []string) {
 			logOff(sendURL)
 		},
@@ -104,9 +104,28 @@ func main() {
 	go server.StartServer(ctx)
 	pulseTicker, refreshTicker := runPulsar(ctx, server, cfgHolder.Configuration.Pulsar)
 
+	var history *pulsar.HistoryServer
+	if address := cfgHolder.Configuration.Pulsar.HistoryListenAddress; address != "" {
+		history, err = pulsar.NewHistoryServer(address, storage, server)
+		if err != nil {
+			inslog.Fatal(err)
+		}
+		err = history.Start(ctx)
+		if err != nil {
+			inslog.Fatal(err)
+		}
+		inslog.Infof("Pulse history is served on %s", address)
+	}
+
 	defer func() {
 		pulseTicker.Stop()
 		refreshTicker.Stop()
+		if history != nil {
+			err = history.Stop(ctx)
+			if err != nil {
+				inslog.Error(err)
+			}
+		}
 		err = storage.Close()
 		if err != nil {
 			inslog.Error(err)
@@ -159,6 +178,26 @@ func initPulsar(ctx context.Context, cfg configuration.Configuration) (*componen
 		inslogger.FromContext(ctx).Fatal(err)
 		panic(err)
 	}
+	listener := net.Listen
+	wrapperFactory := &pulsar.RPCClientWrapperFactoryImpl{}
+	if cfg.Pulsar.ConnectionType == configuration.TLS {
+		privateKey, err := keyStore.GetPrivateKey("")
+		if err != nil {
+			inslogger.FromContext(ctx).Fatal(err)
+		}
+		tlsConfig, err := pulsar.NewTLSConfig(privateKey, keyProcessor, cfg.Pulsar.Neighbours)
+		if err != nil {
+			inslogger.FromContext(ctx).Fatal(err)
+		}
+		listener = pulsar.TLSListener(tlsConfig)
+		wrapperFactory.TLSConfig = tlsConfig
+	}
+
+	entropyGenerator, err := entropygenerator.NewEntropyGenerator(cfg.Pulsar.EntropyGenerator)
+	if err != nil {
+		inslogger.FromContext(ctx).Fatal(err)
+	}
+
 	switcher := &pulsar.StateSwitcherImpl{}
 	server, err := pulsar.NewPulsar(
 		cfg.Pulsar,
@@ -167,10 +206,10 @@ func initPulsar(ctx context.Context, cfg configuration.Configuration) (*componen
 		keyProcessor,
 		pulseDistributor,
 		storage,
-		&pulsar.RPCClientWrapperFactoryImpl{},
-		&entropygenerator.StandardEntropyGenerator{},
+		wrapperFactory,
+		entropyGenerator,
 		switcher,
-		net.Listen,
+		listener,
 	)
 
 	if err != nil {
@@ -18,6 +18,7 @@ package configuration
 
 import (
 	"fmt"
+	"time"
 )
 
 // APIRunner holds configuration for api
@@ -25,6 +26,36 @@ type APIRunner struct {
 	Address string
 	Call    string
 	RPC     string
+	// BatchCall is a path of endpoint which accepts array of signed requests, disabled if empty
+	BatchCall string
+	// BatchMaxSize is a max number of requests in one batch
+	BatchMaxSize int
+	// BatchTimeout limits total duration of batch processing
+	BatchTimeout time.Duration
+	// BatchMaxBodySize is a max size of batch request body in bytes
+	BatchMaxBodySize int64
+	// WebSocket is a path of endpoint for subscriptions to pulses, network state and objects, disabled if empty
+	WebSocket string
+	// WebSocketOrigins is a list of origins allowed to connect to WebSocket, only origin with host of API is allowed if empty
+	WebSocketOrigins []string
+	// LogOffTimeout limits waiting for the network to apply leave requested by LogOff, keep it below client timeout
+	LogOffTimeout time.Duration
+	// OpenAPI is a path of OpenAPI document which describes Call and RPC endpoints, disabled if empty
+	OpenAPI string
+	// Deploy configures deployment of contracts uploaded by members
+	Deploy Deploy
+}
+
+// Deploy holds configuration of contract deployment
+type Deploy struct {
+	// BuilderURL is an address of sandboxed service which compiles contract sources, only pre-built wasm code is accepted if empty
+	BuilderURL string
+	// BuilderPublicKey is a public key of the builder service in PEM format, code it builds has to be signed by its key
+	BuilderPublicKey string
+	// BuildTimeout limits time of compilation by the builder
+	BuildTimeout time.Duration
+	// MaxCodeSize is a max size of deployed code in bytes
+	MaxCodeSize int
 }
 
 // NewAPIRunner creates new api config
@@ -33,10 +64,28 @@ func NewAPIRunner() APIRunner {
 		Address: "localhost:19101",
 		Call:    "/api/call",
 		RPC:     "/api/rpc",
+
+		BatchCall:        "/api/batch",
+		BatchMaxSize:     100,
+		BatchTimeout:     60 * time.Second,
+		BatchMaxBodySize: 10 * 1024 * 1024,
+
+		WebSocket:     "/api/ws",
+		LogOffTimeout: 30 * time.Second,
+		OpenAPI:       "/api/openapi.json",
+
+		Deploy: Deploy{
+			BuildTimeout: 5 * time.Minute,
+			MaxCodeSize:  64 * 1024 * 1024,
+		},
 	}
 }
 
 func (ar *APIRunner) String() string {
-	res := fmt.Sprintln("Addr ->", ar.Address, ", Call ->", ar.Call, ", RPC ->", ar.RPC)
+	res := fmt.Sprintln("Addr ->", ar.Address, ", Call ->", ar.Call, ", RPC ->", ar.RPC,
+		", BatchCall ->", ar.BatchCall, ", BatchMaxSize ->", ar.BatchMaxSize, ", BatchTimeout ->", ar.BatchTimeout,
+		", BatchMaxBodySize ->", ar.BatchMaxBodySize,
+		", WebSocket ->", ar.WebSocket, ", LogOffTimeout ->", ar.LogOffTimeout, ", OpenAPI ->", ar.OpenAPI,
+		", Deploy.BuilderURL ->", ar.Deploy.BuilderURL)
 	return res
 }
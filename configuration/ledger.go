@@ -37,6 +37,9 @@ type JetSplit struct {
 	ThresholdOverflowCount int
 	// DepthLimit limits jet tree depth (maximum possible jets = 2^DepthLimit)
 	DepthLimit uint8
+	// MergeQuietCount is a how many drops in row both sibling jets should have less than half of ThresholdRecordsCount
+	// records to be merged. Zero disables merge. Siblings are merged only when both are executed by the same light node.
+	MergeQuietCount int
 }
 
 // Backoff configures retry backoff algorithm
@@ -88,6 +91,7 @@ func NewLedger() Ledger {
 			ThresholdRecordsCount:  100,
 			ThresholdOverflowCount: 3,
 			DepthLimit:             10, // limit to 1024 jets
+			MergeQuietCount:        10,
 		},
 		LightChainLimit: 5, // 5 pulses
 
@@ -16,14 +16,50 @@
 
 package configuration
 
+import (
+	"time"
+)
+
 // Log holds configuration for logging
 type Log struct {
 	Level     string
 	Adapter   string
 	Formatter string
+	// OutputType is a destination of log records: stderr, file or syslog.
+	OutputType string
+	// OutputParams is a destination specific parameter: path to log file for file output
+	// or "network://address" of remote syslog (empty value means local syslog).
+	OutputParams string
+	// Rotation limits size and age of log files, it's used by file output only.
+	Rotation LogRotation
+	// Sampling allows to write only every N-th record of noisy levels.
+	Sampling LogSampling
+}
+
+// LogRotation holds configuration of log files rotation
+type LogRotation struct {
+	// MaxSize is a size of log file in megabytes after which file is rotated, 0 disables rotation.
+	MaxSize int
+	// MaxAge is a time after which rotated files are removed, 0 keeps files forever.
+	MaxAge time.Duration
+	// MaxBackups is a number of rotated files to keep, 0 keeps all of them.
+	MaxBackups int
+}
+
+// LogSampling holds per-level sampling configuration, 0 or 1 means every record of the level is written
+type LogSampling struct {
+	Debug uint32
+	Info  uint32
+	Warn  uint32
+	Error uint32
 }
 
 // NewLog creates new default configuration for logging
 func NewLog() Log {
-	return Log{Level: "Info", Adapter: "zerolog", Formatter: "json"}
+	return Log{
+		Level:      "Info",
+		Adapter:    "zerolog",
+		Formatter:  "json",
+		OutputType: "stderr",
+	}
 }
@@ -16,6 +16,10 @@
 
 package configuration
 
+import (
+	"time"
+)
+
 // LogicRunner configuration
 type LogicRunner struct {
 	// RPCListen - address logic runner binds RPC API to
@@ -27,6 +31,10 @@ type LogicRunner struct {
 	BuiltIn *BuiltIn
 	// GoPlugin - configuration of executor based on Go plugins
 	GoPlugin *GoPlugin
+	// Wasm - configuration of executor of WebAssembly contracts
+	Wasm *Wasm
+	// Budget - limits of work a single request is allowed to do
+	Budget ExecutionBudget
 }
 
 // BuiltIn configuration, no options at the moment
@@ -39,6 +47,59 @@ type GoPlugin struct {
 	// RunnerProtocol - protocol (network) of above address,
 	// e.g. "tcp", "unix"... see `net.Dial`
 	RunnerProtocol string
+	// Runners - more runners calls are spread across, the one above is used as well if set
+	Runners []GoPluginRunner
+	// HealthCheck - checks of runners, calls aren't sent to unhealthy ones
+	HealthCheck GoPluginHealthCheck
+}
+
+// GoPluginRunner - an insgorund process
+type GoPluginRunner struct {
+	// Listen - address the runner listens to
+	Listen string
+	// Protocol - protocol (network) of above address
+	Protocol string
+	// Binary - path to insgorund, if set the runner is spawned
+	// and restarted by the node, otherwise it's started externally
+	Binary string
+	// Args - additional arguments of spawned runner, listen and RPC addresses are passed by the node
+	Args []string
+}
+
+// GoPluginHealthCheck configuration
+type GoPluginHealthCheck struct {
+	// Code - reference of healthcheck contract code loaded to runners with `--code`,
+	// if empty runners are pinged
+	Code string
+	// Interval - period of checks
+	Interval time.Duration
+	// Timeout - time runner has to reply to a check
+	Timeout time.Duration
+	// MaxFailures - number of failed checks in a row after which spawned runner is restarted
+	MaxFailures int
+}
+
+// Wasm configuration
+type Wasm struct {
+	// MaxMemoryPages - limit of contract memory in 64KiB pages
+	MaxMemoryPages uint32
+	// MaxCallDepth - limit of nested function calls inside a contract
+	MaxCallDepth int
+	// ModuleCacheSize - limit of total size of code of decoded modules kept in memory, in bytes
+	ModuleCacheSize int
+}
+
+// ExecutionBudget - limits of work a single request is allowed to do,
+// zero value of a limit means it isn't checked
+type ExecutionBudget struct {
+	// MaxDuration - wall time of a contract call in a machine executor
+	MaxDuration time.Duration
+	// MaxRouteCalls - number of outgoing calls to other objects
+	MaxRouteCalls uint32
+	// MaxSaveAsChild - number of objects created as children
+	MaxSaveAsChild uint32
+	// MaxStateSize - size of serialized object memory after the call, in bytes
+	MaxStateSize uint64
 }
 
 // NewLogicRunner - returns default config of the logic runner
@@ -50,6 +111,22 @@ func NewLogicRunner() LogicRunner {
 		GoPlugin: &GoPlugin{
 			RunnerListen:   "127.0.0.1:7777",
 			RunnerProtocol: "tcp",
+			HealthCheck: GoPluginHealthCheck{
+				Interval:    5 * time.Second,
+				Timeout:     5 * time.Second,
+				MaxFailures: 3,
+			},
+		},
+		Wasm: &Wasm{
+			MaxMemoryPages:  256,
+			MaxCallDepth:    1024,
+			ModuleCacheSize: 256 * 1024 * 1024,
+		},
+		Budget: ExecutionBudget{
+			MaxDuration:    10 * time.Minute,
+			MaxRouteCalls:  1000,
+			MaxSaveAsChild: 1000,
+			MaxStateSize:   10 * 1024 * 1024,
 		},
 	}
 }
@@ -20,12 +20,22 @@ type ConnectionType string
 
 const (
 	TCP ConnectionType = "tcp"
+	// TLS is tcp with TLS on top, pulsars authenticate each other by pinned public keys.
+	TLS ConnectionType = "tls"
 )
 
 func (ct ConnectionType) String() string {
 	return string(ct)
 }
 
+// Network returns network name suitable for net.Dial and net.Listen.
+func (ct ConnectionType) Network() string {
+	if ct == TLS {
+		return TCP.String()
+	}
+	return ct.String()
+}
+
 // Pulsar holds configuration for pulsar node.
 type Pulsar struct {
 	ConnectionType      ConnectionType
@@ -42,8 +52,26 @@ type Pulsar struct {
 
 	NumberDelta uint32
 
+	EntropyGenerator EntropyGenerator
+
 	DistributionTransport Transport
 	PulseDistributor      PulseDistributor
+
+	// HistoryListenAddress is an address of read-only JSON-RPC endpoint with saved pulses, empty value disables it.
+	HistoryListenAddress string
+}
+
+// EntropyGenerator holds configuration of pulsar's entropy generator.
+type EntropyGenerator struct {
+	// Type is one of "standard" (crypto/rand), "mixing" (hash of several sources),
+	// "file" (file or FIFO, e.g. hardware RNG) and "seeded" (deterministic, for test networks only).
+	Type string
+	// Sources are mixed by "mixing" generator: "crypto", "time" and "file".
+	Sources []string
+	// FilePath is a path to file read by "file" generator and source.
+	FilePath string
+	// Seed of "seeded" generator.
+	Seed int64
 }
 
 type PulseDistributor struct {
@@ -52,6 +80,17 @@ type PulseDistributor struct {
 	RandomHostsRequestTimeout int32 // ms
 	PulseRequestTimeout       int32 // ms
 	RandomNodesCount          int
+
+	// PulseRetryCount is a number of additional attempts to deliver pulse to a host.
+	PulseRetryCount int
+	// PulseRetryBackoff is a delay before the first retry, it doubles on every next retry.
+	PulseRetryBackoff int32 // ms
+	// PulseRetryMaxBackoff limits delay between retries.
+	PulseRetryMaxBackoff int32 // ms
+	// Quorum is a number of hosts which should acknowledge pulse for successful distribution.
+	Quorum int
+	// RedistributeCount is a number of times pulse that reached no host is distributed again.
+	RedistributeCount int
 }
 
 type PulsarNodeAddress struct {
@@ -76,6 +115,9 @@ func NewPulsar() Pulsar {
 		Storage:    Storage{DataDirectory: "./.artifacts/pulsar_data"},
 
 		NumberDelta: 10,
+		EntropyGenerator: EntropyGenerator{
+			Type: "standard",
+		},
 		DistributionTransport: Transport{
 			Protocol: "TCP",
 			Address:  "0.0.0.0:18091",
@@ -86,6 +128,11 @@ func NewPulsar() Pulsar {
 			RandomHostsRequestTimeout: 1000,
 			PulseRequestTimeout:       1000,
 			RandomNodesCount:          5,
+			PulseRetryCount:           3,
+			PulseRetryBackoff:         100,
+			PulseRetryMaxBackoff:      1000,
+			Quorum:                    1,
+			RedistributeCount:         1,
 		},
 	}
 }
@@ -54,6 +54,19 @@ type ContractRequester struct {
 	// callTimeout is mainly needed for unit tests which
 	// sometimes may unpredictably fail on CI with a default timeout
 	callTimeout time.Duration
+
+	// results of async requests
+	trackedMutex     sync.Mutex
+	tracked          map[insolar.Reference]trackedResult
+	asyncCallTimeout time.Duration
+}
+
+// asyncResultTTL is how long finished results of async requests are kept.
+const asyncResultTTL = 10 * time.Minute
+
+type trackedResult struct {
+	result    *insolar.RequestResult
+	expiresAt time.Time
 }
 
 // New creates new ContractRequester
@@ -62,6 +75,9 @@ func New(lr insolar.LogicRunner) (*ContractRequester, error) {
 		ResultMap:   make(map[[insolar.RecordHashSize]byte]chan *message.ReturnResults),
 		callTimeout: 25 * time.Second,
 		lr:          lr,
+
+		tracked:          make(map[insolar.Reference]trackedResult),
+		asyncCallTimeout: 10 * time.Minute,
 	}, nil
 }
 
@@ -93,12 +109,106 @@ func (cr *ContractRequester) SendRequestWithPulse(ctx context.Context, ref *inso
 	ctx, span := instracer.StartSpan(ctx, "SendRequest "+method)
 	defer span.End()
 
-	args, err := insolar.MarshalArgs(argsIn...)
+	msg, err := cr.makeCallMethod(ctx, ref, method, argsIn, pulse)
 	if err != nil {
 		return nil, errors.Wrap(err, "[ ContractRequester::SendRequest ] Can't marshal")
 	}
 
-	msg := &message.CallMethod{
+	routResult, err := cr.CallMethod(ctx, msg)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ ContractRequester::SendRequest ] Can't route call")
+	}
+
+	return routResult, nil
+}
+
+// SendRequestWithPulseAsync registers request and returns its reference, results are waited in background
+// and can be fetched by GetRequestResult.
+func (cr *ContractRequester) SendRequestWithPulseAsync(ctx context.Context, ref *insolar.Reference, method string, argsIn []interface{}, pulse insolar.PulseNumber) (*insolar.Reference, error) {
+	ctx, span := instracer.StartSpan(ctx, "SendRequestAsync "+method)
+	defer span.End()
+
+	msg, err := cr.makeCallMethod(ctx, ref, method, argsIn, pulse)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ ContractRequester::SendRequestAsync ] Can't marshal")
+	}
+	msg.Nonce = randomUint64()
+
+	reqHash, ch, err := cr.waitResult(msg.IncomingRequest)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ ContractRequester::SendRequestAsync ] Failed to calculate hash")
+	}
+
+	r, err := cr.send(ctx, msg)
+	if err != nil {
+		cr.forgetResult(reqHash)
+		return nil, errors.Wrap(err, "[ ContractRequester::SendRequestAsync ] Can't route call")
+	}
+	if !bytes.Equal(r.Request.Record().Hash(), reqHash[:]) {
+		cr.forgetResult(reqHash)
+		return nil, errors.New("[ ContractRequester::SendRequestAsync ] Registered request has different hash")
+	}
+
+	cr.trackResult(r.Request, &insolar.RequestResult{Status: insolar.RequestStatusPending})
+	go cr.awaitResult(ctx, r.Request, reqHash, ch)
+
+	return &r.Request, nil
+}
+
+// GetRequestResult returns result of request sent by SendRequestWithPulseAsync.
+func (cr *ContractRequester) GetRequestResult(ctx context.Context, request insolar.Reference) (*insolar.RequestResult, bool) {
+	cr.trackedMutex.Lock()
+	defer cr.trackedMutex.Unlock()
+
+	tracked, ok := cr.tracked[request]
+	if !ok {
+		return nil, false
+	}
+	res := *tracked.result
+	return &res, true
+}
+
+func (cr *ContractRequester) awaitResult(ctx context.Context, request insolar.Reference, reqHash [insolar.RecordHashSize]byte, ch chan *message.ReturnResults) {
+	logger := inslogger.FromContext(ctx).WithField("request", request.String())
+
+	select {
+	case ret := <-ch:
+		logger.Debug("Got results of async request")
+		res := &insolar.RequestResult{Status: insolar.RequestStatusDone, Reply: ret.Reply}
+		if ret.Error != "" {
+			res = &insolar.RequestResult{Status: insolar.RequestStatusFailed, Error: ret.Error}
+		}
+		cr.trackResult(request, res)
+	case <-time.After(cr.asyncCallTimeout):
+		// status of request becomes unknown for this node and should be checked on ledger
+		logger.Warnf("results of async request weren't received in %s", cr.asyncCallTimeout)
+		cr.forgetResult(reqHash)
+		cr.trackedMutex.Lock()
+		delete(cr.tracked, request)
+		cr.trackedMutex.Unlock()
+	}
+}
+
+func (cr *ContractRequester) trackResult(request insolar.Reference, result *insolar.RequestResult) {
+	cr.trackedMutex.Lock()
+	defer cr.trackedMutex.Unlock()
+
+	now := time.Now()
+	for ref, tracked := range cr.tracked {
+		if tracked.result.Status != insolar.RequestStatusPending && now.After(tracked.expiresAt) {
+			delete(cr.tracked, ref)
+		}
+	}
+	cr.tracked[request] = trackedResult{result: result, expiresAt: now.Add(asyncResultTTL)}
+}
+
+func (cr *ContractRequester) makeCallMethod(ctx context.Context, ref *insolar.Reference, method string, argsIn []interface{}, pulse insolar.PulseNumber) (*message.CallMethod, error) {
+	args, err := insolar.MarshalArgs(argsIn...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &message.CallMethod{
 		IncomingRequest: record.IncomingRequest{
 			Object:       ref,
 			Method:       method,
@@ -107,14 +217,7 @@ func (cr *ContractRequester) SendRequestWithPulse(ctx context.Context, ref *inso
 			Reason:       api.MakeReason(pulse, args),
 			APINode:      cr.JetCoordinator.Me(),
 		},
-	}
-
-	routResult, err := cr.CallMethod(ctx, msg)
-	if err != nil {
-		return nil, errors.Wrap(err, "[ ContractRequester::SendRequest ] Can't route call")
-	}
-
-	return routResult, nil
+	}, nil
 }
 
 func (cr *ContractRequester) calcRequestHash(request record.IncomingRequest) ([insolar.RecordHashSize]byte, error) {
@@ -147,36 +250,20 @@ func (cr *ContractRequester) Call(ctx context.Context, inMsg insolar.Message) (i
 	var reqHash [insolar.RecordHashSize]byte
 
 	if !async {
-		cr.ResultMutex.Lock()
 		var err error
-		reqHash, err = cr.calcRequestHash(msg.IncomingRequest)
+		reqHash, ch, err = cr.waitResult(msg.IncomingRequest)
 		if err != nil {
 			return nil, errors.Wrap(err, "[ ContractRequester::Call ] Failed to calculate hash")
 		}
-		ch = make(chan *message.ReturnResults, 1)
-		cr.ResultMap[reqHash] = ch
-
-		cr.ResultMutex.Unlock()
 	}
 
-	sender := messagebus.BuildSender(
-		cr.MessageBus.Send,
-		messagebus.RetryIncorrectPulse(cr.PulseAccessor),
-		messagebus.RetryFlowCancelled(cr.PulseAccessor),
-	)
-
-	res, err := sender(ctx, msg, nil)
+	r, err := cr.send(ctx, msg)
 	if err != nil {
-		return nil, errors.Wrap(err, "couldn't dispatch event")
-	}
-
-	r, ok := res.(*reply.RegisterRequest)
-	if !ok {
-		return nil, errors.New("Got not reply.RegisterRequest in reply for CallMethod")
+		return nil, err
 	}
 
 	if async {
-		return res, nil
+		return r, nil
 	}
 
 	if !bytes.Equal(r.Request.Record().Hash(), reqHash[:]) {
@@ -199,13 +286,51 @@ func (cr *ContractRequester) Call(ctx context.Context, inMsg insolar.Message) (i
 		}
 		return ret.Reply, nil
 	case <-ctx.Done():
-		cr.ResultMutex.Lock()
-		delete(cr.ResultMap, reqHash)
-		cr.ResultMutex.Unlock()
+		cr.forgetResult(reqHash)
 		return nil, errors.Errorf("request to contract was canceled: timeout of %s was exceeded", cr.callTimeout)
 	}
 }
 
+// send dispatches request and returns reference of registered request.
+func (cr *ContractRequester) send(ctx context.Context, msg *message.CallMethod) (*reply.RegisterRequest, error) {
+	sender := messagebus.BuildSender(
+		cr.MessageBus.Send,
+		messagebus.RetryIncorrectPulse(cr.PulseAccessor),
+		messagebus.RetryFlowCancelled(cr.PulseAccessor),
+	)
+
+	res, err := sender(ctx, msg, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't dispatch event")
+	}
+
+	r, ok := res.(*reply.RegisterRequest)
+	if !ok {
+		return nil, errors.New("Got not reply.RegisterRequest in reply for CallMethod")
+	}
+	return r, nil
+}
+
+// waitResult registers channel for results of the request.
+func (cr *ContractRequester) waitResult(request record.IncomingRequest) ([insolar.RecordHashSize]byte, chan *message.ReturnResults, error) {
+	cr.ResultMutex.Lock()
+	defer cr.ResultMutex.Unlock()
+
+	reqHash, err := cr.calcRequestHash(request)
+	if err != nil {
+		return reqHash, nil, err
+	}
+	ch := make(chan *message.ReturnResults, 1)
+	cr.ResultMap[reqHash] = ch
+	return reqHash, ch, nil
+}
+
+func (cr *ContractRequester) forgetResult(reqHash [insolar.RecordHashSize]byte) {
+	cr.ResultMutex.Lock()
+	delete(cr.ResultMap, reqHash)
+	cr.ResultMutex.Unlock()
+}
+
 func (cr *ContractRequester) CallMethod(ctx context.Context, inMsg insolar.Message) (insolar.Reply, error) {
 	return cr.Call(ctx, inMsg)
 }
@@ -163,6 +163,87 @@ func TestContractRequester_SendRequest(t *testing.T) {
 	}
 }
 
+func TestContractRequester_SendRequestWithPulseAsync(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	ref := gen.Reference()
+
+	cReq, err := New(nil)
+	require.NoError(t, err)
+
+	cReq.JetCoordinator = mockJetCoordinator(mc)
+	cReq.PulseAccessor = mockPulseAccessor(mc)
+	cReq.PlatformCryptographyScheme = testutils.NewPlatformCryptographyScheme()
+
+	table := []struct {
+		name          string
+		resultMessage message.ReturnResults
+		status        insolar.RequestStatus
+	}{
+		{
+			name:          "done",
+			resultMessage: message.ReturnResults{Reply: &reply.CallMethod{}},
+			status:        insolar.RequestStatusDone,
+		},
+		{
+			name:          "failed",
+			resultMessage: message.ReturnResults{Error: "some error"},
+			status:        insolar.RequestStatusFailed,
+		},
+	}
+
+	for _, test := range table {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			release := make(chan struct{})
+			cReq.MessageBus = testutils.NewMessageBusMock(mc).SendMock.
+				Set(func(ctx context.Context, m insolar.Message, opt *insolar.MessageSendOptions) (insolar.Reply, error) {
+					request := m.(*message.CallMethod).IncomingRequest
+
+					hash, err := cReq.calcRequestHash(request)
+					require.NoError(t, err)
+					requestRef := insolar.NewReference(*insolar.NewID(insolar.FirstPulseNumber, hash[:]))
+
+					go func() {
+						<-release
+						res := test.resultMessage
+						res.RequestRef = *requestRef
+						cReq.result(ctx, &res)
+					}()
+
+					return &reply.RegisterRequest{Request: *requestRef}, nil
+				})
+
+			requestRef, err := cReq.SendRequestWithPulseAsync(ctx, &ref, "TestMethod", []interface{}{}, insolar.FirstPulseNumber)
+			require.NoError(t, err)
+
+			result, ok := cReq.GetRequestResult(ctx, *requestRef)
+			require.True(t, ok)
+			require.Equal(t, insolar.RequestStatusPending, result.Status)
+
+			close(release)
+			deadline := time.Now().Add(10 * time.Second)
+			for result.Status == insolar.RequestStatusPending && time.Now().Before(deadline) {
+				time.Sleep(10 * time.Millisecond)
+				result, ok = cReq.GetRequestResult(ctx, *requestRef)
+				require.True(t, ok)
+			}
+			require.Equal(t, test.status, result.Status)
+			require.Equal(t, test.resultMessage.Error, result.Error)
+		})
+	}
+}
+
+func TestContractRequester_GetRequestResult_Unknown(t *testing.T) {
+	cReq, err := New(nil)
+	require.NoError(t, err)
+
+	_, ok := cReq.GetRequestResult(inslogger.TestContext(t), gen.Reference())
+	require.False(t, ok)
+}
+
 func TestContractRequester_CallMethod_Timeout(t *testing.T) {
 	ctx := inslogger.TestContext(t)
 	mc := minimock.NewController(t)
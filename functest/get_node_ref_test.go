@@ -66,5 +66,5 @@ func TestGetNodeRefInvalidParams(t *testing.T) {
 	nodeRef, err := getNodeRefSignedCall(map[string]interface{}{"publicKey": 123})
 	require.Equal(t, "", nodeRef)
 	require.NotNil(t, err)
-	require.Contains(t, err.Error(), "incorect input: failed to get 'publicKey' param")
+	require.Contains(t, err.Error(), "callParams.publicKey: expected string, got number")
 }
@@ -25,6 +25,8 @@ import (
 	"go/build"
 	"io"
 	"io/ioutil"
+	"net"
+	"net/http"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -39,6 +41,7 @@ import (
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/insolar/defaults"
 	"github.com/insolar/insolar/logicrunner/goplugin/goplugintestutils"
+	"github.com/insolar/insolar/platformpolicy"
 	"github.com/pkg/errors"
 )
 
@@ -156,12 +159,40 @@ func setInfo() error {
 }
 
 var insgorundPath string
+var insgoccPath string
 
 func buildGinsiderCLI() (err error) {
-	insgorundPath, _, err = goplugintestutils.Build()
+	insgorundPath, insgoccPath, err = goplugintestutils.Build()
 	return errors.Wrap(err, "[ buildGinsiderCLI ] could't build ginsider CLI: ")
 }
 
+// startBuilder starts builder service that compiles uploaded contracts,
+// nodes get its address and key from environment
+func startBuilder() error {
+	ks := platformpolicy.NewKeyProcessor()
+	privateKey, err := ks.GeneratePrivateKey()
+	if err != nil {
+		return errors.Wrap(err, "[ startBuilder ] can't generate key")
+	}
+	publicKey, err := ks.ExportPublicKeyPEM(ks.ExtractPublicKey(privateKey))
+	if err != nil {
+		return errors.Wrap(err, "[ startBuilder ] can't export public key")
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return errors.Wrap(err, "[ startBuilder ] can't listen")
+	}
+	go http.Serve(listener, goplugintestutils.NewLocalBuilder(insgoccPath, privateKey)) // nolint: errcheck
+
+	err = os.Setenv("INSOLAR_APIRUNNER_DEPLOY_BUILDERURL", "http://"+listener.Addr().String())
+	if err != nil {
+		return errors.Wrap(err, "[ startBuilder ] can't set builder url")
+	}
+	err = os.Setenv("INSOLAR_APIRUNNER_DEPLOY_BUILDERPUBLICKEY", string(publicKey))
+	return errors.Wrap(err, "[ startBuilder ] can't set builder public key")
+}
+
 func stopInsolard() error {
 	if stdin != nil {
 		defer stdin.Close()
@@ -363,6 +394,12 @@ func setup() error {
 	}
 	fmt.Println("[ setup ] ginsider CLI was successfully builded")
 
+	err = startBuilder()
+	if err != nil {
+		return errors.Wrap(err, "[ setup ] could't start builder: ")
+	}
+	fmt.Println("[ setup ] builder was successfully started")
+
 	err = startAllInsgorunds()
 	if err != nil {
 		return errors.Wrap(err, "[ setup ] could't start insgorund: ")
@@ -41,6 +41,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/insolar/insolar/api/requester"
+	"github.com/insolar/insolar/logicrunner/deploy"
 	"github.com/insolar/insolar/platformpolicy"
 
 	"github.com/pkg/errors"
@@ -331,14 +332,35 @@ func uploadContractOnce(t *testing.T, name string, code string) *insolar.Referen
 	return contracts[name].reference
 }
 
+// uploadContract deploys contract on behalf of root member, sources are built by the builder service
 func uploadContract(t *testing.T, contractName string, contractCode string) *insolar.Reference {
+	member, err := insolar.NewReferenceFromBase58(root.ref)
+	require.NoError(t, err)
+	ks := platformpolicy.NewKeyProcessor()
+	privateKey, err := ks.ImportPrivateKeyPEM([]byte(root.privKey))
+	require.NoError(t, err)
+
+	req := deploy.Request{
+		Name:        contractName,
+		Version:     "1.0.0",
+		Member:      *member,
+		MachineType: insolar.MachineTypeGoPlugin,
+		Source:      []byte(contractCode),
+	}
+	req.Signature, err = requester.Sign(privateKey, req.SignedData())
+	require.NoError(t, err)
+
 	uploadBody := getRPSResponseBody(t, postParams{
 		"jsonrpc": "2.0",
-		"method":  "contract.upload",
+		"method":  "deploy.upload",
 		"id":      "",
-		"params": map[string]string{
-			"name": contractName,
-			"code": contractCode,
+		"params": api.DeployArgs{
+			Name:        req.Name,
+			Version:     req.Version,
+			Member:      root.ref,
+			MachineType: "goplugin",
+			Source:      req.Source,
+			Signature:   req.Signature,
 		},
 	})
 	require.NotEmpty(t, uploadBody)
@@ -346,11 +368,11 @@ func uploadContract(t *testing.T, contractName string, contractCode string) *ins
 	uploadRes := struct {
 		Version string          `json:"jsonrpc"`
 		ID      string          `json:"id"`
-		Result  api.UploadReply `json:"result"`
+		Result  api.DeployReply `json:"result"`
 		Error   json2.Error     `json:"error"`
 	}{}
 
-	err := json.Unmarshal(uploadBody, &uploadRes)
+	err = json.Unmarshal(uploadBody, &uploadRes)
 	require.NoError(t, err)
 	require.Empty(t, uploadRes.Error)
 
@@ -30,4 +30,29 @@ type ContractRequester interface {
 	// CallMethod - low level calls contract
 	CallMethod(ctx context.Context, msg Message) (Reply, error)
 	CallConstructor(ctx context.Context, msg Message) (*Reference, error)
+
+	// SendRequestWithPulseAsync registers request and returns its reference without waiting for results.
+	// Results are tracked and can be fetched by GetRequestResult.
+	SendRequestWithPulseAsync(ctx context.Context, ref *Reference, method string, argsIn []interface{}, pulse PulseNumber) (*Reference, error)
+	// GetRequestResult returns tracked result of request sent by SendRequestWithPulseAsync, false if request isn't tracked.
+	GetRequestResult(ctx context.Context, request Reference) (*RequestResult, bool)
+}
+
+// RequestStatus is a status of request sent by contract requester.
+type RequestStatus string
+
+const (
+	// RequestStatusPending means that request is registered, but results aren't received yet.
+	RequestStatusPending RequestStatus = "pending"
+	// RequestStatusDone means that request is executed.
+	RequestStatusDone RequestStatus = "done"
+	// RequestStatusFailed means that execution of request returned error.
+	RequestStatusFailed RequestStatus = "failed"
+)
+
+// RequestResult is a tracked result of request.
+type RequestResult struct {
+	Status RequestStatus
+	Reply  Reply
+	Error  string
 }
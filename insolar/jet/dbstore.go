@@ -110,6 +110,23 @@ func (s *DBStore) Split(ctx context.Context, pulse insolar.PulseNumber, id insol
 	}
 	return left, right, nil
 }
+
+func (s *DBStore) Merge(ctx context.Context, pulse insolar.PulseNumber, id insolar.JetID) (insolar.JetID, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	tree := s.get(pulse)
+	parent, err := tree.Merge(id)
+	if err != nil {
+		return insolar.ZeroJetID, err
+	}
+	err = s.set(pulse, tree)
+	if err != nil {
+		return insolar.ZeroJetID, err
+	}
+	return parent, nil
+}
+
 func (s *DBStore) Clone(ctx context.Context, from, to insolar.PulseNumber) error {
 	s.Lock()
 	defer s.Unlock()
@@ -188,6 +188,29 @@ func TestDBStorage_SplitJetTree(t *testing.T) {
 	require.Equal(t, expectedLeafs, *tree, "actual tree in string form: %v", tree.String())
 }
 
+func TestDBStorage_MergeJetTree(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+
+	tmpdir, err := ioutil.TempDir("", "bdb-test-")
+	defer os.RemoveAll(tmpdir)
+	require.NoError(t, err)
+
+	db, err := store.NewBadgerDB(tmpdir)
+	require.NoError(t, err)
+	defer db.Stop(ctx)
+	s := NewDBStore(db)
+
+	left, _, err := s.Split(ctx, 100, insolar.ZeroJetID)
+	require.NoError(t, err)
+
+	parent, err := s.Merge(ctx, 100, left)
+	require.NoError(t, err)
+	assert.Equal(t, insolar.ZeroJetID, parent, "actual tree node in string form: %v", parent.DebugString())
+
+	tree := dbTreeForPulse(s, 100)
+	require.Equal(t, Tree{Head: &jet{Actual: true}}, *tree, "actual tree in string form: %v", tree.String())
+}
+
 func TestDBStorage_CloneJetTree(t *testing.T) {
 	ctx := inslogger.TestContext(t)
 
@@ -35,6 +35,11 @@ type StorageMock struct {
 	ForIDPreCounter uint64
 	ForIDMock       mStorageMockForID
 
+	MergeFunc       func(p context.Context, p1 insolar.PulseNumber, p2 insolar.JetID) (r insolar.JetID, r1 error)
+	MergeCounter    uint64
+	MergePreCounter uint64
+	MergeMock       mStorageMockMerge
+
 	SplitFunc       func(p context.Context, p1 insolar.PulseNumber, p2 insolar.JetID) (r insolar.JetID, r1 insolar.JetID, r2 error)
 	SplitCounter    uint64
 	SplitPreCounter uint64
@@ -57,6 +62,7 @@ func NewStorageMock(t minimock.Tester) *StorageMock {
 	m.AllMock = mStorageMockAll{mock: m}
 	m.CloneMock = mStorageMockClone{mock: m}
 	m.ForIDMock = mStorageMockForID{mock: m}
+	m.MergeMock = mStorageMockMerge{mock: m}
 	m.SplitMock = mStorageMockSplit{mock: m}
 	m.UpdateMock = mStorageMockUpdate{mock: m}
 
@@ -512,6 +518,158 @@ func (m *StorageMock) ForIDFinished() bool {
 	return true
 }
 
+type mStorageMockMerge struct {
+	mock              *StorageMock
+	mainExpectation   *StorageMockMergeExpectation
+	expectationSeries []*StorageMockMergeExpectation
+}
+
+type StorageMockMergeExpectation struct {
+	input  *StorageMockMergeInput
+	result *StorageMockMergeResult
+}
+
+type StorageMockMergeInput struct {
+	p  context.Context
+	p1 insolar.PulseNumber
+	p2 insolar.JetID
+}
+
+type StorageMockMergeResult struct {
+	r  insolar.JetID
+	r1 error
+}
+
+//Expect specifies that invocation of Storage.Merge is expected from 1 to Infinity times
+func (m *mStorageMockMerge) Expect(p context.Context, p1 insolar.PulseNumber, p2 insolar.JetID) *mStorageMockMerge {
+	m.mock.MergeFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &StorageMockMergeExpectation{}
+	}
+	m.mainExpectation.input = &StorageMockMergeInput{p, p1, p2}
+	return m
+}
+
+//Return specifies results of invocation of Storage.Merge
+func (m *mStorageMockMerge) Return(r insolar.JetID, r1 error) *StorageMock {
+	m.mock.MergeFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &StorageMockMergeExpectation{}
+	}
+	m.mainExpectation.result = &StorageMockMergeResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of Storage.Merge is expected once
+func (m *mStorageMockMerge) ExpectOnce(p context.Context, p1 insolar.PulseNumber, p2 insolar.JetID) *StorageMockMergeExpectation {
+	m.mock.MergeFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &StorageMockMergeExpectation{}
+	expectation.input = &StorageMockMergeInput{p, p1, p2}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *StorageMockMergeExpectation) Return(r insolar.JetID, r1 error) {
+	e.result = &StorageMockMergeResult{r, r1}
+}
+
+//Set uses given function f as a mock of Storage.Merge method
+func (m *mStorageMockMerge) Set(f func(p context.Context, p1 insolar.PulseNumber, p2 insolar.JetID) (r insolar.JetID, r1 error)) *StorageMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.MergeFunc = f
+	return m.mock
+}
+
+//Merge implements github.com/insolar/insolar/insolar/jet.Storage interface
+func (m *StorageMock) Merge(p context.Context, p1 insolar.PulseNumber, p2 insolar.JetID) (r insolar.JetID, r1 error) {
+	counter := atomic.AddUint64(&m.MergePreCounter, 1)
+	defer atomic.AddUint64(&m.MergeCounter, 1)
+
+	if len(m.MergeMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.MergeMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to StorageMock.Merge. %v %v %v", p, p1, p2)
+			return
+		}
+
+		input := m.MergeMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, StorageMockMergeInput{p, p1, p2}, "Storage.Merge got unexpected parameters")
+
+		result := m.MergeMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the StorageMock.Merge")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.MergeMock.mainExpectation != nil {
+
+		input := m.MergeMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, StorageMockMergeInput{p, p1, p2}, "Storage.Merge got unexpected parameters")
+		}
+
+		result := m.MergeMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the StorageMock.Merge")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.MergeFunc == nil {
+		m.t.Fatalf("Unexpected call to StorageMock.Merge. %v %v %v", p, p1, p2)
+		return
+	}
+
+	return m.MergeFunc(p, p1, p2)
+}
+
+//MergeMinimockCounter returns a count of StorageMock.MergeFunc invocations
+func (m *StorageMock) MergeMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.MergeCounter)
+}
+
+//MergeMinimockPreCounter returns the value of StorageMock.Merge invocations
+func (m *StorageMock) MergeMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.MergePreCounter)
+}
+
+//MergeFinished returns true if mock invocations count is ok
+func (m *StorageMock) MergeFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.MergeMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.MergeCounter) == uint64(len(m.MergeMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.MergeMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.MergeCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.MergeFunc != nil {
+		return atomic.LoadUint64(&m.MergeCounter) > 0
+	}
+
+	return true
+}
+
 type mStorageMockSplit struct {
 	mock              *StorageMock
 	mainExpectation   *StorageMockSplitExpectation
@@ -833,6 +991,10 @@ func (m *StorageMock) ValidateCallCounters() {
 		m.t.Fatal("Expected call to StorageMock.ForID")
 	}
 
+	if !m.MergeFinished() {
+		m.t.Fatal("Expected call to StorageMock.Merge")
+	}
+
 	if !m.SplitFinished() {
 		m.t.Fatal("Expected call to StorageMock.Split")
 	}
@@ -870,6 +1032,10 @@ func (m *StorageMock) MinimockFinish() {
 		m.t.Fatal("Expected call to StorageMock.ForID")
 	}
 
+	if !m.MergeFinished() {
+		m.t.Fatal("Expected call to StorageMock.Merge")
+	}
+
 	if !m.SplitFinished() {
 		m.t.Fatal("Expected call to StorageMock.Split")
 	}
@@ -895,6 +1061,7 @@ func (m *StorageMock) MinimockWait(timeout time.Duration) {
 		ok = ok && m.AllFinished()
 		ok = ok && m.CloneFinished()
 		ok = ok && m.ForIDFinished()
+		ok = ok && m.MergeFinished()
 		ok = ok && m.SplitFinished()
 		ok = ok && m.UpdateFinished()
 
@@ -917,6 +1084,10 @@ func (m *StorageMock) MinimockWait(timeout time.Duration) {
 				m.t.Error("Expected call to StorageMock.ForID")
 			}
 
+			if !m.MergeFinished() {
+				m.t.Error("Expected call to StorageMock.Merge")
+			}
+
 			if !m.SplitFinished() {
 				m.t.Error("Expected call to StorageMock.Split")
 			}
@@ -949,6 +1120,10 @@ func (m *StorageMock) AllMocksCalled() bool {
 		return false
 	}
 
+	if !m.MergeFinished() {
+		return false
+	}
+
 	if !m.SplitFinished() {
 		return false
 	}
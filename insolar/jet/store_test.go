@@ -69,6 +69,26 @@ func TestJetStorage_SplitJetTree(t *testing.T) {
 	require.Equal(t, "root (level=0 actual=false)\n 0 (level=1 actual=true)\n 1 (level=1 actual=true)\n", tree.String())
 }
 
+func TestJetStorage_MergeJetTree(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	s := NewStore()
+
+	left, right, err := s.Split(ctx, 100, insolar.ZeroJetID)
+	require.NoError(t, err)
+
+	parent, err := s.Merge(ctx, 100, right)
+	require.NoError(t, err)
+	require.Equal(t, insolar.ZeroJetID, parent)
+	require.Equal(t, []insolar.JetID{insolar.ZeroJetID}, s.All(ctx, 100))
+
+	parent, err = s.Merge(ctx, 100, left)
+	require.NoError(t, err)
+	require.Equal(t, insolar.ZeroJetID, parent)
+
+	tree, _ := treeForPulse(s, 100)
+	require.Equal(t, "root (level=0 actual=true)\n", tree.String())
+}
+
 func TestJetStorage_CloneJetTree(t *testing.T) {
 	ctx := inslogger.TestContext(t)
 	s := NewStore()
@@ -71,6 +71,24 @@ func (j *jet) Update(prefix []byte, setActual bool, maxDepth, depth uint8) {
 	}
 }
 
+// Get returns jet node located exactly on provided depth for provided prefix or nil if there is no such node.
+func (j *jet) Get(prefix []byte, depth, targetDepth uint8) *jet {
+	if j == nil {
+		return nil
+	}
+	if depth == targetDepth {
+		return j
+	}
+	if getBit(prefix, depth) {
+		return j.Right.Get(prefix, depth+1, targetDepth)
+	}
+	return j.Left.Get(prefix, depth+1, targetDepth)
+}
+
+func (j *jet) isLeaf() bool {
+	return j.Left == nil && j.Right == nil
+}
+
 // Clone clones tree either keeping actuality state or resetting it to false.
 func (j *jet) Clone(keep bool) *jet {
 	res := &jet{
@@ -160,6 +178,28 @@ func (t *Tree) Split(id insolar.JetID) (insolar.JetID, insolar.JetID, error) {
 	return left, right, nil
 }
 
+// Merge looks for parent of provided jet and removes both its branches, so parent becomes actual leaf.
+// It returns the parent jet. Both branches should be leafs, merge of already merged jet does nothing.
+func (t *Tree) Merge(id insolar.JetID) (insolar.JetID, error) {
+	if id.Depth() == 0 {
+		return insolar.ZeroJetID, errors.New("failed to merge: root jet has no sibling")
+	}
+
+	parentID := Parent(id)
+	j := t.Head.Get(parentID.Prefix(), 0, parentID.Depth())
+	if j == nil {
+		return insolar.ZeroJetID, errors.New("failed to merge: incorrect jet provided")
+	}
+	if (j.Left != nil && !j.Left.isLeaf()) || (j.Right != nil && !j.Right.isLeaf()) {
+		return insolar.ZeroJetID, errors.New("failed to merge: jet sibling is split")
+	}
+
+	j.Left = nil
+	j.Right = nil
+	j.Actual = true
+	return parentID, nil
+}
+
 func (t *Tree) LeafIDs() []insolar.JetID {
 	var ids []insolar.JetID
 	t.Head.ExtractLeafIDs(&ids, make([]byte, insolar.RecordHashSize), 0)
@@ -134,6 +134,43 @@ func TestTree_Split(t *testing.T) {
 	})
 }
 
+func TestTree_Merge(t *testing.T) {
+	tree := NewTree(true)
+	left, right, err := tree.Split(insolar.ZeroJetID)
+	require.NoError(t, err)
+	leftLeft, leftRight, err := tree.Split(left)
+	require.NoError(t, err)
+
+	t.Run("root jet returns error", func(t *testing.T) {
+		_, err := tree.Merge(insolar.ZeroJetID)
+		assert.Error(t, err)
+	})
+
+	t.Run("not existing jet returns error", func(t *testing.T) {
+		_, err := tree.Merge(NewIDFromString("0110"))
+		assert.Error(t, err)
+	})
+
+	t.Run("split sibling returns error", func(t *testing.T) {
+		_, err := tree.Merge(right)
+		assert.Error(t, err)
+	})
+
+	t.Run("merges jet", func(t *testing.T) {
+		parent, err := tree.Merge(leftLeft)
+		require.NoError(t, err)
+		assert.Equal(t, left, parent)
+		assert.Equal(t, []insolar.JetID{left, right}, tree.LeafIDs())
+	})
+
+	t.Run("merge of merged jet does nothing", func(t *testing.T) {
+		parent, err := tree.Merge(leftRight)
+		require.NoError(t, err)
+		assert.Equal(t, left, parent)
+		assert.Equal(t, []insolar.JetID{left, right}, tree.LeafIDs())
+	})
+}
+
 func TestTree_String(t *testing.T) {
 	tree := Tree{
 		Head: &jet{
@@ -0,0 +1,46 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package jet
+
+import (
+	"github.com/insolar/insolar/insolar"
+)
+
+// Remote procedures used by network in JetlessNetworkState to verify completeness of stored data.
+const (
+	// DropHashesProcedure is served by heavy nodes. It replies with hashes of jet drops for the last finalized pulse.
+	DropHashesProcedure = "jet.DropHashes"
+	// ConfirmDropsProcedure is served by light nodes. It replies with jets whose drops are stored with provided hashes.
+	ConfirmDropsProcedure = "jet.ConfirmDrops"
+)
+
+// DropHash is a hash of jet drop stored on ledger.
+type DropHash struct {
+	JetID insolar.JetID
+	Hash  []byte
+}
+
+// DropHashes is a set of jet drop hashes for one pulse.
+type DropHashes struct {
+	Pulse  insolar.PulseNumber
+	Hashes []DropHash
+}
+
+// DropsConfirmation is a reply of light node with jets whose drops match requested hashes.
+type DropsConfirmation struct {
+	Confirmed []insolar.JetID
+}
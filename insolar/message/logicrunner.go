@@ -152,12 +152,26 @@ type ValidateCaseBind struct {
 	Pulse     insolar.Pulse
 }
 
+// CaseBindRequest is a completed request, validator fetches the request, its result and
+// the object state from ledger and replays the request.
 type CaseBindRequest struct {
-	Parcel         insolar.Parcel
-	Request        insolar.Reference
-	MessageBusTape []byte
-	Reply          insolar.Reply
-	Error          string
+	RequestRef insolar.Reference
+	// Object is an object the request was executed on, for constructors it's the created object.
+	Object insolar.Reference
+	// State is an object state the request was executed on, it's empty for constructors.
+	State insolar.ID
+	// Outgoing are calls made during execution with their results.
+	Outgoing []CaseBindOutgoing
+	// StateHash is a hash of object memory after execution.
+	StateHash []byte
+}
+
+// CaseBindOutgoing is an outgoing call made by request with its result.
+type CaseBindOutgoing struct {
+	Request   record.IncomingRequest
+	Response  []byte
+	NewObject *insolar.Reference
+	Error     string
 }
 
 // AllowedSenderObjectAndRole implements interface method
@@ -197,6 +211,19 @@ type ValidationResults struct {
 	RecordRef        insolar.Reference
 	PassedStepsCount int
 	Error            string
+	Verdicts         []ValidationVerdict
+}
+
+// ValidationVerdict is a signed result of request replay made by validator.
+type ValidationVerdict struct {
+	Request    insolar.Reference
+	Validator  insolar.Reference
+	Valid      bool
+	Reason     string
+	ResultHash []byte
+	StateHash  []byte
+	// Signature is a validator signature of the verdict serialized with empty signature.
+	Signature []byte
 }
 
 // AllowedSenderObjectAndRole implements interface method
@@ -18,6 +18,7 @@ package insolar
 
 import (
 	"context"
+	"time"
 )
 
 // Cascade contains routing data for cascade sending
@@ -59,6 +60,48 @@ type PulseDistributor interface {
 	Distribute(context.Context, Pulse)
 }
 
+//go:generate minimock -i github.com/insolar/insolar/insolar.ReportingPulseDistributor -o ../testutils -s _mock.go
+
+// ReportingPulseDistributor is a PulseDistributor which waits for acknowledgements from nodes.
+type ReportingPulseDistributor interface {
+	PulseDistributor
+	// DistributeWithReport distributes a pulse across the network and reports which hosts acknowledged it.
+	DistributeWithReport(context.Context, Pulse) PulseDeliveryReport
+}
+
+// PulseDeliveryReport is a result of pulse distribution.
+type PulseDeliveryReport struct {
+	PulseNumber PulseNumber
+	// Hosts contains delivery result for every host pulse was sent to.
+	Hosts []PulseHostDelivery
+	// Delivered is a number of hosts acknowledged the pulse.
+	Delivered int
+	// Quorum is a number of acknowledgements required for successful distribution.
+	Quorum    int
+	StartedAt time.Time
+	Duration  time.Duration
+}
+
+// QuorumReached checks if enough hosts acknowledged the pulse.
+func (r PulseDeliveryReport) QuorumReached() bool {
+	return r.Delivered > 0 && r.Delivered >= r.Quorum
+}
+
+// Lost checks if the pulse reached no host.
+func (r PulseDeliveryReport) Lost() bool {
+	return r.Delivered == 0
+}
+
+// PulseHostDelivery is a result of pulse delivery to a single host.
+type PulseHostDelivery struct {
+	Address   string
+	Attempts  int
+	Delivered bool
+	// Error is the last delivery error.
+	Error   string
+	Latency time.Duration
+}
+
 // NetworkState type for bootstrapping process
 type NetworkState int
 
@@ -0,0 +1,33 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package insolar
+
+import (
+	"context"
+)
+
+//go:generate minimock -i github.com/insolar/insolar/insolar.Notifier -o ../testutils -s _mock.go
+
+// Notifier receives events of the node and delivers them to API subscribers.
+type Notifier interface {
+	// NotifyPulse is called when node switches to new pulse.
+	NotifyPulse(ctx context.Context, pulse Pulse)
+	// NotifyNetworkState is called when network state of the node is changed.
+	NotifyNetworkState(ctx context.Context, state NetworkState)
+	// NotifyObjectState is called when new state of the object is saved.
+	NotifyObjectState(ctx context.Context, object ID, state ID)
+}
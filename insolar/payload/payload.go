@@ -67,6 +67,9 @@ const (
 	TypeAdditionalCallFromPreviousExecutor
 	TypeStillExecuting
 
+	TypeGetResult
+	TypeObjectStateNotification
+
 	// should be the last (required by TypesMap)
 	_latestType
 )
@@ -216,6 +219,9 @@ func Marshal(payload Payload) ([]byte, error) {
 	case *Request:
 		pl.Polymorph = uint32(TypeRequest)
 		return pl.Marshal()
+	case *GetResult:
+		pl.Polymorph = uint32(TypeGetResult)
+		return pl.Marshal()
 	case *Deactivate:
 		pl.Polymorph = uint32(TypeDeactivate)
 		return pl.Marshal()
@@ -252,6 +258,9 @@ func Marshal(payload Payload) ([]byte, error) {
 	case *GetPendings:
 		pl.Polymorph = uint32(TypeGetPendings)
 		return pl.Marshal()
+	case *ObjectStateNotification:
+		pl.Polymorph = uint32(TypeObjectStateNotification)
+		return pl.Marshal()
 	}
 
 	return nil, errors.New("unknown payload type")
@@ -351,6 +360,10 @@ func Unmarshal(data []byte) (Payload, error) {
 		pl := Request{}
 		err := pl.Unmarshal(data)
 		return &pl, err
+	case TypeGetResult:
+		pl := GetResult{}
+		err := pl.Unmarshal(data)
+		return &pl, err
 	case TypeDeactivate:
 		pl := Deactivate{}
 		err := pl.Unmarshal(data)
@@ -399,6 +412,10 @@ func Unmarshal(data []byte) (Payload, error) {
 		pl := GetPendings{}
 		err := pl.Unmarshal(data)
 		return &pl, err
+	case TypeObjectStateNotification:
+		pl := ObjectStateNotification{}
+		err := pl.Unmarshal(data)
+		return &pl, err
 	}
 
 	return nil, errors.New("unknown payload type")
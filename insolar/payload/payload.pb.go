@@ -153,6 +153,8 @@ type GetObject struct {
 	Polymorph       uint32                                `protobuf:"varint,16,opt,name=Polymorph,proto3" json:"Polymorph,omitempty"`
 	ObjectID        github_com_insolar_insolar_insolar.ID `protobuf:"bytes,20,opt,name=ObjectID,proto3,customtype=github.com/insolar/insolar/insolar.ID" json:"ObjectID"`
 	ObjectRequestID github_com_insolar_insolar_insolar.ID `protobuf:"bytes,21,opt,name=ObjectRequestID,proto3,customtype=github.com/insolar/insolar/insolar.ID" json:"ObjectRequestID"`
+	// StateID is an optional ID of the state to fetch instead of the latest one.
+	StateID github_com_insolar_insolar_insolar.ID `protobuf:"bytes,22,opt,name=StateID,proto3,customtype=github.com/insolar/insolar/insolar.ID" json:"StateID"`
 }
 
 func (m *GetObject) Reset()      { *m = GetObject{} }
@@ -845,6 +847,52 @@ func (m *SagaCallAcceptNotification) GetRequest() []byte {
 	return nil
 }
 
+// ObjectStateNotification informs virtual node that new state of the object is saved.
+type ObjectStateNotification struct {
+	Polymorph uint32                                `protobuf:"varint,16,opt,name=Polymorph,proto3" json:"Polymorph,omitempty"`
+	ObjectID  github_com_insolar_insolar_insolar.ID `protobuf:"bytes,20,opt,name=ObjectID,proto3,customtype=github.com/insolar/insolar/insolar.ID" json:"ObjectID"`
+	StateID   github_com_insolar_insolar_insolar.ID `protobuf:"bytes,21,opt,name=StateID,proto3,customtype=github.com/insolar/insolar/insolar.ID" json:"StateID"`
+}
+
+func (m *ObjectStateNotification) Reset()      { *m = ObjectStateNotification{} }
+func (*ObjectStateNotification) ProtoMessage() {}
+func (*ObjectStateNotification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33334fec96407f54, []int{16}
+}
+func (m *ObjectStateNotification) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ObjectStateNotification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ObjectStateNotification.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ObjectStateNotification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectStateNotification.Merge(m, src)
+}
+func (m *ObjectStateNotification) XXX_Size() int {
+	return m.Size()
+}
+func (m *ObjectStateNotification) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectStateNotification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectStateNotification proto.InternalMessageInfo
+
+func (m *ObjectStateNotification) GetPolymorph() uint32 {
+	if m != nil {
+		return m.Polymorph
+	}
+	return 0
+}
+
 type SetResult struct {
 	Polymorph uint32 `protobuf:"varint,16,opt,name=Polymorph,proto3" json:"Polymorph,omitempty"`
 	Result    []byte `protobuf:"bytes,20,opt,name=Result,proto3" json:"Result,omitempty"`
@@ -853,7 +901,7 @@ type SetResult struct {
 func (m *SetResult) Reset()      { *m = SetResult{} }
 func (*SetResult) ProtoMessage() {}
 func (*SetResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33334fec96407f54, []int{16}
+	return fileDescriptor_33334fec96407f54, []int{17}
 }
 func (m *SetResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -905,7 +953,7 @@ type Activate struct {
 func (m *Activate) Reset()      { *m = Activate{} }
 func (*Activate) ProtoMessage() {}
 func (*Activate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33334fec96407f54, []int{17}
+	return fileDescriptor_33334fec96407f54, []int{18}
 }
 func (m *Activate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -964,7 +1012,7 @@ type Deactivate struct {
 func (m *Deactivate) Reset()      { *m = Deactivate{} }
 func (*Deactivate) ProtoMessage() {}
 func (*Deactivate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33334fec96407f54, []int{18}
+	return fileDescriptor_33334fec96407f54, []int{19}
 }
 func (m *Deactivate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1023,7 +1071,7 @@ type Update struct {
 func (m *Update) Reset()      { *m = Update{} }
 func (*Update) ProtoMessage() {}
 func (*Update) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33334fec96407f54, []int{19}
+	return fileDescriptor_33334fec96407f54, []int{20}
 }
 func (m *Update) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1083,7 +1131,7 @@ type GetFilament struct {
 func (m *GetFilament) Reset()      { *m = GetFilament{} }
 func (*GetFilament) ProtoMessage() {}
 func (*GetFilament) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33334fec96407f54, []int{20}
+	return fileDescriptor_33334fec96407f54, []int{21}
 }
 func (m *GetFilament) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1128,7 +1176,7 @@ type FilamentSegment struct {
 func (m *FilamentSegment) Reset()      { *m = FilamentSegment{} }
 func (*FilamentSegment) ProtoMessage() {}
 func (*FilamentSegment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33334fec96407f54, []int{21}
+	return fileDescriptor_33334fec96407f54, []int{22}
 }
 func (m *FilamentSegment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1182,7 +1230,7 @@ type RequestInfo struct {
 func (m *RequestInfo) Reset()      { *m = RequestInfo{} }
 func (*RequestInfo) ProtoMessage() {}
 func (*RequestInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33334fec96407f54, []int{22}
+	return fileDescriptor_33334fec96407f54, []int{23}
 }
 func (m *RequestInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1242,7 +1290,7 @@ type ResultInfo struct {
 func (m *ResultInfo) Reset()      { *m = ResultInfo{} }
 func (*ResultInfo) ProtoMessage() {}
 func (*ResultInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33334fec96407f54, []int{23}
+	return fileDescriptor_33334fec96407f54, []int{24}
 }
 func (m *ResultInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1296,7 +1344,7 @@ type HotObjects struct {
 func (m *HotObjects) Reset()      { *m = HotObjects{} }
 func (*HotObjects) ProtoMessage() {}
 func (*HotObjects) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33334fec96407f54, []int{24}
+	return fileDescriptor_33334fec96407f54, []int{25}
 }
 func (m *HotObjects) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1354,7 +1402,7 @@ type GetRequest struct {
 func (m *GetRequest) Reset()      { *m = GetRequest{} }
 func (*GetRequest) ProtoMessage() {}
 func (*GetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33334fec96407f54, []int{25}
+	return fileDescriptor_33334fec96407f54, []int{26}
 }
 func (m *GetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1399,7 +1447,7 @@ type Request struct {
 func (m *Request) Reset()      { *m = Request{} }
 func (*Request) ProtoMessage() {}
 func (*Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33334fec96407f54, []int{26}
+	return fileDescriptor_33334fec96407f54, []int{27}
 }
 func (m *Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1442,6 +1490,51 @@ func (m *Request) GetRequest() record.Virtual {
 	return record.Virtual{}
 }
 
+type GetResult struct {
+	Polymorph uint32                                `protobuf:"varint,16,opt,name=Polymorph,proto3" json:"Polymorph,omitempty"`
+	ObjectID  github_com_insolar_insolar_insolar.ID `protobuf:"bytes,20,opt,name=ObjectID,proto3,customtype=github.com/insolar/insolar/insolar.ID" json:"ObjectID"`
+	RequestID github_com_insolar_insolar_insolar.ID `protobuf:"bytes,21,opt,name=RequestID,proto3,customtype=github.com/insolar/insolar/insolar.ID" json:"RequestID"`
+}
+
+func (m *GetResult) Reset()      { *m = GetResult{} }
+func (*GetResult) ProtoMessage() {}
+func (*GetResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33334fec96407f54, []int{28}
+}
+func (m *GetResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetResult.Merge(m, src)
+}
+func (m *GetResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetResult proto.InternalMessageInfo
+
+func (m *GetResult) GetPolymorph() uint32 {
+	if m != nil {
+		return m.Polymorph
+	}
+	return 0
+}
+
 type ServiceData struct {
 	Polymorph     uint32                                      `protobuf:"varint,16,opt,name=Polymorph,proto3" json:"Polymorph,omitempty"`
 	LogTraceID    string                                      `protobuf:"bytes,20,opt,name=LogTraceID,proto3" json:"LogTraceID,omitempty"`
@@ -1452,7 +1545,7 @@ type ServiceData struct {
 func (m *ServiceData) Reset()      { *m = ServiceData{} }
 func (*ServiceData) ProtoMessage() {}
 func (*ServiceData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33334fec96407f54, []int{27}
+	return fileDescriptor_33334fec96407f54, []int{29}
 }
 func (m *ServiceData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1512,7 +1605,7 @@ type ExecutionQueueElement struct {
 func (m *ExecutionQueueElement) Reset()      { *m = ExecutionQueueElement{} }
 func (*ExecutionQueueElement) ProtoMessage() {}
 func (*ExecutionQueueElement) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33334fec96407f54, []int{28}
+	return fileDescriptor_33334fec96407f54, []int{30}
 }
 func (m *ExecutionQueueElement) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1573,7 +1666,7 @@ type ReturnResults struct {
 func (m *ReturnResults) Reset()      { *m = ReturnResults{} }
 func (*ReturnResults) ProtoMessage() {}
 func (*ReturnResults) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33334fec96407f54, []int{29}
+	return fileDescriptor_33334fec96407f54, []int{31}
 }
 func (m *ReturnResults) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1632,7 +1725,7 @@ type CallMethod struct {
 func (m *CallMethod) Reset()      { *m = CallMethod{} }
 func (*CallMethod) ProtoMessage() {}
 func (*CallMethod) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33334fec96407f54, []int{30}
+	return fileDescriptor_33334fec96407f54, []int{32}
 }
 func (m *CallMethod) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1687,7 +1780,7 @@ type ExecutorResults struct {
 func (m *ExecutorResults) Reset()      { *m = ExecutorResults{} }
 func (*ExecutorResults) ProtoMessage() {}
 func (*ExecutorResults) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33334fec96407f54, []int{31}
+	return fileDescriptor_33334fec96407f54, []int{33}
 }
 func (m *ExecutorResults) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1745,7 +1838,7 @@ type PendingFinished struct {
 func (m *PendingFinished) Reset()      { *m = PendingFinished{} }
 func (*PendingFinished) ProtoMessage() {}
 func (*PendingFinished) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33334fec96407f54, []int{32}
+	return fileDescriptor_33334fec96407f54, []int{34}
 }
 func (m *PendingFinished) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1793,7 +1886,7 @@ type AdditionalCallFromPreviousExecutor struct {
 func (m *AdditionalCallFromPreviousExecutor) Reset()      { *m = AdditionalCallFromPreviousExecutor{} }
 func (*AdditionalCallFromPreviousExecutor) ProtoMessage() {}
 func (*AdditionalCallFromPreviousExecutor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33334fec96407f54, []int{33}
+	return fileDescriptor_33334fec96407f54, []int{35}
 }
 func (m *AdditionalCallFromPreviousExecutor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1851,7 +1944,7 @@ type StillExecuting struct {
 func (m *StillExecuting) Reset()      { *m = StillExecuting{} }
 func (*StillExecuting) ProtoMessage() {}
 func (*StillExecuting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33334fec96407f54, []int{34}
+	return fileDescriptor_33334fec96407f54, []int{36}
 }
 func (m *StillExecuting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1895,7 +1988,7 @@ type GetPendings struct {
 func (m *GetPendings) Reset()      { *m = GetPendings{} }
 func (*GetPendings) ProtoMessage() {}
 func (*GetPendings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33334fec96407f54, []int{35}
+	return fileDescriptor_33334fec96407f54, []int{37}
 }
 func (m *GetPendings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1943,7 +2036,7 @@ type Replication struct {
 func (m *Replication) Reset()      { *m = Replication{} }
 func (*Replication) ProtoMessage() {}
 func (*Replication) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33334fec96407f54, []int{36}
+	return fileDescriptor_33334fec96407f54, []int{38}
 }
 func (m *Replication) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2017,6 +2110,7 @@ func init() {
 	proto.RegisterType((*SetIncomingRequest)(nil), "payload.SetIncomingRequest")
 	proto.RegisterType((*SetOutgoingRequest)(nil), "payload.SetOutgoingRequest")
 	proto.RegisterType((*SagaCallAcceptNotification)(nil), "payload.SagaCallAcceptNotification")
+	proto.RegisterType((*ObjectStateNotification)(nil), "payload.ObjectStateNotification")
 	proto.RegisterType((*SetResult)(nil), "payload.SetResult")
 	proto.RegisterType((*Activate)(nil), "payload.Activate")
 	proto.RegisterType((*Deactivate)(nil), "payload.Deactivate")
@@ -2028,6 +2122,7 @@ func init() {
 	proto.RegisterType((*HotObjects)(nil), "payload.HotObjects")
 	proto.RegisterType((*GetRequest)(nil), "payload.GetRequest")
 	proto.RegisterType((*Request)(nil), "payload.Request")
+	proto.RegisterType((*GetResult)(nil), "payload.GetResult")
 	proto.RegisterType((*ServiceData)(nil), "payload.ServiceData")
 	proto.RegisterType((*ExecutionQueueElement)(nil), "payload.ExecutionQueueElement")
 	proto.RegisterType((*ReturnResults)(nil), "payload.ReturnResults")
@@ -2043,99 +2138,101 @@ func init() {
 func init() { proto.RegisterFile("insolar/payload/payload.proto", fileDescriptor_33334fec96407f54) }
 
 var fileDescriptor_33334fec96407f54 = []byte{
-	// 1464 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x4f, 0x6f, 0xdc, 0xd4,
-	0x16, 0x8f, 0x93, 0x4c, 0x26, 0x73, 0xe6, 0xa5, 0xa9, 0xfc, 0x32, 0x13, 0xbf, 0xea, 0x3d, 0x37,
-	0xb2, 0x1e, 0x52, 0x24, 0x68, 0x52, 0x9a, 0xa8, 0x2c, 0x00, 0x55, 0x49, 0x27, 0x49, 0xa7, 0x24,
-	0x4d, 0x7a, 0x27, 0x2d, 0x15, 0x0b, 0x24, 0xc7, 0x73, 0xe2, 0x18, 0x79, 0x7c, 0x87, 0xeb, 0x3b,
-	0xa1, 0xd9, 0x21, 0xba, 0x63, 0xd5, 0x2d, 0x1f, 0x00, 0x89, 0x4f, 0x50, 0x84, 0xd8, 0xb1, 0xea,
-	0x06, 0xa9, 0xcb, 0x8a, 0x45, 0x45, 0xd3, 0x0d, 0xcb, 0x22, 0x81, 0xc4, 0x06, 0x09, 0xdd, 0xeb,
-	0xeb, 0xb1, 0x27, 0x6a, 0xb1, 0x99, 0x49, 0x07, 0x56, 0x73, 0xef, 0xf5, 0x39, 0xbf, 0xf3, 0xff,
-	0xf8, 0x5c, 0x0f, 0xfc, 0xcf, 0x0b, 0x42, 0xea, 0xdb, 0x6c, 0xb1, 0x6d, 0x1f, 0xf9, 0xd4, 0x6e,
-	0xc6, 0xbf, 0x0b, 0x6d, 0x46, 0x39, 0xd5, 0x8b, 0x6a, 0x7b, 0xee, 0x82, 0xeb, 0xf1, 0x83, 0xce,
-	0xde, 0x82, 0x43, 0x5b, 0x8b, 0x2e, 0x75, 0xe9, 0xa2, 0x7c, 0xbe, 0xd7, 0xd9, 0x97, 0x3b, 0xb9,
-	0x91, 0xab, 0x88, 0xef, 0xdc, 0xe5, 0x14, 0x79, 0x2c, 0xe1, 0xe4, 0x2f, 0x43, 0x87, 0xb2, 0xa6,
-	0xfa, 0x89, 0xf8, 0xac, 0x9f, 0x47, 0x61, 0x7c, 0x0b, 0xb9, 0xad, 0xff, 0x17, 0x4a, 0x3b, 0xd4,
-	0x3f, 0x6a, 0x51, 0xd6, 0x3e, 0x30, 0xce, 0xce, 0x69, 0xf3, 0x53, 0x24, 0x39, 0xd0, 0x0d, 0x28,
-	0xee, 0x44, 0x8a, 0x19, 0x33, 0x73, 0xda, 0xfc, 0xbf, 0x48, 0xbc, 0xd5, 0x37, 0x61, 0xa2, 0x81,
-	0x41, 0x13, 0x99, 0x51, 0x11, 0x0f, 0x56, 0x97, 0x1f, 0x3e, 0x39, 0x3f, 0xf2, 0xc3, 0x93, 0xf3,
-	0x6f, 0x64, 0x2b, 0xb4, 0x40, 0x70, 0x1f, 0x19, 0x06, 0x0e, 0x12, 0x85, 0xa1, 0xef, 0xc0, 0x24,
-	0x41, 0x07, 0xbd, 0x43, 0x64, 0x46, 0x75, 0x00, 0xbc, 0x2e, 0x8a, 0xbe, 0x09, 0x85, 0x9d, 0x8e,
-	0x1f, 0xa2, 0x31, 0x2b, 0xe1, 0x2e, 0x2b, 0xb8, 0x85, 0x1c, 0x70, 0x92, 0xef, 0x46, 0xa7, 0xb5,
-	0x87, 0x8c, 0x44, 0x20, 0xfa, 0x19, 0x18, 0xad, 0xd7, 0x0c, 0x43, 0xba, 0x60, 0xb4, 0x5e, 0xd3,
-	0x97, 0x00, 0xb6, 0x99, 0xe7, 0x7a, 0xc1, 0x35, 0x3b, 0x3c, 0x30, 0xfe, 0x23, 0x45, 0xfc, 0x5b,
-	0x89, 0x28, 0x6f, 0x61, 0x18, 0xda, 0x2e, 0x8a, 0x47, 0x24, 0x45, 0x66, 0x6d, 0x41, 0x61, 0x8d,
-	0x31, 0xca, 0x32, 0x7c, 0xae, 0xc3, 0xf8, 0x55, 0xda, 0x44, 0xe9, 0xf0, 0x29, 0x22, 0xd7, 0xe2,
-	0x6c, 0x17, 0xef, 0x72, 0xe9, 0xeb, 0x12, 0x91, 0x6b, 0xeb, 0x7b, 0x0d, 0x4a, 0x1b, 0xc8, 0xb7,
-	0xf7, 0x3e, 0x42, 0x87, 0x67, 0x60, 0xd6, 0x61, 0x32, 0xa2, 0xab, 0xd7, 0xa2, 0x40, 0xae, 0x5e,
-	0x50, 0xda, 0xbe, 0x96, 0xc3, 0x21, 0xf5, 0x1a, 0xe9, 0xb2, 0xeb, 0xef, 0xc3, 0x74, 0xb4, 0x26,
-	0xf8, 0x71, 0x07, 0x43, 0x81, 0x58, 0xe9, 0x07, 0xf1, 0x24, 0x8a, 0x15, 0x40, 0x71, 0x03, 0xb9,
-	0x34, 0xf7, 0xcf, 0x8d, 0x59, 0x83, 0x09, 0x41, 0xd5, 0xaf, 0x29, 0x8a, 0xd9, 0xfa, 0x5c, 0x83,
-	0xd2, 0x8e, 0x1d, 0x86, 0x0d, 0x6e, 0xf3, 0x2c, 0x91, 0x55, 0x98, 0x88, 0x02, 0xa9, 0xca, 0x40,
-	0xed, 0xf4, 0x0d, 0x28, 0x4a, 0xf6, 0x7e, 0x9d, 0x10, 0x73, 0x5b, 0xef, 0xc0, 0xb8, 0xd0, 0xa5,
-	0x3f, 0x35, 0xac, 0x2b, 0x50, 0x6c, 0xe4, 0x72, 0x5d, 0x15, 0x26, 0x88, 0x6c, 0x03, 0x31, 0x40,
-	0xb4, 0xb3, 0xde, 0x86, 0x42, 0x3d, 0x68, 0xe2, 0xdd, 0x0c, 0xf6, 0x19, 0x45, 0xa6, 0xb8, 0xa3,
-	0x8d, 0xd0, 0x7d, 0x00, 0xd1, 0xb7, 0xa0, 0x90, 0x33, 0x02, 0x2f, 0x62, 0x17, 0xe7, 0x5b, 0xd8,
-	0xa2, 0xec, 0x28, 0x0a, 0x00, 0x51, 0x3b, 0xcb, 0x16, 0x15, 0x9b, 0x81, 0xf9, 0xae, 0xac, 0xea,
-	0xbe, 0x92, 0x68, 0xb4, 0x5e, 0xb3, 0x9a, 0x30, 0x56, 0xaf, 0x65, 0x85, 0xec, 0x8a, 0x24, 0x32,
-	0x66, 0xe6, 0xc6, 0xfe, 0xba, 0x10, 0xc1, 0x69, 0x7d, 0xa3, 0xc1, 0xd8, 0x75, 0xcc, 0x2a, 0xf0,
-	0x75, 0x28, 0x5c, 0xc7, 0xa4, 0xba, 0x2f, 0x2a, 0x41, 0xf3, 0x39, 0x04, 0x49, 0x3e, 0x12, 0xb1,
-	0x27, 0x6d, 0xb3, 0x72, 0x0a, 0x6d, 0xd3, 0x72, 0x40, 0x6f, 0x20, 0xaf, 0x07, 0x0e, 0x6d, 0x79,
-	0x81, 0xab, 0x4a, 0x3d, 0xc3, 0x92, 0x45, 0x28, 0x2a, 0x42, 0x69, 0x4b, 0xf9, 0xd2, 0xf4, 0x82,
-	0x7a, 0x73, 0xdd, 0xf6, 0x18, 0xef, 0xd8, 0xfe, 0xea, 0xb8, 0x50, 0x8a, 0xc4, 0x54, 0x4a, 0xc8,
-	0x76, 0x87, 0xbb, 0xf4, 0xd5, 0x09, 0xf9, 0x45, 0x83, 0x73, 0x0d, 0xdb, 0xb5, 0xaf, 0xda, 0xbe,
-	0xbf, 0xe2, 0x38, 0xd8, 0xe6, 0x37, 0x28, 0xf7, 0xf6, 0x3d, 0xc7, 0xe6, 0x1e, 0x0d, 0x86, 0xd7,
-	0x7d, 0x1b, 0x30, 0x95, 0xb2, 0xb4, 0xdf, 0xb6, 0xd3, 0x8b, 0x21, 0xde, 0xf2, 0xb1, 0x37, 0xaa,
-	0xd1, 0x5b, 0x3e, 0x36, 0x7b, 0x05, 0x4a, 0x0d, 0xe4, 0x04, 0xc3, 0x8e, 0xcf, 0xf3, 0x14, 0xa8,
-	0xa0, 0x4b, 0x0a, 0x54, 0xec, 0xac, 0x3b, 0x30, 0xb9, 0xe2, 0x70, 0xef, 0x70, 0xa0, 0x12, 0x57,
-	0xc8, 0x95, 0x1e, 0xe4, 0x0f, 0x00, 0x6a, 0x68, 0xbf, 0x1a, 0xec, 0xdb, 0x30, 0x71, 0xab, 0xdd,
-	0x3c, 0x7d, 0xdc, 0x2f, 0x46, 0xa1, 0xbc, 0x81, 0x7c, 0xdd, 0xf3, 0xed, 0x16, 0x06, 0x43, 0x7c,
-	0x6d, 0xbf, 0x07, 0xa5, 0x06, 0xb7, 0x19, 0x5f, 0x67, 0xb4, 0xd5, 0x5f, 0xd2, 0x24, 0xfc, 0xfa,
-	0x2e, 0x94, 0x08, 0xda, 0xcd, 0x5b, 0x01, 0xf7, 0x7c, 0x35, 0xaf, 0xf5, 0xdb, 0x29, 0x12, 0x20,
-	0xeb, 0x5b, 0x0d, 0xa6, 0x63, 0xc7, 0x34, 0xd0, 0x1d, 0xae, 0x7f, 0xae, 0x88, 0x1a, 0x10, 0xa1,
-	0x0b, 0x8d, 0xca, 0xdc, 0xd8, 0x7c, 0xf9, 0xd2, 0xf9, 0xb8, 0x23, 0x5c, 0xa5, 0xad, 0x36, 0x0d,
-	0x3d, 0x8e, 0xb1, 0x6e, 0x11, 0x5d, 0xd2, 0x21, 0x24, 0x97, 0xf5, 0xab, 0x06, 0xe5, 0x78, 0x98,
-	0x09, 0xf6, 0xe9, 0x50, 0x23, 0x3b, 0xe0, 0x28, 0x96, 0xf0, 0xbf, 0xbc, 0x15, 0xa4, 0x32, 0x7a,
-	0xb6, 0x27, 0xa3, 0x1f, 0x6b, 0x00, 0xd1, 0x72, 0xb8, 0x66, 0xd7, 0xc5, 0x95, 0x41, 0x8a, 0xed,
-	0xd3, 0xea, 0x2e, 0x7b, 0xca, 0xb4, 0x6a, 0x8f, 0x69, 0xf7, 0x46, 0x01, 0xae, 0x51, 0x35, 0x61,
-	0x87, 0xc3, 0x7e, 0x03, 0x57, 0x4f, 0xe3, 0xe2, 0xa2, 0xc3, 0x78, 0x8d, 0xd1, 0xb6, 0xea, 0x42,
-	0x72, 0xad, 0x5f, 0x80, 0xa2, 0x1c, 0xdc, 0x30, 0x34, 0x66, 0x65, 0xaa, 0x4f, 0xc5, 0xa9, 0x2e,
-	0x8f, 0xe3, 0xc4, 0x56, 0x34, 0xd6, 0x27, 0x00, 0x1b, 0xc8, 0xf3, 0xbd, 0x57, 0x7b, 0x72, 0x71,
-	0x66, 0xb0, 0x5c, 0xb4, 0xbe, 0xd4, 0xba, 0xc9, 0x38, 0x44, 0xb1, 0xe9, 0xd9, 0xa0, 0x92, 0x6b,
-	0x36, 0xf8, 0x4e, 0x83, 0x72, 0x03, 0xd9, 0xa1, 0xe7, 0x60, 0xcd, 0xce, 0xbc, 0x52, 0x9b, 0x00,
-	0x9b, 0xd4, 0xdd, 0x65, 0xb6, 0x13, 0xdf, 0x60, 0x4a, 0x24, 0x75, 0xa2, 0x6f, 0xc3, 0xe4, 0x26,
-	0x75, 0x37, 0xf1, 0x10, 0x7d, 0x29, 0x7f, 0x6a, 0x75, 0x49, 0x99, 0xf2, 0x7a, 0x0e, 0x53, 0x62,
-	0x56, 0xd2, 0x05, 0xd1, 0xff, 0x0f, 0x53, 0x12, 0xbb, 0xd1, 0xb6, 0x03, 0xa1, 0x9f, 0x4a, 0xf2,
-	0xde, 0x43, 0xeb, 0x37, 0x0d, 0x2a, 0x6b, 0x77, 0xd1, 0xe9, 0x88, 0x79, 0xe6, 0x66, 0x07, 0x3b,
-	0xb8, 0xe6, 0x63, 0x8e, 0x16, 0xbc, 0x2b, 0xaa, 0x5f, 0xfa, 0x81, 0xe0, 0xbe, 0xf2, 0x7d, 0x7f,
-	0x77, 0xf7, 0x14, 0x8e, 0xbe, 0x04, 0x93, 0xf1, 0xd4, 0xa8, 0x82, 0x30, 0x9b, 0xe4, 0x68, 0xcf,
-	0x34, 0x49, 0xba, 0x84, 0xfa, 0xe5, 0x9e, 0x30, 0x48, 0x33, 0xcb, 0x97, 0x66, 0x16, 0xe2, 0x0f,
-	0x2d, 0xa9, 0x67, 0x24, 0x4d, 0x68, 0xfd, 0xae, 0xc1, 0x14, 0x41, 0xde, 0x61, 0x41, 0x54, 0xf7,
-	0x59, 0x95, 0xbe, 0x09, 0x13, 0xbb, 0x36, 0x73, 0x91, 0x0f, 0x64, 0xae, 0xc2, 0x38, 0xe1, 0xc0,
-	0xca, 0x29, 0x39, 0x70, 0x06, 0x0a, 0x04, 0xdb, 0xfe, 0x91, 0x0a, 0x76, 0xb4, 0x11, 0xa7, 0xf2,
-	0x0b, 0x84, 0x6c, 0xe1, 0x25, 0x12, 0x6d, 0xac, 0xaf, 0x35, 0x00, 0x31, 0xd7, 0x6e, 0x21, 0x3f,
-	0xa0, 0xcd, 0x0c, 0xe3, 0xdf, 0x3c, 0x39, 0x39, 0xbf, 0x34, 0x30, 0xdd, 0xda, 0xbd, 0x03, 0xe5,
-	0x54, 0x67, 0x52, 0x49, 0xdd, 0x6f, 0x5f, 0x4b, 0x43, 0x59, 0xf7, 0xc7, 0x60, 0x3a, 0x4a, 0x5a,
-	0xca, 0x72, 0xc7, 0x4e, 0x98, 0x8a, 0x6c, 0xb0, 0xd8, 0x45, 0x18, 0x3a, 0x11, 0x7d, 0x47, 0x18,
-	0x3f, 0x68, 0xe8, 0x12, 0x18, 0x7d, 0x19, 0x0a, 0xb2, 0xfc, 0x8c, 0xaa, 0xec, 0xcd, 0x66, 0x37,
-	0x7f, 0x5f, 0x58, 0x9d, 0x24, 0x22, 0xd6, 0x97, 0xa1, 0xb2, 0x89, 0x4d, 0x17, 0xd9, 0x35, 0x3b,
-	0xdc, 0xa2, 0x0c, 0x95, 0xef, 0x43, 0x19, 0xe9, 0x49, 0xf2, 0xe2, 0x87, 0xfa, 0x4d, 0x28, 0xee,
-	0x60, 0xd0, 0x14, 0x55, 0x66, 0xcc, 0x69, 0xf3, 0x85, 0xd5, 0xb7, 0x94, 0xf6, 0x8b, 0x79, 0xa2,
-	0x12, 0x71, 0xca, 0xcb, 0x3b, 0x89, 0x71, 0xac, 0x7b, 0x1a, 0x4c, 0xab, 0xf5, 0xba, 0x17, 0x78,
-	0xe1, 0x01, 0x66, 0x65, 0x14, 0x81, 0x52, 0xfc, 0x29, 0x68, 0xb0, 0x06, 0x92, 0xc0, 0x58, 0x0f,
-	0xc6, 0xc0, 0x5a, 0x69, 0x36, 0x3d, 0xe1, 0x2e, 0xdb, 0x17, 0xd1, 0x12, 0x73, 0xeb, 0x0e, 0xc3,
-	0x43, 0x8f, 0x76, 0xc2, 0x38, 0x65, 0x32, 0x14, 0xfb, 0x30, 0xf9, 0xd2, 0xa5, 0x44, 0x0c, 0xa4,
-	0xde, 0x49, 0xb0, 0xb4, 0xf7, 0x2b, 0xa7, 0xe3, 0xfd, 0x13, 0xcd, 0xa4, 0x7a, 0x4a, 0xcd, 0x24,
-	0x55, 0xf3, 0xb3, 0x39, 0x6b, 0xfe, 0x44, 0x2f, 0x36, 0xf2, 0xf6, 0xe2, 0xcf, 0x34, 0x38, 0xd3,
-	0xe0, 0x9e, 0xef, 0xab, 0x6c, 0x0f, 0xdc, 0xbf, 0x21, 0x7b, 0x0e, 0xe5, 0x1d, 0x4d, 0xf9, 0x34,
-	0x1c, 0xda, 0x48, 0x6b, 0x3d, 0x18, 0x15, 0x57, 0x88, 0xb6, 0x9f, 0xef, 0xab, 0xc2, 0x3f, 0xf2,
-	0x93, 0x4f, 0x7a, 0xb8, 0xac, 0x66, 0x0f, 0x97, 0xfa, 0xc5, 0xe4, 0xda, 0x15, 0xcd, 0xa2, 0x67,
-	0x63, 0xf2, 0x2d, 0x9b, 0x23, 0xf3, 0xd2, 0xd3, 0x96, 0x24, 0xeb, 0x4e, 0xb4, 0x46, 0x32, 0xd1,
-	0xae, 0x2e, 0x3f, 0x7a, 0x6a, 0x8e, 0x3c, 0x7e, 0x6a, 0x8e, 0x3c, 0x7f, 0x6a, 0x6a, 0x9f, 0x1e,
-	0x9b, 0xda, 0x57, 0xc7, 0xa6, 0xf6, 0xf0, 0xd8, 0xd4, 0x1e, 0x1d, 0x9b, 0xda, 0x8f, 0xc7, 0xa6,
-	0xf6, 0xd3, 0xb1, 0x39, 0xf2, 0xfc, 0xd8, 0xd4, 0xee, 0x3f, 0x33, 0x47, 0x1e, 0x3d, 0x33, 0x47,
-	0x1e, 0x3f, 0x33, 0x47, 0xf6, 0x26, 0xe4, 0x5f, 0x21, 0x4b, 0x7f, 0x04, 0x00, 0x00, 0xff, 0xff,
-	0xc5, 0x9a, 0xd7, 0x76, 0x9b, 0x19, 0x00, 0x00,
+	// 1494 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x4f, 0x6f, 0xdb, 0x46,
+	0x16, 0x37, 0x65, 0xcb, 0xb2, 0x9e, 0xd6, 0x71, 0xc0, 0x95, 0x64, 0x6e, 0xb0, 0xcb, 0x18, 0xc4,
+	0x16, 0x30, 0xd0, 0xc6, 0x4e, 0x63, 0x23, 0x3d, 0xb4, 0x45, 0x60, 0x47, 0xb6, 0xa3, 0xd4, 0x8e,
+	0x9d, 0x91, 0x93, 0x06, 0x3d, 0x14, 0xa0, 0xa9, 0x67, 0x9a, 0x05, 0xc5, 0x51, 0x87, 0x23, 0x37,
+	0xbe, 0x15, 0xcd, 0xad, 0xa7, 0x9c, 0x0a, 0xf4, 0x03, 0x14, 0xe8, 0x27, 0x48, 0x51, 0xf4, 0xd4,
+	0x9e, 0x02, 0xf4, 0x92, 0x63, 0xd0, 0x43, 0xd0, 0x38, 0x97, 0x1e, 0x53, 0xa0, 0x05, 0x7a, 0x29,
+	0x50, 0x70, 0x38, 0x14, 0x29, 0x23, 0x29, 0x59, 0x49, 0x51, 0x72, 0xd2, 0x0c, 0xf9, 0xde, 0x6f,
+	0xde, 0xbf, 0xf9, 0xf1, 0xcd, 0x08, 0xfe, 0xe7, 0x78, 0x3e, 0x75, 0x4d, 0xb6, 0xd8, 0x36, 0x8f,
+	0x5c, 0x6a, 0x36, 0xa3, 0xdf, 0x85, 0x36, 0xa3, 0x9c, 0xaa, 0x05, 0x39, 0x3d, 0x73, 0xce, 0x76,
+	0xf8, 0x41, 0x67, 0x6f, 0xc1, 0xa2, 0xad, 0x45, 0x9b, 0xda, 0x74, 0x51, 0xbc, 0xdf, 0xeb, 0xec,
+	0x8b, 0x99, 0x98, 0x88, 0x51, 0xa8, 0x77, 0xe6, 0x62, 0x42, 0x3c, 0x5a, 0xe1, 0xe4, 0x2f, 0x43,
+	0x8b, 0xb2, 0xa6, 0xfc, 0x09, 0xf5, 0x8c, 0x5f, 0x73, 0x30, 0xb1, 0x85, 0xdc, 0x54, 0xff, 0x0b,
+	0xc5, 0x1d, 0xea, 0x1e, 0xb5, 0x28, 0x6b, 0x1f, 0x68, 0xa7, 0xe7, 0x94, 0xf9, 0x69, 0x12, 0x3f,
+	0x50, 0x35, 0x28, 0xec, 0x84, 0x86, 0x69, 0xe5, 0x39, 0x65, 0xfe, 0x5f, 0x24, 0x9a, 0xaa, 0x9b,
+	0x30, 0xd9, 0x40, 0xaf, 0x89, 0x4c, 0xab, 0x04, 0x2f, 0x56, 0x97, 0xef, 0x3f, 0x3a, 0x3b, 0xf6,
+	0xd3, 0xa3, 0xb3, 0x6f, 0xa4, 0x1b, 0xb4, 0x40, 0x70, 0x1f, 0x19, 0x7a, 0x16, 0x12, 0x89, 0xa1,
+	0xee, 0xc0, 0x14, 0x41, 0x0b, 0x9d, 0x43, 0x64, 0x5a, 0x75, 0x00, 0xbc, 0x2e, 0x8a, 0xba, 0x09,
+	0xf9, 0x9d, 0x8e, 0xeb, 0xa3, 0x36, 0x2b, 0xe0, 0x2e, 0x4a, 0xb8, 0x85, 0x0c, 0x70, 0x42, 0xef,
+	0x5a, 0xa7, 0xb5, 0x87, 0x8c, 0x84, 0x20, 0xea, 0x29, 0xc8, 0xd5, 0x6b, 0x9a, 0x26, 0x42, 0x90,
+	0xab, 0xd7, 0xd4, 0x25, 0x80, 0x6d, 0xe6, 0xd8, 0x8e, 0x77, 0xc5, 0xf4, 0x0f, 0xb4, 0xff, 0x88,
+	0x25, 0xfe, 0x2d, 0x97, 0x28, 0x6d, 0xa1, 0xef, 0x9b, 0x36, 0x06, 0xaf, 0x48, 0x42, 0xcc, 0xd8,
+	0x82, 0xfc, 0x1a, 0x63, 0x94, 0xa5, 0xc4, 0x5c, 0x85, 0x89, 0xcb, 0xb4, 0x89, 0x22, 0xe0, 0xd3,
+	0x44, 0x8c, 0x83, 0x67, 0xbb, 0x78, 0x9b, 0x8b, 0x58, 0x17, 0x89, 0x18, 0x1b, 0x5f, 0xe4, 0xa0,
+	0xb8, 0x81, 0x7c, 0x7b, 0xef, 0x23, 0xb4, 0x78, 0x0a, 0x66, 0x1d, 0xa6, 0x42, 0xb9, 0x7a, 0x2d,
+	0x4c, 0xe4, 0xea, 0x39, 0x69, 0xed, 0x6b, 0x19, 0x02, 0x52, 0xaf, 0x91, 0xae, 0xba, 0xfa, 0x3e,
+	0xcc, 0x84, 0x63, 0x82, 0x1f, 0x77, 0xd0, 0x0f, 0x10, 0x2b, 0xfd, 0x20, 0x9e, 0x44, 0x51, 0x37,
+	0xa0, 0xd0, 0xe0, 0x26, 0xc7, 0x7a, 0x4d, 0xab, 0xf6, 0x03, 0x18, 0x69, 0x1b, 0x1e, 0x14, 0x36,
+	0x90, 0x8b, 0xb8, 0xfd, 0x7d, 0x54, 0xd6, 0x60, 0x32, 0x90, 0xea, 0x37, 0x26, 0x52, 0xd9, 0xf8,
+	0x5c, 0x81, 0xe2, 0x8e, 0xe9, 0xfb, 0x62, 0xfd, 0x94, 0x25, 0xab, 0x30, 0x19, 0x56, 0x84, 0xdc,
+	0x4f, 0x72, 0x96, 0x74, 0xbe, 0x32, 0x90, 0xf3, 0xef, 0xc0, 0x44, 0x60, 0x4b, 0x7f, 0x66, 0x18,
+	0x97, 0xa0, 0xd0, 0xc8, 0x14, 0xba, 0x2a, 0x4c, 0x12, 0xc1, 0x27, 0x11, 0x40, 0x38, 0x33, 0xde,
+	0x86, 0x7c, 0xdd, 0x6b, 0xe2, 0xed, 0x14, 0xf5, 0xb2, 0x14, 0x93, 0xda, 0xe1, 0x24, 0xb0, 0x7d,
+	0x80, 0xa5, 0x6f, 0x40, 0x3e, 0x63, 0x06, 0x9e, 0xa5, 0x1e, 0x3c, 0xdf, 0xc2, 0x16, 0x65, 0x47,
+	0x61, 0x02, 0x88, 0x9c, 0x19, 0x66, 0xb0, 0xf5, 0x53, 0x30, 0xdf, 0x15, 0xf4, 0xd0, 0x57, 0x11,
+	0xe5, 0xea, 0x35, 0xa3, 0x09, 0xe3, 0xf5, 0x5a, 0x5a, 0xca, 0x2e, 0x09, 0x21, 0xad, 0x3c, 0x37,
+	0xfe, 0xcf, 0x17, 0x09, 0x34, 0x8d, 0x6f, 0x15, 0x18, 0xbf, 0x8a, 0x69, 0x4c, 0xb1, 0x0e, 0xf9,
+	0xab, 0x18, 0xd3, 0xc4, 0x79, 0xb9, 0xd0, 0x7c, 0x86, 0x85, 0x84, 0x1e, 0x09, 0xd5, 0x63, 0xfe,
+	0xad, 0x0c, 0x81, 0x7f, 0x0d, 0x0b, 0xd4, 0x06, 0xf2, 0xba, 0x67, 0xd1, 0x96, 0xe3, 0xd9, 0x92,
+	0x33, 0x52, 0x3c, 0x59, 0x84, 0x82, 0x14, 0x14, 0xbe, 0x94, 0x2e, 0xcc, 0x2c, 0xc8, 0x4f, 0xe0,
+	0x4d, 0x87, 0xf1, 0x8e, 0xe9, 0xae, 0x4e, 0x04, 0x46, 0x91, 0x48, 0x4a, 0x2e, 0xb2, 0xdd, 0xe1,
+	0x36, 0x7d, 0x71, 0x8b, 0xfc, 0xa6, 0xc0, 0x99, 0x86, 0x69, 0x9b, 0x97, 0x4d, 0xd7, 0x5d, 0xb1,
+	0x2c, 0x6c, 0xf3, 0x6b, 0x94, 0x3b, 0xfb, 0x8e, 0x65, 0x72, 0x87, 0x7a, 0xa3, 0xa3, 0xf1, 0x06,
+	0x4c, 0x27, 0x3c, 0xed, 0x97, 0x76, 0x7a, 0x31, 0x82, 0x76, 0x21, 0x8a, 0x46, 0x35, 0x6c, 0x17,
+	0x22, 0xb7, 0x7f, 0x54, 0x60, 0x36, 0x5c, 0x5b, 0xec, 0xd1, 0x97, 0xe3, 0xf3, 0xd0, 0x48, 0x76,
+	0x05, 0x8a, 0x0d, 0xe4, 0x04, 0xfd, 0x8e, 0xcb, 0xb3, 0xd0, 0x4d, 0x20, 0x17, 0xd3, 0x4d, 0x30,
+	0x33, 0x6e, 0xc1, 0xd4, 0x8a, 0xc5, 0x9d, 0xc3, 0x81, 0x08, 0x4b, 0x22, 0x57, 0x7a, 0x90, 0x3f,
+	0x00, 0xa8, 0xa1, 0xf9, 0x62, 0xb0, 0x6f, 0xc2, 0xe4, 0x8d, 0x76, 0x73, 0xf8, 0xb8, 0x5f, 0xe6,
+	0xa0, 0xb4, 0x81, 0x7c, 0xdd, 0x71, 0xcd, 0x16, 0x7a, 0x23, 0xec, 0x66, 0xde, 0x83, 0x62, 0x83,
+	0x9b, 0x8c, 0xaf, 0x33, 0xda, 0xea, 0xaf, 0x28, 0x62, 0x7d, 0x75, 0x17, 0x8a, 0x04, 0xcd, 0xe6,
+	0x0d, 0x8f, 0x3b, 0xae, 0x56, 0x1d, 0x88, 0xf7, 0x62, 0x20, 0xe3, 0x3b, 0x05, 0x66, 0xa2, 0xc0,
+	0x34, 0xd0, 0x1e, 0x6d, 0x7c, 0x2e, 0x41, 0x21, 0x4c, 0x9d, 0xaf, 0x55, 0xe6, 0xc6, 0xe7, 0x4b,
+	0x17, 0xce, 0x46, 0xfc, 0x76, 0x99, 0xb6, 0xda, 0xd4, 0x77, 0x38, 0x46, 0xb6, 0x85, 0x72, 0x31,
+	0xdf, 0x09, 0x2d, 0xe3, 0x77, 0x05, 0x4a, 0x51, 0x8f, 0xe7, 0xed, 0xd3, 0x91, 0x66, 0x76, 0xc0,
+	0x0e, 0x35, 0xd6, 0x7f, 0x3e, 0xb1, 0x25, 0x2a, 0x7a, 0xb6, 0xa7, 0xa2, 0x1f, 0x2a, 0x00, 0xe1,
+	0x70, 0xb4, 0x6e, 0xd7, 0x61, 0x4a, 0x2e, 0xdb, 0xa7, 0xd7, 0x5d, 0xf5, 0x84, 0x6b, 0xd5, 0x1e,
+	0xd7, 0xee, 0xe4, 0x00, 0xae, 0x50, 0x79, 0xf0, 0xf0, 0x47, 0xdd, 0x4f, 0x54, 0x87, 0x71, 0x9e,
+	0x53, 0x61, 0xa2, 0xc6, 0x68, 0x5b, 0xb2, 0x90, 0x18, 0xab, 0xe7, 0xa0, 0x20, 0xda, 0x50, 0xf4,
+	0xb5, 0x59, 0x51, 0xea, 0xd3, 0x51, 0xa9, 0x8b, 0xc7, 0x51, 0x61, 0x4b, 0x19, 0xe3, 0x13, 0x80,
+	0x0d, 0xe4, 0xd9, 0xba, 0x84, 0x9e, 0x5a, 0x2c, 0x0f, 0x56, 0x8b, 0xc6, 0x57, 0x0a, 0x14, 0x46,
+	0xbf, 0x6c, 0xb2, 0xd3, 0xa9, 0x64, 0xea, 0x74, 0xbe, 0x57, 0xc4, 0xf9, 0x34, 0xd3, 0x57, 0xf2,
+	0x15, 0xdd, 0xf7, 0xc6, 0x0f, 0x0a, 0x94, 0x1a, 0xc8, 0x0e, 0x1d, 0x0b, 0x6b, 0x66, 0xea, 0x6d,
+	0x89, 0x0e, 0xb0, 0x49, 0xed, 0x5d, 0x66, 0x5a, 0xd1, 0x99, 0xb2, 0x48, 0x12, 0x4f, 0xd4, 0x6d,
+	0x98, 0xda, 0xa4, 0xf6, 0x26, 0x1e, 0xa2, 0x2b, 0x2c, 0x9b, 0x5e, 0x5d, 0x92, 0x96, 0xbd, 0x9e,
+	0xc1, 0xb2, 0x48, 0x95, 0x74, 0x41, 0xd4, 0xff, 0xc3, 0xb4, 0xc0, 0x6e, 0xb4, 0x4d, 0x2f, 0xb0,
+	0x4f, 0x6e, 0xd4, 0xde, 0x87, 0xc6, 0x1f, 0x0a, 0x54, 0xd6, 0x6e, 0xa3, 0xd5, 0x09, 0xba, 0xad,
+	0xeb, 0x1d, 0xec, 0xe0, 0x9a, 0x8b, 0x19, 0x3e, 0x23, 0xbb, 0x00, 0x32, 0x12, 0x04, 0xf7, 0xb5,
+	0xf2, 0x00, 0xd7, 0x32, 0x09, 0x1c, 0x75, 0x09, 0xa6, 0xa2, 0x3e, 0x5e, 0x16, 0xd2, 0x6c, 0xbc,
+	0xcf, 0x7a, 0xfa, 0x7b, 0xd2, 0x15, 0x54, 0x2f, 0xf6, 0xa4, 0x41, 0xb8, 0x59, 0xba, 0x50, 0x5e,
+	0x88, 0xee, 0xd0, 0x12, 0xef, 0x48, 0x52, 0xd0, 0xf8, 0x53, 0x81, 0x69, 0x82, 0xbc, 0xc3, 0xbc,
+	0xb0, 0x0c, 0xd3, 0xd8, 0x6a, 0x13, 0x26, 0x77, 0x4d, 0x66, 0x23, 0x1f, 0xc8, 0x5d, 0x89, 0x71,
+	0x22, 0x80, 0x95, 0x21, 0x05, 0xb0, 0x0c, 0x79, 0x82, 0x6d, 0xf7, 0x48, 0x26, 0x3b, 0x9c, 0xa8,
+	0x65, 0x79, 0xb9, 0x24, 0x3e, 0x43, 0x45, 0x12, 0x4e, 0x8c, 0x6f, 0x14, 0x80, 0xe0, 0xa4, 0xb1,
+	0x85, 0xfc, 0x80, 0x36, 0x53, 0x9c, 0x7f, 0xf3, 0xe4, 0x59, 0xe6, 0xb9, 0x89, 0xe9, 0xf2, 0xcf,
+	0x2d, 0x28, 0x25, 0xd8, 0x55, 0x16, 0x75, 0xbf, 0xdc, 0x9c, 0x84, 0x32, 0xee, 0x8e, 0xc3, 0x4c,
+	0x58, 0xb4, 0x94, 0x65, 0xce, 0x5d, 0xe0, 0x2a, 0xb2, 0xc1, 0x72, 0x17, 0x62, 0xa8, 0x24, 0xa0,
+	0x91, 0xc0, 0xf9, 0x41, 0x53, 0x17, 0xc3, 0xa8, 0xcb, 0x90, 0x17, 0xdb, 0x4f, 0xab, 0x8a, 0xef,
+	0x8b, 0xde, 0xad, 0xdf, 0x67, 0xee, 0x4e, 0x12, 0x0a, 0xab, 0xcb, 0x50, 0xd9, 0xc4, 0xa6, 0x8d,
+	0xec, 0x8a, 0xe9, 0x6f, 0x51, 0x86, 0x32, 0xf6, 0xbe, 0xc8, 0xf4, 0x14, 0x79, 0xf6, 0x4b, 0xf5,
+	0x3a, 0x14, 0x76, 0xd0, 0x6b, 0x06, 0xbb, 0x2c, 0xb8, 0xb6, 0xcc, 0xaf, 0xbe, 0x25, 0xad, 0x5f,
+	0xcc, 0x92, 0x95, 0x50, 0x53, 0x1c, 0x77, 0x48, 0x84, 0x63, 0xdc, 0x51, 0x60, 0x46, 0x8e, 0xd7,
+	0x1d, 0xcf, 0xf1, 0x0f, 0x30, 0xad, 0xa2, 0x08, 0x14, 0xa3, 0x5b, 0xbe, 0xc1, 0x08, 0x24, 0x86,
+	0x31, 0xee, 0x8d, 0x83, 0xb1, 0xd2, 0x6c, 0x3a, 0x41, 0xb8, 0x4c, 0x37, 0xc8, 0x56, 0xd0, 0x7b,
+	0xef, 0x30, 0x3c, 0x74, 0x68, 0xc7, 0x8f, 0x4a, 0x26, 0xc5, 0xb0, 0x0f, 0xe3, 0x4b, 0x4c, 0xb9,
+	0xc4, 0x40, 0xe6, 0x9d, 0x04, 0x4b, 0x46, 0xbf, 0x32, 0x9c, 0xe8, 0x9f, 0x20, 0x93, 0xea, 0x90,
+	0xc8, 0x24, 0xb1, 0xe7, 0x67, 0x33, 0xee, 0xf9, 0x13, 0x5c, 0xac, 0x65, 0xe5, 0xe2, 0xcf, 0x14,
+	0x38, 0xd5, 0xe0, 0x8e, 0xeb, 0xca, 0x6a, 0xf7, 0xec, 0x97, 0x50, 0x3d, 0x87, 0xe2, 0x9c, 0x29,
+	0x63, 0xea, 0x8f, 0xac, 0x2b, 0x31, 0xee, 0xe5, 0x82, 0x63, 0x50, 0xdb, 0xcd, 0x76, 0xe7, 0xf1,
+	0x4a, 0x5e, 0xc2, 0x25, 0x1b, 0xe4, 0x6a, 0x7a, 0x83, 0xac, 0x9e, 0x8f, 0x8f, 0x8e, 0x61, 0x3f,
+	0x7d, 0x3a, 0x12, 0xdf, 0x32, 0x39, 0x32, 0x27, 0xd9, 0x31, 0x0a, 0xb1, 0x6e, 0x57, 0xae, 0xc5,
+	0x5d, 0xf9, 0xea, 0xf2, 0x83, 0xc7, 0xfa, 0xd8, 0xc3, 0xc7, 0xfa, 0xd8, 0xd3, 0xc7, 0xba, 0xf2,
+	0xe9, 0xb1, 0xae, 0x7c, 0x7d, 0xac, 0x2b, 0xf7, 0x8f, 0x75, 0xe5, 0xc1, 0xb1, 0xae, 0xfc, 0x7c,
+	0xac, 0x2b, 0xbf, 0x1c, 0xeb, 0x63, 0x4f, 0x8f, 0x75, 0xe5, 0xee, 0x13, 0x7d, 0xec, 0xc1, 0x13,
+	0x7d, 0xec, 0xe1, 0x13, 0x7d, 0x6c, 0x6f, 0x52, 0xfc, 0xcb, 0xb5, 0xf4, 0xd7, 0x00, 0x43, 0x54,
+	0xcc, 0x11, 0x76, 0x1b, 0x00, 0x00,
 }
 
 func (this *Meta) Equal(that interface{}) bool {
@@ -2238,6 +2335,9 @@ func (this *GetObject) Equal(that interface{}) bool {
 	if !this.ObjectRequestID.Equal(that1.ObjectRequestID) {
 		return false
 	}
+	if !this.StateID.Equal(that1.StateID) {
+		return false
+	}
 	return true
 }
 func (this *GetCode) Equal(that interface{}) bool {
@@ -2611,6 +2711,36 @@ func (this *SagaCallAcceptNotification) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ObjectStateNotification) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ObjectStateNotification)
+	if !ok {
+		that2, ok := that.(ObjectStateNotification)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Polymorph != that1.Polymorph {
+		return false
+	}
+	if !this.ObjectID.Equal(that1.ObjectID) {
+		return false
+	}
+	if !this.StateID.Equal(that1.StateID) {
+		return false
+	}
+	return true
+}
 func (this *SetResult) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -2963,6 +3093,36 @@ func (this *Request) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *GetResult) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetResult)
+	if !ok {
+		that2, ok := that.(GetResult)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Polymorph != that1.Polymorph {
+		return false
+	}
+	if !this.ObjectID.Equal(that1.ObjectID) {
+		return false
+	}
+	if !this.RequestID.Equal(that1.RequestID) {
+		return false
+	}
+	return true
+}
 func (this *ServiceData) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -3340,11 +3500,12 @@ func (this *GetObject) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 7)
+	s := make([]string, 0, 8)
 	s = append(s, "&payload.GetObject{")
 	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
 	s = append(s, "ObjectID: "+fmt.Sprintf("%#v", this.ObjectID)+",\n")
 	s = append(s, "ObjectRequestID: "+fmt.Sprintf("%#v", this.ObjectRequestID)+",\n")
+	s = append(s, "StateID: "+fmt.Sprintf("%#v", this.StateID)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -3496,6 +3657,18 @@ func (this *SagaCallAcceptNotification) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *ObjectStateNotification) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&payload.ObjectStateNotification{")
+	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
+	s = append(s, "ObjectID: "+fmt.Sprintf("%#v", this.ObjectID)+",\n")
+	s = append(s, "StateID: "+fmt.Sprintf("%#v", this.StateID)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func (this *SetResult) GoString() string {
 	if this == nil {
 		return "nil"
@@ -3644,6 +3817,18 @@ func (this *Request) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *GetResult) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&payload.GetResult{")
+	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
+	s = append(s, "ObjectID: "+fmt.Sprintf("%#v", this.ObjectID)+",\n")
+	s = append(s, "RequestID: "+fmt.Sprintf("%#v", this.RequestID)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func (this *ServiceData) GoString() string {
 	if this == nil {
 		return "nil"
@@ -3969,6 +4154,16 @@ func (m *GetObject) MarshalTo(dAtA []byte) (int, error) {
 		return 0, err
 	}
 	i += n6
+	dAtA[i] = 0xb2
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintPayload(dAtA, i, uint64(m.StateID.Size()))
+	n7, err := m.StateID.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n7
 	return i, nil
 }
 
@@ -3999,11 +4194,11 @@ func (m *GetCode) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintPayload(dAtA, i, uint64(m.CodeID.Size()))
-	n7, err := m.CodeID.MarshalTo(dAtA[i:])
+	n8, err := m.CodeID.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n7
+	i += n8
 	return i, nil
 }
 
@@ -4042,11 +4237,11 @@ func (m *PassState) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintPayload(dAtA, i, uint64(m.StateID.Size()))
-	n8, err := m.StateID.MarshalTo(dAtA[i:])
+	n9, err := m.StateID.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n8
+	i += n9
 	return i, nil
 }
 
@@ -4250,11 +4445,11 @@ func (m *ID) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintPayload(dAtA, i, uint64(m.ID.Size()))
-	n9, err := m.ID.MarshalTo(dAtA[i:])
+	n10, err := m.ID.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n9
+	i += n10
 	return i, nil
 }
 
@@ -4324,21 +4519,21 @@ func (m *Jet) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintPayload(dAtA, i, uint64(m.JetID.Size()))
-	n10, err := m.JetID.MarshalTo(dAtA[i:])
+	n11, err := m.JetID.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n10
+	i += n11
 	dAtA[i] = 0xaa
 	i++
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintPayload(dAtA, i, uint64(m.Pulse.Size()))
-	n11, err := m.Pulse.MarshalTo(dAtA[i:])
+	n12, err := m.Pulse.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n11
+	i += n12
 	return i, nil
 }
 
@@ -4369,11 +4564,11 @@ func (m *SetIncomingRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintPayload(dAtA, i, uint64(m.Request.Size()))
-	n12, err := m.Request.MarshalTo(dAtA[i:])
+	n13, err := m.Request.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n12
+	i += n13
 	return i, nil
 }
 
@@ -4404,11 +4599,11 @@ func (m *SetOutgoingRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintPayload(dAtA, i, uint64(m.Request.Size()))
-	n13, err := m.Request.MarshalTo(dAtA[i:])
+	n14, err := m.Request.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n13
+	i += n14
 	return i, nil
 }
 
@@ -4439,21 +4634,21 @@ func (m *SagaCallAcceptNotification) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintPayload(dAtA, i, uint64(m.ObjectID.Size()))
-	n14, err := m.ObjectID.MarshalTo(dAtA[i:])
+	n15, err := m.ObjectID.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n14
+	i += n15
 	dAtA[i] = 0xaa
 	i++
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintPayload(dAtA, i, uint64(m.OutgoingReqID.Size()))
-	n15, err := m.OutgoingReqID.MarshalTo(dAtA[i:])
+	n16, err := m.OutgoingReqID.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n15
+	i += n16
 	if len(m.Request) > 0 {
 		dAtA[i] = 0xb2
 		i++
@@ -4465,6 +4660,51 @@ func (m *SagaCallAcceptNotification) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *ObjectStateNotification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ObjectStateNotification) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(m.Polymorph))
+	}
+	dAtA[i] = 0xa2
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintPayload(dAtA, i, uint64(m.ObjectID.Size()))
+	n17, err := m.ObjectID.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n17
+	dAtA[i] = 0xaa
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintPayload(dAtA, i, uint64(m.StateID.Size()))
+	n18, err := m.StateID.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n18
+	return i, nil
+}
+
 func (m *SetResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4648,31 +4888,31 @@ func (m *GetFilament) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintPayload(dAtA, i, uint64(m.ObjectID.Size()))
-	n16, err := m.ObjectID.MarshalTo(dAtA[i:])
+	n19, err := m.ObjectID.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n16
+	i += n19
 	dAtA[i] = 0xaa
 	i++
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintPayload(dAtA, i, uint64(m.StartFrom.Size()))
-	n17, err := m.StartFrom.MarshalTo(dAtA[i:])
+	n20, err := m.StartFrom.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n17
+	i += n20
 	dAtA[i] = 0xb2
 	i++
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintPayload(dAtA, i, uint64(m.ReadUntil.Size()))
-	n18, err := m.ReadUntil.MarshalTo(dAtA[i:])
+	n21, err := m.ReadUntil.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n18
+	i += n21
 	return i, nil
 }
 
@@ -4703,11 +4943,11 @@ func (m *FilamentSegment) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintPayload(dAtA, i, uint64(m.ObjectID.Size()))
-	n19, err := m.ObjectID.MarshalTo(dAtA[i:])
+	n22, err := m.ObjectID.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n19
+	i += n22
 	if len(m.Records) > 0 {
 		for _, msg := range m.Records {
 			dAtA[i] = 0xaa
@@ -4752,21 +4992,21 @@ func (m *RequestInfo) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintPayload(dAtA, i, uint64(m.ObjectID.Size()))
-	n20, err := m.ObjectID.MarshalTo(dAtA[i:])
+	n23, err := m.ObjectID.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n20
+	i += n23
 	dAtA[i] = 0xaa
 	i++
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintPayload(dAtA, i, uint64(m.RequestID.Size()))
-	n21, err := m.RequestID.MarshalTo(dAtA[i:])
+	n24, err := m.RequestID.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n21
+	i += n24
 	if len(m.Request) > 0 {
 		dAtA[i] = 0xb2
 		i++
@@ -4813,21 +5053,21 @@ func (m *ResultInfo) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintPayload(dAtA, i, uint64(m.ObjectID.Size()))
-	n22, err := m.ObjectID.MarshalTo(dAtA[i:])
+	n25, err := m.ObjectID.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n22
+	i += n25
 	dAtA[i] = 0xaa
 	i++
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintPayload(dAtA, i, uint64(m.ResultID.Size()))
-	n23, err := m.ResultID.MarshalTo(dAtA[i:])
+	n26, err := m.ResultID.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n23
+	i += n26
 	if len(m.Result) > 0 {
 		dAtA[i] = 0xb2
 		i++
@@ -4866,11 +5106,11 @@ func (m *HotObjects) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintPayload(dAtA, i, uint64(m.JetID.Size()))
-	n24, err := m.JetID.MarshalTo(dAtA[i:])
+	n27, err := m.JetID.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n24
+	i += n27
 	if len(m.Drop) > 0 {
 		dAtA[i] = 0xaa
 		i++
@@ -4884,11 +5124,11 @@ func (m *HotObjects) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintPayload(dAtA, i, uint64(m.Pulse.Size()))
-	n25, err := m.Pulse.MarshalTo(dAtA[i:])
+	n28, err := m.Pulse.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n25
+	i += n28
 	if len(m.Indexes) > 0 {
 		for _, msg := range m.Indexes {
 			dAtA[i] = 0xba
@@ -4933,11 +5173,11 @@ func (m *GetRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintPayload(dAtA, i, uint64(m.RequestID.Size()))
-	n26, err := m.RequestID.MarshalTo(dAtA[i:])
+	n29, err := m.RequestID.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n26
+	i += n29
 	return i, nil
 }
 
@@ -4968,21 +5208,66 @@ func (m *Request) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintPayload(dAtA, i, uint64(m.RequestID.Size()))
-	n27, err := m.RequestID.MarshalTo(dAtA[i:])
+	n30, err := m.RequestID.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n27
+	i += n30
 	dAtA[i] = 0xaa
 	i++
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintPayload(dAtA, i, uint64(m.Request.Size()))
-	n28, err := m.Request.MarshalTo(dAtA[i:])
+	n31, err := m.Request.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n28
+	i += n31
+	return i, nil
+}
+
+func (m *GetResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetResult) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(m.Polymorph))
+	}
+	dAtA[i] = 0xa2
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintPayload(dAtA, i, uint64(m.ObjectID.Size()))
+	n32, err := m.ObjectID.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n32
+	dAtA[i] = 0xaa
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintPayload(dAtA, i, uint64(m.RequestID.Size()))
+	n33, err := m.RequestID.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n33
 	return i, nil
 }
 
@@ -5061,22 +5346,22 @@ func (m *ExecutionQueueElement) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintPayload(dAtA, i, uint64(m.RequestRef.Size()))
-	n29, err := m.RequestRef.MarshalTo(dAtA[i:])
+	n34, err := m.RequestRef.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n29
+	i += n34
 	if m.Incoming != nil {
 		dAtA[i] = 0xaa
 		i++
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintPayload(dAtA, i, uint64(m.Incoming.Size()))
-		n30, err := m.Incoming.MarshalTo(dAtA[i:])
+		n35, err := m.Incoming.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n30
+		i += n35
 	}
 	if m.ServiceData != nil {
 		dAtA[i] = 0xb2
@@ -5084,11 +5369,11 @@ func (m *ExecutionQueueElement) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintPayload(dAtA, i, uint64(m.ServiceData.Size()))
-		n31, err := m.ServiceData.MarshalTo(dAtA[i:])
+		n36, err := m.ServiceData.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n31
+		i += n36
 	}
 	return i, nil
 }
@@ -5120,21 +5405,21 @@ func (m *ReturnResults) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintPayload(dAtA, i, uint64(m.Target.Size()))
-	n32, err := m.Target.MarshalTo(dAtA[i:])
+	n37, err := m.Target.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n32
+	i += n37
 	dAtA[i] = 0xaa
 	i++
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintPayload(dAtA, i, uint64(m.RequestRef.Size()))
-	n33, err := m.RequestRef.MarshalTo(dAtA[i:])
+	n38, err := m.RequestRef.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n33
+	i += n38
 	if len(m.Reply) > 0 {
 		dAtA[i] = 0xb2
 		i++
@@ -5182,11 +5467,11 @@ func (m *CallMethod) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintPayload(dAtA, i, uint64(m.Request.Size()))
-		n34, err := m.Request.MarshalTo(dAtA[i:])
+		n39, err := m.Request.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n34
+		i += n39
 	}
 	if m.PulseNumber != 0 {
 		dAtA[i] = 0xa8
@@ -5225,21 +5510,21 @@ func (m *ExecutorResults) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintPayload(dAtA, i, uint64(m.Caller.Size()))
-	n35, err := m.Caller.MarshalTo(dAtA[i:])
+	n40, err := m.Caller.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n35
+	i += n40
 	dAtA[i] = 0xaa
 	i++
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintPayload(dAtA, i, uint64(m.RecordRef.Size()))
-	n36, err := m.RecordRef.MarshalTo(dAtA[i:])
+	n41, err := m.RecordRef.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n36
+	i += n41
 	if len(m.Queue) > 0 {
 		for _, msg := range m.Queue {
 			dAtA[i] = 0xb2
@@ -5303,11 +5588,11 @@ func (m *PendingFinished) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintPayload(dAtA, i, uint64(m.ObjectRef.Size()))
-	n37, err := m.ObjectRef.MarshalTo(dAtA[i:])
+	n42, err := m.ObjectRef.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n37
+	i += n42
 	return i, nil
 }
 
@@ -5338,11 +5623,11 @@ func (m *AdditionalCallFromPreviousExecutor) MarshalTo(dAtA []byte) (int, error)
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintPayload(dAtA, i, uint64(m.ObjectReference.Size()))
-	n38, err := m.ObjectReference.MarshalTo(dAtA[i:])
+	n43, err := m.ObjectReference.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n38
+	i += n43
 	if m.Pending != 0 {
 		dAtA[i] = 0xa8
 		i++
@@ -5355,22 +5640,22 @@ func (m *AdditionalCallFromPreviousExecutor) MarshalTo(dAtA []byte) (int, error)
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintPayload(dAtA, i, uint64(m.RequestRef.Size()))
-	n39, err := m.RequestRef.MarshalTo(dAtA[i:])
+	n44, err := m.RequestRef.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n39
+	i += n44
 	if m.Request != nil {
 		dAtA[i] = 0xba
 		i++
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintPayload(dAtA, i, uint64(m.Request.Size()))
-		n40, err := m.Request.MarshalTo(dAtA[i:])
+		n45, err := m.Request.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n40
+		i += n45
 	}
 	if m.ServiceData != nil {
 		dAtA[i] = 0xc2
@@ -5378,11 +5663,11 @@ func (m *AdditionalCallFromPreviousExecutor) MarshalTo(dAtA []byte) (int, error)
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintPayload(dAtA, i, uint64(m.ServiceData.Size()))
-		n41, err := m.ServiceData.MarshalTo(dAtA[i:])
+		n46, err := m.ServiceData.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n41
+		i += n46
 	}
 	return i, nil
 }
@@ -5414,11 +5699,11 @@ func (m *StillExecuting) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintPayload(dAtA, i, uint64(m.ObjectRef.Size()))
-	n42, err := m.ObjectRef.MarshalTo(dAtA[i:])
+	n47, err := m.ObjectRef.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n42
+	i += n47
 	return i, nil
 }
 
@@ -5449,11 +5734,11 @@ func (m *GetPendings) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintPayload(dAtA, i, uint64(m.ObjectID.Size()))
-	n43, err := m.ObjectID.MarshalTo(dAtA[i:])
+	n48, err := m.ObjectID.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n43
+	i += n48
 	return i, nil
 }
 
@@ -5484,21 +5769,21 @@ func (m *Replication) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintPayload(dAtA, i, uint64(m.JetID.Size()))
-	n44, err := m.JetID.MarshalTo(dAtA[i:])
+	n49, err := m.JetID.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n44
+	i += n49
 	dAtA[i] = 0xaa
 	i++
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintPayload(dAtA, i, uint64(m.Pulse.Size()))
-	n45, err := m.Pulse.MarshalTo(dAtA[i:])
+	n50, err := m.Pulse.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n45
+	i += n50
 	if len(m.Indexes) > 0 {
 		for _, msg := range m.Indexes {
 			dAtA[i] = 0xb2
@@ -5607,6 +5892,8 @@ func (m *GetObject) Size() (n int) {
 	n += 2 + l + sovPayload(uint64(l))
 	l = m.ObjectRequestID.Size()
 	n += 2 + l + sovPayload(uint64(l))
+	l = m.StateID.Size()
+	n += 2 + l + sovPayload(uint64(l))
 	return n
 }
 
@@ -5822,6 +6109,22 @@ func (m *SagaCallAcceptNotification) Size() (n int) {
 	return n
 }
 
+func (m *ObjectStateNotification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		n += 2 + sovPayload(uint64(m.Polymorph))
+	}
+	l = m.ObjectID.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	l = m.StateID.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	return n
+}
+
 func (m *SetResult) Size() (n int) {
 	if m == nil {
 		return 0
@@ -6036,6 +6339,22 @@ func (m *Request) Size() (n int) {
 	return n
 }
 
+func (m *GetResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		n += 2 + sovPayload(uint64(m.Polymorph))
+	}
+	l = m.ObjectID.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	l = m.RequestID.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	return n
+}
+
 func (m *ServiceData) Size() (n int) {
 	if m == nil {
 		return 0
@@ -6302,6 +6621,7 @@ func (this *GetObject) String() string {
 		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
 		`ObjectID:` + fmt.Sprintf("%v", this.ObjectID) + `,`,
 		`ObjectRequestID:` + fmt.Sprintf("%v", this.ObjectRequestID) + `,`,
+		`StateID:` + fmt.Sprintf("%v", this.StateID) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -6454,6 +6774,18 @@ func (this *SagaCallAcceptNotification) String() string {
 	}, "")
 	return s
 }
+func (this *ObjectStateNotification) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ObjectStateNotification{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`ObjectID:` + fmt.Sprintf("%v", this.ObjectID) + `,`,
+		`StateID:` + fmt.Sprintf("%v", this.StateID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *SetResult) String() string {
 	if this == nil {
 		return "nil"
@@ -6590,6 +6922,18 @@ func (this *Request) String() string {
 	}, "")
 	return s
 }
+func (this *GetResult) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetResult{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`ObjectID:` + fmt.Sprintf("%v", this.ObjectID) + `,`,
+		`RequestID:` + fmt.Sprintf("%v", this.RequestID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ServiceData) String() string {
 	if this == nil {
 		return "nil"
@@ -7237,6 +7581,39 @@ func (m *GetObject) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StateID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPayload(dAtA[iNdEx:])
@@ -8801,6 +9178,144 @@ func (m *SagaCallAcceptNotification) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ObjectStateNotification) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPayload
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ObjectStateNotification: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ObjectStateNotification: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Polymorph", wireType)
+			}
+			m.Polymorph = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Polymorph |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StateID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPayload(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *SetResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -10464,6 +10979,144 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GetResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPayload
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Polymorph", wireType)
+			}
+			m.Polymorph = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Polymorph |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RequestID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPayload(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ServiceData) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -75,6 +75,8 @@ func TestMarshalUnmarshal(t *testing.T) {
 		{tp: payload.TypeHotObjects, pl: &payload.HotObjects{}},
 		{tp: payload.TypeGetRequest, pl: &payload.GetRequest{}},
 		{tp: payload.TypeGetPendings, pl: &payload.GetPendings{}},
+		{tp: payload.TypeGetResult, pl: &payload.GetResult{}},
+		{tp: payload.TypeObjectStateNotification, pl: &payload.ObjectStateNotification{}},
 	}
 
 	for _, d := range table {
@@ -117,9 +117,16 @@ func (pn PulseNumber) Size() int {
 //go:generate minimock -i github.com/insolar/insolar/insolar.PulseManager -o ../testutils -s _mock.go
 
 // PulseManager provides Ledger's methods related to Pulse.
+//
+// Pulse can be changed in two phases: Prepare is called when consensus starts pulse change, then Set applies the pulse
+// or Cancel discards prepared change and the current pulse continues.
 type PulseManager interface {
 	// Set set's new pulse and closes current jet drop. If dry is true, nothing will be saved to storage.
 	Set(ctx context.Context, pulse Pulse) error
+	// Prepare prepares pulse change to provided pulse number.
+	Prepare(ctx context.Context, pn PulseNumber) error
+	// Cancel discards prepared pulse change.
+	Cancel(ctx context.Context)
 }
 
 // PulseRange represents range of pulses.
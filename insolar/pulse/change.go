@@ -0,0 +1,82 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package pulse
+
+import (
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+)
+
+// PreparedChange keeps state of two-phase pulse change for pulse managers. Change is started by Prepare and finished
+// either by Commit or by Cancel. Consensus round can be aborted without Cancel, so change prepared for an earlier pulse
+// is considered stale and is superseded by Prepare or Commit of a later pulse.
+type PreparedChange struct {
+	lock     sync.Mutex
+	prepared insolar.PulseNumber
+}
+
+// Prepare remembers pulse number of prepared change. Only pulses after current one can be prepared. Preparing the same
+// or a later pulse is allowed, preparing an earlier pulse than prepared one returns ErrChangeInProgress until the
+// change is finished.
+func (c *PreparedChange) Prepare(current, next insolar.PulseNumber) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if next <= current {
+		return errors.Wrapf(ErrBadPulse, "can't prepare pulse %v, current is %v", next, current)
+	}
+	if c.prepared != 0 && c.prepared > next {
+		return errors.Wrapf(ErrChangeInProgress, "can't prepare pulse %v, prepared is %v", next, c.prepared)
+	}
+	c.prepared = next
+	return nil
+}
+
+// Commit finishes prepared change. If change was prepared for a later pulse, ErrChangeInProgress is returned. Commit
+// without Prepare is allowed for pulse managers, which are changed in one phase.
+func (c *PreparedChange) Commit(pn insolar.PulseNumber) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.prepared != 0 && c.prepared > pn {
+		return errors.Wrapf(ErrChangeInProgress, "can't commit pulse %v, prepared is %v", pn, c.prepared)
+	}
+	c.prepared = 0
+	return nil
+}
+
+// Cancel discards prepared change and returns pulse number it was prepared for. If nothing was prepared, false is
+// returned.
+func (c *PreparedChange) Cancel() (insolar.PulseNumber, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	pn := c.prepared
+	c.prepared = 0
+	return pn, pn != 0
+}
+
+// Prepared returns pulse number of prepared change. If nothing was prepared, false is returned.
+func (c *PreparedChange) Prepared() (insolar.PulseNumber, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.prepared, c.prepared != 0
+}
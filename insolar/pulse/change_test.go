@@ -0,0 +1,95 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package pulse
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+)
+
+func TestPreparedChange(t *testing.T) {
+	current := insolar.GenesisPulse.PulseNumber + 10
+	next := current + 10
+
+	t.Run("commit prepared", func(t *testing.T) {
+		var c PreparedChange
+		require.NoError(t, c.Prepare(current, next))
+		require.NoError(t, c.Prepare(current, next))
+
+		pn, ok := c.Prepared()
+		assert.True(t, ok)
+		assert.Equal(t, next, pn)
+
+		err := c.Commit(next - 1)
+		assert.Equal(t, ErrChangeInProgress, errors.Cause(err))
+
+		require.NoError(t, c.Commit(next))
+		_, ok = c.Prepared()
+		assert.False(t, ok)
+	})
+
+	t.Run("commit without prepare", func(t *testing.T) {
+		var c PreparedChange
+		require.NoError(t, c.Commit(next))
+	})
+
+	t.Run("cancel", func(t *testing.T) {
+		var c PreparedChange
+		_, ok := c.Cancel()
+		assert.False(t, ok)
+
+		require.NoError(t, c.Prepare(current, next))
+		err := c.Prepare(current, next-1)
+		assert.Equal(t, ErrChangeInProgress, errors.Cause(err))
+
+		pn, ok := c.Cancel()
+		assert.True(t, ok)
+		assert.Equal(t, next, pn)
+
+		require.NoError(t, c.Prepare(current, next-1))
+	})
+
+	t.Run("round aborted after prepare", func(t *testing.T) {
+		// Round is aborted after state hash is taken, so prepared change is never cancelled.
+		var c PreparedChange
+		require.NoError(t, c.Prepare(current, next))
+
+		// Next round prepares and commits the next pulse.
+		require.NoError(t, c.Prepare(current, next+10))
+		pn, ok := c.Prepared()
+		assert.True(t, ok)
+		assert.Equal(t, next+10, pn)
+		require.NoError(t, c.Commit(next+10))
+
+		// Pulse is set without the next round at all.
+		require.NoError(t, c.Prepare(next+10, next+20))
+		require.NoError(t, c.Commit(next+30))
+		_, ok = c.Prepared()
+		assert.False(t, ok)
+	})
+
+	t.Run("past pulse", func(t *testing.T) {
+		var c PreparedChange
+		err := c.Prepare(current, current)
+		assert.Equal(t, ErrBadPulse, errors.Cause(err))
+	})
+}
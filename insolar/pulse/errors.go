@@ -25,4 +25,6 @@ var (
 	ErrNotFound = errors.New("pulse not found")
 	// ErrBadPulse is returned when appended Pulse is less than the latest.
 	ErrBadPulse = errors.New("pulse should be greater than the latest")
+	// ErrChangeInProgress is returned when another pulse change is already prepared.
+	ErrChangeInProgress = errors.New("another pulse change is already prepared")
 )
@@ -106,6 +106,12 @@ func Wrap(record Record) Virtual {
 				PendingFilament: &generic,
 			},
 		}
+	case Validation:
+		return Virtual{
+			Union: &Virtual_Validation{
+				Validation: &generic,
+			},
+		}
 	default:
 		panic(fmt.Sprintf("%T record is not registered", generic))
 	}
@@ -140,6 +146,8 @@ func Unwrap(v *Virtual) Record {
 		return r.Deactivate
 	case *Virtual_PendingFilament:
 		return r.PendingFilament
+	case *Virtual_Validation:
+		return r.Validation
 	default:
 		panic(fmt.Sprintf("%T virtual record unknown type", r))
 	}
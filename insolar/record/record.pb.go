@@ -298,6 +298,11 @@ type Result struct {
 	Object    github_com_insolar_insolar_insolar.ID        `protobuf:"bytes,20,opt,name=Object,proto3,customtype=github.com/insolar/insolar/insolar.ID" json:"Object"`
 	Request   github_com_insolar_insolar_insolar.Reference `protobuf:"bytes,21,opt,name=Request,proto3,customtype=github.com/insolar/insolar/insolar.Reference" json:"Request"`
 	Payload   []byte                                       `protobuf:"bytes,22,opt,name=Payload,proto3" json:"Payload,omitempty"`
+	// Execution budget spent by the request, see configuration.ExecutionBudget.
+	// Execution time isn't saved: it differs between executor and validators, so it can't be in the hashed record.
+	RouteCalls       uint32 `protobuf:"varint,23,opt,name=RouteCalls,proto3" json:"RouteCalls,omitempty"`
+	SaveAsChildCalls uint32 `protobuf:"varint,24,opt,name=SaveAsChildCalls,proto3" json:"SaveAsChildCalls,omitempty"`
+	StateSize        uint64 `protobuf:"varint,25,opt,name=StateSize,proto3" json:"StateSize,omitempty"`
 }
 
 func (m *Result) Reset()      { *m = Result{} }
@@ -573,6 +578,51 @@ func (m *PendingFilament) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PendingFilament proto.InternalMessageInfo
 
+type Validation struct {
+	Polymorph  int32                                        `protobuf:"varint,16,opt,name=polymorph,proto3" json:"polymorph,omitempty"`
+	Object     github_com_insolar_insolar_insolar.ID        `protobuf:"bytes,20,opt,name=Object,proto3,customtype=github.com/insolar/insolar/insolar.ID" json:"Object"`
+	Request    github_com_insolar_insolar_insolar.Reference `protobuf:"bytes,21,opt,name=Request,proto3,customtype=github.com/insolar/insolar/insolar.Reference" json:"Request"`
+	Validator  github_com_insolar_insolar_insolar.Reference `protobuf:"bytes,22,opt,name=Validator,proto3,customtype=github.com/insolar/insolar/insolar.Reference" json:"Validator"`
+	Valid      bool                                         `protobuf:"varint,23,opt,name=Valid,proto3" json:"Valid,omitempty"`
+	Reason     string                                       `protobuf:"bytes,24,opt,name=Reason,proto3" json:"Reason,omitempty"`
+	ResultHash []byte                                       `protobuf:"bytes,25,opt,name=ResultHash,proto3" json:"ResultHash,omitempty"`
+	StateHash  []byte                                       `protobuf:"bytes,26,opt,name=StateHash,proto3" json:"StateHash,omitempty"`
+	// Validator signature of the verdict, see message.ValidationVerdict.
+	VerdictSignature []byte `protobuf:"bytes,27,opt,name=VerdictSignature,proto3" json:"VerdictSignature,omitempty"`
+}
+
+func (m *Validation) Reset()      { *m = Validation{} }
+func (*Validation) ProtoMessage() {}
+func (*Validation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c86cc3f6f53fe45, []int{12}
+}
+func (m *Validation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Validation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Validation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Validation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Validation.Merge(m, src)
+}
+func (m *Validation) XXX_Size() int {
+	return m.Size()
+}
+func (m *Validation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Validation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Validation proto.InternalMessageInfo
+
 type Lifeline struct {
 	Polymorph           int32                                           `protobuf:"varint,16,opt,name=Polymorph,proto3" json:"Polymorph,omitempty"`
 	LatestState         *github_com_insolar_insolar_insolar.ID          `protobuf:"bytes,20,opt,name=LatestState,proto3,customtype=github.com/insolar/insolar/insolar.ID" json:"LatestState,omitempty"`
@@ -589,7 +639,7 @@ type Lifeline struct {
 func (m *Lifeline) Reset()      { *m = Lifeline{} }
 func (*Lifeline) ProtoMessage() {}
 func (*Lifeline) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c86cc3f6f53fe45, []int{12}
+	return fileDescriptor_0c86cc3f6f53fe45, []int{13}
 }
 func (m *Lifeline) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -627,7 +677,7 @@ type LifelineDelegate struct {
 func (m *LifelineDelegate) Reset()      { *m = LifelineDelegate{} }
 func (*LifelineDelegate) ProtoMessage() {}
 func (*LifelineDelegate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c86cc3f6f53fe45, []int{13}
+	return fileDescriptor_0c86cc3f6f53fe45, []int{14}
 }
 func (m *LifelineDelegate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -667,7 +717,7 @@ type Index struct {
 func (m *Index) Reset()      { *m = Index{} }
 func (*Index) ProtoMessage() {}
 func (*Index) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c86cc3f6f53fe45, []int{14}
+	return fileDescriptor_0c86cc3f6f53fe45, []int{15}
 }
 func (m *Index) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -711,6 +761,7 @@ type Virtual struct {
 	//	*Virtual_Amend
 	//	*Virtual_Deactivate
 	//	*Virtual_PendingFilament
+	//	*Virtual_Validation
 	Union     isVirtual_Union `protobuf_oneof:"union"`
 	Signature []byte          `protobuf:"bytes,200,opt,name=Signature,proto3" json:"Signature,omitempty"`
 }
@@ -718,7 +769,7 @@ type Virtual struct {
 func (m *Virtual) Reset()      { *m = Virtual{} }
 func (*Virtual) ProtoMessage() {}
 func (*Virtual) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c86cc3f6f53fe45, []int{15}
+	return fileDescriptor_0c86cc3f6f53fe45, []int{16}
 }
 func (m *Virtual) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -790,6 +841,9 @@ type Virtual_Deactivate struct {
 type Virtual_PendingFilament struct {
 	PendingFilament *PendingFilament `protobuf:"bytes,112,opt,name=PendingFilament,proto3,oneof"`
 }
+type Virtual_Validation struct {
+	Validation *Validation `protobuf:"bytes,113,opt,name=Validation,proto3,oneof"`
+}
 
 func (*Virtual_Genesis) isVirtual_Union()         {}
 func (*Virtual_Child) isVirtual_Union()           {}
@@ -803,6 +857,7 @@ func (*Virtual_Activate) isVirtual_Union()        {}
 func (*Virtual_Amend) isVirtual_Union()           {}
 func (*Virtual_Deactivate) isVirtual_Union()      {}
 func (*Virtual_PendingFilament) isVirtual_Union() {}
+func (*Virtual_Validation) isVirtual_Union()      {}
 
 func (m *Virtual) GetUnion() isVirtual_Union {
 	if m != nil {
@@ -895,6 +950,13 @@ func (m *Virtual) GetPendingFilament() *PendingFilament {
 	return nil
 }
 
+func (m *Virtual) GetValidation() *Validation {
+	if x, ok := m.GetUnion().(*Virtual_Validation); ok {
+		return x.Validation
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*Virtual) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _Virtual_OneofMarshaler, _Virtual_OneofUnmarshaler, _Virtual_OneofSizer, []interface{}{
@@ -910,6 +972,7 @@ func (*Virtual) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error
 		(*Virtual_Amend)(nil),
 		(*Virtual_Deactivate)(nil),
 		(*Virtual_PendingFilament)(nil),
+		(*Virtual_Validation)(nil),
 	}
 }
 
@@ -977,6 +1040,11 @@ func _Virtual_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.PendingFilament); err != nil {
 			return err
 		}
+	case *Virtual_Validation:
+		_ = b.EncodeVarint(113<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Validation); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("Virtual.Union has unexpected type %T", x)
@@ -1083,6 +1151,14 @@ func _Virtual_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer
 		err := b.DecodeMessage(msg)
 		m.Union = &Virtual_PendingFilament{msg}
 		return true, err
+	case 113: // union.Validation
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Validation)
+		err := b.DecodeMessage(msg)
+		m.Union = &Virtual_Validation{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -1152,6 +1228,11 @@ func _Virtual_OneofSizer(msg proto.Message) (n int) {
 		n += 2 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *Virtual_Validation:
+		s := proto.Size(x.Validation)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -1169,7 +1250,7 @@ type Material struct {
 func (m *Material) Reset()      { *m = Material{} }
 func (*Material) ProtoMessage() {}
 func (*Material) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c86cc3f6f53fe45, []int{16}
+	return fileDescriptor_0c86cc3f6f53fe45, []int{17}
 }
 func (m *Material) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1209,7 +1290,7 @@ type CompositeFilamentRecord struct {
 func (m *CompositeFilamentRecord) Reset()      { *m = CompositeFilamentRecord{} }
 func (*CompositeFilamentRecord) ProtoMessage() {}
 func (*CompositeFilamentRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c86cc3f6f53fe45, []int{17}
+	return fileDescriptor_0c86cc3f6f53fe45, []int{18}
 }
 func (m *CompositeFilamentRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1253,6 +1334,7 @@ func init() {
 	proto.RegisterType((*Amend)(nil), "record.Amend")
 	proto.RegisterType((*Deactivate)(nil), "record.Deactivate")
 	proto.RegisterType((*PendingFilament)(nil), "record.PendingFilament")
+	proto.RegisterType((*Validation)(nil), "record.Validation")
 	proto.RegisterType((*Lifeline)(nil), "record.Lifeline")
 	proto.RegisterType((*LifelineDelegate)(nil), "record.LifelineDelegate")
 	proto.RegisterType((*Index)(nil), "record.Index")
@@ -1264,105 +1346,112 @@ func init() {
 func init() { proto.RegisterFile("insolar/record/record.proto", fileDescriptor_0c86cc3f6f53fe45) }
 
 var fileDescriptor_0c86cc3f6f53fe45 = []byte{
-	// 1562 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x4b, 0x6f, 0x1b, 0x47,
-	0x12, 0x9e, 0x11, 0x1f, 0x92, 0x4a, 0x2f, 0x6e, 0x5b, 0x6b, 0x8d, 0x5f, 0x23, 0x2e, 0x17, 0x06,
-	0x68, 0xaf, 0x2d, 0x1b, 0x5a, 0xc3, 0x58, 0x2c, 0xf6, 0xb0, 0x14, 0x69, 0x2d, 0x29, 0xeb, 0xc1,
-	0x6d, 0xc9, 0xde, 0x45, 0x0e, 0x09, 0x9a, 0x64, 0x8b, 0x1c, 0x67, 0x38, 0xc3, 0xcc, 0x43, 0x88,
-	0x6e, 0xc9, 0x3f, 0x08, 0x02, 0x24, 0xe7, 0x5c, 0x02, 0xf8, 0x17, 0xe4, 0xe0, 0x53, 0x0e, 0x39,
-	0xe8, 0x68, 0xdf, 0x9c, 0x00, 0x31, 0x22, 0xf9, 0x92, 0xa3, 0x91, 0x5f, 0x10, 0xf4, 0x8b, 0x33,
-	0xa4, 0x1c, 0x53, 0x22, 0x8d, 0x00, 0x0e, 0x74, 0xe2, 0x74, 0x4d, 0xd5, 0x37, 0x5d, 0x5f, 0x57,
-	0x55, 0x77, 0x17, 0xe1, 0x92, 0xe5, 0xf8, 0xae, 0x4d, 0xbc, 0x5b, 0x1e, 0xad, 0xbb, 0x5e, 0x43,
-	0xfe, 0x2c, 0x75, 0x3c, 0x37, 0x70, 0x51, 0x5a, 0x8c, 0x2e, 0xde, 0x6c, 0x5a, 0x41, 0x2b, 0xac,
-	0x2d, 0xd5, 0xdd, 0xf6, 0xad, 0xa6, 0xdb, 0x74, 0x6f, 0xf1, 0xd7, 0xb5, 0x70, 0x97, 0x8f, 0xf8,
-	0x80, 0x3f, 0x09, 0xb3, 0x5c, 0x01, 0xc6, 0xff, 0x43, 0x1d, 0xea, 0x5b, 0x3e, 0xba, 0x0c, 0x93,
-	0x1d, 0xd7, 0xde, 0x6f, 0xbb, 0x5e, 0xa7, 0x65, 0x64, 0xb2, 0x7a, 0x3e, 0x85, 0x23, 0x01, 0x42,
-	0x90, 0x2c, 0x13, 0xbf, 0x65, 0xcc, 0x67, 0xf5, 0xfc, 0x34, 0xe6, 0xcf, 0xff, 0x4c, 0x3e, 0xfe,
-	0x6a, 0x51, 0xcf, 0x7d, 0xab, 0x43, 0xaa, 0xd8, 0xb2, 0xec, 0xc6, 0x00, 0x84, 0xfb, 0x30, 0x59,
-	0xf5, 0xe8, 0x1e, 0x57, 0x15, 0x30, 0x2b, 0x37, 0x0f, 0x5e, 0x2c, 0x6a, 0x3f, 0xbc, 0x58, 0xbc,
-	0x1a, 0x9b, 0xb4, 0x72, 0xb2, 0xef, 0x77, 0xa9, 0x52, 0xc2, 0x91, 0x3d, 0x5a, 0x85, 0x04, 0xa6,
-	0xbb, 0xc6, 0x9f, 0x39, 0xcc, 0x1d, 0x09, 0x73, 0xe3, 0x04, 0x30, 0x98, 0xee, 0x52, 0x8f, 0x3a,
-	0x75, 0x8a, 0x19, 0x80, 0x74, 0xe1, 0x1a, 0x24, 0xd6, 0x68, 0xf0, 0xe6, 0xf9, 0x4b, 0xd5, 0x67,
-	0x69, 0x98, 0xab, 0x38, 0x75, 0xb7, 0x6d, 0x39, 0x4d, 0x4c, 0x3f, 0x0a, 0xa9, 0x3f, 0xc0, 0x0e,
-	0xdd, 0x80, 0x89, 0x22, 0xb1, 0xed, 0x9d, 0xfd, 0x0e, 0xe5, 0x6e, 0xcf, 0x2e, 0x67, 0x96, 0xe4,
-	0xd2, 0x29, 0x39, 0xee, 0x6a, 0xa0, 0x75, 0x48, 0xb3, 0x67, 0xea, 0x8d, 0xe4, 0x9b, 0xc4, 0x40,
-	0xef, 0xc3, 0x9c, 0x78, 0xaa, 0xb2, 0xd5, 0x0e, 0xd8, 0x14, 0xce, 0x8f, 0x00, 0xdb, 0x0f, 0x86,
-	0xe6, 0x21, 0xb5, 0xe9, 0x3a, 0x75, 0x6a, 0x2c, 0x64, 0xf5, 0x7c, 0x12, 0x8b, 0x01, 0x5a, 0x06,
-	0xc0, 0x34, 0x08, 0x3d, 0x67, 0xc3, 0x6d, 0x50, 0xe3, 0x02, 0xf7, 0x19, 0x29, 0x9f, 0xa3, 0x37,
-	0x38, 0xa6, 0xc5, 0x38, 0xac, 0xb4, 0xdb, 0x61, 0x40, 0x6a, 0x36, 0x35, 0x2e, 0x66, 0xf5, 0xfc,
-	0x04, 0x8e, 0x04, 0xa8, 0x04, 0xc9, 0x15, 0xe2, 0x53, 0xe3, 0x12, 0x9f, 0xfc, 0xed, 0x53, 0x4f,
-	0x9c, 0x5b, 0xa3, 0x32, 0xa4, 0xb7, 0x6a, 0x8f, 0x68, 0x3d, 0x30, 0x2e, 0x0f, 0x89, 0x23, 0xed,
-	0xd1, 0x26, 0x8b, 0x65, 0xc5, 0xe8, 0x95, 0x21, 0xc1, 0x22, 0x08, 0x74, 0x1e, 0xd2, 0x1b, 0x34,
-	0x68, 0xb9, 0x0d, 0xc3, 0xcc, 0xea, 0xf9, 0x49, 0x2c, 0x47, 0x8c, 0x95, 0x82, 0xd7, 0x0c, 0xdb,
-	0xd4, 0x09, 0x7c, 0x63, 0x91, 0xa7, 0x5e, 0x24, 0x40, 0x39, 0x98, 0x2e, 0x54, 0x2b, 0x32, 0x0a,
-	0x2b, 0x25, 0xe3, 0x2f, 0xdc, 0xb6, 0x47, 0xc6, 0xe2, 0x09, 0x53, 0xe2, 0xbb, 0x8e, 0x91, 0x1b,
-	0x25, 0x9e, 0x04, 0x06, 0xda, 0x84, 0xf1, 0x42, 0xb5, 0xb2, 0xc9, 0x96, 0xf5, 0xaf, 0x23, 0xc0,
-	0x29, 0x90, 0x58, 0x4e, 0x6d, 0x85, 0x41, 0xd3, 0x3d, 0xcb, 0xa9, 0xb3, 0x9c, 0x3a, 0xcb, 0xa9,
-	0xb7, 0x92, 0x53, 0x3f, 0xea, 0x6c, 0x92, 0x7e, 0x68, 0x0f, 0x4a, 0xa5, 0x7b, 0xdd, 0x05, 0x1c,
-	0x6a, 0x4f, 0x8e, 0x56, 0x6f, 0x5c, 0x12, 0x34, 0x52, 0x92, 0x29, 0x10, 0x64, 0xc0, 0x78, 0x95,
-	0xec, 0xdb, 0x2e, 0x69, 0x88, 0xec, 0xc2, 0x6a, 0x28, 0xfd, 0xfb, 0x45, 0x87, 0x24, 0x4f, 0xee,
-	0x37, 0x7b, 0xb7, 0x0e, 0xe9, 0x92, 0xdb, 0x26, 0x96, 0x23, 0xbd, 0x1b, 0x72, 0xa9, 0x04, 0xc6,
-	0x5b, 0x77, 0x32, 0x0f, 0x73, 0xcc, 0x87, 0x12, 0xad, 0xdb, 0xc4, 0x23, 0x81, 0xe5, 0x3a, 0xd2,
-	0xd9, 0x7e, 0xb1, 0x74, 0xfa, 0x9b, 0x31, 0x48, 0x16, 0x65, 0x66, 0xbf, 0xb3, 0x4e, 0x23, 0xe1,
-	0x83, 0xf4, 0x54, 0xf8, 0xf3, 0x7f, 0x98, 0xda, 0x20, 0xf5, 0x96, 0xe5, 0x50, 0x5e, 0xd2, 0x59,
-	0xe5, 0x9b, 0x59, 0xb9, 0x2b, 0xbf, 0xb3, 0x74, 0x82, 0xef, 0xc4, 0xac, 0x71, 0x1c, 0x4a, 0x12,
-	0xf7, 0x24, 0x01, 0x13, 0x85, 0x7a, 0x60, 0xed, 0x91, 0xe0, 0xdd, 0x26, 0x8f, 0x17, 0xb5, 0xb6,
-	0xeb, 0xed, 0x4b, 0xfa, 0xe4, 0x08, 0xad, 0x41, 0xaa, 0xd2, 0x26, 0x4d, 0x41, 0xdd, 0xb0, 0x5f,
-	0x11, 0x10, 0x28, 0x0b, 0x53, 0x15, 0x3f, 0x2a, 0xc5, 0x06, 0xdf, 0x38, 0xe2, 0x22, 0xc6, 0x51,
-	0x95, 0x78, 0xd4, 0x09, 0xf8, 0x46, 0x34, 0x34, 0x47, 0x02, 0x03, 0x99, 0x00, 0x15, 0xbf, 0x44,
-	0x6d, 0xda, 0x24, 0x81, 0xda, 0xa7, 0x62, 0x92, 0xdc, 0x97, 0x09, 0x48, 0x15, 0xda, 0xd4, 0x69,
-	0x9c, 0xad, 0xdc, 0xc8, 0x2b, 0x27, 0x2f, 0x61, 0xdb, 0x01, 0xa3, 0xfa, 0xc2, 0xd0, 0x97, 0x30,
-	0x6e, 0x9f, 0xfb, 0x62, 0x0c, 0xa0, 0x44, 0xc9, 0x1f, 0x21, 0xaf, 0x7a, 0x78, 0x39, 0x3f, 0x22,
-	0x2f, 0xcf, 0x74, 0x98, 0xab, 0x52, 0xa7, 0x61, 0x39, 0xcd, 0x55, 0xcb, 0x26, 0xec, 0x60, 0x31,
-	0x80, 0x9c, 0x0a, 0x4c, 0x60, 0x7e, 0x94, 0xab, 0x94, 0x86, 0xdb, 0x86, 0xbb, 0xe6, 0xe8, 0x01,
-	0xcc, 0xb2, 0x99, 0x58, 0x6e, 0xe8, 0x0b, 0x99, 0x24, 0x88, 0x01, 0xea, 0x27, 0x07, 0xec, 0x03,
-	0xc9, 0x7d, 0x9a, 0x86, 0x89, 0x75, 0x6b, 0x97, 0xda, 0x96, 0xc3, 0x57, 0xba, 0xda, 0xef, 0x4c,
-	0x57, 0x80, 0xb6, 0x60, 0x6a, 0x9d, 0x04, 0xd4, 0x0f, 0x04, 0x9b, 0xf3, 0xc3, 0x7c, 0x3e, 0x8e,
-	0x80, 0x3e, 0x80, 0x73, 0xb1, 0x61, 0xa1, 0xd3, 0xf1, 0xdc, 0x3d, 0x3a, 0xa4, 0x5f, 0xaf, 0x43,
-	0x42, 0xff, 0x85, 0x69, 0xde, 0x56, 0xa8, 0xba, 0x96, 0x13, 0x50, 0x2f, 0x16, 0x00, 0xa7, 0x40,
-	0xee, 0x81, 0x88, 0x95, 0xc8, 0x85, 0xb7, 0x50, 0x22, 0xff, 0x05, 0x93, 0xaa, 0x1c, 0xfa, 0x86,
-	0x91, 0x4d, 0xe4, 0xa7, 0x96, 0x0d, 0x75, 0xf8, 0x57, 0xab, 0xa2, 0x14, 0x56, 0x92, 0xec, 0x53,
-	0x38, 0x32, 0x40, 0xd7, 0x60, 0x9c, 0xfb, 0x5b, 0x29, 0xf1, 0x94, 0x9f, 0x59, 0x99, 0x93, 0x93,
-	0x51, 0x62, 0xac, 0x1e, 0xd0, 0x7b, 0x30, 0x2d, 0x08, 0x7a, 0xd0, 0x69, 0xa8, 0x6a, 0x7c, 0xba,
-	0x9d, 0xb8, 0x1a, 0xda, 0x3e, 0xdd, 0x0c, 0xdb, 0x35, 0xea, 0xe1, 0x1e, 0x2c, 0x1e, 0x99, 0x22,
-	0x2b, 0x14, 0xcf, 0x97, 0x86, 0x8b, 0xcc, 0x1e, 0x10, 0xd4, 0x82, 0x73, 0xf7, 0x88, 0x67, 0x5b,
-	0xd4, 0x0f, 0xb6, 0x3a, 0xd4, 0x51, 0x65, 0x41, 0x5c, 0x47, 0xee, 0x4a, 0xec, 0xd3, 0xce, 0xfc,
-	0x75, 0x90, 0xb9, 0xef, 0x74, 0xc8, 0xf4, 0xb3, 0x3d, 0x20, 0x17, 0x56, 0x21, 0x71, 0x9f, 0xee,
-	0x8f, 0x54, 0xf2, 0x18, 0x00, 0xdb, 0x25, 0x1e, 0x12, 0x3b, 0xa4, 0x23, 0x55, 0x3b, 0x01, 0x91,
-	0xfb, 0x7e, 0x0c, 0x52, 0x15, 0xa7, 0x41, 0x3f, 0x1e, 0x30, 0xf7, 0x22, 0xa4, 0xb6, 0x6a, 0x8f,
-	0x86, 0xad, 0x48, 0xc2, 0x16, 0x2d, 0x47, 0x65, 0x83, 0xcf, 0x7d, 0x2a, 0xba, 0xa9, 0x2b, 0xb9,
-	0x0c, 0xd8, 0xa8, 0xbc, 0xd4, 0x22, 0x9a, 0xd7, 0x89, 0x1f, 0x3c, 0xf0, 0xa9, 0xb8, 0x04, 0x0c,
-	0x1f, 0x88, 0xc7, 0xf0, 0x62, 0xc1, 0x28, 0x0a, 0x9c, 0x6f, 0x2c, 0x64, 0x13, 0xa7, 0xf7, 0xb2,
-	0x0f, 0x24, 0xf7, 0x79, 0x0a, 0xc6, 0x1f, 0x5a, 0x5e, 0x10, 0x12, 0x7b, 0x40, 0xc9, 0xff, 0x5b,
-	0xb7, 0xf3, 0x6a, 0x50, 0xce, 0xcb, 0x9c, 0xe2, 0x45, 0x8a, 0xcb, 0x1a, 0xee, 0xf6, 0x66, 0xaf,
-	0xca, 0x16, 0xab, 0xb1, 0xcb, 0x55, 0x67, 0xba, 0xcd, 0x0e, 0x26, 0x2c, 0x6b, 0x58, 0x36, 0x60,
-	0x17, 0x79, 0x1f, 0xd3, 0x68, 0x72, 0xa5, 0x29, 0xa5, 0xb4, 0x46, 0x83, 0xb2, 0x86, 0x79, 0x87,
-	0xb3, 0x78, 0xac, 0x79, 0x69, 0xb4, 0xb8, 0xf2, 0x82, 0x52, 0xee, 0x7b, 0x5d, 0xd6, 0xf0, 0xb1,
-	0x76, 0x67, 0xf1, 0x58, 0xb7, 0xc6, 0xb0, 0x7a, 0x41, 0xfa, 0x5e, 0x33, 0x90, 0xfe, 0xfe, 0x4e,
-	0x5e, 0x5d, 0x4f, 0x8d, 0x47, 0xdc, 0x76, 0x36, 0xea, 0x65, 0x30, 0x69, 0x59, 0xc3, 0xea, 0xfa,
-	0x9a, 0x13, 0x17, 0x3d, 0xe3, 0x43, 0xae, 0x37, 0xad, 0xf4, 0x98, 0xac, 0xac, 0x61, 0x71, 0x09,
-	0xcc, 0xc9, 0x3b, 0x85, 0xdd, 0xab, 0xc3, 0x64, 0x4c, 0x87, 0xdf, 0x31, 0x96, 0xa2, 0x2b, 0x80,
-	0xd1, 0xee, 0x8d, 0x44, 0x25, 0x2f, 0x6b, 0x38, 0xba, 0x26, 0x5c, 0x95, 0xa7, 0x4e, 0xc3, 0xe9,
-	0xe5, 0x9c, 0x0b, 0x19, 0xe7, 0xe2, 0x4c, 0x7a, 0x27, 0x7e, 0x06, 0x32, 0x5c, 0xae, 0xdb, 0x6d,
-	0xcc, 0x44, 0x6f, 0xca, 0x1a, 0x8e, 0x9f, 0x95, 0x8a, 0xc7, 0x4e, 0x08, 0x46, 0xa7, 0x97, 0xc3,
-	0xbe, 0xd7, 0x8c, 0xc3, 0xfe, 0x33, 0xc5, 0x15, 0x98, 0xdc, 0xb6, 0x9a, 0x0e, 0x09, 0x42, 0x8f,
-	0x1a, 0x07, 0xba, 0x68, 0x65, 0x74, 0x25, 0x2b, 0xe3, 0x90, 0x0a, 0x1d, 0xcb, 0x75, 0x72, 0x4f,
-	0x74, 0x98, 0xd8, 0x20, 0x01, 0xf5, 0xac, 0x81, 0x51, 0x79, 0xad, 0x1b, 0xbe, 0x3c, 0xeb, 0x63,
-	0x51, 0x29, 0xc5, 0xb8, 0x1b, 0xde, 0xab, 0x90, 0x5a, 0xa3, 0x41, 0xa5, 0x24, 0x4b, 0xd2, 0x6d,
-	0x99, 0x38, 0xf9, 0x13, 0x24, 0x0e, 0xb7, 0xc3, 0xc2, 0x7c, 0x80, 0x17, 0xb9, 0xaf, 0xc7, 0x60,
-	0xa1, 0xe8, 0xb6, 0x3b, 0xae, 0x6f, 0x05, 0x54, 0xb9, 0x2e, 0xd2, 0xed, 0xf7, 0x3b, 0x54, 0x2d,
-	0xb1, 0x68, 0xed, 0x1e, 0xa6, 0x62, 0x91, 0xa3, 0x68, 0x95, 0x35, 0x4c, 0x6a, 0xa1, 0x7b, 0xbc,
-	0xf7, 0x44, 0x2a, 0xa5, 0xe1, 0xce, 0x92, 0xd2, 0x18, 0x5d, 0x87, 0x24, 0x7b, 0xe2, 0x47, 0x88,
-	0xdf, 0xfe, 0x28, 0xd7, 0xb9, 0x5e, 0x8d, 0x5a, 0xa2, 0x68, 0x1a, 0x26, 0x8a, 0x3b, 0xa2, 0xdd,
-	0x95, 0xd1, 0xd0, 0x9f, 0x60, 0xa6, 0xb8, 0xb3, 0x4d, 0xf6, 0x68, 0xc1, 0xe7, 0x65, 0x22, 0xa3,
-	0xa3, 0x79, 0xc8, 0x28, 0x91, 0xda, 0xc8, 0x32, 0x63, 0x68, 0x06, 0x26, 0x8b, 0x3b, 0xb2, 0xe4,
-	0x64, 0x12, 0xd7, 0xff, 0x1d, 0x6f, 0x39, 0xa2, 0x0c, 0x4c, 0x8b, 0x91, 0x48, 0xcb, 0x8c, 0x16,
-	0x49, 0x36, 0xdd, 0xff, 0x11, 0x2b, 0xc8, 0xe8, 0x68, 0x56, 0x59, 0x6c, 0x93, 0x26, 0xc9, 0x8c,
-	0xad, 0xfc, 0xe3, 0xe0, 0xd0, 0xd4, 0x9e, 0x1e, 0x9a, 0xda, 0xf3, 0x43, 0x53, 0x7b, 0x75, 0x68,
-	0xea, 0x9f, 0x1c, 0x99, 0xfa, 0xe3, 0x23, 0x53, 0x3f, 0x38, 0x32, 0xf5, 0xa7, 0x47, 0xa6, 0xfe,
-	0xd3, 0x91, 0xa9, 0xff, 0x7c, 0x64, 0x6a, 0xaf, 0x8e, 0x4c, 0xfd, 0xb3, 0x97, 0xa6, 0xf6, 0xf4,
-	0xa5, 0xa9, 0x3d, 0x7f, 0x69, 0x6a, 0xb5, 0x34, 0xff, 0x7b, 0xea, 0xef, 0xbf, 0x06, 0x00, 0x00,
-	0xff, 0xff, 0x88, 0x39, 0x79, 0x04, 0xf4, 0x1a, 0x00, 0x00,
+	// 1680 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x4b, 0x6f, 0x5b, 0x4f,
+	0x15, 0xbf, 0x37, 0x7e, 0xc4, 0x3e, 0x79, 0x99, 0x69, 0x9a, 0x4c, 0x5f, 0x37, 0xc1, 0xa8, 0x92,
+	0x1b, 0xda, 0xb4, 0x0a, 0x55, 0x85, 0x10, 0x0b, 0x1c, 0xbb, 0xc1, 0x4e, 0xf3, 0x30, 0x93, 0x34,
+	0x20, 0x16, 0xa0, 0xb1, 0x3d, 0xb1, 0x6f, 0xb9, 0xbe, 0xd7, 0xbd, 0x8f, 0x88, 0xb0, 0x82, 0x6f,
+	0xc0, 0x06, 0xd6, 0x6c, 0x90, 0xba, 0x47, 0x62, 0xd1, 0x15, 0x0b, 0x24, 0xb2, 0x6c, 0x77, 0x85,
+	0x45, 0x45, 0xd2, 0x0d, 0xcb, 0x8a, 0x4f, 0x80, 0xe6, 0x71, 0x7d, 0xaf, 0x9d, 0xaa, 0x4e, 0xec,
+	0x0a, 0xa9, 0x7f, 0x65, 0xe5, 0x3b, 0x67, 0xce, 0xf9, 0xcd, 0x9c, 0x33, 0x73, 0x1e, 0x73, 0x0c,
+	0xb7, 0x4c, 0xdb, 0x73, 0x2c, 0xea, 0x3e, 0x74, 0x59, 0xc3, 0x71, 0x9b, 0xea, 0x67, 0xb5, 0xeb,
+	0x3a, 0xbe, 0x83, 0xd2, 0x72, 0x74, 0xf3, 0x41, 0xcb, 0xf4, 0xdb, 0x41, 0x7d, 0xb5, 0xe1, 0x74,
+	0x1e, 0xb6, 0x9c, 0x96, 0xf3, 0x50, 0x4c, 0xd7, 0x83, 0x43, 0x31, 0x12, 0x03, 0xf1, 0x25, 0xc5,
+	0xf2, 0x45, 0x98, 0xfc, 0x31, 0xb3, 0x99, 0x67, 0x7a, 0xe8, 0x36, 0x64, 0xbb, 0x8e, 0x75, 0xdc,
+	0x71, 0xdc, 0x6e, 0x1b, 0xe7, 0x96, 0xf5, 0x42, 0x8a, 0x44, 0x04, 0x84, 0x20, 0x59, 0xa1, 0x5e,
+	0x1b, 0xcf, 0x2f, 0xeb, 0x85, 0x69, 0x22, 0xbe, 0x7f, 0x90, 0x7c, 0xf5, 0xa7, 0x25, 0x3d, 0xff,
+	0x37, 0x1d, 0x52, 0xa5, 0xb6, 0x69, 0x35, 0x87, 0x20, 0x3c, 0x83, 0x6c, 0xcd, 0x65, 0x47, 0x82,
+	0x55, 0xc2, 0xac, 0x3f, 0x38, 0x79, 0xbf, 0xa4, 0xfd, 0xeb, 0xfd, 0xd2, 0xdd, 0xd8, 0xa6, 0x43,
+	0x25, 0x07, 0x7e, 0x57, 0xab, 0x65, 0x12, 0xc9, 0xa3, 0x0d, 0x48, 0x10, 0x76, 0x88, 0xaf, 0x0b,
+	0x98, 0xc7, 0x0a, 0xe6, 0xfe, 0x05, 0x60, 0x08, 0x3b, 0x64, 0x2e, 0xb3, 0x1b, 0x8c, 0x70, 0x00,
+	0xa5, 0xc2, 0x3d, 0x48, 0x6c, 0x32, 0xff, 0xf3, 0xfb, 0x57, 0xac, 0x6f, 0xd3, 0x30, 0x57, 0xb5,
+	0x1b, 0x4e, 0xc7, 0xb4, 0x5b, 0x84, 0xbd, 0x0c, 0x98, 0x37, 0x44, 0x0e, 0xdd, 0x87, 0x4c, 0x89,
+	0x5a, 0xd6, 0xfe, 0x71, 0x97, 0x09, 0xb5, 0x67, 0xd7, 0x72, 0xab, 0xea, 0xe8, 0x42, 0x3a, 0xe9,
+	0x71, 0xa0, 0x2d, 0x48, 0xf3, 0x6f, 0xe6, 0x8e, 0xa5, 0x9b, 0xc2, 0x40, 0xbf, 0x80, 0x39, 0xf9,
+	0x55, 0xe3, 0xa7, 0xed, 0xf3, 0x2d, 0x2c, 0x8c, 0x01, 0x3b, 0x08, 0x86, 0xe6, 0x21, 0xb5, 0xe3,
+	0xd8, 0x0d, 0x86, 0x17, 0x97, 0xf5, 0x42, 0x92, 0xc8, 0x01, 0x5a, 0x03, 0x20, 0xcc, 0x0f, 0x5c,
+	0x7b, 0xdb, 0x69, 0x32, 0x7c, 0x43, 0xe8, 0x8c, 0x42, 0x9d, 0xa3, 0x19, 0x12, 0xe3, 0xe2, 0x36,
+	0xac, 0x76, 0x3a, 0x81, 0x4f, 0xeb, 0x16, 0xc3, 0x37, 0x97, 0xf5, 0x42, 0x86, 0x44, 0x04, 0x54,
+	0x86, 0xe4, 0x3a, 0xf5, 0x18, 0xbe, 0x25, 0x36, 0xff, 0xe8, 0xd2, 0x1b, 0x17, 0xd2, 0xa8, 0x02,
+	0xe9, 0xdd, 0xfa, 0x0b, 0xd6, 0xf0, 0xf1, 0xed, 0x11, 0x71, 0x94, 0x3c, 0xda, 0x81, 0x6c, 0xcf,
+	0x08, 0xf8, 0xce, 0x88, 0x60, 0x11, 0x04, 0x5a, 0x80, 0xf4, 0x36, 0xf3, 0xdb, 0x4e, 0x13, 0x1b,
+	0xcb, 0x7a, 0x21, 0x4b, 0xd4, 0x88, 0x5b, 0xa5, 0xe8, 0xb6, 0x82, 0x0e, 0xb3, 0x7d, 0x0f, 0x2f,
+	0x09, 0xd7, 0x8b, 0x08, 0x28, 0x0f, 0xd3, 0xc5, 0x5a, 0x55, 0xdd, 0xc2, 0x6a, 0x19, 0x7f, 0x5b,
+	0xc8, 0xf6, 0xd1, 0xf8, 0x7d, 0x22, 0x8c, 0x7a, 0x8e, 0x8d, 0xf3, 0xe3, 0xdc, 0x27, 0x89, 0x81,
+	0x76, 0x60, 0xb2, 0x58, 0xab, 0xee, 0xf0, 0x63, 0xfd, 0xce, 0x18, 0x70, 0x21, 0x48, 0xcc, 0xa7,
+	0x76, 0x03, 0xbf, 0xe5, 0x5c, 0xf9, 0xd4, 0x95, 0x4f, 0x5d, 0xf9, 0xd4, 0x17, 0xf1, 0xa9, 0x7f,
+	0x4c, 0xf0, 0x4d, 0x7a, 0x81, 0x35, 0xcc, 0x95, 0x9e, 0xf6, 0x0e, 0x70, 0xa4, 0x9c, 0x1c, 0x9d,
+	0xde, 0xa4, 0x32, 0xd0, 0x58, 0x4e, 0x16, 0x82, 0x20, 0x0c, 0x93, 0x35, 0x7a, 0x6c, 0x39, 0xb4,
+	0x29, 0xbd, 0x8b, 0x84, 0x43, 0x64, 0x00, 0x10, 0x27, 0xf0, 0x19, 0xf7, 0x1b, 0x4f, 0x38, 0xc9,
+	0x0c, 0x89, 0x51, 0xd0, 0x0a, 0xe4, 0xf6, 0xe8, 0x11, 0x2b, 0x7a, 0xa2, 0x52, 0x90, 0x5c, 0x58,
+	0x70, 0x9d, 0xa3, 0x73, 0xd3, 0xec, 0xf9, 0xd4, 0x67, 0x7b, 0xe6, 0x6f, 0xa4, 0x53, 0x25, 0x49,
+	0x44, 0x50, 0x96, 0xfc, 0xaf, 0x0e, 0x49, 0x11, 0x46, 0x3e, 0x6f, 0xc7, 0x2d, 0x48, 0x97, 0x9d,
+	0x0e, 0x35, 0x6d, 0x3c, 0x3f, 0x86, 0xfe, 0x0a, 0xe3, 0x8b, 0x9b, 0xb3, 0x00, 0x73, 0x5c, 0x87,
+	0x32, 0x6b, 0x58, 0xd4, 0xa5, 0xbe, 0xe9, 0xd8, 0xca, 0xac, 0x83, 0x64, 0xa5, 0xf4, 0x5f, 0x27,
+	0x20, 0x59, 0x52, 0x31, 0xe4, 0xab, 0x55, 0x1a, 0x49, 0x1d, 0x94, 0xa6, 0x52, 0x9f, 0x9f, 0xc1,
+	0xd4, 0x36, 0x6d, 0xb4, 0x4d, 0x9b, 0x89, 0xe4, 0x21, 0xae, 0xcf, 0xfa, 0x13, 0xb5, 0xce, 0xea,
+	0x05, 0xd6, 0x89, 0x49, 0x93, 0x38, 0x94, 0x32, 0xdc, 0xeb, 0x04, 0x64, 0x8a, 0x0d, 0xdf, 0x3c,
+	0xa2, 0xfe, 0xd7, 0x6d, 0x3c, 0x11, 0x3e, 0x3b, 0x8e, 0x7b, 0xac, 0xcc, 0xa7, 0x46, 0x68, 0x13,
+	0x52, 0xd5, 0x0e, 0x6d, 0x49, 0xd3, 0x8d, 0xba, 0x8a, 0x84, 0x40, 0xcb, 0x30, 0x55, 0xf5, 0xa2,
+	0xa0, 0x8f, 0x45, 0x8a, 0x8a, 0x93, 0xb8, 0x8d, 0x6a, 0xd4, 0x65, 0xb6, 0x8f, 0x6f, 0x8c, 0xb1,
+	0x9c, 0xc2, 0xe0, 0xa1, 0xa3, 0xea, 0x95, 0x99, 0xc5, 0x5a, 0xd4, 0x0f, 0x33, 0x62, 0x8c, 0x92,
+	0xff, 0x63, 0x02, 0x52, 0xc5, 0x0e, 0xb3, 0x9b, 0x57, 0x27, 0x37, 0xf6, 0xc9, 0xa9, 0xe7, 0x9e,
+	0x88, 0xa6, 0xf8, 0xc6, 0x28, 0xa9, 0x25, 0x92, 0xcf, 0xff, 0x61, 0x02, 0xa0, 0xcc, 0xe8, 0x37,
+	0xc1, 0xaf, 0xfa, 0xec, 0xb2, 0x30, 0xa6, 0x5d, 0xde, 0xea, 0x30, 0x57, 0x63, 0x76, 0xd3, 0xb4,
+	0x5b, 0x1b, 0xa6, 0x45, 0x79, 0x09, 0x33, 0xc4, 0x38, 0x55, 0xc8, 0x10, 0x51, 0x34, 0x56, 0xcb,
+	0xa3, 0x25, 0xfc, 0x9e, 0x38, 0x7a, 0x0e, 0xb3, 0x7c, 0x27, 0xa6, 0x13, 0x78, 0x92, 0x86, 0xaf,
+	0xf7, 0x00, 0xf5, 0x8b, 0x03, 0x0e, 0x80, 0xe4, 0xff, 0x92, 0x00, 0x38, 0xa0, 0x96, 0xd9, 0x14,
+	0xf9, 0xe8, 0xeb, 0xac, 0x5e, 0x08, 0x64, 0x95, 0x0a, 0x8e, 0x3b, 0xd6, 0xeb, 0x20, 0x82, 0xe1,
+	0xef, 0x02, 0x31, 0x10, 0xee, 0x9b, 0x21, 0x72, 0xc0, 0x9d, 0x5d, 0xd5, 0xa2, 0x58, 0x56, 0xb9,
+	0x72, 0x24, 0xaa, 0x24, 0x51, 0xfe, 0x89, 0xae, 0x8d, 0xf0, 0x3f, 0x12, 0xa3, 0xf4, 0x2a, 0x1f,
+	0x31, 0x7d, 0x53, 0x4c, 0x47, 0x04, 0x5e, 0x43, 0x1d, 0x30, 0xb7, 0x69, 0x36, 0xfc, 0x3d, 0xb3,
+	0x65, 0x53, 0x3f, 0x70, 0xd5, 0x3b, 0x81, 0x9c, 0xa3, 0xab, 0xbc, 0xf7, 0xbb, 0x34, 0x64, 0xb6,
+	0xcc, 0x43, 0x66, 0x99, 0xb6, 0xf0, 0xcf, 0xda, 0xe0, 0x99, 0xf5, 0x08, 0x68, 0x17, 0xa6, 0xb6,
+	0xa8, 0xcf, 0x3c, 0x5f, 0xfa, 0xc0, 0xfc, 0x28, 0x97, 0x26, 0x8e, 0x80, 0x7e, 0x09, 0xd7, 0x62,
+	0xc3, 0x62, 0xb7, 0xeb, 0x3a, 0x47, 0x6c, 0xc4, 0xdb, 0xf8, 0x29, 0x24, 0xf4, 0x13, 0x98, 0x16,
+	0x45, 0x63, 0xcd, 0x31, 0x6d, 0x9f, 0xb9, 0x78, 0x61, 0x14, 0xe4, 0x3e, 0x88, 0x58, 0x62, 0x5b,
+	0xfc, 0x02, 0x89, 0xed, 0x87, 0x90, 0x0d, 0x93, 0x18, 0x2f, 0x76, 0x13, 0x85, 0xa9, 0x35, 0x1c,
+	0x3e, 0x0e, 0xc3, 0x53, 0x09, 0x19, 0xd6, 0x93, 0x7c, 0x29, 0x12, 0x09, 0xa0, 0x7b, 0x30, 0x29,
+	0xf4, 0xad, 0x96, 0xc5, 0x45, 0x99, 0x59, 0x9f, 0x53, 0x9b, 0x09, 0xc9, 0x24, 0xfc, 0x40, 0x3f,
+	0x87, 0x69, 0x69, 0xa0, 0xe7, 0xdd, 0x66, 0x98, 0x43, 0x2f, 0x57, 0x3f, 0xd5, 0x02, 0xcb, 0x63,
+	0x3b, 0x41, 0xa7, 0xce, 0x5c, 0xd2, 0x87, 0x25, 0xe2, 0x89, 0x8c, 0x65, 0xa1, 0x9d, 0x6f, 0x8d,
+	0x16, 0x4f, 0xfa, 0x40, 0x50, 0x1b, 0xae, 0x3d, 0xa5, 0xae, 0x65, 0x32, 0xcf, 0xdf, 0xed, 0x32,
+	0x3b, 0xf4, 0x73, 0xf9, 0x5c, 0x7d, 0xa2, 0xb0, 0x2f, 0xbb, 0xf3, 0x4f, 0x41, 0xe6, 0xff, 0xae,
+	0x43, 0x6e, 0xd0, 0xda, 0x43, 0x7c, 0x61, 0x03, 0x12, 0xcf, 0xd8, 0xf1, 0x58, 0x89, 0x8a, 0x03,
+	0xf0, 0xdc, 0x7e, 0x40, 0xad, 0x80, 0x8d, 0x15, 0xbe, 0x24, 0x44, 0xfe, 0x9f, 0x13, 0x90, 0xaa,
+	0xda, 0x4d, 0xf6, 0xeb, 0x21, 0x7b, 0x2f, 0x41, 0x6a, 0xb7, 0xfe, 0x62, 0xd4, 0x3c, 0x22, 0x65,
+	0xd1, 0x5a, 0x14, 0x36, 0xc4, 0xde, 0xa7, 0xa2, 0x4e, 0x4e, 0x48, 0x57, 0x17, 0x36, 0x0a, 0x2f,
+	0xf5, 0xc8, 0xcc, 0x5b, 0xd4, 0xf3, 0x9f, 0x7b, 0x4c, 0x3e, 0x12, 0x47, 0xbf, 0x88, 0xe7, 0xf0,
+	0x62, 0x97, 0x51, 0xa6, 0x25, 0xfe, 0xd2, 0x4c, 0x5c, 0x5e, 0xcb, 0x01, 0x90, 0xfc, 0x49, 0x0a,
+	0x26, 0x0f, 0x4c, 0xd7, 0x0f, 0xa8, 0x35, 0x24, 0xb3, 0x7d, 0xb7, 0xd7, 0x99, 0xc7, 0x4c, 0xd8,
+	0x65, 0x2e, 0xb4, 0x8b, 0x22, 0x57, 0x34, 0x12, 0x72, 0xa0, 0xbb, 0xaa, 0x05, 0x8f, 0x0f, 0x05,
+	0xeb, 0x4c, 0xc8, 0x2a, 0x88, 0x15, 0x8d, 0xc8, 0x59, 0xb4, 0x24, 0xfa, 0xdc, 0xb8, 0x25, 0x98,
+	0xa6, 0x42, 0xa6, 0x4d, 0xe6, 0x57, 0x34, 0xc2, 0x67, 0x50, 0xe9, 0x5c, 0x73, 0x1b, 0xb7, 0x05,
+	0xf3, 0x62, 0xc8, 0x3c, 0x30, 0x5d, 0xd1, 0xc8, 0xa0, 0x04, 0x2a, 0x9d, 0xeb, 0xe6, 0x61, 0xb3,
+	0x1f, 0x64, 0x60, 0x9a, 0x83, 0x0c, 0x90, 0x50, 0x21, 0x6c, 0x5f, 0xe0, 0x17, 0x42, 0x76, 0x36,
+	0xea, 0x75, 0x89, 0x1c, 0xa6, 0x11, 0x35, 0x8f, 0xf2, 0xf2, 0x79, 0x8e, 0x7f, 0x25, 0xf8, 0xa6,
+	0x43, 0x3e, 0x4e, 0xab, 0x68, 0x44, 0xcc, 0x71, 0x1e, 0xf1, 0x12, 0xb4, 0xfa, 0x79, 0x38, 0x8d,
+	0xf3, 0xf0, 0x5f, 0xb4, 0x1a, 0x3d, 0xdc, 0x70, 0xa7, 0xff, 0x26, 0x86, 0xf4, 0x8a, 0x46, 0x7a,
+	0x3c, 0xe8, 0xae, 0x7a, 0x2b, 0x60, 0xbb, 0xdf, 0xe6, 0x82, 0xc8, 0x6d, 0x2e, 0x3e, 0xd0, 0xe3,
+	0x78, 0xe5, 0x8a, 0x1d, 0xc1, 0xdb, 0x6b, 0xdc, 0x45, 0x33, 0x15, 0x8d, 0xc4, 0xf8, 0xb8, 0x0d,
+	0x07, 0xea, 0x3a, 0xdc, 0xed, 0xb7, 0xe1, 0xc0, 0x34, 0xb7, 0xe1, 0x00, 0x89, 0x2f, 0x1d, 0x15,
+	0x52, 0xf8, 0x65, 0xff, 0xd2, 0xd1, 0x0c, 0x5f, 0x3a, 0x1a, 0xa1, 0x3b, 0x90, 0x8d, 0x92, 0xfe,
+	0x89, 0xae, 0x4a, 0x83, 0x90, 0xb2, 0x3e, 0x09, 0xa9, 0xc0, 0x36, 0x1d, 0x3b, 0xff, 0x5a, 0x87,
+	0xcc, 0x36, 0xf5, 0x99, 0x6b, 0x0e, 0xbd, 0xcb, 0xf7, 0x7a, 0x97, 0x1e, 0xcf, 0xf7, 0xdf, 0x65,
+	0x45, 0x26, 0x3d, 0xa7, 0xd8, 0x80, 0xd4, 0x26, 0xe3, 0x8d, 0x37, 0x19, 0xc8, 0x1e, 0x29, 0x77,
+	0x2b, 0x5c, 0xc0, 0xdd, 0x84, 0x1c, 0x91, 0xe2, 0x43, 0xb4, 0xc8, 0xff, 0x79, 0x02, 0x16, 0x4b,
+	0x4e, 0xa7, 0xeb, 0x78, 0xa6, 0xcf, 0x42, 0x83, 0x49, 0x27, 0xfd, 0xff, 0x15, 0xd0, 0xab, 0x90,
+	0x96, 0xdf, 0x83, 0x91, 0x2f, 0x34, 0xab, 0x8a, 0x7c, 0x8a, 0x8b, 0x17, 0xbb, 0xdb, 0xcc, 0xa7,
+	0xd5, 0xf2, 0x68, 0xef, 0x06, 0x25, 0x8c, 0x56, 0x20, 0xc9, 0xbf, 0xf0, 0xe2, 0x67, 0x17, 0x15,
+	0x3c, 0x2b, 0xb5, 0xa8, 0xd1, 0x8e, 0xa6, 0x21, 0x53, 0xda, 0x97, 0x4d, 0xd4, 0x9c, 0x86, 0xbe,
+	0x05, 0x33, 0xa5, 0xfd, 0x58, 0x43, 0x2d, 0xa7, 0xa3, 0x79, 0xc8, 0x85, 0xa4, 0x30, 0xfd, 0xe5,
+	0x26, 0xd0, 0x0c, 0x64, 0x4b, 0xfb, 0x2a, 0x50, 0xe5, 0x12, 0x2b, 0x3f, 0x8a, 0x37, 0xb2, 0x51,
+	0x0e, 0xa6, 0xe5, 0x48, 0x3a, 0x73, 0x4e, 0x8b, 0x28, 0x3b, 0xce, 0x4f, 0xa9, 0xe9, 0xe7, 0x74,
+	0x34, 0x1b, 0x4a, 0xec, 0xd1, 0x16, 0xcd, 0x4d, 0xac, 0x7f, 0xff, 0xe4, 0xd4, 0xd0, 0xde, 0x9c,
+	0x1a, 0xda, 0xbb, 0x53, 0x43, 0xfb, 0x78, 0x6a, 0xe8, 0xbf, 0x3d, 0x33, 0xf4, 0x57, 0x67, 0x86,
+	0x7e, 0x72, 0x66, 0xe8, 0x6f, 0xce, 0x0c, 0xfd, 0xdf, 0x67, 0x86, 0xfe, 0x9f, 0x33, 0x43, 0xfb,
+	0x78, 0x66, 0xe8, 0xbf, 0xff, 0x60, 0x68, 0x6f, 0x3e, 0x18, 0xda, 0xbb, 0x0f, 0x86, 0x56, 0x4f,
+	0x8b, 0x3f, 0x3d, 0xbf, 0xf7, 0xbf, 0x01, 0x00, 0x8b, 0xd4, 0xc7, 0x87, 0x4a, 0x1d, 0x00, 0x00,
 }
 
 func (x CallType) String() string {
@@ -1647,6 +1736,15 @@ func (this *Result) Equal(that interface{}) bool {
 	if !bytes.Equal(this.Payload, that1.Payload) {
 		return false
 	}
+	if this.RouteCalls != that1.RouteCalls {
+		return false
+	}
+	if this.SaveAsChildCalls != that1.SaveAsChildCalls {
+		return false
+	}
+	if this.StateSize != that1.StateSize {
+		return false
+	}
 	return true
 }
 func (this *Type) Equal(that interface{}) bool {
@@ -1872,6 +1970,54 @@ func (this *PendingFilament) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *Validation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Validation)
+	if !ok {
+		that2, ok := that.(Validation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Polymorph != that1.Polymorph {
+		return false
+	}
+	if !this.Object.Equal(that1.Object) {
+		return false
+	}
+	if !this.Request.Equal(that1.Request) {
+		return false
+	}
+	if !this.Validator.Equal(that1.Validator) {
+		return false
+	}
+	if this.Valid != that1.Valid {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	if !bytes.Equal(this.ResultHash, that1.ResultHash) {
+		return false
+	}
+	if !bytes.Equal(this.StateHash, that1.StateHash) {
+		return false
+	}
+	if !bytes.Equal(this.VerdictSignature, that1.VerdictSignature) {
+		return false
+	}
+	return true
+}
 func (this *Lifeline) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -2343,6 +2489,30 @@ func (this *Virtual_PendingFilament) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *Virtual_Validation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Virtual_Validation)
+	if !ok {
+		that2, ok := that.(Virtual_Validation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Validation.Equal(that1.Validation) {
+		return false
+	}
+	return true
+}
 func (this *Material) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -2720,6 +2890,9 @@ type ResultFace interface {
 	GetObject() github_com_insolar_insolar_insolar.ID
 	GetRequest() github_com_insolar_insolar_insolar.Reference
 	GetPayload() []byte
+	GetRouteCalls() uint32
+	GetSaveAsChildCalls() uint32
+	GetStateSize() uint64
 }
 
 func (this *Result) Proto() github_com_gogo_protobuf_proto.Message {
@@ -2746,12 +2919,27 @@ func (this *Result) GetPayload() []byte {
 	return this.Payload
 }
 
+func (this *Result) GetRouteCalls() uint32 {
+	return this.RouteCalls
+}
+
+func (this *Result) GetSaveAsChildCalls() uint32 {
+	return this.SaveAsChildCalls
+}
+
+func (this *Result) GetStateSize() uint64 {
+	return this.StateSize
+}
+
 func NewResultFromFace(that ResultFace) *Result {
 	this := &Result{}
 	this.Polymorph = that.GetPolymorph()
 	this.Object = that.GetObject()
 	this.Request = that.GetRequest()
 	this.Payload = that.GetPayload()
+	this.RouteCalls = that.GetRouteCalls()
+	this.SaveAsChildCalls = that.GetSaveAsChildCalls()
+	this.StateSize = that.GetStateSize()
 	return this
 }
 
@@ -2843,6 +3031,77 @@ func NewCodeFromFace(that CodeFace) *Code {
 	return this
 }
 
+type ValidationFace interface {
+	Proto() github_com_gogo_protobuf_proto.Message
+	GetPolymorph() int32
+	GetObject() github_com_insolar_insolar_insolar.ID
+	GetRequest() github_com_insolar_insolar_insolar.Reference
+	GetValidator() github_com_insolar_insolar_insolar.Reference
+	GetValid() bool
+	GetReason() string
+	GetResultHash() []byte
+	GetStateHash() []byte
+	GetVerdictSignature() []byte
+}
+
+func (this *Validation) Proto() github_com_gogo_protobuf_proto.Message {
+	return this
+}
+
+func (this *Validation) TestProto() github_com_gogo_protobuf_proto.Message {
+	return NewValidationFromFace(this)
+}
+
+func (this *Validation) GetPolymorph() int32 {
+	return this.Polymorph
+}
+
+func (this *Validation) GetObject() github_com_insolar_insolar_insolar.ID {
+	return this.Object
+}
+
+func (this *Validation) GetRequest() github_com_insolar_insolar_insolar.Reference {
+	return this.Request
+}
+
+func (this *Validation) GetValidator() github_com_insolar_insolar_insolar.Reference {
+	return this.Validator
+}
+
+func (this *Validation) GetValid() bool {
+	return this.Valid
+}
+
+func (this *Validation) GetReason() string {
+	return this.Reason
+}
+
+func (this *Validation) GetResultHash() []byte {
+	return this.ResultHash
+}
+
+func (this *Validation) GetStateHash() []byte {
+	return this.StateHash
+}
+
+func (this *Validation) GetVerdictSignature() []byte {
+	return this.VerdictSignature
+}
+
+func NewValidationFromFace(that ValidationFace) *Validation {
+	this := &Validation{}
+	this.Polymorph = that.GetPolymorph()
+	this.Object = that.GetObject()
+	this.Request = that.GetRequest()
+	this.Validator = that.GetValidator()
+	this.Valid = that.GetValid()
+	this.Reason = that.GetReason()
+	this.ResultHash = that.GetResultHash()
+	this.StateHash = that.GetStateHash()
+	this.VerdictSignature = that.GetVerdictSignature()
+	return this
+}
+
 func (this *Genesis) GoString() string {
 	if this == nil {
 		return "nil"
@@ -2928,12 +3187,15 @@ func (this *Result) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 8)
+	s := make([]string, 0, 11)
 	s = append(s, "&record.Result{")
 	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
 	s = append(s, "Object: "+fmt.Sprintf("%#v", this.Object)+",\n")
 	s = append(s, "Request: "+fmt.Sprintf("%#v", this.Request)+",\n")
 	s = append(s, "Payload: "+fmt.Sprintf("%#v", this.Payload)+",\n")
+	s = append(s, "RouteCalls: "+fmt.Sprintf("%#v", this.RouteCalls)+",\n")
+	s = append(s, "SaveAsChildCalls: "+fmt.Sprintf("%#v", this.SaveAsChildCalls)+",\n")
+	s = append(s, "StateSize: "+fmt.Sprintf("%#v", this.StateSize)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -3022,6 +3284,24 @@ func (this *PendingFilament) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *Validation) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 13)
+	s = append(s, "&record.Validation{")
+	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
+	s = append(s, "Object: "+fmt.Sprintf("%#v", this.Object)+",\n")
+	s = append(s, "Request: "+fmt.Sprintf("%#v", this.Request)+",\n")
+	s = append(s, "Validator: "+fmt.Sprintf("%#v", this.Validator)+",\n")
+	s = append(s, "Valid: "+fmt.Sprintf("%#v", this.Valid)+",\n")
+	s = append(s, "Reason: "+fmt.Sprintf("%#v", this.Reason)+",\n")
+	s = append(s, "ResultHash: "+fmt.Sprintf("%#v", this.ResultHash)+",\n")
+	s = append(s, "StateHash: "+fmt.Sprintf("%#v", this.StateHash)+",\n")
+	s = append(s, "VerdictSignature: "+fmt.Sprintf("%#v", this.VerdictSignature)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func (this *Lifeline) GoString() string {
 	if this == nil {
 		return "nil"
@@ -3077,7 +3357,7 @@ func (this *Virtual) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 18)
+	s := make([]string, 0, 19)
 	s = append(s, "&record.Virtual{")
 	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
 	if this.Union != nil {
@@ -3183,6 +3463,14 @@ func (this *Virtual_PendingFilament) GoString() string {
 		`PendingFilament:` + fmt.Sprintf("%#v", this.PendingFilament) + `}`}, ", ")
 	return s
 }
+func (this *Virtual_Validation) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&record.Virtual_Validation{` +
+		`Validation:` + fmt.Sprintf("%#v", this.Validation) + `}`}, ", ")
+	return s
+}
 func (this *Material) GoString() string {
 	if this == nil {
 		return "nil"
@@ -3689,6 +3977,27 @@ func (m *Result) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintRecord(dAtA, i, uint64(len(m.Payload)))
 		i += copy(dAtA[i:], m.Payload)
 	}
+	if m.RouteCalls != 0 {
+		dAtA[i] = 0xb8
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintRecord(dAtA, i, uint64(m.RouteCalls))
+	}
+	if m.SaveAsChildCalls != 0 {
+		dAtA[i] = 0xc0
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintRecord(dAtA, i, uint64(m.SaveAsChildCalls))
+	}
+	if m.StateSize != 0 {
+		dAtA[i] = 0xc8
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintRecord(dAtA, i, uint64(m.StateSize))
+	}
 	return i, nil
 }
 
@@ -4089,7 +4398,7 @@ func (m *PendingFilament) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *Lifeline) Marshal() (dAtA []byte, err error) {
+func (m *Validation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4099,7 +4408,7 @@ func (m *Lifeline) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Lifeline) MarshalTo(dAtA []byte) (int, error) {
+func (m *Validation) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -4111,58 +4420,157 @@ func (m *Lifeline) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintRecord(dAtA, i, uint64(m.Polymorph))
 	}
-	if m.LatestState != nil {
-		dAtA[i] = 0xa2
+	dAtA[i] = 0xa2
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintRecord(dAtA, i, uint64(m.Object.Size()))
+	n36, err := m.Object.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n36
+	dAtA[i] = 0xaa
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintRecord(dAtA, i, uint64(m.Request.Size()))
+	n37, err := m.Request.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n37
+	dAtA[i] = 0xb2
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintRecord(dAtA, i, uint64(m.Validator.Size()))
+	n38, err := m.Validator.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n38
+	if m.Valid {
+		dAtA[i] = 0xb8
 		i++
 		dAtA[i] = 0x1
 		i++
-		i = encodeVarintRecord(dAtA, i, uint64(m.LatestState.Size()))
-		n36, err := m.LatestState.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i += n36
+		i++
 	}
-	if m.LatestStateApproved != nil {
-		dAtA[i] = 0xaa
+	if len(m.Reason) > 0 {
+		dAtA[i] = 0xc2
 		i++
 		dAtA[i] = 0x1
 		i++
-		i = encodeVarintRecord(dAtA, i, uint64(m.LatestStateApproved.Size()))
-		n37, err := m.LatestStateApproved.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n37
+		i = encodeVarintRecord(dAtA, i, uint64(len(m.Reason)))
+		i += copy(dAtA[i:], m.Reason)
 	}
-	if m.ChildPointer != nil {
-		dAtA[i] = 0xb2
+	if len(m.ResultHash) > 0 {
+		dAtA[i] = 0xca
 		i++
 		dAtA[i] = 0x1
 		i++
-		i = encodeVarintRecord(dAtA, i, uint64(m.ChildPointer.Size()))
-		n38, err := m.ChildPointer.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n38
+		i = encodeVarintRecord(dAtA, i, uint64(len(m.ResultHash)))
+		i += copy(dAtA[i:], m.ResultHash)
 	}
-	dAtA[i] = 0xba
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintRecord(dAtA, i, uint64(m.Parent.Size()))
-	n39, err := m.Parent.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	if len(m.StateHash) > 0 {
+		dAtA[i] = 0xd2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintRecord(dAtA, i, uint64(len(m.StateHash)))
+		i += copy(dAtA[i:], m.StateHash)
 	}
-	i += n39
-	if len(m.Delegates) > 0 {
-		for _, msg := range m.Delegates {
-			dAtA[i] = 0xc2
-			i++
-			dAtA[i] = 0x1
-			i++
+	if len(m.VerdictSignature) > 0 {
+		dAtA[i] = 0xda
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintRecord(dAtA, i, uint64(len(m.VerdictSignature)))
+		i += copy(dAtA[i:], m.VerdictSignature)
+	}
+	return i, nil
+}
+
+func (m *Lifeline) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Lifeline) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintRecord(dAtA, i, uint64(m.Polymorph))
+	}
+	if m.LatestState != nil {
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintRecord(dAtA, i, uint64(m.LatestState.Size()))
+		n39, err := m.LatestState.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n39
+	}
+	if m.LatestStateApproved != nil {
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintRecord(dAtA, i, uint64(m.LatestStateApproved.Size()))
+		n40, err := m.LatestStateApproved.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n40
+	}
+	if m.ChildPointer != nil {
+		dAtA[i] = 0xb2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintRecord(dAtA, i, uint64(m.ChildPointer.Size()))
+		n41, err := m.ChildPointer.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n41
+	}
+	dAtA[i] = 0xba
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintRecord(dAtA, i, uint64(m.Parent.Size()))
+	n42, err := m.Parent.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n42
+	if len(m.Delegates) > 0 {
+		for _, msg := range m.Delegates {
+			dAtA[i] = 0xc2
+			i++
+			dAtA[i] = 0x1
+			i++
 			i = encodeVarintRecord(dAtA, i, uint64(msg.Size()))
 			n, err := msg.MarshalTo(dAtA[i:])
 			if err != nil {
@@ -4191,11 +4599,11 @@ func (m *Lifeline) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintRecord(dAtA, i, uint64(m.PendingPointer.Size()))
-		n40, err := m.PendingPointer.MarshalTo(dAtA[i:])
+		n43, err := m.PendingPointer.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n40
+		i += n43
 	}
 	if m.EarliestOpenRequest != nil {
 		dAtA[i] = 0xe2
@@ -4203,11 +4611,11 @@ func (m *Lifeline) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintRecord(dAtA, i, uint64(m.EarliestOpenRequest.Size()))
-		n41, err := m.EarliestOpenRequest.MarshalTo(dAtA[i:])
+		n44, err := m.EarliestOpenRequest.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n41
+		i += n44
 	}
 	return i, nil
 }
@@ -4239,21 +4647,21 @@ func (m *LifelineDelegate) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.Key.Size()))
-	n42, err := m.Key.MarshalTo(dAtA[i:])
+	n45, err := m.Key.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n42
+	i += n45
 	dAtA[i] = 0xaa
 	i++
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.Value.Size()))
-	n43, err := m.Value.MarshalTo(dAtA[i:])
+	n46, err := m.Value.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n43
+	i += n46
 	return i, nil
 }
 
@@ -4284,21 +4692,21 @@ func (m *Index) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.ObjID.Size()))
-	n44, err := m.ObjID.MarshalTo(dAtA[i:])
+	n47, err := m.ObjID.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n44
+	i += n47
 	dAtA[i] = 0xaa
 	i++
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.Lifeline.Size()))
-	n45, err := m.Lifeline.MarshalTo(dAtA[i:])
+	n48, err := m.Lifeline.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n45
+	i += n48
 	if m.LifelineLastUsed != 0 {
 		dAtA[i] = 0xb0
 		i++
@@ -4346,11 +4754,11 @@ func (m *Virtual) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintRecord(dAtA, i, uint64(m.Polymorph))
 	}
 	if m.Union != nil {
-		nn46, err := m.Union.MarshalTo(dAtA[i:])
+		nn49, err := m.Union.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += nn46
+		i += nn49
 	}
 	if len(m.Signature) > 0 {
 		dAtA[i] = 0xc2
@@ -4371,11 +4779,11 @@ func (m *Virtual_Genesis) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintRecord(dAtA, i, uint64(m.Genesis.Size()))
-		n47, err := m.Genesis.MarshalTo(dAtA[i:])
+		n50, err := m.Genesis.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n47
+		i += n50
 	}
 	return i, nil
 }
@@ -4387,11 +4795,11 @@ func (m *Virtual_Child) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintRecord(dAtA, i, uint64(m.Child.Size()))
-		n48, err := m.Child.MarshalTo(dAtA[i:])
+		n51, err := m.Child.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n48
+		i += n51
 	}
 	return i, nil
 }
@@ -4403,11 +4811,11 @@ func (m *Virtual_Jet) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintRecord(dAtA, i, uint64(m.Jet.Size()))
-		n49, err := m.Jet.MarshalTo(dAtA[i:])
+		n52, err := m.Jet.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n49
+		i += n52
 	}
 	return i, nil
 }
@@ -4419,11 +4827,11 @@ func (m *Virtual_IncomingRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintRecord(dAtA, i, uint64(m.IncomingRequest.Size()))
-		n50, err := m.IncomingRequest.MarshalTo(dAtA[i:])
+		n53, err := m.IncomingRequest.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n50
+		i += n53
 	}
 	return i, nil
 }
@@ -4435,11 +4843,11 @@ func (m *Virtual_OutgoingRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintRecord(dAtA, i, uint64(m.OutgoingRequest.Size()))
-		n51, err := m.OutgoingRequest.MarshalTo(dAtA[i:])
+		n54, err := m.OutgoingRequest.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n51
+		i += n54
 	}
 	return i, nil
 }
@@ -4451,11 +4859,11 @@ func (m *Virtual_Result) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintRecord(dAtA, i, uint64(m.Result.Size()))
-		n52, err := m.Result.MarshalTo(dAtA[i:])
+		n55, err := m.Result.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n52
+		i += n55
 	}
 	return i, nil
 }
@@ -4467,11 +4875,11 @@ func (m *Virtual_Type) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintRecord(dAtA, i, uint64(m.Type.Size()))
-		n53, err := m.Type.MarshalTo(dAtA[i:])
+		n56, err := m.Type.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n53
+		i += n56
 	}
 	return i, nil
 }
@@ -4483,11 +4891,11 @@ func (m *Virtual_Code) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintRecord(dAtA, i, uint64(m.Code.Size()))
-		n54, err := m.Code.MarshalTo(dAtA[i:])
+		n57, err := m.Code.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n54
+		i += n57
 	}
 	return i, nil
 }
@@ -4499,11 +4907,11 @@ func (m *Virtual_Activate) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintRecord(dAtA, i, uint64(m.Activate.Size()))
-		n55, err := m.Activate.MarshalTo(dAtA[i:])
+		n58, err := m.Activate.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n55
+		i += n58
 	}
 	return i, nil
 }
@@ -4515,11 +4923,11 @@ func (m *Virtual_Amend) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintRecord(dAtA, i, uint64(m.Amend.Size()))
-		n56, err := m.Amend.MarshalTo(dAtA[i:])
+		n59, err := m.Amend.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n56
+		i += n59
 	}
 	return i, nil
 }
@@ -4531,11 +4939,11 @@ func (m *Virtual_Deactivate) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintRecord(dAtA, i, uint64(m.Deactivate.Size()))
-		n57, err := m.Deactivate.MarshalTo(dAtA[i:])
+		n60, err := m.Deactivate.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n57
+		i += n60
 	}
 	return i, nil
 }
@@ -4547,11 +4955,27 @@ func (m *Virtual_PendingFilament) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x7
 		i++
 		i = encodeVarintRecord(dAtA, i, uint64(m.PendingFilament.Size()))
-		n58, err := m.PendingFilament.MarshalTo(dAtA[i:])
+		n61, err := m.PendingFilament.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n58
+		i += n61
+	}
+	return i, nil
+}
+func (m *Virtual_Validation) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.Validation != nil {
+		dAtA[i] = 0x8a
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintRecord(dAtA, i, uint64(m.Validation.Size()))
+		n62, err := m.Validation.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n62
 	}
 	return i, nil
 }
@@ -4583,22 +5007,22 @@ func (m *Material) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintRecord(dAtA, i, uint64(m.Virtual.Size()))
-		n59, err := m.Virtual.MarshalTo(dAtA[i:])
+		n63, err := m.Virtual.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n59
+		i += n63
 	}
 	dAtA[i] = 0xaa
 	i++
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.JetID.Size()))
-	n60, err := m.JetID.MarshalTo(dAtA[i:])
+	n64, err := m.JetID.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n60
+	i += n64
 	if len(m.Signature) > 0 {
 		dAtA[i] = 0xc2
 		i++
@@ -4637,41 +5061,41 @@ func (m *CompositeFilamentRecord) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.RecordID.Size()))
-	n61, err := m.RecordID.MarshalTo(dAtA[i:])
+	n65, err := m.RecordID.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n61
+	i += n65
 	dAtA[i] = 0xaa
 	i++
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.Record.Size()))
-	n62, err := m.Record.MarshalTo(dAtA[i:])
+	n66, err := m.Record.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n62
+	i += n66
 	dAtA[i] = 0xb2
 	i++
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.MetaID.Size()))
-	n63, err := m.MetaID.MarshalTo(dAtA[i:])
+	n67, err := m.MetaID.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n63
+	i += n67
 	dAtA[i] = 0xba
 	i++
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.Meta.Size()))
-	n64, err := m.Meta.MarshalTo(dAtA[i:])
+	n68, err := m.Meta.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n64
+	i += n68
 	return i, nil
 }
 
@@ -4857,6 +5281,15 @@ func (m *Result) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovRecord(uint64(l))
 	}
+	if m.RouteCalls != 0 {
+		n += 2 + sovRecord(uint64(m.RouteCalls))
+	}
+	if m.SaveAsChildCalls != 0 {
+		n += 2 + sovRecord(uint64(m.SaveAsChildCalls))
+	}
+	if m.StateSize != 0 {
+		n += 2 + sovRecord(uint64(m.StateSize))
+	}
 	return n
 }
 
@@ -4996,6 +5429,43 @@ func (m *PendingFilament) Size() (n int) {
 	return n
 }
 
+func (m *Validation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		n += 2 + sovRecord(uint64(m.Polymorph))
+	}
+	l = m.Object.Size()
+	n += 2 + l + sovRecord(uint64(l))
+	l = m.Request.Size()
+	n += 2 + l + sovRecord(uint64(l))
+	l = m.Validator.Size()
+	n += 2 + l + sovRecord(uint64(l))
+	if m.Valid {
+		n += 3
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 2 + l + sovRecord(uint64(l))
+	}
+	l = len(m.ResultHash)
+	if l > 0 {
+		n += 2 + l + sovRecord(uint64(l))
+	}
+	l = len(m.StateHash)
+	if l > 0 {
+		n += 2 + l + sovRecord(uint64(l))
+	}
+	l = len(m.VerdictSignature)
+	if l > 0 {
+		n += 2 + l + sovRecord(uint64(l))
+	}
+	return n
+}
+
 func (m *Lifeline) Size() (n int) {
 	if m == nil {
 		return 0
@@ -5246,6 +5716,18 @@ func (m *Virtual_PendingFilament) Size() (n int) {
 	}
 	return n
 }
+func (m *Virtual_Validation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Validation != nil {
+		l = m.Validation.Size()
+		n += 2 + l + sovRecord(uint64(l))
+	}
+	return n
+}
 func (m *Material) Size() (n int) {
 	if m == nil {
 		return 0
@@ -5391,6 +5873,9 @@ func (this *Result) String() string {
 		`Object:` + fmt.Sprintf("%v", this.Object) + `,`,
 		`Request:` + fmt.Sprintf("%v", this.Request) + `,`,
 		`Payload:` + fmt.Sprintf("%v", this.Payload) + `,`,
+		`RouteCalls:` + fmt.Sprintf("%v", this.RouteCalls) + `,`,
+		`SaveAsChildCalls:` + fmt.Sprintf("%v", this.SaveAsChildCalls) + `,`,
+		`StateSize:` + fmt.Sprintf("%v", this.StateSize) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5480,6 +5965,24 @@ func (this *PendingFilament) String() string {
 	}, "")
 	return s
 }
+func (this *Validation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Validation{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`Object:` + fmt.Sprintf("%v", this.Object) + `,`,
+		`Request:` + fmt.Sprintf("%v", this.Request) + `,`,
+		`Validator:` + fmt.Sprintf("%v", this.Validator) + `,`,
+		`Valid:` + fmt.Sprintf("%v", this.Valid) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`ResultHash:` + fmt.Sprintf("%v", this.ResultHash) + `,`,
+		`StateHash:` + fmt.Sprintf("%v", this.StateHash) + `,`,
+		`VerdictSignature:` + fmt.Sprintf("%v", this.VerdictSignature) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Lifeline) String() string {
 	if this == nil {
 		return "nil"
@@ -5657,6 +6160,16 @@ func (this *Virtual_PendingFilament) String() string {
 	}, "")
 	return s
 }
+func (this *Virtual_Validation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Virtual_Validation{`,
+		`Validation:` + strings.Replace(fmt.Sprintf("%v", this.Validation), "Validation", "Validation", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Material) String() string {
 	if this == nil {
 		return "nil"
@@ -7124,6 +7637,63 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				m.Payload = []byte{}
 			}
 			iNdEx = postIndex
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RouteCalls", wireType)
+			}
+			m.RouteCalls = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RouteCalls |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SaveAsChildCalls", wireType)
+			}
+			m.SaveAsChildCalls = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SaveAsChildCalls |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateSize", wireType)
+			}
+			m.StateSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StateSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRecord(dAtA[iNdEx:])
@@ -8358,7 +8928,7 @@ func (m *PendingFilament) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Lifeline) Unmarshal(dAtA []byte) error {
+func (m *Validation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8381,10 +8951,10 @@ func (m *Lifeline) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Lifeline: wiretype end group for non-group")
+			return fmt.Errorf("proto: Validation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Lifeline: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Validation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 16:
@@ -8408,7 +8978,7 @@ func (m *Lifeline) Unmarshal(dAtA []byte) error {
 			}
 		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -8435,15 +9005,13 @@ func (m *Lifeline) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_insolar_insolar_insolar.ID
-			m.LatestState = &v
-			if err := m.LatestState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Object.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestStateApproved", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -8470,15 +9038,13 @@ func (m *Lifeline) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_insolar_insolar_insolar.ID
-			m.LatestStateApproved = &v
-			if err := m.LatestStateApproved.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChildPointer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -8505,17 +9071,15 @@ func (m *Lifeline) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_insolar_insolar_insolar.ID
-			m.ChildPointer = &v
-			if err := m.ChildPointer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Validator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 23:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
 			}
-			var byteLen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRecord
@@ -8525,16 +9089,347 @@ func (m *Lifeline) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthRecord
+			m.Valid = bool(v != 0)
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResultHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResultHash = append(m.ResultHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ResultHash == nil {
+				m.ResultHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateHash = append(m.StateHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.StateHash == nil {
+				m.StateHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerdictSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerdictSignature = append(m.VerdictSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.VerdictSignature == nil {
+				m.VerdictSignature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Lifeline) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Lifeline: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Lifeline: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Polymorph", wireType)
+			}
+			m.Polymorph = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Polymorph |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestState", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_insolar_insolar_insolar.ID
+			m.LatestState = &v
+			if err := m.LatestState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestStateApproved", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_insolar_insolar_insolar.ID
+			m.LatestStateApproved = &v
+			if err := m.LatestStateApproved.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChildPointer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_insolar_insolar_insolar.ID
+			m.ChildPointer = &v
+			if err := m.ChildPointer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
 				return ErrInvalidLengthRecord
 			}
 			if postIndex > l {
@@ -9508,6 +10403,41 @@ func (m *Virtual) Unmarshal(dAtA []byte) error {
 			}
 			m.Union = &Virtual_PendingFilament{v}
 			iNdEx = postIndex
+		case 113:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Validation{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Union = &Virtual_Validation{v}
+			iNdEx = postIndex
 		case 200:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
@@ -22,12 +22,31 @@ import (
 
 type LeaveApproved struct{}
 
+// LeaveReason is a code of graceful leave reason, it's passed to other nodes through consensus.
+type LeaveReason uint32
+
+const (
+	// LeaveReasonUnknown is used when reason is not provided.
+	LeaveReasonUnknown LeaveReason = iota
+	// LeaveReasonShutdown is used when node process is stopped.
+	LeaveReasonShutdown
+	// LeaveReasonLogOff is used when operator logs the node off through API.
+	LeaveReasonLogOff
+	// LeaveReasonFinalization is used when heavy node can't finalize pulses anymore.
+	LeaveReasonFinalization
+)
+
 //go:generate minimock -i github.com/insolar/insolar/insolar.TerminationHandler -o ../testutils -s _mock.go
 
 // TerminationHandler handles such node events as graceful stop, abort, etc.
 type TerminationHandler interface {
 	// Leave locks until network accept leaving claim
 	Leave(context.Context, PulseNumber)
+	// RequestLeave asks network to remove the node after provided number of pulses. Returned channel is closed when
+	// the leave is applied by the network.
+	RequestLeave(ctx context.Context, reason LeaveReason, leaveAfterPulses PulseNumber) <-chan LeaveApproved
+	// RequiredLeave returns reason of requested leave if it should be announced to the network in current pulse.
+	RequiredLeave(context.Context) (LeaveReason, bool)
 	OnLeaveApproved(context.Context)
 	// Abort forces to stop all node components
 	Abort(reason string)
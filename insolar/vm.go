@@ -28,6 +28,7 @@ const (
 	MachineTypeNotExist             = 0
 	MachineTypeBuiltin  MachineType = iota + 1
 	MachineTypeGoPlugin
+	MachineTypeWasm
 
 	MachineTypesLastID
 )
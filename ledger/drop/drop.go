@@ -68,6 +68,11 @@ type Drop struct {
 	SplitThresholdExceeded int
 	// Split indicates to heavy, what split for this jet happened on light.
 	Split bool
+
+	// QuietCount is a counter, which stores how many times in the row jet records count was low enough to merge jet with its sibling.
+	QuietCount int
+	// Merge indicates to heavy, what merge of this jet with its sibling happened on light.
+	Merge bool
 }
 
 // MustEncode serializes jet drop.
@@ -0,0 +1,74 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package executor
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/drop"
+)
+
+// DropHashes provides hashes of jet drops for the last finalized pulse.
+// Network requests them in JetlessNetworkState to verify completeness of data stored on light nodes.
+type DropHashes struct {
+	jetKeeper JetKeeper
+	jets      jet.Accessor
+	drops     drop.Accessor
+}
+
+// NewDropHashes creates new DropHashes instance.
+func NewDropHashes(jk JetKeeper, jets jet.Accessor, drops drop.Accessor) *DropHashes {
+	return &DropHashes{
+		jetKeeper: jk,
+		jets:      jets,
+		drops:     drops,
+	}
+}
+
+// ForTopSyncPulse returns hashes of all jet drops for the last finalized pulse.
+func (d *DropHashes) ForTopSyncPulse(ctx context.Context) (jet.DropHashes, error) {
+	pn := d.jetKeeper.TopSyncPulse()
+	res := jet.DropHashes{Pulse: pn}
+
+	for _, jetID := range d.jets.All(ctx, pn) {
+		dr, err := d.drops.ForPulse(ctx, jetID, pn)
+		if err == drop.ErrNotFound {
+			inslogger.FromContext(ctx).Debugf("DropHashes: no drop for jet %s in pulse %d", jetID.DebugString(), pn)
+			continue
+		}
+		if err != nil {
+			return jet.DropHashes{}, errors.Wrapf(err, "failed to get drop for jet %s", jetID.DebugString())
+		}
+		res.Hashes = append(res.Hashes, jet.DropHash{JetID: jetID, Hash: dr.Hash})
+	}
+
+	return res, nil
+}
+
+// Procedure is a remote procedure for jet.DropHashesProcedure.
+func (d *DropHashes) Procedure(ctx context.Context, _ []byte) ([]byte, error) {
+	hashes, err := d.ForTopSyncPulse(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return insolar.Serialize(hashes)
+}
@@ -0,0 +1,104 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package executor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/drop"
+)
+
+func TestDropHashes_ForTopSyncPulse(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	pn := gen.PulseNumber()
+	left, right := jet.NewIDFromString("0"), jet.NewIDFromString("1")
+
+	jk := NewJetKeeperMock(t)
+	jk.TopSyncPulseMock.Return(pn)
+	jets := jet.NewAccessorMock(t)
+	jets.AllMock.Expect(ctx, pn).Return([]insolar.JetID{left, right})
+
+	t.Run("all drops found", func(t *testing.T) {
+		drops := drop.NewAccessorMock(t)
+		drops.ForPulseFunc = func(_ context.Context, jetID insolar.JetID, p insolar.PulseNumber) (drop.Drop, error) {
+			require.Equal(t, pn, p)
+			return drop.Drop{JetID: jetID, Pulse: p, Hash: []byte(jetID.DebugString())}, nil
+		}
+
+		hashes, err := NewDropHashes(jk, jets, drops).ForTopSyncPulse(ctx)
+		require.NoError(t, err)
+		require.Equal(t, jet.DropHashes{
+			Pulse: pn,
+			Hashes: []jet.DropHash{
+				{JetID: left, Hash: []byte(left.DebugString())},
+				{JetID: right, Hash: []byte(right.DebugString())},
+			},
+		}, hashes)
+	})
+
+	t.Run("missing drop is skipped", func(t *testing.T) {
+		drops := drop.NewAccessorMock(t)
+		drops.ForPulseFunc = func(_ context.Context, jetID insolar.JetID, p insolar.PulseNumber) (drop.Drop, error) {
+			if jetID == left {
+				return drop.Drop{}, drop.ErrNotFound
+			}
+			return drop.Drop{JetID: jetID, Pulse: p, Hash: []byte{1}}, nil
+		}
+
+		hashes, err := NewDropHashes(jk, jets, drops).ForTopSyncPulse(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []jet.DropHash{{JetID: right, Hash: []byte{1}}}, hashes.Hashes)
+	})
+
+	t.Run("storage error", func(t *testing.T) {
+		drops := drop.NewAccessorMock(t)
+		drops.ForPulseMock.Return(drop.Drop{}, errors.New("test"))
+
+		_, err := NewDropHashes(jk, jets, drops).ForTopSyncPulse(ctx)
+		require.Error(t, err)
+	})
+}
+
+func TestDropHashes_Procedure(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	pn := gen.PulseNumber()
+	jetID := gen.JetID()
+
+	jk := NewJetKeeperMock(t)
+	jk.TopSyncPulseMock.Return(pn)
+	jets := jet.NewAccessorMock(t)
+	jets.AllMock.Return([]insolar.JetID{jetID})
+	drops := drop.NewAccessorMock(t)
+	drops.ForPulseMock.Return(drop.Drop{Hash: []byte{1, 2, 3}}, nil)
+
+	res, err := NewDropHashes(jk, jets, drops).Procedure(ctx, nil)
+	require.NoError(t, err)
+
+	var hashes jet.DropHashes
+	err = insolar.Deserialize(res, &hashes)
+	require.NoError(t, err)
+	require.Equal(t, pn, hashes.Pulse)
+	require.Equal(t, []jet.DropHash{{JetID: jetID, Hash: []byte{1, 2, 3}}}, hashes.Hashes)
+}
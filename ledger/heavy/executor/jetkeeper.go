@@ -96,8 +96,15 @@ func (jk *dbJetKeeper) checkPulseConsistency(ctx context.Context, pulse insolar.
 		actualMap[jet] = true
 	}
 
+	maxDepth := uint8(0)
+	for _, id := range actualJets {
+		if id.IsValid() && id.Depth() > maxDepth {
+			maxDepth = id.Depth()
+		}
+	}
+
 	for _, jet := range expectedJets {
-		if !actualMap[jet] {
+		if !covered(jet, actualMap, maxDepth) {
 			logger.Debugf("[CheckPulseConsistency] noncomplete pulse=%v expected=%v actual=%v", pulse,
 				insolar.JetIDCollection(expectedJets).DebugString(),
 				insolar.JetIDCollection(actualJets).DebugString())
@@ -114,6 +121,32 @@ func (jk *dbJetKeeper) checkPulseConsistency(ctx context.Context, pulse insolar.
 	return nil
 }
 
+// covered checks if received drops cover provided jet. Jet tree of the pulse may not know about merges and
+// splits performed by light nodes, so drop of the parent jet covers its children (jets were merged) and
+// drops of both children cover the parent (jet was split).
+func covered(id insolar.JetID, actual map[insolar.JetID]bool, maxDepth uint8) bool {
+	for parent := id; ; parent = jet.Parent(parent) {
+		if actual[parent] {
+			return true
+		}
+		if parent.Depth() == 0 {
+			break
+		}
+	}
+	return coveredByChildren(id, actual, maxDepth)
+}
+
+func coveredByChildren(id insolar.JetID, actual map[insolar.JetID]bool, maxDepth uint8) bool {
+	if actual[id] {
+		return true
+	}
+	if id.Depth() >= maxDepth {
+		return false
+	}
+	left, right := jet.Siblings(id)
+	return coveredByChildren(left, actual, maxDepth) && coveredByChildren(right, actual, maxDepth)
+}
+
 func (jk *dbJetKeeper) all(pulse insolar.PulseNumber) []insolar.JetID {
 	jets, err := jk.get(pulse)
 	if err != nil {
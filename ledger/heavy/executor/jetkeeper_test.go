@@ -111,3 +111,40 @@ func TestDbJetKeeper_TopSyncPulse(t *testing.T) {
 
 	require.Equal(t, futurePulse, jetKeeper.TopSyncPulse())
 }
+
+func TestDbJetKeeper_TopSyncPulse_SplitAndMerge(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+
+	tmpdir, err := ioutil.TempDir("", "bdb-test-")
+	defer os.RemoveAll(tmpdir)
+	require.NoError(t, err)
+
+	db, err := store.NewBadgerDB(tmpdir)
+	require.NoError(t, err)
+	defer db.Stop(context.Background())
+	jets := jet.NewDBStore(db)
+	jetKeeper := NewJetKeeper(jets, db)
+
+	var (
+		mergedPulse insolar.PulseNumber = 10
+		splitPulse  insolar.PulseNumber = 20
+	)
+
+	// tree knows about children, but drop came for merged parent
+	_, _, err = jets.Split(ctx, mergedPulse, insolar.ZeroJetID)
+	require.NoError(t, err)
+	err = jetKeeper.Add(ctx, mergedPulse, insolar.ZeroJetID)
+	require.NoError(t, err)
+	require.Equal(t, mergedPulse, jetKeeper.TopSyncPulse())
+
+	// tree knows about parent, but drops came for split children
+	err = jets.Update(ctx, splitPulse, true, insolar.ZeroJetID)
+	require.NoError(t, err)
+	left, right := jet.Siblings(insolar.ZeroJetID)
+	err = jetKeeper.Add(ctx, splitPulse, left)
+	require.NoError(t, err)
+	require.Equal(t, mergedPulse, jetKeeper.TopSyncPulse())
+	err = jetKeeper.Add(ctx, splitPulse, right)
+	require.NoError(t, err)
+	require.Equal(t, splitPulse, jetKeeper.TopSyncPulse())
+}
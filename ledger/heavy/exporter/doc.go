@@ -0,0 +1,23 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package exporter provides streaming export of finalized pulses from heavy node.
+//
+// Export is paginated: every page is requested with a cursor (pulse number plus id of the last received record) and
+// contains the cursor to request the next page with. The first page of a pulse carries pulse metadata, jet drops and
+// object indexes of the pulse, all pages carry records of the pulse ordered by id. A pulse becomes available for export
+// after it's finalized (see executor.JetKeeper) and ExportLag seconds have passed since it started.
+package exporter
@@ -0,0 +1,185 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package exporter
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/ledger/drop"
+	"github.com/insolar/insolar/ledger/heavy/executor"
+	"github.com/insolar/insolar/ledger/object"
+)
+
+const (
+	// DefaultLimit is a number of records in page if limit is not provided.
+	DefaultLimit = 100
+	// MaxLimit is a maximum number of records in page.
+	MaxLimit = 1000
+)
+
+var (
+	// ErrNotReady is returned when requested pulse is not finalized yet or export lag is not passed.
+	ErrNotReady = errors.New("pulse is not ready for export")
+	// ErrBadLimit is returned when requested limit is out of bounds.
+	ErrBadLimit = errors.New("limit should be positive and not greater than max limit")
+)
+
+// Cursor points to a position in export stream.
+type Cursor struct {
+	// PulseNumber is a pulse to export.
+	PulseNumber insolar.PulseNumber
+	// RecordID is an id of the last received record of the pulse. If nil, export starts from the beginning of the pulse.
+	RecordID *insolar.ID
+}
+
+// Page is a portion of exported data.
+type Page struct {
+	// Pulse is exported pulse metadata. Set only for the first page of a pulse.
+	Pulse *insolar.Pulse
+	// Drops are jet drops of the pulse. Set only for the first page of a pulse.
+	Drops []drop.Drop
+	// Indexes are object indexes of the pulse. Set only for the first page of a pulse.
+	Indexes []record.Index
+	// Records are records of the pulse ordered by id.
+	Records []object.IdentifiedRecord
+	// Next is a cursor for the next page.
+	Next Cursor
+}
+
+// Exporter provides access to finalized pulses data.
+type Exporter struct {
+	cfg       configuration.Exporter
+	jetKeeper executor.JetKeeper
+	pulses    pulse.Accessor
+	calc      pulse.Calculator
+	jets      jet.Accessor
+	drops     drop.Accessor
+	indexes   object.IndexAccessor
+	records   object.RecordBatchAccessor
+
+	now func() time.Time
+}
+
+// NewExporter creates new Exporter instance.
+func NewExporter(
+	cfg configuration.Exporter,
+	jetKeeper executor.JetKeeper,
+	pulses pulse.Accessor,
+	calc pulse.Calculator,
+	jets jet.Accessor,
+	drops drop.Accessor,
+	indexes object.IndexAccessor,
+	records object.RecordBatchAccessor,
+) *Exporter {
+	return &Exporter{
+		cfg:       cfg,
+		jetKeeper: jetKeeper,
+		pulses:    pulses,
+		calc:      calc,
+		jets:      jets,
+		drops:     drops,
+		indexes:   indexes,
+		records:   records,
+		now:       time.Now,
+	}
+}
+
+// Export returns a page of data starting from the cursor. If limit is zero, DefaultLimit is used. If the pulse is not
+// finalized yet or export lag is not passed, ErrNotReady is returned and the same cursor should be requested later.
+func (e *Exporter) Export(ctx context.Context, cursor Cursor, limit int) (*Page, error) {
+	if limit == 0 {
+		limit = DefaultLimit
+	}
+	if limit < 0 || limit > MaxLimit {
+		return nil, ErrBadLimit
+	}
+
+	pn := cursor.PulseNumber
+	if pn == 0 {
+		pn = insolar.GenesisPulse.PulseNumber
+	}
+
+	if pn > e.jetKeeper.TopSyncPulse() {
+		return nil, ErrNotReady
+	}
+	p, err := e.pulses.ForPulseNumber(ctx, pn)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to fetch pulse %s", pn)
+	}
+	lag := time.Duration(e.cfg.ExportLag) * time.Second
+	if e.now().Sub(time.Unix(0, p.PulseTimestamp)) < lag {
+		return nil, ErrNotReady
+	}
+
+	page := &Page{}
+	if cursor.RecordID == nil {
+		page.Pulse = &p
+		page.Indexes = e.indexes.ForPulse(ctx, pn)
+		page.Drops, err = e.dropsForPulse(ctx, pn)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	page.Records, err = e.records.BatchForPulse(ctx, pn, cursor.RecordID, limit)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to fetch records for pulse %s", pn)
+	}
+
+	if len(page.Records) == limit {
+		last := page.Records[len(page.Records)-1].ID
+		page.Next = Cursor{PulseNumber: pn, RecordID: &last}
+		return page, nil
+	}
+
+	// Pulse is exported completely, moving to the next one.
+	next, err := e.calc.Forwards(ctx, pn, 1)
+	if err == nil {
+		page.Next = Cursor{PulseNumber: next.PulseNumber}
+		return page, nil
+	}
+	if err != pulse.ErrNotFound {
+		return nil, errors.Wrapf(err, "failed to calculate next pulse for %s", pn)
+	}
+	page.Next = Cursor{PulseNumber: p.NextPulseNumber}
+
+	return page, nil
+}
+
+func (e *Exporter) dropsForPulse(ctx context.Context, pn insolar.PulseNumber) ([]drop.Drop, error) {
+	var drops []drop.Drop
+	for _, jetID := range e.jets.All(ctx, pn) {
+		d, err := e.drops.ForPulse(ctx, jetID, pn)
+		if err == store.ErrNotFound || err == drop.ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to fetch drop for jet %s", jetID.DebugString())
+		}
+		drops = append(drops, d)
+	}
+	return drops, nil
+}
@@ -0,0 +1,149 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package exporter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/ledger/drop"
+	"github.com/insolar/insolar/ledger/heavy/executor"
+	"github.com/insolar/insolar/ledger/object"
+)
+
+type exporterMocks struct {
+	jetKeeper *executor.JetKeeperMock
+	pulses    *pulse.AccessorMock
+	calc      *pulse.CalculatorMock
+	jets      *jet.AccessorMock
+	drops     *drop.AccessorMock
+	indexes   *object.IndexAccessorMock
+	records   *object.RecordBatchAccessorMock
+}
+
+func newTestExporter(t *testing.T, now time.Time) (*Exporter, *exporterMocks) {
+	m := &exporterMocks{
+		jetKeeper: executor.NewJetKeeperMock(t),
+		pulses:    pulse.NewAccessorMock(t),
+		calc:      pulse.NewCalculatorMock(t),
+		jets:      jet.NewAccessorMock(t),
+		drops:     drop.NewAccessorMock(t),
+		indexes:   object.NewIndexAccessorMock(t),
+		records:   object.NewRecordBatchAccessorMock(t),
+	}
+	e := NewExporter(
+		configuration.Exporter{ExportLag: 40},
+		m.jetKeeper, m.pulses, m.calc, m.jets, m.drops, m.indexes, m.records,
+	)
+	e.now = func() time.Time { return now }
+	return e, m
+}
+
+func TestExporter_Export(t *testing.T) {
+	ctx := context.Background()
+	pn := insolar.GenesisPulse.PulseNumber + 10
+	now := time.Now()
+	p := insolar.Pulse{
+		PulseNumber:     pn,
+		NextPulseNumber: pn + 10,
+		PulseTimestamp:  now.Add(-time.Minute).UnixNano(),
+	}
+
+	t.Run("not finalized pulse is not ready", func(t *testing.T) {
+		e, m := newTestExporter(t, now)
+		m.jetKeeper.TopSyncPulseMock.Return(pn - 1)
+
+		_, err := e.Export(ctx, Cursor{PulseNumber: pn}, 0)
+		assert.Equal(t, ErrNotReady, err)
+	})
+
+	t.Run("recent pulse is not ready", func(t *testing.T) {
+		e, m := newTestExporter(t, now)
+		m.jetKeeper.TopSyncPulseMock.Return(pn)
+		recent := p
+		recent.PulseTimestamp = now.Add(-time.Second).UnixNano()
+		m.pulses.ForPulseNumberMock.Expect(ctx, pn).Return(recent, nil)
+
+		_, err := e.Export(ctx, Cursor{PulseNumber: pn}, 0)
+		assert.Equal(t, ErrNotReady, err)
+	})
+
+	t.Run("bad limit", func(t *testing.T) {
+		e, _ := newTestExporter(t, now)
+
+		_, err := e.Export(ctx, Cursor{PulseNumber: pn}, MaxLimit+1)
+		assert.Equal(t, ErrBadLimit, err)
+	})
+
+	t.Run("first page contains pulse data", func(t *testing.T) {
+		e, m := newTestExporter(t, now)
+		m.jetKeeper.TopSyncPulseMock.Return(pn)
+		m.pulses.ForPulseNumberMock.Expect(ctx, pn).Return(p, nil)
+
+		indexes := []record.Index{{ObjID: *insolar.NewID(pn, []byte{1})}}
+		m.indexes.ForPulseMock.Expect(ctx, pn).Return(indexes)
+
+		jetID := gen.JetID()
+		m.jets.AllMock.Expect(ctx, pn).Return([]insolar.JetID{jetID})
+		d := drop.Drop{Pulse: pn, JetID: jetID}
+		m.drops.ForPulseMock.Expect(ctx, jetID, pn).Return(d, nil)
+
+		records := []object.IdentifiedRecord{
+			{ID: *insolar.NewID(pn, []byte{1})},
+			{ID: *insolar.NewID(pn, []byte{2})},
+		}
+		m.records.BatchForPulseMock.Expect(ctx, pn, nil, 2).Return(records, nil)
+
+		page, err := e.Export(ctx, Cursor{PulseNumber: pn}, 2)
+		require.NoError(t, err)
+		require.NotNil(t, page.Pulse)
+		assert.Equal(t, p, *page.Pulse)
+		assert.Equal(t, indexes, page.Indexes)
+		assert.Equal(t, []drop.Drop{d}, page.Drops)
+		assert.Equal(t, records, page.Records)
+		assert.Equal(t, Cursor{PulseNumber: pn, RecordID: &records[1].ID}, page.Next)
+	})
+
+	t.Run("last page moves to the next pulse", func(t *testing.T) {
+		e, m := newTestExporter(t, now)
+		m.jetKeeper.TopSyncPulseMock.Return(pn)
+		m.pulses.ForPulseNumberMock.Expect(ctx, pn).Return(p, nil)
+
+		after := insolar.NewID(pn, []byte{2})
+		records := []object.IdentifiedRecord{{ID: *insolar.NewID(pn, []byte{3})}}
+		m.records.BatchForPulseMock.Expect(ctx, pn, after, 2).Return(records, nil)
+		m.calc.ForwardsMock.Expect(ctx, pn, 1).Return(insolar.Pulse{PulseNumber: pn + 10}, nil)
+
+		page, err := e.Export(ctx, Cursor{PulseNumber: pn, RecordID: after}, 2)
+		require.NoError(t, err)
+		assert.Nil(t, page.Pulse)
+		assert.Nil(t, page.Drops)
+		assert.Nil(t, page.Indexes)
+		assert.Equal(t, records, page.Records)
+		assert.Equal(t, Cursor{PulseNumber: pn + 10}, page.Next)
+	})
+}
@@ -0,0 +1,145 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package exporter
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/utils"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/drop"
+)
+
+// ExportArgs is arguments that Export accepts.
+type ExportArgs struct {
+	PulseNumber insolar.PulseNumber `json:"pulseNumber"`
+	RecordID    string              `json:"recordID"`
+	Limit       int                 `json:"limit"`
+}
+
+// ExportedRecord is a record with its id.
+type ExportedRecord struct {
+	ID     string `json:"id"`
+	Record []byte `json:"record"`
+}
+
+// ExportReply is reply for Export requests.
+type ExportReply struct {
+	Pulse   *insolar.Pulse   `json:"pulse,omitempty"`
+	Drops   [][]byte         `json:"drops,omitempty"`
+	Indexes [][]byte         `json:"indexes,omitempty"`
+	Records []ExportedRecord `json:"records"`
+
+	NextPulseNumber insolar.PulseNumber `json:"nextPulseNumber"`
+	NextRecordID    string              `json:"nextRecordID,omitempty"`
+
+	TraceID string `json:"traceID"`
+}
+
+// Service is a service that provides API for exporting finalized pulses.
+type Service struct {
+	exporter *Exporter
+}
+
+// NewService creates new Export service instance.
+func NewService(exporter *Exporter) *Service {
+	return &Service{exporter: exporter}
+}
+
+// Export returns a page of finalized pulse data.
+//
+//   Request structure:
+//   {
+//     "jsonrpc": "2.0",
+//     "method": "exporter.export",
+//     "id": str|int|null
+//     "params": {
+//       "pulseNumber": int, // pulse to export, 0 means first pulse
+//       "recordID": str, // base58 id of the last received record of the pulse, empty for the first page
+//       "limit": int // max records in page, 0 means default
+//     }
+//   }
+//
+//     Response structure:
+// 	{
+// 		"jsonrpc": "2.0",
+// 		"result": {
+// 			"pulse": object, // pulse metadata, only on the first page of a pulse
+// 			"drops": [str], // base64 encoded jet drops, only on the first page of a pulse
+// 			"indexes": [str], // base64 encoded object indexes, only on the first page of a pulse
+// 			"records": [{"id": str, "record": str}], // base58 record id and base64 encoded record
+// 			"nextPulseNumber": int, // pulse to request next page with
+// 			"nextRecordID": str, // record id to request next page with
+// 			"traceID": str // traceID for request
+// 		},
+// 		"id": str|int|null // same as in request
+// 	}
+//
+func (s *Service) Export(r *http.Request, args *ExportArgs, reply *ExportReply) error {
+	traceID := utils.RandTraceID()
+	ctx, inslog := inslogger.WithTraceField(context.Background(), traceID)
+
+	inslog.Infof("[ Export ] Incoming request: %s", r.RequestURI)
+
+	cursor := Cursor{PulseNumber: args.PulseNumber}
+	if args.RecordID != "" {
+		id, err := insolar.NewIDFromBase58(args.RecordID)
+		if err != nil {
+			return errors.Wrap(err, "[ Export ] failed to parse record id")
+		}
+		cursor.RecordID = id
+	}
+
+	page, err := s.exporter.Export(ctx, cursor, args.Limit)
+	if err != nil {
+		if err != ErrNotReady {
+			inslog.Error(errors.Wrap(err, "[ Export ] failed to export"))
+		}
+		return err
+	}
+
+	reply.Pulse = page.Pulse
+	for _, d := range page.Drops {
+		reply.Drops = append(reply.Drops, drop.MustEncode(&d))
+	}
+	for _, idx := range page.Indexes {
+		buf, err := idx.Marshal()
+		if err != nil {
+			return errors.Wrap(err, "[ Export ] failed to marshal index")
+		}
+		reply.Indexes = append(reply.Indexes, buf)
+	}
+	reply.Records = make([]ExportedRecord, 0, len(page.Records))
+	for _, rec := range page.Records {
+		buf, err := rec.Record.Marshal()
+		if err != nil {
+			return errors.Wrap(err, "[ Export ] failed to marshal record")
+		}
+		reply.Records = append(reply.Records, ExportedRecord{ID: rec.ID.String(), Record: buf})
+	}
+	reply.NextPulseNumber = page.Next.PulseNumber
+	if page.Next.RecordID != nil {
+		reply.NextRecordID = page.Next.RecordID.String()
+	}
+	reply.TraceID = traceID
+
+	return nil
+}
@@ -98,13 +98,17 @@ func (p *Replication) Proceed(ctx context.Context) error {
 	if err != nil {
 		return errors.Wrap(err, "failed to store drop")
 	}
-	if dr.Split {
+	switch {
+	case dr.Split:
 		_, _, err = p.dep.jets.Split(ctx, futurePulse, dr.JetID)
-	} else {
+	case dr.Merge:
+		// both siblings send drops with merge flag, merge of already merged jet does nothing
+		_, err = p.dep.jets.Merge(ctx, futurePulse, dr.JetID)
+	default:
 		err = p.dep.jets.Update(ctx, futurePulse, false, dr.JetID)
 	}
 	if err != nil {
-		return errors.Wrapf(err, "failed to split/update jet=%v pulse=%v", dr.JetID.DebugString(), futurePulse)
+		return errors.Wrapf(err, "failed to split/merge/update jet=%v pulse=%v", dr.JetID.DebugString(), futurePulse)
 	}
 
 	if err := p.dep.keeper.Add(ctx, dr.Pulse, dr.JetID); err != nil {
@@ -71,6 +71,7 @@ type MessageHandler struct {
 	handlers       map[insolar.MessageType]insolar.MessageHandler
 
 	filamentModifier   *executor.FilamentModifierDefault
+	stateNotifier      *executor.StateNotifierDefault
 	FilamentCalculator *executor.FilamentCalculatorDefault
 }
 
@@ -147,6 +148,7 @@ func NewMessageHandler(
 				h.IndexStorage,
 				h.filamentModifier,
 				h.Sender,
+				h.stateNotifier,
 			)
 		},
 		DeactivateObject: func(p *proc.DeactivateObject) {
@@ -167,6 +169,7 @@ func NewMessageHandler(
 				h.IndexStorage,
 				h.filamentModifier,
 				h.Sender,
+				h.stateNotifier,
 			)
 		},
 		SendObject: func(p *proc.SendObject) {
@@ -212,6 +215,12 @@ func NewMessageHandler(
 				h.Sender,
 			)
 		},
+		GetResult: func(p *proc.GetResult) {
+			p.Dep(
+				h.FilamentCalculator,
+				h.Sender,
+			)
+		},
 		GetPendingRequests: func(p *proc.GetPendingRequests) {
 			p.Dep(h.IndexStorage, h.Sender)
 		},
@@ -275,6 +284,7 @@ func (h *MessageHandler) Init(ctx context.Context) error {
 		h.PulseCalculator,
 		h.Sender,
 	)
+	h.stateNotifier = executor.NewStateNotifier(h.Nodes, h.Sender)
 
 	return nil
 }
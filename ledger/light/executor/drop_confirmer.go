@@ -0,0 +1,73 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package executor
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/ledger/drop"
+)
+
+// DropConfirmer checks that jet drops stored on the node match hashes provided by heavy node.
+// Network requests it in JetlessNetworkState to verify completeness of stored data.
+type DropConfirmer struct {
+	drops drop.Accessor
+}
+
+// NewDropConfirmer creates new DropConfirmer instance.
+func NewDropConfirmer(drops drop.Accessor) *DropConfirmer {
+	return &DropConfirmer{
+		drops: drops,
+	}
+}
+
+// Confirm returns jets whose drops are stored with provided hashes. Drops which are not stored are skipped.
+func (c *DropConfirmer) Confirm(ctx context.Context, hashes jet.DropHashes) (jet.DropsConfirmation, error) {
+	var res jet.DropsConfirmation
+	for _, h := range hashes.Hashes {
+		dr, err := c.drops.ForPulse(ctx, h.JetID, hashes.Pulse)
+		if err == drop.ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return jet.DropsConfirmation{}, errors.Wrapf(err, "failed to get drop for jet %s", h.JetID.DebugString())
+		}
+		if bytes.Equal(dr.Hash, h.Hash) {
+			res.Confirmed = append(res.Confirmed, h.JetID)
+		}
+	}
+	return res, nil
+}
+
+// Procedure is a remote procedure for jet.ConfirmDropsProcedure.
+func (c *DropConfirmer) Procedure(ctx context.Context, args []byte) ([]byte, error) {
+	var hashes jet.DropHashes
+	err := insolar.Deserialize(args, &hashes)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to deserialize drop hashes")
+	}
+	confirmation, err := c.Confirm(ctx, hashes)
+	if err != nil {
+		return nil, err
+	}
+	return insolar.Serialize(confirmation)
+}
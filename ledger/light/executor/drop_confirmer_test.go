@@ -0,0 +1,100 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package executor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/drop"
+)
+
+func TestDropConfirmer_Confirm(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	pn := gen.PulseNumber()
+	matched := jet.NewIDFromString("00")
+	mismatched := jet.NewIDFromString("01")
+	missing := jet.NewIDFromString("1")
+
+	drops := drop.NewAccessorMock(t)
+	drops.ForPulseFunc = func(_ context.Context, jetID insolar.JetID, p insolar.PulseNumber) (drop.Drop, error) {
+		require.Equal(t, pn, p)
+		switch jetID {
+		case matched:
+			return drop.Drop{Hash: []byte{1}}, nil
+		case mismatched:
+			return drop.Drop{Hash: []byte{2}}, nil
+		default:
+			return drop.Drop{}, drop.ErrNotFound
+		}
+	}
+
+	confirmation, err := NewDropConfirmer(drops).Confirm(ctx, jet.DropHashes{
+		Pulse: pn,
+		Hashes: []jet.DropHash{
+			{JetID: matched, Hash: []byte{1}},
+			{JetID: mismatched, Hash: []byte{1}},
+			{JetID: missing, Hash: []byte{1}},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []insolar.JetID{matched}, confirmation.Confirmed)
+}
+
+func TestDropConfirmer_Procedure(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	jetID := gen.JetID()
+
+	t.Run("confirmed", func(t *testing.T) {
+		drops := drop.NewAccessorMock(t)
+		drops.ForPulseMock.Return(drop.Drop{Hash: []byte{1}}, nil)
+
+		args, err := insolar.Serialize(jet.DropHashes{
+			Pulse:  gen.PulseNumber(),
+			Hashes: []jet.DropHash{{JetID: jetID, Hash: []byte{1}}},
+		})
+		require.NoError(t, err)
+
+		res, err := NewDropConfirmer(drops).Procedure(ctx, args)
+		require.NoError(t, err)
+
+		var confirmation jet.DropsConfirmation
+		err = insolar.Deserialize(res, &confirmation)
+		require.NoError(t, err)
+		require.Equal(t, []insolar.JetID{jetID}, confirmation.Confirmed)
+	})
+
+	t.Run("storage error", func(t *testing.T) {
+		drops := drop.NewAccessorMock(t)
+		drops.ForPulseMock.Return(drop.Drop{}, errors.New("test"))
+
+		args, err := insolar.Serialize(jet.DropHashes{
+			Hashes: []jet.DropHash{{JetID: jetID, Hash: []byte{1}}},
+		})
+		require.NoError(t, err)
+
+		_, err = NewDropConfirmer(drops).Procedure(ctx, args)
+		require.Error(t, err)
+	})
+}
@@ -45,6 +45,11 @@ type FilamentCalculatorMock struct {
 	ResultDuplicateCounter    uint64
 	ResultDuplicatePreCounter uint64
 	ResultDuplicateMock       mFilamentCalculatorMockResultDuplicate
+
+	ResultForRequestFunc       func(p context.Context, p1 insolar.PulseNumber, p2 insolar.ID, p3 insolar.ID) (r *record.CompositeFilamentRecord, r1 error)
+	ResultForRequestCounter    uint64
+	ResultForRequestPreCounter uint64
+	ResultForRequestMock       mFilamentCalculatorMockResultForRequest
 }
 
 //NewFilamentCalculatorMock returns a mock for github.com/insolar/insolar/ledger/light/executor.FilamentCalculator
@@ -60,6 +65,7 @@ func NewFilamentCalculatorMock(t minimock.Tester) *FilamentCalculatorMock {
 	m.RequestDuplicateMock = mFilamentCalculatorMockRequestDuplicate{mock: m}
 	m.RequestsMock = mFilamentCalculatorMockRequests{mock: m}
 	m.ResultDuplicateMock = mFilamentCalculatorMockResultDuplicate{mock: m}
+	m.ResultForRequestMock = mFilamentCalculatorMockResultForRequest{mock: m}
 
 	return m
 }
@@ -834,6 +840,159 @@ func (m *FilamentCalculatorMock) ResultDuplicateFinished() bool {
 	return true
 }
 
+type mFilamentCalculatorMockResultForRequest struct {
+	mock              *FilamentCalculatorMock
+	mainExpectation   *FilamentCalculatorMockResultForRequestExpectation
+	expectationSeries []*FilamentCalculatorMockResultForRequestExpectation
+}
+
+type FilamentCalculatorMockResultForRequestExpectation struct {
+	input  *FilamentCalculatorMockResultForRequestInput
+	result *FilamentCalculatorMockResultForRequestResult
+}
+
+type FilamentCalculatorMockResultForRequestInput struct {
+	p  context.Context
+	p1 insolar.PulseNumber
+	p2 insolar.ID
+	p3 insolar.ID
+}
+
+type FilamentCalculatorMockResultForRequestResult struct {
+	r  *record.CompositeFilamentRecord
+	r1 error
+}
+
+//Expect specifies that invocation of FilamentCalculator.ResultForRequest is expected from 1 to Infinity times
+func (m *mFilamentCalculatorMockResultForRequest) Expect(p context.Context, p1 insolar.PulseNumber, p2 insolar.ID, p3 insolar.ID) *mFilamentCalculatorMockResultForRequest {
+	m.mock.ResultForRequestFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &FilamentCalculatorMockResultForRequestExpectation{}
+	}
+	m.mainExpectation.input = &FilamentCalculatorMockResultForRequestInput{p, p1, p2, p3}
+	return m
+}
+
+//Return specifies results of invocation of FilamentCalculator.ResultForRequest
+func (m *mFilamentCalculatorMockResultForRequest) Return(r *record.CompositeFilamentRecord, r1 error) *FilamentCalculatorMock {
+	m.mock.ResultForRequestFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &FilamentCalculatorMockResultForRequestExpectation{}
+	}
+	m.mainExpectation.result = &FilamentCalculatorMockResultForRequestResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of FilamentCalculator.ResultForRequest is expected once
+func (m *mFilamentCalculatorMockResultForRequest) ExpectOnce(p context.Context, p1 insolar.PulseNumber, p2 insolar.ID, p3 insolar.ID) *FilamentCalculatorMockResultForRequestExpectation {
+	m.mock.ResultForRequestFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &FilamentCalculatorMockResultForRequestExpectation{}
+	expectation.input = &FilamentCalculatorMockResultForRequestInput{p, p1, p2, p3}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *FilamentCalculatorMockResultForRequestExpectation) Return(r *record.CompositeFilamentRecord, r1 error) {
+	e.result = &FilamentCalculatorMockResultForRequestResult{r, r1}
+}
+
+//Set uses given function f as a mock of FilamentCalculator.ResultForRequest method
+func (m *mFilamentCalculatorMockResultForRequest) Set(f func(p context.Context, p1 insolar.PulseNumber, p2 insolar.ID, p3 insolar.ID) (r *record.CompositeFilamentRecord, r1 error)) *FilamentCalculatorMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.ResultForRequestFunc = f
+	return m.mock
+}
+
+//ResultForRequest implements github.com/insolar/insolar/ledger/light/executor.FilamentCalculator interface
+func (m *FilamentCalculatorMock) ResultForRequest(p context.Context, p1 insolar.PulseNumber, p2 insolar.ID, p3 insolar.ID) (r *record.CompositeFilamentRecord, r1 error) {
+	counter := atomic.AddUint64(&m.ResultForRequestPreCounter, 1)
+	defer atomic.AddUint64(&m.ResultForRequestCounter, 1)
+
+	if len(m.ResultForRequestMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.ResultForRequestMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to FilamentCalculatorMock.ResultForRequest. %v %v %v %v", p, p1, p2, p3)
+			return
+		}
+
+		input := m.ResultForRequestMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, FilamentCalculatorMockResultForRequestInput{p, p1, p2, p3}, "FilamentCalculator.ResultForRequest got unexpected parameters")
+
+		result := m.ResultForRequestMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the FilamentCalculatorMock.ResultForRequest")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.ResultForRequestMock.mainExpectation != nil {
+
+		input := m.ResultForRequestMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, FilamentCalculatorMockResultForRequestInput{p, p1, p2, p3}, "FilamentCalculator.ResultForRequest got unexpected parameters")
+		}
+
+		result := m.ResultForRequestMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the FilamentCalculatorMock.ResultForRequest")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.ResultForRequestFunc == nil {
+		m.t.Fatalf("Unexpected call to FilamentCalculatorMock.ResultForRequest. %v %v %v %v", p, p1, p2, p3)
+		return
+	}
+
+	return m.ResultForRequestFunc(p, p1, p2, p3)
+}
+
+//ResultForRequestMinimockCounter returns a count of FilamentCalculatorMock.ResultForRequestFunc invocations
+func (m *FilamentCalculatorMock) ResultForRequestMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.ResultForRequestCounter)
+}
+
+//ResultForRequestMinimockPreCounter returns the value of FilamentCalculatorMock.ResultForRequest invocations
+func (m *FilamentCalculatorMock) ResultForRequestMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.ResultForRequestPreCounter)
+}
+
+//ResultForRequestFinished returns true if mock invocations count is ok
+func (m *FilamentCalculatorMock) ResultForRequestFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.ResultForRequestMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.ResultForRequestCounter) == uint64(len(m.ResultForRequestMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.ResultForRequestMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.ResultForRequestCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.ResultForRequestFunc != nil {
+		return atomic.LoadUint64(&m.ResultForRequestCounter) > 0
+	}
+
+	return true
+}
+
 //ValidateCallCounters checks that all mocked methods of the interface have been called at least once
 //Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
 func (m *FilamentCalculatorMock) ValidateCallCounters() {
@@ -857,6 +1016,9 @@ func (m *FilamentCalculatorMock) ValidateCallCounters() {
 	if !m.ResultDuplicateFinished() {
 		m.t.Fatal("Expected call to FilamentCalculatorMock.ResultDuplicate")
 	}
+	if !m.ResultForRequestFinished() {
+		m.t.Fatal("Expected call to FilamentCalculatorMock.ResultForRequest")
+	}
 
 }
 
@@ -894,6 +1056,9 @@ func (m *FilamentCalculatorMock) MinimockFinish() {
 	if !m.ResultDuplicateFinished() {
 		m.t.Fatal("Expected call to FilamentCalculatorMock.ResultDuplicate")
 	}
+	if !m.ResultForRequestFinished() {
+		m.t.Fatal("Expected call to FilamentCalculatorMock.ResultForRequest")
+	}
 
 }
 
@@ -914,6 +1079,7 @@ func (m *FilamentCalculatorMock) MinimockWait(timeout time.Duration) {
 		ok = ok && m.RequestDuplicateFinished()
 		ok = ok && m.RequestsFinished()
 		ok = ok && m.ResultDuplicateFinished()
+		ok = ok && m.ResultForRequestFinished()
 
 		if ok {
 			return
@@ -941,6 +1107,9 @@ func (m *FilamentCalculatorMock) MinimockWait(timeout time.Duration) {
 			if !m.ResultDuplicateFinished() {
 				m.t.Error("Expected call to FilamentCalculatorMock.ResultDuplicate")
 			}
+			if !m.ResultForRequestFinished() {
+				m.t.Error("Expected call to FilamentCalculatorMock.ResultForRequest")
+			}
 
 			m.t.Fatalf("Some mocks were not called on time: %s", timeout)
 			return
@@ -973,6 +1142,9 @@ func (m *FilamentCalculatorMock) AllMocksCalled() bool {
 	if !m.ResultDuplicateFinished() {
 		return false
 	}
+	if !m.ResultForRequestFinished() {
+		return false
+	}
 
 	return true
 }
@@ -70,6 +70,9 @@ type FilamentCalculator interface {
 	ResultDuplicate(ctx context.Context, startFrom insolar.PulseNumber, objectID, resultID insolar.ID, result record.Result) (foundResult *record.CompositeFilamentRecord, err error)
 
 	FindRecord(ctx context.Context, startFrom insolar.ID, objectID, recordID insolar.ID) (record.CompositeFilamentRecord, error)
+
+	// ResultForRequest goes to network. It returns nil result if the request is still pending.
+	ResultForRequest(ctx context.Context, startFrom insolar.PulseNumber, objectID, requestID insolar.ID) (*record.CompositeFilamentRecord, error)
 }
 
 //go:generate minimock -i github.com/insolar/insolar/ledger/light/executor.FilamentCleaner -o ./ -s _mock.go
@@ -681,6 +684,54 @@ func (c *FilamentCalculatorDefault) FindRecord(ctx context.Context, startFrom in
 	return record.CompositeFilamentRecord{}, ErrRecordNotFound
 }
 
+func (c *FilamentCalculatorDefault) ResultForRequest(
+	ctx context.Context, startFrom insolar.PulseNumber, objectID, requestID insolar.ID,
+) (*record.CompositeFilamentRecord, error) {
+	idx, err := c.indexes.ForID(ctx, startFrom, objectID)
+	if err != nil {
+		return nil, err
+	}
+	if idx.Lifeline.PendingPointer == nil {
+		return nil, ErrRecordNotFound
+	}
+
+	cache := c.cache.Get(objectID)
+	cache.Lock()
+	defer cache.Unlock()
+
+	iter := newFetchingIterator(
+		ctx,
+		cache,
+		objectID,
+		*idx.Lifeline.PendingPointer,
+		requestID.Pulse(),
+		c.jetFetcher,
+		c.coordinator,
+		c.sender,
+	)
+
+	for iter.HasPrev() {
+		rec, err := iter.Prev(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to calculate pending")
+		}
+
+		// Result goes before request on iteration, so request without found result is still pending.
+		if bytes.Equal(rec.RecordID.Hash(), requestID.Hash()) {
+			return nil, nil
+		}
+
+		virtual := record.Unwrap(rec.Record.Virtual)
+		if r, ok := virtual.(*record.Result); ok {
+			if bytes.Equal(r.Request.Record().Hash(), requestID.Hash()) {
+				return &rec, nil
+			}
+		}
+	}
+
+	return nil, ErrRecordNotFound
+}
+
 func (c *FilamentCalculatorDefault) Clear(objID insolar.ID) {
 	c.cache.Delete(objID)
 }
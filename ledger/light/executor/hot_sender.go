@@ -170,17 +170,26 @@ func (m *HotSenderDefault) sendForJet(
 
 // findDrop try to get drop for provided jet and if not found tries
 // to find Parent's jet (if jet have been split and we have no previous drop for it by this reason)
+// or left child's jet (if jet have been merged and we have only drops of its children).
 func (m *HotSenderDefault) findDrop(
 	ctx context.Context, pn insolar.PulseNumber, jetID insolar.JetID,
 ) (drop.Drop, error) {
 	block, err := m.dropAccessor.ForPulse(ctx, jetID, pn)
+	if err != drop.ErrNotFound {
+		return block, err
+	}
+
+	// try to get parent's drop
+	block, err = m.dropAccessor.ForPulse(ctx, jet.Parent(jetID), pn)
+	if err != drop.ErrNotFound {
+		return block, err
+	}
+
+	// try to get child's drop, counters of merged jet start over, so any child's drop fits
+	left, _ := jet.Siblings(jetID)
+	block, err = m.dropAccessor.ForPulse(ctx, left, pn)
 	if err == drop.ErrNotFound {
-		jetID = jet.Parent(jetID)
-		// try to get parent's drop
-		block, err = m.dropAccessor.ForPulse(ctx, jetID, pn)
-		if err == drop.ErrNotFound {
-			err = errors.Wrap(err, "drop for parent jet not found too")
-		}
+		err = errors.Wrap(err, "drop for parent and child jets not found too")
 	}
 	return block, err
 }
@@ -0,0 +1,107 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package executor_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/gojuno/minimock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/bus"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/payload"
+	"github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/drop"
+	"github.com/insolar/insolar/ledger/light/executor"
+	"github.com/insolar/insolar/ledger/object"
+)
+
+func TestHotSenderDefault_SendHot_MergedJet(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	var (
+		endedPulse = insolar.PulseNumber(insolar.FirstPulseNumber + 10)
+		newPulse   = endedPulse + 10
+		merged     = jet.NewIDFromString("10")
+		objID      = gen.ID()
+	)
+	left, right := jet.Siblings(merged)
+
+	// both children have drops in ended pulse, merged jet has none
+	drops := drop.NewStorageMemory()
+	for _, jetID := range []insolar.JetID{left, right} {
+		err := drops.Set(ctx, drop.Drop{Pulse: endedPulse, JetID: jetID, Merge: true})
+		require.NoError(t, err)
+	}
+
+	indexes := object.NewIndexStorageMemory()
+	err := indexes.SetIndex(ctx, endedPulse, record.Index{ObjID: objID, LifelineLastUsed: endedPulse})
+	require.NoError(t, err)
+
+	calc := pulse.NewCalculatorMock(mc)
+	calc.BackwardsMock.Return(insolar.Pulse{}, pulse.ErrNotFound)
+
+	jets := jet.NewAccessorMock(mc)
+	jets.ForIDFunc = func(_ context.Context, pn insolar.PulseNumber, id insolar.ID) (insolar.JetID, bool) {
+		require.Equal(t, newPulse, pn)
+		require.Equal(t, objID, id)
+		return merged, true
+	}
+
+	sent := make(chan *payload.HotObjects, 1)
+	sender := bus.NewSenderMock(mc)
+	sender.SendRoleFunc = func(
+		_ context.Context, msg *message.Message, role insolar.DynamicRole, ref insolar.Reference,
+	) (<-chan *message.Message, func()) {
+		require.Equal(t, insolar.DynamicRoleLightExecutor, role)
+		require.Equal(t, *insolar.NewReference(insolar.ID(merged)), ref)
+
+		pl, err := payload.Unmarshal(msg.Payload)
+		require.NoError(t, err)
+		sent <- pl.(*payload.HotObjects)
+		return nil, func() {}
+	}
+
+	hs := executor.NewHotSender(drops, indexes, calc, jets, 5, sender)
+	err = hs.SendHot(ctx, endedPulse, newPulse, []insolar.JetID{merged})
+	require.NoError(t, err)
+
+	select {
+	case hot := <-sent:
+		require.Equal(t, merged, hot.JetID)
+		require.Equal(t, newPulse, hot.Pulse)
+		require.Len(t, hot.Indexes, 1)
+		require.Equal(t, objID, hot.Indexes[0].ObjID)
+
+		block, err := drop.Decode(hot.Drop)
+		require.NoError(t, err)
+		require.Equal(t, left, block.JetID)
+		require.Equal(t, endedPulse, block.Pulse)
+	case <-time.After(5 * time.Second):
+		t.Fatal("hot objects are not sent for merged jet")
+	}
+}
@@ -30,9 +30,9 @@ import (
 	"github.com/pkg/errors"
 )
 
-// JetSplitter provides method for processing and splitting jets.
+// JetSplitter provides method for processing, splitting and merging jets.
 type JetSplitter interface {
-	// Do performs jets processing, it decides which jets to split and returns list of resulting jets).
+	// Do performs jets processing, it decides which jets to split or merge and returns list of resulting jets).
 	Do(ctx context.Context, ended, new insolar.PulseNumber) ([]insolar.JetID, error)
 }
 
@@ -82,7 +82,7 @@ func NewJetSplitter(
 	}
 }
 
-// Do performs jets processing, it decides which jets to split and returns list of resulting jets.
+// Do performs jets processing, it decides which jets to split or merge and returns list of resulting jets.
 func (js *JetSplitterDefault) Do(
 	ctx context.Context,
 	endedPulse, newPulse insolar.PulseNumber,
@@ -99,15 +99,44 @@ func (js *JetSplitterDefault) Do(
 	}
 
 	all := js.jetCalculator.MineForPulse(ctx, endedPulse)
+	drops := make(map[insolar.JetID]*drop.Drop, len(all))
+	for _, jetID := range all {
+		block := js.newDrop(ctx, jetID, endedPulse)
+		drops[jetID] = &block
+	}
+	js.markMerges(drops)
+
 	result := make([]insolar.JetID, 0, len(all)*2)
+	merged := make(map[insolar.JetID]struct{})
 	for _, jetID := range all {
-		exceed, err := js.createDrop(ctx, jetID, endedPulse)
-		if err != nil {
+		block := drops[jetID]
+		if err := js.dropModifier.Set(ctx, *block); err != nil {
 			return nil, errors.Wrapf(err, "failed create drop for pulse=%v, jet=%v",
 				endedPulse, jetID.DebugString())
 		}
 
-		if !exceed {
+		if block.Merge {
+			parentID := jet.Parent(jetID)
+			if _, ok := merged[parentID]; ok {
+				// sibling is already merged
+				continue
+			}
+			merged[parentID] = struct{}{}
+
+			// merge jet with its sibling for new pulse
+			parentID, err := js.jetModifier.Merge(ctx, newPulse, jetID)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to merge jet tree")
+			}
+			result = append(result, parentID)
+
+			inslog.WithFields(map[string]interface{}{
+				"jet": parentID.DebugString(),
+			}).Info("jet merge performed")
+			continue
+		}
+
+		if !block.Split {
 			// no split, just mark jet as actual for new pulse
 			if err := js.jetModifier.Update(ctx, newPulse, true, jetID); err != nil {
 				panic("failed to update jets on LM-node: " + err.Error())
@@ -131,51 +160,92 @@ func (js *JetSplitterDefault) Do(
 	return result, nil
 }
 
-func (js *JetSplitterDefault) createDrop(
+func (js *JetSplitterDefault) newDrop(
 	ctx context.Context,
 	jetID insolar.JetID,
 	pn insolar.PulseNumber,
-) (bool, error) {
+) drop.Drop {
 	block := drop.Drop{
 		Pulse: pn,
 		JetID: jetID,
 	}
 
+	prev := js.getPreviousDrop(ctx, jetID, pn)
+	recordsCount := len(js.recordsAccessor.ForPulse(ctx, jetID, pn))
+	// jet is quiet if merged jet wouldn't exceed split threshold
+	if recordsCount <= js.cfg.ThresholdRecordsCount/2 {
+		block.QuietCount = prev.QuietCount + 1
+	}
+
 	// skip any thresholds calculation for split if jet depth for jetID reached limit.
 	if jetID.Depth() >= js.cfg.DepthLimit {
-		return false, js.dropModifier.Set(ctx, block)
+		return block
 	}
 
-	threshold := js.getPreviousDropThreshold(ctx, jetID, pn)
+	threshold := prev.SplitThresholdExceeded
 	// reset threshold counter, if split is happened
 	if threshold > js.cfg.ThresholdOverflowCount {
 		threshold = 0
 	}
 	// if records count reached threshold increase counter (instead it reset)
-	recordsCount := len(js.recordsAccessor.ForPulse(ctx, jetID, pn))
 	if recordsCount > js.cfg.ThresholdRecordsCount {
 		block.SplitThresholdExceeded = threshold + 1
 	}
-	// first return value is split needed
+	// split is needed
 	if block.SplitThresholdExceeded > js.cfg.ThresholdOverflowCount {
 		block.Split = true
 	}
-	return block.Split, js.dropModifier.Set(ctx, block)
+	return block
+}
+
+// markMerges marks drops of sibling jets which were quiet long enough to be merged.
+//
+// Light nodes don't exchange drops, so the decision is made only for siblings which both
+// belong to this node in the ended pulse. Siblings executed by different light nodes are
+// never merged, even if both are quiet: each node sees only its own drop and merging a jet
+// alone would leave the tree inconsistent. Such siblings still get merged later, in a pulse
+// when jet coordinator assigns both of them to the same node.
+func (js *JetSplitterDefault) markMerges(drops map[insolar.JetID]*drop.Drop) {
+	if js.cfg.MergeQuietCount <= 0 {
+		return
+	}
+
+	for jetID := range drops {
+		if jetID.Depth() == 0 {
+			continue
+		}
+		left, right := jet.Siblings(jet.Parent(jetID))
+		leftDrop, ok := drops[left]
+		if !ok {
+			continue
+		}
+		rightDrop, ok := drops[right]
+		if !ok {
+			continue
+		}
+		if leftDrop.Split || rightDrop.Split {
+			continue
+		}
+		if leftDrop.QuietCount >= js.cfg.MergeQuietCount && rightDrop.QuietCount >= js.cfg.MergeQuietCount {
+			leftDrop.Merge = true
+			rightDrop.Merge = true
+		}
+	}
 }
 
-func (js *JetSplitterDefault) getPreviousDropThreshold(
+func (js *JetSplitterDefault) getPreviousDrop(
 	ctx context.Context,
 	jetID insolar.JetID,
 	pn insolar.PulseNumber,
-) int {
+) drop.Drop {
 	prevPulse, err := js.pulseCalculator.Backwards(ctx, pn, 1)
 	if err != nil {
 		if err == pulse.ErrNotFound {
-			return 0
+			return drop.Drop{}
 		}
 		panic("failed to fetch previous pulse")
 	}
-	return js.getDropThreshold(ctx, jetID, prevPulse.PulseNumber)
+	return js.getDrop(ctx, jetID, prevPulse.PulseNumber)
 }
 
 func (js *JetSplitterDefault) getDropThreshold(
@@ -183,16 +253,24 @@ func (js *JetSplitterDefault) getDropThreshold(
 	jetID insolar.JetID,
 	pn insolar.PulseNumber,
 ) int {
+	return js.getDrop(ctx, jetID, pn).SplitThresholdExceeded
+}
+
+func (js *JetSplitterDefault) getDrop(
+	ctx context.Context,
+	jetID insolar.JetID,
+	pn insolar.PulseNumber,
+) drop.Drop {
 	block, err := js.dropAccessor.ForPulse(ctx, jetID, pn)
 	if err != nil {
 		if err == drop.ErrNotFound {
 			// it could happen in two cases:
 			// 1) Previous drop does not exist for first pulse after (re)start.
-			// 2) Previous drop was split in the previous pulse, hence has different jet.
-			//    Returning 0 because we starting from 0 after split.
-			return 0
+			// 2) Previous drop was split or merged in the previous pulse, hence has different jet.
+			//    Returning empty drop because we starting counters from 0 after split or merge.
+			return drop.Drop{}
 		}
 		panic(errors.Wrapf(err, "failed to get drop for pulse=%v and jetID=%v", pn, jetID.DebugString()))
 	}
-	return block.SplitThresholdExceeded
+	return block
 }
@@ -258,3 +258,102 @@ func jsort(jets []insolar.JetID) []string {
 	}
 	return result
 }
+
+func TestJetSplitter_Merge(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+
+	jetStore := jet.NewStore()
+	db := drop.NewStorageMemory()
+	jetCalc := NewJetCalculatorMock(t)
+	collectionAccessor := object.NewRecordCollectionAccessorMock(t)
+	pulseCalc := pulse.NewCalculatorMock(t)
+
+	splitter := NewJetSplitter(
+		configuration.JetSplit{
+			ThresholdRecordsCount:  4,
+			ThresholdOverflowCount: 0,
+			DepthLimit:             defaultDepthLimit,
+			MergeQuietCount:        2,
+		},
+		jetCalc, jetStore, jetStore,
+		db, db,
+		pulseCalc, collectionAccessor,
+	)
+
+	jetCalc.MineForPulseFunc = func(ctx context.Context, pn insolar.PulseNumber) []insolar.JetID {
+		return jetStore.All(ctx, pn)
+	}
+	// two records are quiet enough for merge
+	collectionAccessor.ForPulseMock.Return(make([]record.Material, 2))
+
+	var initialPulse insolar.PulseNumber = 60000
+	err := jetStore.Update(ctx, initialPulse, true, jet0, jet10, jet11)
+	require.NoError(t, err)
+
+	jet1 := jet.NewIDFromString("1")
+	expected := [][]insolar.JetID{
+		{jet0, jet10, jet11},
+		// jet0 sibling is not a leaf, so it's not merged
+		{jet0, jet1},
+		// merged jet starts counting from scratch
+		{jet0, jet1},
+		{insolar.ZeroJetID},
+	}
+
+	for i, expectJets := range expected {
+		previous := initialPulse + insolar.PulseNumber(i) - 1
+		ended := previous + 1
+		newpulse := ended + 1
+		pulseCalc.BackwardsMock.Return(insolar.Pulse{PulseNumber: previous}, nil)
+
+		gotJets, err := splitter.Do(ctx, ended, newpulse)
+		require.NoError(t, err)
+		require.Equal(t, jsort(expectJets), jsort(gotJets), "jets on +%v pulse", i)
+		require.Equal(t, jsort(expectJets), jsort(jetStore.All(ctx, newpulse)), "jet tree on +%v pulse", i)
+	}
+
+	block, err := db.ForPulse(ctx, jet10, initialPulse+1)
+	require.NoError(t, err)
+	require.True(t, block.Merge)
+	require.Equal(t, 2, block.QuietCount)
+}
+
+func TestJetSplitter_Merge_SiblingOnOtherNode(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+
+	jetStore := jet.NewStore()
+	db := drop.NewStorageMemory()
+	jetCalc := NewJetCalculatorMock(t)
+	collectionAccessor := object.NewRecordCollectionAccessorMock(t)
+	pulseCalc := pulse.NewCalculatorMock(t)
+
+	splitter := NewJetSplitter(
+		configuration.JetSplit{
+			ThresholdRecordsCount:  4,
+			ThresholdOverflowCount: 0,
+			DepthLimit:             defaultDepthLimit,
+			MergeQuietCount:        1,
+		},
+		jetCalc, jetStore, jetStore,
+		db, db,
+		pulseCalc, collectionAccessor,
+	)
+
+	// jet11 is executed by another light node
+	jetCalc.MineForPulseMock.Return([]insolar.JetID{jet10})
+	collectionAccessor.ForPulseMock.Return(nil)
+
+	var previous insolar.PulseNumber = 60000
+	err := jetStore.Update(ctx, previous+1, true, jet0, jet10, jet11)
+	require.NoError(t, err)
+	pulseCalc.BackwardsMock.Return(insolar.Pulse{PulseNumber: previous}, nil)
+
+	gotJets, err := splitter.Do(ctx, previous+1, previous+2)
+	require.NoError(t, err)
+	require.Equal(t, jsort([]insolar.JetID{jet10}), jsort(gotJets))
+
+	block, err := db.ForPulse(ctx, jet10, previous+1)
+	require.NoError(t, err)
+	require.False(t, block.Merge)
+	require.Equal(t, 1, block.QuietCount)
+}
@@ -0,0 +1,74 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package executor
+
+import (
+	"context"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/bus"
+	"github.com/insolar/insolar/insolar/node"
+	"github.com/insolar/insolar/insolar/payload"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/pkg/errors"
+)
+
+//go:generate minimock -i github.com/insolar/insolar/ledger/light/executor.StateNotifier -o ./ -s _mock.go
+
+// StateNotifier informs virtual nodes about saved states of objects, so they could deliver them to API subscribers.
+type StateNotifier interface {
+	NotifyObjectState(ctx context.Context, pn insolar.PulseNumber, object, state insolar.ID)
+}
+
+// StateNotifierDefault implements StateNotifier.
+type StateNotifierDefault struct {
+	nodes  node.Accessor
+	sender bus.Sender
+}
+
+// NewStateNotifier returns a new instance of a default StateNotifier implementation.
+func NewStateNotifier(nodes node.Accessor, sender bus.Sender) *StateNotifierDefault {
+	return &StateNotifierDefault{
+		nodes:  nodes,
+		sender: sender,
+	}
+}
+
+// NotifyObjectState sends notification to every virtual node of provided pulse. Notifications don't expect replies
+// and failures are only logged, because they must not affect saving of the state.
+func (n *StateNotifierDefault) NotifyObjectState(ctx context.Context, pn insolar.PulseNumber, object, state insolar.ID) {
+	logger := inslogger.FromContext(ctx)
+
+	virtuals, err := n.nodes.InRole(pn, insolar.StaticRoleVirtual)
+	if err != nil {
+		logger.Error(errors.Wrap(err, "failed to fetch virtual nodes"))
+		return
+	}
+
+	for _, v := range virtuals {
+		msg, err := payload.NewMessage(&payload.ObjectStateNotification{
+			ObjectID: object,
+			StateID:  state,
+		})
+		if err != nil {
+			logger.Error(errors.Wrap(err, "failed to create notification"))
+			return
+		}
+		_, done := n.sender.SendTarget(ctx, msg, v.ID)
+		done()
+	}
+}
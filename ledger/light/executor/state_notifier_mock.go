@@ -0,0 +1,241 @@
+package executor
+
+/*
+DO NOT EDIT!
+This code was generated automatically using github.com/gojuno/minimock v1.9
+The original interface "StateNotifier" can be found in github.com/insolar/insolar/ledger/light/executor
+*/
+import (
+	context "context"
+	"sync/atomic"
+	"time"
+
+	"github.com/gojuno/minimock"
+	insolar "github.com/insolar/insolar/insolar"
+
+	testify_assert "github.com/stretchr/testify/assert"
+)
+
+//StateNotifierMock implements github.com/insolar/insolar/ledger/light/executor.StateNotifier
+type StateNotifierMock struct {
+	t minimock.Tester
+
+	NotifyObjectStateFunc       func(p context.Context, p1 insolar.PulseNumber, p2 insolar.ID, p3 insolar.ID)
+	NotifyObjectStateCounter    uint64
+	NotifyObjectStatePreCounter uint64
+	NotifyObjectStateMock       mStateNotifierMockNotifyObjectState
+}
+
+//NewStateNotifierMock returns a mock for github.com/insolar/insolar/ledger/light/executor.StateNotifier
+func NewStateNotifierMock(t minimock.Tester) *StateNotifierMock {
+	m := &StateNotifierMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.NotifyObjectStateMock = mStateNotifierMockNotifyObjectState{mock: m}
+
+	return m
+}
+
+type mStateNotifierMockNotifyObjectState struct {
+	mock              *StateNotifierMock
+	mainExpectation   *StateNotifierMockNotifyObjectStateExpectation
+	expectationSeries []*StateNotifierMockNotifyObjectStateExpectation
+}
+
+type StateNotifierMockNotifyObjectStateExpectation struct {
+	input *StateNotifierMockNotifyObjectStateInput
+}
+
+type StateNotifierMockNotifyObjectStateInput struct {
+	p  context.Context
+	p1 insolar.PulseNumber
+	p2 insolar.ID
+	p3 insolar.ID
+}
+
+//Expect specifies that invocation of StateNotifier.NotifyObjectState is expected from 1 to Infinity times
+func (m *mStateNotifierMockNotifyObjectState) Expect(p context.Context, p1 insolar.PulseNumber, p2 insolar.ID, p3 insolar.ID) *mStateNotifierMockNotifyObjectState {
+	m.mock.NotifyObjectStateFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &StateNotifierMockNotifyObjectStateExpectation{}
+	}
+	m.mainExpectation.input = &StateNotifierMockNotifyObjectStateInput{p, p1, p2, p3}
+	return m
+}
+
+//Return specifies results of invocation of StateNotifier.NotifyObjectState
+func (m *mStateNotifierMockNotifyObjectState) Return() *StateNotifierMock {
+	m.mock.NotifyObjectStateFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &StateNotifierMockNotifyObjectStateExpectation{}
+	}
+
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of StateNotifier.NotifyObjectState is expected once
+func (m *mStateNotifierMockNotifyObjectState) ExpectOnce(p context.Context, p1 insolar.PulseNumber, p2 insolar.ID, p3 insolar.ID) *StateNotifierMockNotifyObjectStateExpectation {
+	m.mock.NotifyObjectStateFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &StateNotifierMockNotifyObjectStateExpectation{}
+	expectation.input = &StateNotifierMockNotifyObjectStateInput{p, p1, p2, p3}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+//Set uses given function f as a mock of StateNotifier.NotifyObjectState method
+func (m *mStateNotifierMockNotifyObjectState) Set(f func(p context.Context, p1 insolar.PulseNumber, p2 insolar.ID, p3 insolar.ID)) *StateNotifierMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.NotifyObjectStateFunc = f
+	return m.mock
+}
+
+//NotifyObjectState implements github.com/insolar/insolar/ledger/light/executor.StateNotifier interface
+func (m *StateNotifierMock) NotifyObjectState(p context.Context, p1 insolar.PulseNumber, p2 insolar.ID, p3 insolar.ID) {
+	counter := atomic.AddUint64(&m.NotifyObjectStatePreCounter, 1)
+	defer atomic.AddUint64(&m.NotifyObjectStateCounter, 1)
+
+	if len(m.NotifyObjectStateMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.NotifyObjectStateMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to StateNotifierMock.NotifyObjectState. %v %v %v %v", p, p1, p2, p3)
+			return
+		}
+
+		input := m.NotifyObjectStateMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, StateNotifierMockNotifyObjectStateInput{p, p1, p2, p3}, "StateNotifier.NotifyObjectState got unexpected parameters")
+
+		return
+	}
+
+	if m.NotifyObjectStateMock.mainExpectation != nil {
+
+		input := m.NotifyObjectStateMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, StateNotifierMockNotifyObjectStateInput{p, p1, p2, p3}, "StateNotifier.NotifyObjectState got unexpected parameters")
+		}
+
+		return
+	}
+
+	if m.NotifyObjectStateFunc == nil {
+		m.t.Fatalf("Unexpected call to StateNotifierMock.NotifyObjectState. %v %v %v %v", p, p1, p2, p3)
+		return
+	}
+
+	m.NotifyObjectStateFunc(p, p1, p2, p3)
+}
+
+//NotifyObjectStateMinimockCounter returns a count of StateNotifierMock.NotifyObjectStateFunc invocations
+func (m *StateNotifierMock) NotifyObjectStateMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.NotifyObjectStateCounter)
+}
+
+//NotifyObjectStateMinimockPreCounter returns the value of StateNotifierMock.NotifyObjectState invocations
+func (m *StateNotifierMock) NotifyObjectStateMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.NotifyObjectStatePreCounter)
+}
+
+//NotifyObjectStateFinished returns true if mock invocations count is ok
+func (m *StateNotifierMock) NotifyObjectStateFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.NotifyObjectStateMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.NotifyObjectStateCounter) == uint64(len(m.NotifyObjectStateMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.NotifyObjectStateMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.NotifyObjectStateCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.NotifyObjectStateFunc != nil {
+		return atomic.LoadUint64(&m.NotifyObjectStateCounter) > 0
+	}
+
+	return true
+}
+
+//ValidateCallCounters checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *StateNotifierMock) ValidateCallCounters() {
+
+	if !m.NotifyObjectStateFinished() {
+		m.t.Fatal("Expected call to StateNotifierMock.NotifyObjectState")
+	}
+
+}
+
+//CheckMocksCalled checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *StateNotifierMock) CheckMocksCalled() {
+	m.Finish()
+}
+
+//Finish checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish or use Finish method of minimock.Controller
+func (m *StateNotifierMock) Finish() {
+	m.MinimockFinish()
+}
+
+//MinimockFinish checks that all mocked methods of the interface have been called at least once
+func (m *StateNotifierMock) MinimockFinish() {
+
+	if !m.NotifyObjectStateFinished() {
+		m.t.Fatal("Expected call to StateNotifierMock.NotifyObjectState")
+	}
+
+}
+
+//Wait waits for all mocked methods to be called at least once
+//Deprecated: please use MinimockWait or use Wait method of minimock.Controller
+func (m *StateNotifierMock) Wait(timeout time.Duration) {
+	m.MinimockWait(timeout)
+}
+
+//MinimockWait waits for all mocked methods to be called at least once
+//this method is called by minimock.Controller
+func (m *StateNotifierMock) MinimockWait(timeout time.Duration) {
+	timeoutCh := time.After(timeout)
+	for {
+		ok := true
+		ok = ok && m.NotifyObjectStateFinished()
+
+		if ok {
+			return
+		}
+
+		select {
+		case <-timeoutCh:
+
+			if !m.NotifyObjectStateFinished() {
+				m.t.Error("Expected call to StateNotifierMock.NotifyObjectState")
+			}
+
+			m.t.Fatalf("Some mocks were not called on time: %s", timeout)
+			return
+		default:
+			time.Sleep(time.Millisecond)
+		}
+	}
+}
+
+//AllMocksCalled returns true if all mocked methods were called before the execution of AllMocksCalled,
+//it can be used with assert/require, i.e. assert.True(mock.AllMocksCalled())
+func (m *StateNotifierMock) AllMocksCalled() bool {
+
+	if !m.NotifyObjectStateFinished() {
+		return false
+	}
+
+	return true
+}
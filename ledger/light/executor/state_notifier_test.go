@@ -0,0 +1,90 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package executor_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/gojuno/minimock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/bus"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/node"
+	"github.com/insolar/insolar/insolar/payload"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/light/executor"
+)
+
+func TestStateNotifierDefault_NotifyObjectState(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	var (
+		pn     = gen.PulseNumber()
+		object = gen.ID()
+		state  = gen.ID()
+		nodes  = []insolar.Node{{ID: gen.Reference()}, {ID: gen.Reference()}}
+	)
+
+	accessor := node.NewAccessorMock(mc)
+	accessor.InRoleMock.Expect(pn, insolar.StaticRoleVirtual).Return(nodes, nil)
+
+	var (
+		targets []insolar.Reference
+		done    int
+	)
+	sender := bus.NewSenderMock(mc)
+	sender.SendTargetFunc = func(
+		_ context.Context, msg *message.Message, target insolar.Reference,
+	) (<-chan *message.Message, func()) {
+		pl, err := payload.Unmarshal(msg.Payload)
+		require.NoError(t, err)
+		require.Equal(t, &payload.ObjectStateNotification{
+			Polymorph: uint32(payload.TypeObjectStateNotification),
+			ObjectID:  object,
+			StateID:   state,
+		}, pl)
+
+		targets = append(targets, target)
+		return nil, func() { done++ }
+	}
+
+	n := executor.NewStateNotifier(accessor, sender)
+	n.NotifyObjectState(ctx, pn, object, state)
+
+	require.Equal(t, []insolar.Reference{nodes[0].ID, nodes[1].ID}, targets)
+	require.Equal(t, len(nodes), done)
+}
+
+func TestStateNotifierDefault_NotifyObjectState_NodesErr(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	accessor := node.NewAccessorMock(mc)
+	accessor.InRoleMock.Return(nil, errors.New("nodes not found"))
+	sender := bus.NewSenderMock(mc)
+
+	n := executor.NewStateNotifier(accessor, sender)
+	n.NotifyObjectState(ctx, gen.PulseNumber(), gen.ID(), gen.ID())
+}
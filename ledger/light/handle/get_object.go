@@ -75,11 +75,12 @@ func (s *GetObject) Present(ctx context.Context, f flow.Flow) error {
 		return err
 	}
 
-	if idx.Result.Lifeline.StateID == record.StateDeactivation {
+	// Deactivated object still has its previous states.
+	if msg.StateID.IsEmpty() && idx.Result.Lifeline.StateID == record.StateDeactivation {
 		return errors.New("object is deactivated")
 	}
 
-	send := proc.NewSendObject(s.meta, msg.ObjectID, idx.Result.Lifeline)
+	send := proc.NewSendObject(s.meta, msg.ObjectID, msg.StateID, idx.Result.Lifeline)
 	s.dep.SendObject(send)
 	return f.Procedure(ctx, send, false)
 }
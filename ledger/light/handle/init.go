@@ -121,6 +121,9 @@ func (s *Init) handle(ctx context.Context, f flow.Flow) error {
 	case payload.TypeGetPendings:
 		h := NewGetPendings(s.dep, meta, false)
 		err = f.Handle(ctx, h.Present)
+	case payload.TypeGetResult:
+		h := NewGetResult(s.dep, meta, false)
+		err = f.Handle(ctx, h.Present)
 	case payload.TypePass:
 		err = s.handlePass(ctx, f, meta)
 	case payload.TypeError:
@@ -239,6 +242,9 @@ func (s *Init) handlePass(ctx context.Context, f flow.Flow, meta payload.Meta) e
 	case payload.TypeGetPendings:
 		h := NewGetPendings(s.dep, originMeta, true)
 		err = f.Handle(ctx, h.Present)
+	case payload.TypeGetResult:
+		h := NewGetResult(s.dep, originMeta, true)
+		err = f.Handle(ctx, h.Present)
 	default:
 		err = fmt.Errorf("no handler for message type %s", payloadType.String())
 	}
@@ -24,6 +24,7 @@ import (
 	"github.com/ThreeDotsLabs/watermill/message"
 	"github.com/ThreeDotsLabs/watermill/message/infrastructure/gochannel"
 	"github.com/ThreeDotsLabs/watermill/message/router/middleware"
+	"github.com/insolar/insolar/api/subscription"
 	"github.com/insolar/insolar/component"
 	"github.com/insolar/insolar/configuration"
 	"github.com/insolar/insolar/cryptography"
@@ -169,6 +170,7 @@ func NewServer(ctx context.Context, cfg configuration.Configuration, receive fun
 	)
 	{
 		conf := cfg.Ledger
+		notifier := subscription.NewHub()
 		idLocker := object.NewIndexLocker()
 		drops := drop.NewStorageMemory()
 		records := object.NewRecordMemory()
@@ -267,6 +269,7 @@ func NewServer(ctx context.Context, cfg configuration.Configuration, receive fun
 		pm.PulseAppender = Pulses
 		pm.GIL = &stub{}
 		pm.NodeNet = NodeNetwork
+		pm.Notifier = notifier
 
 		PulseManager = pm
 		Handler = handler
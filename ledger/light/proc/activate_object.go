@@ -46,6 +46,7 @@ type ActivateObject struct {
 		indexStorage  object.IndexStorage
 		filament      executor.FilamentModifier
 		sender        bus.Sender
+		notifier      executor.StateNotifier
 	}
 }
 
@@ -74,6 +75,7 @@ func (a *ActivateObject) Dep(
 	is object.IndexStorage,
 	f executor.FilamentModifier,
 	s bus.Sender,
+	n executor.StateNotifier,
 ) {
 	a.dep.records = r
 	a.dep.indexLocker = il
@@ -81,6 +83,7 @@ func (a *ActivateObject) Dep(
 	a.dep.filament = f
 	a.dep.writeAccessor = w
 	a.dep.sender = s
+	a.dep.notifier = n
 }
 
 func (a *ActivateObject) Proceed(ctx context.Context) error {
@@ -148,6 +151,8 @@ func (a *ActivateObject) Proceed(ctx context.Context) error {
 		}
 	}
 
+	a.dep.notifier.NotifyObjectState(ctx, flow.Pulse(ctx), *a.activate.Request.Record(), a.activateID)
+
 	msg, err := payload.NewMessage(&payload.ResultInfo{
 		ObjectID: *a.activate.Request.Record(),
 		ResultID: a.resultID,
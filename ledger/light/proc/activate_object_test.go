@@ -73,6 +73,7 @@ func TestActivateObject_RecordOverrideErr(t *testing.T) {
 		idxStorage,
 		nil,
 		nil,
+		nil,
 	)
 
 	err := p.Proceed(ctx)
@@ -115,6 +116,7 @@ func TestActivateObject_RecordErr(t *testing.T) {
 		idxStorage,
 		nil,
 		nil,
+		nil,
 	)
 
 	err := p.Proceed(ctx)
@@ -161,6 +163,7 @@ func TestActivateObject_FilamentSetResultErr(t *testing.T) {
 		idxStorageMock,
 		filaments,
 		nil,
+		nil,
 	)
 
 	err := p.Proceed(ctx)
@@ -196,10 +199,17 @@ func TestActivateObject_Proceed(t *testing.T) {
 	sender := bus.NewSenderMock(t)
 	sender.ReplyMock.Return()
 
+	stateID := gen.ID()
+	notifier := executor.NewStateNotifierMock(t)
+	notifier.NotifyObjectStateFunc = func(_ context.Context, _ insolar.PulseNumber, obj insolar.ID, state insolar.ID) {
+		require.Equal(t, insolar.ID{}, obj)
+		require.Equal(t, stateID, state)
+	}
+
 	p := proc.NewActivateObject(
 		payload.Meta{},
 		record.Activate{},
-		gen.ID(),
+		stateID,
 		record.Result{},
 		gen.ID(),
 		gen.JetID(),
@@ -211,10 +221,12 @@ func TestActivateObject_Proceed(t *testing.T) {
 		idxStorageMock,
 		filaments,
 		sender,
+		notifier,
 	)
 
 	err := p.Proceed(flow.TestContextWithPulse(ctx, gen.PulseNumber()))
 	require.NoError(t, err)
+	require.Equal(t, uint64(1), notifier.NotifyObjectStateCounter)
 }
 
 func TestActivateObject_ObjectIsDeactivated(t *testing.T) {
@@ -265,6 +277,7 @@ func TestActivateObject_ObjectIsDeactivated(t *testing.T) {
 		idxStorageMock,
 		nil,
 		sender,
+		nil,
 	)
 
 	err := p.Proceed(flow.TestContextWithPulse(ctx, gen.PulseNumber()))
@@ -0,0 +1,92 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package proc
+
+import (
+	"context"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/bus"
+	"github.com/insolar/insolar/insolar/flow"
+	"github.com/insolar/insolar/insolar/payload"
+	"github.com/insolar/insolar/ledger/light/executor"
+	"github.com/pkg/errors"
+)
+
+type GetResult struct {
+	message   payload.Meta
+	objectID  insolar.ID
+	requestID insolar.ID
+
+	dep struct {
+		filaments executor.FilamentCalculator
+		sender    bus.Sender
+	}
+}
+
+func NewGetResult(msg payload.Meta, objectID, requestID insolar.ID) *GetResult {
+	return &GetResult{
+		message:   msg,
+		objectID:  objectID,
+		requestID: requestID,
+	}
+}
+
+func (p *GetResult) Dep(
+	f executor.FilamentCalculator,
+	s bus.Sender,
+) {
+	p.dep.filaments = f
+	p.dep.sender = s
+}
+
+func (p *GetResult) Proceed(ctx context.Context) error {
+	res, err := p.dep.filaments.ResultForRequest(ctx, flow.Pulse(ctx), p.objectID, p.requestID)
+	if err == executor.ErrRecordNotFound {
+		msg, err := payload.NewMessage(&payload.Error{
+			Text: "request is not found",
+			Code: payload.CodeObjectNotFound,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to create reply")
+		}
+		go p.dep.sender.Reply(ctx, p.message, msg)
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to find result")
+	}
+
+	// Empty result means the request is still pending.
+	resultInfo := &payload.ResultInfo{ObjectID: p.objectID}
+	if res != nil {
+		buf, err := res.Record.Virtual.Marshal()
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal result")
+		}
+		resultInfo.ResultID = res.RecordID
+		resultInfo.Result = buf
+	}
+
+	msg, err := payload.NewMessage(resultInfo)
+	if err != nil {
+		return errors.Wrap(err, "failed to create reply")
+	}
+
+	go p.dep.sender.Reply(ctx, p.message, msg)
+	return nil
+}
@@ -33,6 +33,7 @@ type Dependencies struct {
 	UpdateObject        func(*UpdateObject)
 	RegisterChild       func(*RegisterChild)
 	GetPendings         func(*GetPendings)
+	GetResult           func(*GetResult)
 	GetPendingRequests  func(*GetPendingRequests)
 	GetPendingRequestID func(*GetPendingRequestID)
 	GetJet              func(*GetJet)
@@ -65,6 +66,7 @@ func NewDependenciesMock() *Dependencies {
 		UpdateObject:        func(*UpdateObject) {},
 		RegisterChild:       func(*RegisterChild) {},
 		GetPendings:         func(*GetPendings) {},
+		GetResult:           func(*GetResult) {},
 		GetPendingRequests:  func(*GetPendingRequests) {},
 		GetPendingRequestID: func(*GetPendingRequestID) {},
 		GetJet:              func(*GetJet) {},
@@ -34,6 +34,7 @@ import (
 type SendObject struct {
 	message  payload.Meta
 	objectID insolar.ID
+	stateID  insolar.ID
 	index    record.Lifeline
 
 	Dep struct {
@@ -46,15 +47,19 @@ type SendObject struct {
 	}
 }
 
+// NewSendObject creates procedure that sends object index and its state. If state ID is empty, the latest
+// state is sent.
 func NewSendObject(
 	msg payload.Meta,
 	id insolar.ID,
+	stateID insolar.ID,
 	idx record.Lifeline,
 ) *SendObject {
 	return &SendObject{
 		message:  msg,
 		index:    idx,
 		objectID: id,
+		stateID:  stateID,
 	}
 }
 
@@ -152,14 +157,19 @@ func (p *SendObject) Proceed(ctx context.Context) error {
 		logger.Info("sending index")
 	}
 
-	rec, err := p.Dep.RecordAccessor.ForID(ctx, *p.index.LatestState)
+	stateID := p.stateID
+	if stateID.IsEmpty() {
+		stateID = *p.index.LatestState
+	}
+
+	rec, err := p.Dep.RecordAccessor.ForID(ctx, stateID)
 	switch err {
 	case nil:
 		logger.Info("sending state")
 		return sendState(rec)
 	case object.ErrNotFound:
 		logger.Info("state not found (sending pass)")
-		return sendPassState(*p.index.LatestState)
+		return sendPassState(stateID)
 	default:
 		return errors.Wrap(err, "failed to fetch record")
 	}
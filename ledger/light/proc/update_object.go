@@ -46,6 +46,7 @@ type UpdateObject struct {
 		index         object.IndexStorage
 		filament      executor.FilamentModifier
 		sender        bus.Sender
+		notifier      executor.StateNotifier
 	}
 }
 
@@ -74,6 +75,7 @@ func (a *UpdateObject) Dep(
 	i object.IndexStorage,
 	f executor.FilamentModifier,
 	s bus.Sender,
+	n executor.StateNotifier,
 ) {
 	a.dep.records = r
 	a.dep.indexLocker = il
@@ -81,6 +83,7 @@ func (a *UpdateObject) Dep(
 	a.dep.filament = f
 	a.dep.writeAccessor = w
 	a.dep.sender = s
+	a.dep.notifier = n
 }
 
 func (a *UpdateObject) Proceed(ctx context.Context) error {
@@ -158,6 +161,8 @@ func (a *UpdateObject) Proceed(ctx context.Context) error {
 		}
 	}
 
+	a.dep.notifier.NotifyObjectState(ctx, flow.Pulse(ctx), a.result.Object, a.updateID)
+
 	msg, err := payload.NewMessage(&payload.ResultInfo{
 		ObjectID: a.result.Object,
 		ResultID: a.resultID,
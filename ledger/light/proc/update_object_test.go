@@ -73,6 +73,7 @@ func TestUpdateObject_RecordOverrideErr(t *testing.T) {
 		idxStorage,
 		nil,
 		nil,
+		nil,
 	)
 
 	err := p.Proceed(ctx)
@@ -119,6 +120,7 @@ func TestUpdateObject_RecordErr(t *testing.T) {
 		idxStorage,
 		nil,
 		nil,
+		nil,
 	)
 
 	err := p.Proceed(ctx)
@@ -161,6 +163,7 @@ func TestUpdateObject_IndexForIDErr(t *testing.T) {
 		idxStorageMock,
 		nil,
 		nil,
+		nil,
 	)
 
 	err := p.Proceed(ctx)
@@ -205,6 +208,7 @@ func TestUpdateObject_SetIndexErr(t *testing.T) {
 		idxStorageMock,
 		nil,
 		nil,
+		nil,
 	)
 
 	err := p.Proceed(ctx)
@@ -252,6 +256,7 @@ func TestUpdateObject_FilamentSetResultErr(t *testing.T) {
 		idxStorageMock,
 		filaments,
 		nil,
+		nil,
 	)
 
 	err := p.Proceed(ctx)
@@ -287,10 +292,17 @@ func TestUpdateObject_Proceed(t *testing.T) {
 	sender := bus.NewSenderMock(t)
 	sender.ReplyMock.Return()
 
+	stateID := gen.ID()
+	notifier := executor.NewStateNotifierMock(t)
+	notifier.NotifyObjectStateFunc = func(_ context.Context, _ insolar.PulseNumber, obj insolar.ID, state insolar.ID) {
+		require.Equal(t, insolar.ID{}, obj)
+		require.Equal(t, stateID, state)
+	}
+
 	p := proc.NewUpdateObject(
 		payload.Meta{},
 		record.Amend{},
-		gen.ID(),
+		stateID,
 		record.Result{},
 		gen.ID(),
 		gen.JetID(),
@@ -302,10 +314,12 @@ func TestUpdateObject_Proceed(t *testing.T) {
 		idxStorageMock,
 		filaments,
 		sender,
+		notifier,
 	)
 
 	err := p.Proceed(ctx)
 	require.NoError(t, err)
+	require.Equal(t, uint64(1), notifier.NotifyObjectStateCounter)
 }
 
 func TestUpdateObject_ObjectIsDeactivated(t *testing.T) {
@@ -356,6 +370,7 @@ func TestUpdateObject_ObjectIsDeactivated(t *testing.T) {
 		idxStorageMock,
 		nil,
 		sender,
+		nil,
 	)
 
 	err := p.Proceed(ctx)
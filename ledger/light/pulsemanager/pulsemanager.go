@@ -58,11 +58,16 @@ type PulseManager struct {
 	PulseCalculator pulse.Calculator `inject:""`
 	PulseAppender   pulse.Appender   `inject:""`
 
+	Notifier insolar.Notifier `inject:""`
+
 	LightReplicator replication.LightReplicator
 	HotSender       executor.HotSender
 
 	WriteManager hot.WriteManager
 
+	// change keeps state of two-phase pulse change.
+	change pulse.PreparedChange
+
 	// setLock locks Set method call.
 	setLock sync.RWMutex
 }
@@ -90,6 +95,10 @@ func (m *PulseManager) Set(ctx context.Context, newPulse insolar.Pulse) error {
 	m.setLock.Lock()
 	defer m.setLock.Unlock()
 
+	if err := m.change.Commit(newPulse.PulseNumber); err != nil {
+		return err
+	}
+
 	defer func() {
 		err := m.Bus.OnPulse(ctx, newPulse)
 		if err != nil {
@@ -129,9 +138,33 @@ func (m *PulseManager) Set(ctx context.Context, newPulse insolar.Pulse) error {
 	}
 
 	m.MessageHandler.OnPulse(ctx, newPulse)
+	m.Notifier.NotifyPulse(ctx, newPulse)
 	return nil
 }
 
+// Prepare prepares pulse change. Prepared change is applied by Set or discarded by Cancel.
+func (m *PulseManager) Prepare(ctx context.Context, pn insolar.PulseNumber) error {
+	m.setLock.RLock()
+	defer m.setLock.RUnlock()
+
+	var current insolar.PulseNumber
+	latest, err := m.PulseAccessor.Latest(ctx)
+	if err == nil {
+		current = latest.PulseNumber
+	} else if err != pulse.ErrNotFound {
+		return errors.Wrap(err, "failed to fetch latest pulse")
+	}
+
+	return m.change.Prepare(current, pn)
+}
+
+// Cancel discards prepared pulse change.
+func (m *PulseManager) Cancel(ctx context.Context) {
+	if pn, ok := m.change.Cancel(); ok {
+		inslogger.FromContext(ctx).Infof("pulse change to %v is cancelled", pn)
+	}
+}
+
 func (m *PulseManager) setUnderGilSection(ctx context.Context, newPulse insolar.Pulse) (
 	[]insolar.JetID, insolar.Pulse, error,
 ) {
@@ -99,19 +99,19 @@ func (i *IndexDB) TruncateHead(ctx context.Context, from insolar.PulseNumber) er
 	i.lock.Lock()
 	defer i.lock.Unlock()
 
-	it := i.db.NewIterator(&indexKey{objID: insolar.ID{}, pn: from}, false)
-	defer it.Close()
-
 	var hasKeys bool
-	for it.Next() {
+	err := i.scan(from, func(key indexKey, _ func() ([]byte, error)) (bool, error) {
 		hasKeys = true
-		key := newIndexKey(it.Key())
 		err := i.db.Delete(&key)
 		if err != nil {
-			return errors.Wrapf(err, "can't delete key: %+v", key)
+			return false, errors.Wrapf(err, "can't delete key: %+v", key)
 		}
 
 		inslogger.FromContext(ctx).Debugf("Erased key. Pulse number: %s. ObjectID: %s", key.pn.String(), key.objID.String())
+		return true, nil
+	})
+	if err != nil {
+		return err
 	}
 
 	if !hasKeys {
@@ -142,8 +142,54 @@ func (i *IndexDB) ForID(ctx context.Context, pn insolar.PulseNumber, objID insol
 	return *buck, nil
 }
 
+// ForPulse returns a collection of buckets for a provided pulse number.
 func (i *IndexDB) ForPulse(ctx context.Context, pn insolar.PulseNumber) []record.Index {
-	panic("implement me")
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+
+	var res []record.Index
+	err := i.scan(pn, func(key indexKey, value func() ([]byte, error)) (bool, error) {
+		if key.pn != pn {
+			return false, nil
+		}
+		buf, err := value()
+		if err != nil {
+			return false, errors.Wrapf(err, "can't read value for key: %+v", key)
+		}
+		bucket := record.Index{}
+		err = bucket.Unmarshal(buf)
+		if err != nil {
+			return false, errors.Wrapf(err, "can't unmarshal bucket: %+v", key)
+		}
+		res = append(res, bucket)
+		return true, nil
+	})
+	if err != nil {
+		inslogger.FromContext(ctx).Error(errors.Wrapf(err, "failed to fetch buckets for pulse %s", pn.String()))
+		return nil
+	}
+
+	return res
+}
+
+// scan walks through buckets in ascending order of pulse numbers, starting from the first bucket of the provided pulse.
+// Index keys are prefixed with pulse number, so buckets of one pulse are stored sequentially. Walking stops when
+// handler returns false or an error. Value of the bucket is read only when handler asks for it.
+func (i *IndexDB) scan(from insolar.PulseNumber, handler func(key indexKey, value func() ([]byte, error)) (bool, error)) error {
+	it := i.db.NewIterator(&indexKey{objID: insolar.ID{}, pn: from}, false)
+	defer it.Close()
+
+	for it.Next() {
+		next, err := handler(newIndexKey(it.Key()), it.Value)
+		if err != nil {
+			return err
+		}
+		if !next {
+			return nil
+		}
+	}
+
+	return nil
 }
 
 func (i *IndexDB) setBucket(pn insolar.PulseNumber, objID insolar.ID, bucket *record.Index) error {
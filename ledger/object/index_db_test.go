@@ -111,6 +111,58 @@ func TestIndexDB_TruncateHead(t *testing.T) {
 	}
 }
 
+func TestIndexDB_ForPulse(t *testing.T) {
+	t.Parallel()
+
+	ctx := inslogger.TestContext(t)
+	tmpdir, err := ioutil.TempDir("", "bdb-test-")
+	defer os.RemoveAll(tmpdir)
+	require.NoError(t, err)
+
+	db, err := store.NewBadgerDB(tmpdir)
+	require.NoError(t, err)
+	defer db.Stop(ctx)
+
+	indexStore := NewIndexDB(db)
+
+	startPulseNumber := insolar.GenesisPulse.PulseNumber
+	expected := map[insolar.PulseNumber]map[insolar.ID]struct{}{}
+	for pn := startPulseNumber; pn < startPulseNumber+10; pn++ {
+		expected[pn] = map[insolar.ID]struct{}{}
+		for i := 0; i < 5; i++ {
+			bucket := record.Index{ObjID: gen.ID()}
+			err := indexStore.SetIndex(ctx, pn, bucket)
+			require.NoError(t, err)
+			expected[pn][bucket.ObjID] = struct{}{}
+		}
+	}
+
+	t.Run("returns all buckets of the pulse", func(t *testing.T) {
+		for pn, objects := range expected {
+			buckets := indexStore.ForPulse(ctx, pn)
+			require.Len(t, buckets, len(objects))
+			for _, b := range buckets {
+				_, ok := objects[b.ObjID]
+				assert.True(t, ok, "unexpected bucket for object %s in pulse %s", b.ObjID.DebugString(), pn)
+			}
+		}
+	})
+
+	t.Run("returns nothing for unknown pulse", func(t *testing.T) {
+		assert.Empty(t, indexStore.ForPulse(ctx, startPulseNumber-1))
+		assert.Empty(t, indexStore.ForPulse(ctx, startPulseNumber+10))
+	})
+
+	t.Run("truncated pulses are not returned", func(t *testing.T) {
+		err := indexStore.TruncateHead(ctx, startPulseNumber+5)
+		require.NoError(t, err)
+
+		assert.Len(t, indexStore.ForPulse(ctx, startPulseNumber+4), 5)
+		assert.Empty(t, indexStore.ForPulse(ctx, startPulseNumber+5))
+		assert.Empty(t, indexStore.ForPulse(ctx, startPulseNumber+9))
+	})
+}
+
 func TestDBIndexStorage_ForID(t *testing.T) {
 	t.Parallel()
 
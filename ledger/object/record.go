@@ -60,6 +60,21 @@ type RecordCollectionAccessor interface {
 	ForPulse(ctx context.Context, jetID insolar.JetID, pn insolar.PulseNumber) []record.Material
 }
 
+//go:generate minimock -i github.com/insolar/insolar/ledger/object.RecordBatchAccessor -o ./ -s _mock.go
+
+// RecordBatchAccessor provides method for reading records of a pulse in batches.
+type RecordBatchAccessor interface {
+	// BatchForPulse returns up to limit records of the pulse ordered by id. Records are returned starting from
+	// the record that follows after (or from the first record of the pulse if after is nil).
+	BatchForPulse(ctx context.Context, pn insolar.PulseNumber, after *insolar.ID, limit int) ([]IdentifiedRecord, error)
+}
+
+// IdentifiedRecord is a record with its id.
+type IdentifiedRecord struct {
+	ID     insolar.ID
+	Record record.Material
+}
+
 //go:generate minimock -i github.com/insolar/insolar/ledger/object.RecordModifier -o ./ -s _mock.go
 
 // RecordModifier provides methods for setting record-values to storage.
@@ -240,6 +255,53 @@ func (r *RecordDB) ForID(ctx context.Context, id insolar.ID) (record.Material, e
 	return r.get(id)
 }
 
+// BatchForPulse returns up to limit records of the pulse ordered by id. Records are returned starting from the record
+// that follows after (or from the first record of the pulse if after is nil).
+func (r *RecordDB) BatchForPulse(
+	ctx context.Context,
+	pn insolar.PulseNumber,
+	after *insolar.ID,
+	limit int,
+) ([]IdentifiedRecord, error) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	pivot := recordKey(*insolar.NewID(pn, nil))
+	if after != nil {
+		if after.Pulse() != pn {
+			return nil, errors.Errorf("record %s doesn't belong to pulse %s", after.DebugString(), pn)
+		}
+		pivot = recordKey(*after)
+	}
+
+	it := r.db.NewIterator(pivot, false)
+	defer it.Close()
+
+	var res []IdentifiedRecord
+	for len(res) < limit && it.Next() {
+		id := insolar.ID(newRecordKey(it.Key()))
+		if id.Pulse() != pn {
+			break
+		}
+		if after != nil && id == *after {
+			continue
+		}
+
+		buff, err := it.Value()
+		if err != nil {
+			return nil, errors.Wrapf(err, "can't read record %s", id.DebugString())
+		}
+		rec := record.Material{}
+		err = rec.Unmarshal(buff)
+		if err != nil {
+			return nil, errors.Wrapf(err, "can't unmarshal record %s", id.DebugString())
+		}
+		res = append(res, IdentifiedRecord{ID: id, Record: rec})
+	}
+
+	return res, nil
+}
+
 func (r *RecordDB) set(id insolar.ID, rec record.Material) error {
 	key := recordKey(id)
 
@@ -0,0 +1,268 @@
+package object
+
+/*
+DO NOT EDIT!
+This code was generated automatically using github.com/gojuno/minimock v1.9
+The original interface "RecordBatchAccessor" can be found in github.com/insolar/insolar/ledger/object
+*/
+import (
+	context "context"
+	"sync/atomic"
+	"time"
+
+	"github.com/gojuno/minimock"
+	insolar "github.com/insolar/insolar/insolar"
+
+	testify_assert "github.com/stretchr/testify/assert"
+)
+
+//RecordBatchAccessorMock implements github.com/insolar/insolar/ledger/object.RecordBatchAccessor
+type RecordBatchAccessorMock struct {
+	t minimock.Tester
+
+	BatchForPulseFunc       func(p context.Context, p1 insolar.PulseNumber, p2 *insolar.ID, p3 int) (r []IdentifiedRecord, r1 error)
+	BatchForPulseCounter    uint64
+	BatchForPulsePreCounter uint64
+	BatchForPulseMock       mRecordBatchAccessorMockBatchForPulse
+}
+
+//NewRecordBatchAccessorMock returns a mock for github.com/insolar/insolar/ledger/object.RecordBatchAccessor
+func NewRecordBatchAccessorMock(t minimock.Tester) *RecordBatchAccessorMock {
+	m := &RecordBatchAccessorMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.BatchForPulseMock = mRecordBatchAccessorMockBatchForPulse{mock: m}
+
+	return m
+}
+
+type mRecordBatchAccessorMockBatchForPulse struct {
+	mock              *RecordBatchAccessorMock
+	mainExpectation   *RecordBatchAccessorMockBatchForPulseExpectation
+	expectationSeries []*RecordBatchAccessorMockBatchForPulseExpectation
+}
+
+type RecordBatchAccessorMockBatchForPulseExpectation struct {
+	input  *RecordBatchAccessorMockBatchForPulseInput
+	result *RecordBatchAccessorMockBatchForPulseResult
+}
+
+type RecordBatchAccessorMockBatchForPulseInput struct {
+	p  context.Context
+	p1 insolar.PulseNumber
+	p2 *insolar.ID
+	p3 int
+}
+
+type RecordBatchAccessorMockBatchForPulseResult struct {
+	r  []IdentifiedRecord
+	r1 error
+}
+
+//Expect specifies that invocation of RecordBatchAccessor.BatchForPulse is expected from 1 to Infinity times
+func (m *mRecordBatchAccessorMockBatchForPulse) Expect(p context.Context, p1 insolar.PulseNumber, p2 *insolar.ID, p3 int) *mRecordBatchAccessorMockBatchForPulse {
+	m.mock.BatchForPulseFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &RecordBatchAccessorMockBatchForPulseExpectation{}
+	}
+	m.mainExpectation.input = &RecordBatchAccessorMockBatchForPulseInput{p, p1, p2, p3}
+	return m
+}
+
+//Return specifies results of invocation of RecordBatchAccessor.BatchForPulse
+func (m *mRecordBatchAccessorMockBatchForPulse) Return(r []IdentifiedRecord, r1 error) *RecordBatchAccessorMock {
+	m.mock.BatchForPulseFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &RecordBatchAccessorMockBatchForPulseExpectation{}
+	}
+	m.mainExpectation.result = &RecordBatchAccessorMockBatchForPulseResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of RecordBatchAccessor.BatchForPulse is expected once
+func (m *mRecordBatchAccessorMockBatchForPulse) ExpectOnce(p context.Context, p1 insolar.PulseNumber, p2 *insolar.ID, p3 int) *RecordBatchAccessorMockBatchForPulseExpectation {
+	m.mock.BatchForPulseFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &RecordBatchAccessorMockBatchForPulseExpectation{}
+	expectation.input = &RecordBatchAccessorMockBatchForPulseInput{p, p1, p2, p3}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *RecordBatchAccessorMockBatchForPulseExpectation) Return(r []IdentifiedRecord, r1 error) {
+	e.result = &RecordBatchAccessorMockBatchForPulseResult{r, r1}
+}
+
+//Set uses given function f as a mock of RecordBatchAccessor.BatchForPulse method
+func (m *mRecordBatchAccessorMockBatchForPulse) Set(f func(p context.Context, p1 insolar.PulseNumber, p2 *insolar.ID, p3 int) (r []IdentifiedRecord, r1 error)) *RecordBatchAccessorMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.BatchForPulseFunc = f
+	return m.mock
+}
+
+//BatchForPulse implements github.com/insolar/insolar/ledger/object.RecordBatchAccessor interface
+func (m *RecordBatchAccessorMock) BatchForPulse(p context.Context, p1 insolar.PulseNumber, p2 *insolar.ID, p3 int) (r []IdentifiedRecord, r1 error) {
+	counter := atomic.AddUint64(&m.BatchForPulsePreCounter, 1)
+	defer atomic.AddUint64(&m.BatchForPulseCounter, 1)
+
+	if len(m.BatchForPulseMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.BatchForPulseMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to RecordBatchAccessorMock.BatchForPulse. %v %v %v %v", p, p1, p2, p3)
+			return
+		}
+
+		input := m.BatchForPulseMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, RecordBatchAccessorMockBatchForPulseInput{p, p1, p2, p3}, "RecordBatchAccessor.BatchForPulse got unexpected parameters")
+
+		result := m.BatchForPulseMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the RecordBatchAccessorMock.BatchForPulse")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.BatchForPulseMock.mainExpectation != nil {
+
+		input := m.BatchForPulseMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, RecordBatchAccessorMockBatchForPulseInput{p, p1, p2, p3}, "RecordBatchAccessor.BatchForPulse got unexpected parameters")
+		}
+
+		result := m.BatchForPulseMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the RecordBatchAccessorMock.BatchForPulse")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.BatchForPulseFunc == nil {
+		m.t.Fatalf("Unexpected call to RecordBatchAccessorMock.BatchForPulse. %v %v %v %v", p, p1, p2, p3)
+		return
+	}
+
+	return m.BatchForPulseFunc(p, p1, p2, p3)
+}
+
+//BatchForPulseMinimockCounter returns a count of RecordBatchAccessorMock.BatchForPulseFunc invocations
+func (m *RecordBatchAccessorMock) BatchForPulseMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.BatchForPulseCounter)
+}
+
+//BatchForPulseMinimockPreCounter returns the value of RecordBatchAccessorMock.BatchForPulse invocations
+func (m *RecordBatchAccessorMock) BatchForPulseMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.BatchForPulsePreCounter)
+}
+
+//BatchForPulseFinished returns true if mock invocations count is ok
+func (m *RecordBatchAccessorMock) BatchForPulseFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.BatchForPulseMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.BatchForPulseCounter) == uint64(len(m.BatchForPulseMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.BatchForPulseMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.BatchForPulseCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.BatchForPulseFunc != nil {
+		return atomic.LoadUint64(&m.BatchForPulseCounter) > 0
+	}
+
+	return true
+}
+
+//ValidateCallCounters checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *RecordBatchAccessorMock) ValidateCallCounters() {
+
+	if !m.BatchForPulseFinished() {
+		m.t.Fatal("Expected call to RecordBatchAccessorMock.BatchForPulse")
+	}
+
+}
+
+//CheckMocksCalled checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *RecordBatchAccessorMock) CheckMocksCalled() {
+	m.Finish()
+}
+
+//Finish checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish or use Finish method of minimock.Controller
+func (m *RecordBatchAccessorMock) Finish() {
+	m.MinimockFinish()
+}
+
+//MinimockFinish checks that all mocked methods of the interface have been called at least once
+func (m *RecordBatchAccessorMock) MinimockFinish() {
+
+	if !m.BatchForPulseFinished() {
+		m.t.Fatal("Expected call to RecordBatchAccessorMock.BatchForPulse")
+	}
+
+}
+
+//Wait waits for all mocked methods to be called at least once
+//Deprecated: please use MinimockWait or use Wait method of minimock.Controller
+func (m *RecordBatchAccessorMock) Wait(timeout time.Duration) {
+	m.MinimockWait(timeout)
+}
+
+//MinimockWait waits for all mocked methods to be called at least once
+//this method is called by minimock.Controller
+func (m *RecordBatchAccessorMock) MinimockWait(timeout time.Duration) {
+	timeoutCh := time.After(timeout)
+	for {
+		ok := true
+		ok = ok && m.BatchForPulseFinished()
+
+		if ok {
+			return
+		}
+
+		select {
+		case <-timeoutCh:
+
+			if !m.BatchForPulseFinished() {
+				m.t.Error("Expected call to RecordBatchAccessorMock.BatchForPulse")
+			}
+
+			m.t.Fatalf("Some mocks were not called on time: %s", timeout)
+			return
+		default:
+			time.Sleep(time.Millisecond)
+		}
+	}
+}
+
+//AllMocksCalled returns true if all mocked methods were called before the execution of AllMocksCalled,
+//it can be used with assert/require, i.e. assert.True(mock.AllMocksCalled())
+func (m *RecordBatchAccessorMock) AllMocksCalled() bool {
+
+	if !m.BatchForPulseFinished() {
+		return false
+	}
+
+	return true
+}
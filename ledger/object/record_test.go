@@ -17,6 +17,7 @@
 package object
 
 import (
+	"bytes"
 	"crypto/sha256"
 	"io/ioutil"
 	"math/rand"
@@ -104,6 +105,67 @@ func TestRecordStorage_TruncateHead(t *testing.T) {
 	}
 }
 
+func TestRecordStorage_BatchForPulse(t *testing.T) {
+	t.Parallel()
+
+	ctx := inslogger.TestContext(t)
+	tmpdir, err := ioutil.TempDir("", "bdb-test-")
+	defer os.RemoveAll(tmpdir)
+	require.NoError(t, err)
+
+	db, err := store.NewBadgerDB(tmpdir)
+	require.NoError(t, err)
+	defer db.Stop(ctx)
+
+	recordStore := NewRecordDB(db)
+
+	pn := insolar.GenesisPulse.PulseNumber + 10
+	expected := map[insolar.ID]struct{}{}
+	for i := 0; i < 10; i++ {
+		id := *insolar.NewID(pn, []byte(testutils.RandomString()))
+		err := recordStore.Set(ctx, id, record.Material{JetID: gen.JetID()})
+		require.NoError(t, err)
+		expected[id] = struct{}{}
+	}
+	// Records of neighbour pulses should not be returned.
+	err = recordStore.Set(ctx, *insolar.NewID(pn-1, []byte(testutils.RandomString())), record.Material{})
+	require.NoError(t, err)
+	err = recordStore.Set(ctx, *insolar.NewID(pn+1, []byte(testutils.RandomString())), record.Material{})
+	require.NoError(t, err)
+
+	var (
+		after  *insolar.ID
+		actual []insolar.ID
+	)
+	for {
+		batch, err := recordStore.BatchForPulse(ctx, pn, after, 3)
+		require.NoError(t, err)
+		require.True(t, len(batch) <= 3)
+		for _, rec := range batch {
+			actual = append(actual, rec.ID)
+		}
+		if len(batch) < 3 {
+			break
+		}
+		after = &batch[len(batch)-1].ID
+	}
+
+	require.Len(t, actual, len(expected))
+	for i, id := range actual {
+		_, ok := expected[id]
+		assert.True(t, ok)
+		if i > 0 {
+			assert.True(t, bytes.Compare(actual[i-1].Bytes(), id.Bytes()) < 0, "records should be ordered")
+		}
+	}
+
+	t.Run("returns error for record of another pulse", func(t *testing.T) {
+		id := gen.ID()
+		_, err := recordStore.BatchForPulse(ctx, id.Pulse()+1, &id, 3)
+		assert.Error(t, err)
+	})
+}
+
 func TestRecordStorage_NewStorageMemory(t *testing.T) {
 	t.Parallel()
 
@@ -36,5 +36,24 @@ package main
 		logger.Warnln("warning log message")
 	}
 
+Adapters are selected by name in configuration: "zerolog" is a production one, "memory" keeps records
+in memory for tests. Other adapters can be added with RegisterAdapter.
+
+Records are written to stderr by default. Configuration may redirect them to a file with size and age
+based rotation or to syslog, and may set sampling to write only every N-th record of noisy levels:
+
+	log:
+	  level: Debug
+	  adapter: zerolog
+	  formatter: json
+	  outputtype: file
+	  outputparams: /var/log/insolard.log
+	  rotation:
+	    maxsize: 100
+	    maxage: 168h
+	    maxbackups: 10
+	  sampling:
+	    debug: 100
+
 */
 package log
@@ -58,15 +58,33 @@ func formatCaller() zerolog.Formatter {
 	}
 }
 
+// AdapterFactory creates logger adapter with particular configuration.
+type AdapterFactory func(cfg configuration.Log) (insolar.Logger, error)
+
+var adapters = map[string]AdapterFactory{
+	"zerolog": func(cfg configuration.Log) (insolar.Logger, error) {
+		return newZerologAdapter(cfg)
+	},
+	"memory": func(cfg configuration.Log) (insolar.Logger, error) {
+		return NewMemoryLogger(), nil
+	},
+}
+
+// RegisterAdapter makes adapter available by name in logger configuration.
+// It should be called before loggers creation, e.g. from init function.
+func RegisterAdapter(name string, factory AdapterFactory) {
+	adapters[strings.ToLower(name)] = factory
+}
+
 // NewLog creates logger instance with particular configuration
 func NewLog(cfg configuration.Log) (insolar.Logger, error) {
 	var logger insolar.Logger
 	var err error
 
-	switch strings.ToLower(cfg.Adapter) {
-	case "zerolog":
-		logger, err = newZerologAdapter(cfg)
-	default:
+	factory, ok := adapters[strings.ToLower(cfg.Adapter)]
+	if ok {
+		logger, err = factory(cfg)
+	} else {
 		err = errors.New("unknown adapter")
 	}
 
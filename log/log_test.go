@@ -136,6 +136,8 @@ func TestLog_NewLog_Config(t *testing.T) {
 		"InvalidAdapter":   configuration.Log{Level: "Debug", Adapter: "invalid", Formatter: "text"},
 		"InvalidLevel":     configuration.Log{Level: "Invalid", Adapter: "zerolog", Formatter: "text"},
 		"InvalidFormatter": configuration.Log{Level: "Debug", Adapter: "zerolog", Formatter: "invalid"},
+		"InvalidOutput":    configuration.Log{Level: "Debug", Adapter: "zerolog", Formatter: "text", OutputType: "invalid"},
+		"NoLogFile":        configuration.Log{Level: "Debug", Adapter: "zerolog", Formatter: "text", OutputType: "file"},
 	}
 
 	for name, test := range invalidtests {
@@ -147,7 +149,8 @@ func TestLog_NewLog_Config(t *testing.T) {
 	}
 
 	validtests := map[string]configuration.Log{
-		"WithAdapter": configuration.Log{Level: "Debug", Adapter: "zerolog", Formatter: "text"},
+		"WithAdapter":   configuration.Log{Level: "Debug", Adapter: "zerolog", Formatter: "text"},
+		"MemoryAdapter": configuration.Log{Level: "Debug", Adapter: "memory"},
 	}
 	for name, test := range validtests {
 		t.Run(name, func(t *testing.T) {
@@ -0,0 +1,232 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package log
+
+import (
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/insolar/insolar/insolar"
+)
+
+// Entry is a log record captured by MemoryLogger.
+type Entry struct {
+	Level   insolar.LogLevel
+	Message string
+	Fields  map[string]interface{}
+}
+
+type memoryStorage struct {
+	lock    sync.Mutex
+	entries []Entry
+}
+
+// MemoryLogger is a logger adapter which keeps records in memory. It's intended for tests which check
+// what was logged. Loggers derived from it by With* methods share the same storage.
+// Fatal and Panic records are stored and then panic, so test can recover from them.
+type MemoryLogger struct {
+	storage *memoryStorage
+	level   insolar.LogLevel
+	fields  map[string]interface{}
+	output  io.Writer
+}
+
+// NewMemoryLogger creates memory logger with Debug level.
+func NewMemoryLogger() *MemoryLogger {
+	return &MemoryLogger{
+		storage: &memoryStorage{},
+		level:   insolar.DebugLevel,
+		fields:  map[string]interface{}{},
+	}
+}
+
+// Entries returns copy of records logged so far.
+func (m *MemoryLogger) Entries() []Entry {
+	m.storage.lock.Lock()
+	defer m.storage.lock.Unlock()
+
+	entries := make([]Entry, len(m.storage.entries))
+	copy(entries, m.storage.entries)
+	return entries
+}
+
+// Reset removes all logged records.
+func (m *MemoryLogger) Reset() {
+	m.storage.lock.Lock()
+	defer m.storage.lock.Unlock()
+
+	m.storage.entries = nil
+}
+
+func (m *MemoryLogger) log(level insolar.LogLevel, msg string) {
+	if !m.Is(level) {
+		return
+	}
+
+	fields := make(map[string]interface{}, len(m.fields))
+	for k, v := range m.fields {
+		fields[k] = v
+	}
+
+	m.storage.lock.Lock()
+	m.storage.entries = append(m.storage.entries, Entry{Level: level, Message: msg, Fields: fields})
+	if m.output != nil {
+		_, _ = fmt.Fprintf(m.output, "%s %s %v\n", level, msg, fields)
+	}
+	m.storage.lock.Unlock()
+
+	if level == insolar.FatalLevel || level == insolar.PanicLevel {
+		panic(msg)
+	}
+}
+
+func (m *MemoryLogger) clone() *MemoryLogger {
+	mCopy := *m
+	mCopy.fields = make(map[string]interface{}, len(m.fields))
+	for k, v := range m.fields {
+		mCopy.fields[k] = v
+	}
+	return &mCopy
+}
+
+// WithLevel sets log level.
+func (m *MemoryLogger) WithLevel(level string) (insolar.Logger, error) {
+	levelNumber, err := insolar.ParseLevel(level)
+	if err != nil {
+		return nil, err
+	}
+	return m.WithLevelNumber(levelNumber)
+}
+
+// WithLevelNumber sets log level with constant.
+func (m *MemoryLogger) WithLevelNumber(level insolar.LogLevel) (insolar.Logger, error) {
+	if level == insolar.NoLevel {
+		return m, nil
+	}
+	mCopy := m.clone()
+	mCopy.level = level
+	return mCopy, nil
+}
+
+// WithFormat does nothing, records are kept unformatted.
+func (m *MemoryLogger) WithFormat(format insolar.LogFormat) (insolar.Logger, error) {
+	return m, nil
+}
+
+// WithCaller does nothing, memory logger doesn't compute caller.
+func (m *MemoryLogger) WithCaller(flag bool) insolar.Logger {
+	return m
+}
+
+// WithSkipFrameCount does nothing, memory logger doesn't compute caller.
+func (m *MemoryLogger) WithSkipFrameCount(delta int) insolar.Logger {
+	return m
+}
+
+// WithFuncName does nothing, memory logger doesn't compute caller.
+func (m *MemoryLogger) WithFuncName(flag bool) insolar.Logger {
+	return m
+}
+
+// WithOutput additionally writes records to w.
+func (m *MemoryLogger) WithOutput(w io.Writer) insolar.Logger {
+	mCopy := m.clone()
+	mCopy.output = w
+	return mCopy
+}
+
+// WithFields return copy of logger with predefined fields.
+func (m *MemoryLogger) WithFields(fields map[string]interface{}) insolar.Logger {
+	mCopy := m.clone()
+	for k, v := range fields {
+		mCopy.fields[k] = v
+	}
+	return mCopy
+}
+
+// WithField return copy of logger with predefined single field.
+func (m *MemoryLogger) WithField(key string, value interface{}) insolar.Logger {
+	mCopy := m.clone()
+	mCopy.fields[key] = value
+	return mCopy
+}
+
+// Is returns if passed log level is enabled.
+func (m *MemoryLogger) Is(level insolar.LogLevel) bool {
+	return level >= m.level
+}
+
+// Debug logs a message at level Debug.
+func (m *MemoryLogger) Debug(args ...interface{}) {
+	m.log(insolar.DebugLevel, fmt.Sprint(args...))
+}
+
+// Debugf formatted logs a message at level Debug.
+func (m *MemoryLogger) Debugf(format string, args ...interface{}) {
+	m.log(insolar.DebugLevel, fmt.Sprintf(format, args...))
+}
+
+// Info logs a message at level Info.
+func (m *MemoryLogger) Info(args ...interface{}) {
+	m.log(insolar.InfoLevel, fmt.Sprint(args...))
+}
+
+// Infof formatted logs a message at level Info.
+func (m *MemoryLogger) Infof(format string, args ...interface{}) {
+	m.log(insolar.InfoLevel, fmt.Sprintf(format, args...))
+}
+
+// Warn logs a message at level Warn.
+func (m *MemoryLogger) Warn(args ...interface{}) {
+	m.log(insolar.WarnLevel, fmt.Sprint(args...))
+}
+
+// Warnf formatted logs a message at level Warn.
+func (m *MemoryLogger) Warnf(format string, args ...interface{}) {
+	m.log(insolar.WarnLevel, fmt.Sprintf(format, args...))
+}
+
+// Error logs a message at level Error.
+func (m *MemoryLogger) Error(args ...interface{}) {
+	m.log(insolar.ErrorLevel, fmt.Sprint(args...))
+}
+
+// Errorf formatted logs a message at level Error.
+func (m *MemoryLogger) Errorf(format string, args ...interface{}) {
+	m.log(insolar.ErrorLevel, fmt.Sprintf(format, args...))
+}
+
+// Fatal logs a message at level Fatal and panics.
+func (m *MemoryLogger) Fatal(args ...interface{}) {
+	m.log(insolar.FatalLevel, fmt.Sprint(args...))
+}
+
+// Fatalf formatted logs a message at level Fatal and panics.
+func (m *MemoryLogger) Fatalf(format string, args ...interface{}) {
+	m.log(insolar.FatalLevel, fmt.Sprintf(format, args...))
+}
+
+// Panic logs a message at level Panic and panics.
+func (m *MemoryLogger) Panic(args ...interface{}) {
+	m.log(insolar.PanicLevel, fmt.Sprint(args...))
+}
+
+// Panicf formatted logs a message at level Panic and panics.
+func (m *MemoryLogger) Panicf(format string, args ...interface{}) {
+	m.log(insolar.PanicLevel, fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,50 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package log
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+)
+
+func TestMemoryLogger(t *testing.T) {
+	logger, err := NewLog(configuration.Log{Level: "info", Adapter: "memory"})
+	require.NoError(t, err)
+	mem := logger.(*MemoryLogger)
+
+	logger.Debug("skipped")
+	logger.WithField("key", "value").Infof("info %d", 1)
+	logger.Warn("warn")
+
+	entries := mem.Entries()
+	require.Len(t, entries, 2)
+	require.Equal(t, insolar.InfoLevel, entries[0].Level)
+	require.Equal(t, "info 1", entries[0].Message)
+	require.Equal(t, map[string]interface{}{"key": "value"}, entries[0].Fields)
+	require.Equal(t, insolar.WarnLevel, entries[1].Level)
+	require.Empty(t, entries[1].Fields)
+
+	require.Panics(t, func() { logger.Fatal("fatal") })
+	require.Len(t, mem.Entries(), 3)
+
+	mem.Reset()
+	require.Empty(t, mem.Entries())
+}
@@ -0,0 +1,58 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package log
+
+import (
+	"io"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/configuration"
+)
+
+// Output types supported by logger configuration.
+const (
+	StdErrOutput = "stderr"
+	FileOutput   = "file"
+	SyslogOutput = "syslog"
+)
+
+const megabyte = 1024 * 1024
+
+// newOutput creates destination of log records from configuration.
+func newOutput(cfg configuration.Log) (io.Writer, error) {
+	switch strings.ToLower(cfg.OutputType) {
+	case "", StdErrOutput:
+		return os.Stderr, nil
+	case FileOutput:
+		if cfg.OutputParams == "" {
+			return nil, errors.New("path to log file is not set")
+		}
+		return newRotatingWriter(
+			cfg.OutputParams,
+			int64(cfg.Rotation.MaxSize)*megabyte,
+			cfg.Rotation.MaxAge,
+			cfg.Rotation.MaxBackups,
+		)
+	case SyslogOutput:
+		return newSyslogWriter(cfg.OutputParams)
+	default:
+		return nil, errors.New("unknown output type " + cfg.OutputType)
+	}
+}
@@ -0,0 +1,156 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package log
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const backupTimeFormat = "2006-01-02T15-04-05.000000000"
+
+// rotatingWriter writes log records to file and rotates it when file size exceeds the limit.
+// Rotated files are renamed to "<path>.<timestamp>" and removed according to age and count limits.
+type rotatingWriter struct {
+	path       string
+	maxSize    int64
+	maxAge     time.Duration
+	maxBackups int
+
+	lock sync.Mutex
+	file *os.File
+	size int64
+	now  func() time.Time
+}
+
+func newRotatingWriter(path string, maxSize int64, maxAge time.Duration, maxBackups int) (*rotatingWriter, error) {
+	w := &rotatingWriter{
+		path:       path,
+		maxSize:    maxSize,
+		maxAge:     maxAge,
+		maxBackups: maxBackups,
+		now:        time.Now,
+	}
+	err := w.open()
+	if err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// Write implements io.Writer.
+func (w *rotatingWriter) Write(p []byte) (int, error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	if w.maxSize > 0 && w.size > 0 && w.size+int64(len(p)) > w.maxSize {
+		err := w.rotate()
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := w.file.Write(p)
+	w.size += int64(n)
+	return n, err
+}
+
+// Close closes current log file.
+func (w *rotatingWriter) Close() error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	return w.file.Close()
+}
+
+func (w *rotatingWriter) open() error {
+	err := os.MkdirAll(filepath.Dir(w.path), 0755)
+	if err != nil {
+		return errors.Wrap(err, "failed to create log directory")
+	}
+	file, err := os.OpenFile(w.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return errors.Wrap(err, "failed to open log file")
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return errors.Wrap(err, "failed to stat log file")
+	}
+
+	w.file = file
+	w.size = info.Size()
+	return nil
+}
+
+func (w *rotatingWriter) rotate() error {
+	err := w.file.Close()
+	if err != nil {
+		return errors.Wrap(err, "failed to close log file")
+	}
+
+	backup := fmt.Sprintf("%s.%s", w.path, w.now().UTC().Format(backupTimeFormat))
+	err = os.Rename(w.path, backup)
+	if err != nil {
+		return errors.Wrap(err, "failed to rename log file")
+	}
+
+	err = w.open()
+	if err != nil {
+		return err
+	}
+	return w.removeOutdated()
+}
+
+// removeOutdated removes rotated files exceeding age and count limits.
+func (w *rotatingWriter) removeOutdated() error {
+	if w.maxAge <= 0 && w.maxBackups <= 0 {
+		return nil
+	}
+
+	backups, err := filepath.Glob(w.path + ".*")
+	if err != nil {
+		return errors.Wrap(err, "failed to list rotated log files")
+	}
+	// Timestamp suffix makes lexical order chronological, newest files go first.
+	sort.Sort(sort.Reverse(sort.StringSlice(backups)))
+
+	kept := 0
+	for _, backup := range backups {
+		created, err := time.Parse(backupTimeFormat, strings.TrimPrefix(backup, w.path+"."))
+		if err != nil {
+			// Not a file rotated by us.
+			continue
+		}
+		if (w.maxBackups > 0 && kept >= w.maxBackups) || (w.maxAge > 0 && w.now().UTC().Sub(created) > w.maxAge) {
+			err = os.Remove(backup)
+			if err != nil {
+				return errors.Wrap(err, "failed to remove rotated log file")
+			}
+			continue
+		}
+		kept++
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package log
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRotatingWriter(t *testing.T) {
+	dir, err := ioutil.TempDir("", "log")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "insolard.log")
+	w, err := newRotatingWriter(path, 10, 0, 2)
+	require.NoError(t, err)
+	defer w.Close()
+
+	now := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+	w.now = func() time.Time {
+		now = now.Add(time.Second)
+		return now
+	}
+
+	for i := 0; i < 5; i++ {
+		_, err = w.Write([]byte("0123456789"))
+		require.NoError(t, err)
+	}
+
+	backups, err := filepath.Glob(path + ".*")
+	require.NoError(t, err)
+	require.Len(t, backups, 2, "only MaxBackups rotated files should be kept")
+
+	data, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "0123456789", string(data))
+}
+
+func TestRotatingWriter_MaxAge(t *testing.T) {
+	dir, err := ioutil.TempDir("", "log")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "insolard.log")
+	w, err := newRotatingWriter(path, 10, time.Hour, 0)
+	require.NoError(t, err)
+	defer w.Close()
+
+	now := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+	w.now = func() time.Time { return now }
+
+	_, err = w.Write([]byte("0123456789"))
+	require.NoError(t, err)
+	_, err = w.Write([]byte("0123456789"))
+	require.NoError(t, err)
+
+	backups, err := filepath.Glob(path + ".*")
+	require.NoError(t, err)
+	require.Len(t, backups, 1)
+
+	now = now.Add(2 * time.Hour)
+	_, err = w.Write([]byte("0123456789"))
+	require.NoError(t, err)
+
+	backups, err = filepath.Glob(path + ".*")
+	require.NoError(t, err)
+	require.Len(t, backups, 1, "outdated file should be removed")
+	require.Equal(t, path+"."+now.Format(backupTimeFormat), backups[0])
+}
@@ -0,0 +1,50 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// +build !windows
+
+package log
+
+import (
+	"io"
+	"log/syslog"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+const syslogTag = "insolar"
+
+// newSyslogWriter connects to syslog daemon. Empty address means local syslog,
+// remote one is set as "network://address", e.g. "udp://127.0.0.1:514".
+func newSyslogWriter(address string) (io.Writer, error) {
+	var network, raddr string
+	if address != "" {
+		parts := strings.SplitN(address, "://", 2)
+		if len(parts) != 2 {
+			return nil, errors.New("syslog address should be in form network://address")
+		}
+		network, raddr = parts[0], parts[1]
+	}
+
+	w, err := syslog.Dial(network, raddr, syslog.LOG_INFO|syslog.LOG_DAEMON, syslogTag)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to connect to syslog")
+	}
+	// Level writer maps log levels to syslog priorities.
+	return zerolog.SyslogLevelWriter(w), nil
+}
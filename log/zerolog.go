@@ -20,7 +20,6 @@ import (
 	"fmt"
 	"io"
 	"net/http"
-	"os"
 	"strconv"
 	"strings"
 
@@ -56,6 +55,8 @@ type zerologAdapter struct {
 	logger       zerolog.Logger
 	level        zerolog.Level
 	callerConfig callerHookConfig
+	// output is a destination of records before formatting.
+	output io.Writer
 }
 
 type loglevelChangeHandler struct {
@@ -111,9 +112,9 @@ func InternalLevelToZerologLevel(level insolar.LogLevel) (zerolog.Level, error)
 	return zerolog.NoLevel, errors.New("Unknown internal level")
 }
 
-func newDefaultTextOutput() io.Writer {
+func newDefaultTextOutput(out io.Writer) io.Writer {
 	return zerolog.ConsoleWriter{
-		Out:          os.Stderr,
+		Out:          out,
 		NoColor:      true,
 		TimeFormat:   timestampFormat,
 		PartsOrder:   fieldsOrder,
@@ -121,14 +122,14 @@ func newDefaultTextOutput() io.Writer {
 	}
 }
 
-func selectFormatter(format insolar.LogFormat) (io.Writer, error) {
+func selectFormatter(format insolar.LogFormat, out io.Writer) (io.Writer, error) {
 	var output io.Writer
 
 	switch format {
 	case insolar.TextFormat:
-		output = newDefaultTextOutput()
+		output = newDefaultTextOutput(out)
 	case insolar.JSONFormat:
-		output = os.Stderr
+		output = out
 	default:
 		return nil, errors.New("unknown formatter " + format.String())
 	}
@@ -136,18 +137,47 @@ func selectFormatter(format insolar.LogFormat) (io.Writer, error) {
 	return output, nil
 }
 
+// newSampler creates sampler which writes only every N-th record of level, it returns nil if sampling is disabled.
+func newSampler(cfg configuration.LogSampling) zerolog.Sampler {
+	levelSampler := func(n uint32) zerolog.Sampler {
+		if n <= 1 {
+			return nil
+		}
+		return &zerolog.BasicSampler{N: n}
+	}
+
+	sampler := zerolog.LevelSampler{
+		DebugSampler: levelSampler(cfg.Debug),
+		InfoSampler:  levelSampler(cfg.Info),
+		WarnSampler:  levelSampler(cfg.Warn),
+		ErrorSampler: levelSampler(cfg.Error),
+	}
+	if sampler == (zerolog.LevelSampler{}) {
+		return nil
+	}
+	return sampler
+}
+
 func newZerologAdapter(cfg configuration.Log) (*zerologAdapter, error) {
 	format, err := insolar.ParseFormat(cfg.Formatter)
 	if err != nil {
 		return nil, err
 	}
 
-	output, err := selectFormatter(format)
+	out, err := newOutput(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := selectFormatter(format, out)
 	if err != nil {
 		return nil, err
 	}
 
 	logger := zerolog.New(output).Level(zerolog.InfoLevel).With().Timestamp().Logger()
+	if sampler := newSampler(cfg.Sampling); sampler != nil {
+		logger = logger.Sample(sampler)
+	}
 	za := &zerologAdapter{
 		logger: logger,
 		level:  zerolog.InfoLevel,
@@ -155,6 +185,7 @@ func newZerologAdapter(cfg configuration.Log) (*zerologAdapter, error) {
 			enabled:        true,
 			skipFrameCount: defaultCallerSkipFrameCount,
 		},
+		output: out,
 	}
 	return za, nil
 }
@@ -168,6 +199,7 @@ func (z *zerologAdapter) WithFields(fields map[string]interface{}) insolar.Logge
 	return &zerologAdapter{
 		logger:       zCtx.Logger(),
 		callerConfig: z.callerConfig,
+		output:       z.output,
 	}
 }
 
@@ -176,6 +208,7 @@ func (z *zerologAdapter) WithField(key string, value interface{}) insolar.Logger
 	return &zerologAdapter{
 		logger:       z.logger.With().Interface(key, value).Logger(),
 		callerConfig: z.callerConfig,
+		output:       z.output,
 	}
 }
 
@@ -267,6 +300,7 @@ func (z *zerologAdapter) WithLevelNumber(level insolar.LogLevel) (insolar.Logger
 func (z *zerologAdapter) WithOutput(w io.Writer) insolar.Logger {
 	zCopy := *z
 	zCopy.logger = z.logger.Output(w)
+	zCopy.output = w
 	return &zCopy
 }
 
@@ -297,12 +331,14 @@ func (z *zerologAdapter) WithFuncName(flag bool) insolar.Logger {
 
 // WithFormat sets logger output format
 func (z *zerologAdapter) WithFormat(format insolar.LogFormat) (insolar.Logger, error) {
-	output, err := selectFormatter(format)
+	output, err := selectFormatter(format, z.output)
 	if err != nil {
 		return nil, err
 	}
 
-	return z.WithOutput(output), nil
+	zCopy := *z
+	zCopy.logger = z.logger.Output(output)
+	return &zCopy, nil
 }
 
 func (z *zerologAdapter) loggerWithHooks() *zerolog.Logger {
@@ -18,6 +18,10 @@ package log
 
 import (
 	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -35,5 +39,48 @@ func TestZeroLogAdapter_CallerInfo(t *testing.T) {
 
 	log.Error("test")
 
-	require.Contains(t, buf.String(), "zerolog_test.go:36")
+	require.Contains(t, buf.String(), "zerolog_test.go:40")
+}
+
+func TestZeroLogAdapter_Sampling(t *testing.T) {
+	log, err := NewLog(configuration.Log{
+		Level:     "debug",
+		Adapter:   "zerolog",
+		Formatter: "json",
+		Sampling:  configuration.LogSampling{Debug: 3},
+	})
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	log = log.WithOutput(&buf)
+
+	for i := 0; i < 9; i++ {
+		log.Debug("sampled")
+		log.Info("not sampled")
+	}
+
+	require.Equal(t, 3, strings.Count(buf.String(), "\"sampled\""))
+	require.Equal(t, 9, strings.Count(buf.String(), "not sampled"))
+}
+
+func TestZeroLogAdapter_FileOutput(t *testing.T) {
+	dir, err := ioutil.TempDir("", "log")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "insolard.log")
+	log, err := NewLog(configuration.Log{
+		Level:        "info",
+		Adapter:      "zerolog",
+		Formatter:    "text",
+		OutputType:   FileOutput,
+		OutputParams: path,
+	})
+	require.NoError(t, err)
+
+	log.Info("to file")
+
+	data, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	require.Contains(t, string(data), "to file")
 }
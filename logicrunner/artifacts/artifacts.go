@@ -38,6 +38,9 @@ type Client interface {
 	// GetIncomingRequest returns an incoming request for an object.
 	GetIncomingRequest(ctx context.Context, objectRef, reqRef insolar.Reference) (*record.IncomingRequest, error)
 
+	// GetResult returns result record of the request, result is nil if the request is still pending.
+	GetResult(ctx context.Context, objectRef, reqRef insolar.Reference) (*record.Result, error)
+
 	// GetPendings returns pending request IDs of an object.
 	GetPendings(ctx context.Context, objectRef insolar.Reference) ([]insolar.Reference, error)
 
@@ -49,6 +52,12 @@ type Client interface {
 	// When fetching object, validity can be specified.
 	RegisterValidation(ctx context.Context, object insolar.Reference, state insolar.ID, isValid bool, validationMessages []insolar.Message) error
 
+	// RegisterVerdict saves signed validator verdict about request execution to ledger.
+	RegisterVerdict(ctx context.Context, verdict record.Validation) (*insolar.ID, error)
+
+	// GetVerdict returns validator verdict saved by RegisterVerdict.
+	GetVerdict(ctx context.Context, id insolar.ID) (*record.Validation, error)
+
 	// GetCode returns code from code record by provided reference according to provided machine preference.
 	//
 	// This method is used by VM to fetch code for execution.
@@ -60,6 +69,11 @@ type Client interface {
 	// provide methods for fetching all related data.
 	GetObject(ctx context.Context, head insolar.Reference) (ObjectDescriptor, error)
 
+	// GetObjectState returns descriptor for provided state of the object.
+	//
+	// It's used to get the object as it was before some request, e.g. during validation.
+	GetObjectState(ctx context.Context, head insolar.Reference, state insolar.ID) (ObjectDescriptor, error)
+
 	// GetDelegate returns provided object's delegate reference for provided type.
 	//
 	// Object delegate should be previously created for this object. If object delegate does not exist, an error will
@@ -190,4 +204,14 @@ type RequestResult interface {
 
 	Result() []byte
 	ObjectReference() insolar.Reference
+
+	// Usage returns execution budget spent on the request, it's saved in the result record.
+	Usage() ExecutionUsage
+}
+
+// ExecutionUsage is a part of execution budget spent by a request.
+type ExecutionUsage struct {
+	RouteCalls       uint32
+	SaveAsChildCalls uint32
+	StateSize        uint64
 }
@@ -291,14 +291,34 @@ func (m *client) GetObject(
 	ctx context.Context,
 	head insolar.Reference,
 ) (ObjectDescriptor, error) {
-	var (
-		err error
-	)
-
 	if desc := m.localStorage.Object(head); desc != nil {
 		return desc, nil
 	}
 
+	return m.getObject(ctx, head, insolar.ID{})
+}
+
+// GetObjectState returns descriptor for provided state of the object.
+func (m *client) GetObjectState(
+	ctx context.Context,
+	head insolar.Reference,
+	state insolar.ID,
+) (ObjectDescriptor, error) {
+	if state.IsEmpty() {
+		return nil, errors.New("state is empty")
+	}
+	return m.getObject(ctx, head, state)
+}
+
+func (m *client) getObject(
+	ctx context.Context,
+	head insolar.Reference,
+	stateID insolar.ID,
+) (ObjectDescriptor, error) {
+	var (
+		err error
+	)
+
 	ctx, span := instracer.StartSpan(ctx, "artifactmanager.Getobject")
 	instrumenter := instrument(ctx, "GetObject").err(&err)
 	defer func() {
@@ -316,6 +336,7 @@ func (m *client) GetObject(
 
 	msg, err := payload.NewMessage(&payload.GetObject{
 		ObjectID: *head.Record(),
+		StateID:  stateID,
 	})
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to marshal message")
@@ -382,10 +403,13 @@ func (m *client) GetObject(
 		return nil, errors.New("wrong state record")
 	}
 	state := s
+	if stateID.IsEmpty() {
+		stateID = *index.LatestState
+	}
 
 	desc := &objectDescriptor{
 		head:         head,
-		state:        *index.LatestState,
+		state:        stateID,
 		prototype:    state.GetImage(),
 		isPrototype:  state.GetIsPrototype(),
 		childPointer: index.ChildPointer,
@@ -446,6 +470,66 @@ func (m *client) GetIncomingRequest(
 	return castedRecord, nil
 }
 
+// GetResult returns result record of the request, result is nil if the request is still pending.
+func (m *client) GetResult(
+	ctx context.Context, object, reqRef insolar.Reference,
+) (*record.Result, error) {
+	var err error
+	instrumenter := instrument(ctx, "GetResult").err(&err)
+	ctx, span := instracer.StartSpan(ctx, "artifactmanager.GetResult")
+	defer func() {
+		if err != nil {
+			span.AddAttributes(trace.StringAttribute("error", err.Error()))
+		}
+		span.End()
+		instrumenter.end()
+	}()
+
+	msg, err := payload.NewMessage(&payload.GetResult{
+		ObjectID:  *object.Record(),
+		RequestID: *reqRef.Record(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create a message")
+	}
+
+	reps, done := m.sender.SendRole(ctx, msg, insolar.DynamicRoleLightExecutor, object)
+	defer done()
+	res, ok := <-reps
+	if !ok {
+		return nil, errors.New("no reply while fetching result")
+	}
+
+	pl, err := payload.UnmarshalFromMeta(res.Payload)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal reply")
+	}
+
+	switch p := pl.(type) {
+	case *payload.ResultInfo:
+		if p.Result == nil {
+			return nil, nil
+		}
+		virtual := record.Virtual{}
+		err = virtual.Unmarshal(p.Result)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal result record")
+		}
+		result, ok := record.Unwrap(&virtual).(*record.Result)
+		if !ok {
+			return nil, fmt.Errorf("GetResult: unexpected record %T", record.Unwrap(&virtual))
+		}
+		return result, nil
+	case *payload.Error:
+		if p.Code == payload.CodeObjectNotFound {
+			return nil, ErrNotFound
+		}
+		return nil, errors.New(p.Text)
+	default:
+		return nil, fmt.Errorf("GetResult: unexpected reply %T", pl)
+	}
+}
+
 // GetPendings returns a list of pending requests
 func (m *client) GetPendings(ctx context.Context, object insolar.Reference) ([]insolar.Reference, error) {
 	var err error
@@ -629,7 +713,96 @@ func (m *client) DeployCode(
 		Code:        code,
 		MachineType: machineType,
 	}
-	virtual := record.Wrap(codeRec)
+	id, err := m.setRecord(ctx, currentPN, codeRec)
+	return id, err
+}
+
+// RegisterVerdict saves signed validator verdict about request execution to ledger.
+//
+// Verdict is a standalone record, it refers to the object and the request it's made for.
+func (m *client) RegisterVerdict(ctx context.Context, verdict record.Validation) (*insolar.ID, error) {
+	var err error
+	ctx, span := instracer.StartSpan(ctx, "artifactmanager.RegisterVerdict")
+	instrumenter := instrument(ctx, "RegisterVerdict").err(&err)
+	defer func() {
+		if err != nil {
+			span.AddAttributes(trace.StringAttribute("error", err.Error()))
+		}
+		span.End()
+		instrumenter.end()
+	}()
+
+	currentPN, err := m.pulse(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := m.setRecord(ctx, currentPN, verdict)
+	return id, err
+}
+
+// GetVerdict returns validator verdict saved by RegisterVerdict.
+func (m *client) GetVerdict(ctx context.Context, id insolar.ID) (*record.Validation, error) {
+	var err error
+	ctx, span := instracer.StartSpan(ctx, "artifactmanager.GetVerdict")
+	instrumenter := instrument(ctx, "GetVerdict").err(&err)
+	defer func() {
+		if err != nil {
+			span.AddAttributes(trace.StringAttribute("error", err.Error()))
+		}
+		span.End()
+		instrumenter.end()
+	}()
+
+	// light node serves standalone records by ID regardless of their type
+	msg, err := payload.NewMessage(&payload.GetCode{
+		CodeID: id,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal message")
+	}
+
+	r := bus.NewRetrySender(m.sender, 3)
+	reps, done := r.SendRole(ctx, msg, insolar.DynamicRoleLightExecutor, *insolar.NewReference(id))
+	defer done()
+
+	rep, ok := <-reps
+	if !ok {
+		err = ErrNoReply
+		return nil, err
+	}
+
+	pl, err := payload.UnmarshalFromMeta(rep.Payload)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal reply")
+	}
+
+	switch p := pl.(type) {
+	case *payload.Code:
+		rec := record.Material{}
+		err = rec.Unmarshal(p.Record)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal record")
+		}
+		virtual := record.Unwrap(rec.Virtual)
+		verdict, ok := virtual.(*record.Validation)
+		if !ok {
+			err = fmt.Errorf("GetVerdict: unexpected record %T", virtual)
+			return nil, err
+		}
+		return verdict, nil
+	case *payload.Error:
+		err = errors.New(p.Text)
+		return nil, err
+	default:
+		err = fmt.Errorf("GetVerdict: unexpected reply: %#v", p)
+		return nil, err
+	}
+}
+
+// setRecord saves standalone record to ledger, record is stored on light node responsible for its ID.
+func (m *client) setRecord(ctx context.Context, pn insolar.PulseNumber, rec record.Record) (*insolar.ID, error) {
+	virtual := record.Wrap(rec)
 	buf, err := virtual.Marshal()
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to marshal record")
@@ -640,7 +813,7 @@ func (m *client) DeployCode(
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to calculate hash")
 	}
-	recID := *insolar.NewID(currentPN, h.Sum(nil))
+	recID := *insolar.NewID(pn, h.Sum(nil))
 
 	psc := &payload.SetCode{
 		Record: buf,
@@ -656,7 +829,7 @@ func (m *client) DeployCode(
 	case *payload.Error:
 		return nil, errors.New(p.Text)
 	default:
-		return nil, fmt.Errorf("DeployCode: unexpected reply: %#v", p)
+		return nil, fmt.Errorf("setRecord: unexpected reply: %#v", p)
 	}
 }
 
@@ -954,10 +1127,15 @@ func (m *client) RegisterResult(
 	span.AddAttributes(trace.StringAttribute("SideEffect", result.Type().String()))
 
 	objReference := result.ObjectReference()
+	usage := result.Usage()
 	resultRecord := record.Result{
 		Object:  *objReference.Record(),
 		Request: request,
 		Payload: result.Result(),
+
+		RouteCalls:       usage.RouteCalls,
+		SaveAsChildCalls: usage.SaveAsChildCalls,
+		StateSize:        usage.StateSize,
 	}
 
 	switch result.Type() {
@@ -56,11 +56,26 @@ type ClientMock struct {
 	GetObjectPreCounter uint64
 	GetObjectMock       mClientMockGetObject
 
+	GetObjectStateFunc       func(p context.Context, p1 insolar.Reference, p2 insolar.ID) (r ObjectDescriptor, r1 error)
+	GetObjectStateCounter    uint64
+	GetObjectStatePreCounter uint64
+	GetObjectStateMock       mClientMockGetObjectState
+
 	GetPendingsFunc       func(p context.Context, p1 insolar.Reference) (r []insolar.Reference, r1 error)
 	GetPendingsCounter    uint64
 	GetPendingsPreCounter uint64
 	GetPendingsMock       mClientMockGetPendings
 
+	GetResultFunc       func(p context.Context, p1 insolar.Reference, p2 insolar.Reference) (r *record.Result, r1 error)
+	GetResultCounter    uint64
+	GetResultPreCounter uint64
+	GetResultMock       mClientMockGetResult
+
+	GetVerdictFunc       func(p context.Context, p1 insolar.ID) (r *record.Validation, r1 error)
+	GetVerdictCounter    uint64
+	GetVerdictPreCounter uint64
+	GetVerdictMock       mClientMockGetVerdict
+
 	HasPendingRequestsFunc       func(p context.Context, p1 insolar.Reference) (r bool, r1 error)
 	HasPendingRequestsCounter    uint64
 	HasPendingRequestsPreCounter uint64
@@ -101,6 +116,11 @@ type ClientMock struct {
 	RegisterValidationPreCounter uint64
 	RegisterValidationMock       mClientMockRegisterValidation
 
+	RegisterVerdictFunc       func(p context.Context, p1 record.Validation) (r *insolar.ID, r1 error)
+	RegisterVerdictCounter    uint64
+	RegisterVerdictPreCounter uint64
+	RegisterVerdictMock       mClientMockRegisterVerdict
+
 	StateFunc       func() (r []byte)
 	StateCounter    uint64
 	StatePreCounter uint64
@@ -122,7 +142,10 @@ func NewClientMock(t minimock.Tester) *ClientMock {
 	m.GetDelegateMock = mClientMockGetDelegate{mock: m}
 	m.GetIncomingRequestMock = mClientMockGetIncomingRequest{mock: m}
 	m.GetObjectMock = mClientMockGetObject{mock: m}
+	m.GetObjectStateMock = mClientMockGetObjectState{mock: m}
 	m.GetPendingsMock = mClientMockGetPendings{mock: m}
+	m.GetResultMock = mClientMockGetResult{mock: m}
+	m.GetVerdictMock = mClientMockGetVerdict{mock: m}
 	m.HasPendingRequestsMock = mClientMockHasPendingRequests{mock: m}
 	m.InjectCodeDescriptorMock = mClientMockInjectCodeDescriptor{mock: m}
 	m.InjectFinishMock = mClientMockInjectFinish{mock: m}
@@ -131,6 +154,7 @@ func NewClientMock(t minimock.Tester) *ClientMock {
 	m.RegisterOutgoingRequestMock = mClientMockRegisterOutgoingRequest{mock: m}
 	m.RegisterResultMock = mClientMockRegisterResult{mock: m}
 	m.RegisterValidationMock = mClientMockRegisterValidation{mock: m}
+	m.RegisterVerdictMock = mClientMockRegisterVerdict{mock: m}
 	m.StateMock = mClientMockState{mock: m}
 
 	return m
@@ -1199,6 +1223,158 @@ func (m *ClientMock) GetObjectFinished() bool {
 	return true
 }
 
+type mClientMockGetObjectState struct {
+	mock              *ClientMock
+	mainExpectation   *ClientMockGetObjectStateExpectation
+	expectationSeries []*ClientMockGetObjectStateExpectation
+}
+
+type ClientMockGetObjectStateExpectation struct {
+	input  *ClientMockGetObjectStateInput
+	result *ClientMockGetObjectStateResult
+}
+
+type ClientMockGetObjectStateInput struct {
+	p  context.Context
+	p1 insolar.Reference
+	p2 insolar.ID
+}
+
+type ClientMockGetObjectStateResult struct {
+	r  ObjectDescriptor
+	r1 error
+}
+
+//Expect specifies that invocation of Client.GetObjectState is expected from 1 to Infinity times
+func (m *mClientMockGetObjectState) Expect(p context.Context, p1 insolar.Reference, p2 insolar.ID) *mClientMockGetObjectState {
+	m.mock.GetObjectStateFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ClientMockGetObjectStateExpectation{}
+	}
+	m.mainExpectation.input = &ClientMockGetObjectStateInput{p, p1, p2}
+	return m
+}
+
+//Return specifies results of invocation of Client.GetObjectState
+func (m *mClientMockGetObjectState) Return(r ObjectDescriptor, r1 error) *ClientMock {
+	m.mock.GetObjectStateFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ClientMockGetObjectStateExpectation{}
+	}
+	m.mainExpectation.result = &ClientMockGetObjectStateResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of Client.GetObjectState is expected once
+func (m *mClientMockGetObjectState) ExpectOnce(p context.Context, p1 insolar.Reference, p2 insolar.ID) *ClientMockGetObjectStateExpectation {
+	m.mock.GetObjectStateFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &ClientMockGetObjectStateExpectation{}
+	expectation.input = &ClientMockGetObjectStateInput{p, p1, p2}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *ClientMockGetObjectStateExpectation) Return(r ObjectDescriptor, r1 error) {
+	e.result = &ClientMockGetObjectStateResult{r, r1}
+}
+
+//Set uses given function f as a mock of Client.GetObjectState method
+func (m *mClientMockGetObjectState) Set(f func(p context.Context, p1 insolar.Reference, p2 insolar.ID) (r ObjectDescriptor, r1 error)) *ClientMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.GetObjectStateFunc = f
+	return m.mock
+}
+
+//GetObjectState implements github.com/insolar/insolar/logicrunner/artifacts.Client interface
+func (m *ClientMock) GetObjectState(p context.Context, p1 insolar.Reference, p2 insolar.ID) (r ObjectDescriptor, r1 error) {
+	counter := atomic.AddUint64(&m.GetObjectStatePreCounter, 1)
+	defer atomic.AddUint64(&m.GetObjectStateCounter, 1)
+
+	if len(m.GetObjectStateMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.GetObjectStateMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to ClientMock.GetObjectState. %v %v %v", p, p1, p2)
+			return
+		}
+
+		input := m.GetObjectStateMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, ClientMockGetObjectStateInput{p, p1, p2}, "Client.GetObjectState got unexpected parameters")
+
+		result := m.GetObjectStateMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the ClientMock.GetObjectState")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.GetObjectStateMock.mainExpectation != nil {
+
+		input := m.GetObjectStateMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, ClientMockGetObjectStateInput{p, p1, p2}, "Client.GetObjectState got unexpected parameters")
+		}
+
+		result := m.GetObjectStateMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the ClientMock.GetObjectState")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.GetObjectStateFunc == nil {
+		m.t.Fatalf("Unexpected call to ClientMock.GetObjectState. %v %v %v", p, p1, p2)
+		return
+	}
+
+	return m.GetObjectStateFunc(p, p1, p2)
+}
+
+//GetObjectStateMinimockCounter returns a count of ClientMock.GetObjectStateFunc invocations
+func (m *ClientMock) GetObjectStateMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.GetObjectStateCounter)
+}
+
+//GetObjectStateMinimockPreCounter returns the value of ClientMock.GetObjectState invocations
+func (m *ClientMock) GetObjectStateMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.GetObjectStatePreCounter)
+}
+
+//GetObjectStateFinished returns true if mock invocations count is ok
+func (m *ClientMock) GetObjectStateFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.GetObjectStateMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.GetObjectStateCounter) == uint64(len(m.GetObjectStateMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.GetObjectStateMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.GetObjectStateCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.GetObjectStateFunc != nil {
+		return atomic.LoadUint64(&m.GetObjectStateCounter) > 0
+	}
+
+	return true
+}
+
 type mClientMockGetPendings struct {
 	mock              *ClientMock
 	mainExpectation   *ClientMockGetPendingsExpectation
@@ -1350,6 +1526,309 @@ func (m *ClientMock) GetPendingsFinished() bool {
 	return true
 }
 
+type mClientMockGetResult struct {
+	mock              *ClientMock
+	mainExpectation   *ClientMockGetResultExpectation
+	expectationSeries []*ClientMockGetResultExpectation
+}
+
+type ClientMockGetResultExpectation struct {
+	input  *ClientMockGetResultInput
+	result *ClientMockGetResultResult
+}
+
+type ClientMockGetResultInput struct {
+	p  context.Context
+	p1 insolar.Reference
+	p2 insolar.Reference
+}
+
+type ClientMockGetResultResult struct {
+	r  *record.Result
+	r1 error
+}
+
+//Expect specifies that invocation of Client.GetResult is expected from 1 to Infinity times
+func (m *mClientMockGetResult) Expect(p context.Context, p1 insolar.Reference, p2 insolar.Reference) *mClientMockGetResult {
+	m.mock.GetResultFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ClientMockGetResultExpectation{}
+	}
+	m.mainExpectation.input = &ClientMockGetResultInput{p, p1, p2}
+	return m
+}
+
+//Return specifies results of invocation of Client.GetResult
+func (m *mClientMockGetResult) Return(r *record.Result, r1 error) *ClientMock {
+	m.mock.GetResultFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ClientMockGetResultExpectation{}
+	}
+	m.mainExpectation.result = &ClientMockGetResultResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of Client.GetResult is expected once
+func (m *mClientMockGetResult) ExpectOnce(p context.Context, p1 insolar.Reference, p2 insolar.Reference) *ClientMockGetResultExpectation {
+	m.mock.GetResultFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &ClientMockGetResultExpectation{}
+	expectation.input = &ClientMockGetResultInput{p, p1, p2}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *ClientMockGetResultExpectation) Return(r *record.Result, r1 error) {
+	e.result = &ClientMockGetResultResult{r, r1}
+}
+
+//Set uses given function f as a mock of Client.GetResult method
+func (m *mClientMockGetResult) Set(f func(p context.Context, p1 insolar.Reference, p2 insolar.Reference) (r *record.Result, r1 error)) *ClientMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.GetResultFunc = f
+	return m.mock
+}
+
+//GetResult implements github.com/insolar/insolar/logicrunner/artifacts.Client interface
+func (m *ClientMock) GetResult(p context.Context, p1 insolar.Reference, p2 insolar.Reference) (r *record.Result, r1 error) {
+	counter := atomic.AddUint64(&m.GetResultPreCounter, 1)
+	defer atomic.AddUint64(&m.GetResultCounter, 1)
+
+	if len(m.GetResultMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.GetResultMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to ClientMock.GetResult. %v %v %v", p, p1, p2)
+			return
+		}
+
+		input := m.GetResultMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, ClientMockGetResultInput{p, p1, p2}, "Client.GetResult got unexpected parameters")
+
+		result := m.GetResultMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the ClientMock.GetResult")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.GetResultMock.mainExpectation != nil {
+
+		input := m.GetResultMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, ClientMockGetResultInput{p, p1, p2}, "Client.GetResult got unexpected parameters")
+		}
+
+		result := m.GetResultMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the ClientMock.GetResult")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.GetResultFunc == nil {
+		m.t.Fatalf("Unexpected call to ClientMock.GetResult. %v %v %v", p, p1, p2)
+		return
+	}
+
+	return m.GetResultFunc(p, p1, p2)
+}
+
+//GetResultMinimockCounter returns a count of ClientMock.GetResultFunc invocations
+func (m *ClientMock) GetResultMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.GetResultCounter)
+}
+
+//GetResultMinimockPreCounter returns the value of ClientMock.GetResult invocations
+func (m *ClientMock) GetResultMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.GetResultPreCounter)
+}
+
+//GetResultFinished returns true if mock invocations count is ok
+func (m *ClientMock) GetResultFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.GetResultMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.GetResultCounter) == uint64(len(m.GetResultMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.GetResultMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.GetResultCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.GetResultFunc != nil {
+		return atomic.LoadUint64(&m.GetResultCounter) > 0
+	}
+
+	return true
+}
+
+type mClientMockGetVerdict struct {
+	mock              *ClientMock
+	mainExpectation   *ClientMockGetVerdictExpectation
+	expectationSeries []*ClientMockGetVerdictExpectation
+}
+
+type ClientMockGetVerdictExpectation struct {
+	input  *ClientMockGetVerdictInput
+	result *ClientMockGetVerdictResult
+}
+
+type ClientMockGetVerdictInput struct {
+	p  context.Context
+	p1 insolar.ID
+}
+
+type ClientMockGetVerdictResult struct {
+	r  *record.Validation
+	r1 error
+}
+
+//Expect specifies that invocation of Client.GetVerdict is expected from 1 to Infinity times
+func (m *mClientMockGetVerdict) Expect(p context.Context, p1 insolar.ID) *mClientMockGetVerdict {
+	m.mock.GetVerdictFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ClientMockGetVerdictExpectation{}
+	}
+	m.mainExpectation.input = &ClientMockGetVerdictInput{p, p1}
+	return m
+}
+
+//Return specifies results of invocation of Client.GetVerdict
+func (m *mClientMockGetVerdict) Return(r *record.Validation, r1 error) *ClientMock {
+	m.mock.GetVerdictFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ClientMockGetVerdictExpectation{}
+	}
+	m.mainExpectation.result = &ClientMockGetVerdictResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of Client.GetVerdict is expected once
+func (m *mClientMockGetVerdict) ExpectOnce(p context.Context, p1 insolar.ID) *ClientMockGetVerdictExpectation {
+	m.mock.GetVerdictFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &ClientMockGetVerdictExpectation{}
+	expectation.input = &ClientMockGetVerdictInput{p, p1}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *ClientMockGetVerdictExpectation) Return(r *record.Validation, r1 error) {
+	e.result = &ClientMockGetVerdictResult{r, r1}
+}
+
+//Set uses given function f as a mock of Client.GetVerdict method
+func (m *mClientMockGetVerdict) Set(f func(p context.Context, p1 insolar.ID) (r *record.Validation, r1 error)) *ClientMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.GetVerdictFunc = f
+	return m.mock
+}
+
+//GetVerdict implements github.com/insolar/insolar/logicrunner/artifacts.Client interface
+func (m *ClientMock) GetVerdict(p context.Context, p1 insolar.ID) (r *record.Validation, r1 error) {
+	counter := atomic.AddUint64(&m.GetVerdictPreCounter, 1)
+	defer atomic.AddUint64(&m.GetVerdictCounter, 1)
+
+	if len(m.GetVerdictMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.GetVerdictMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to ClientMock.GetVerdict. %v %v", p, p1)
+			return
+		}
+
+		input := m.GetVerdictMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, ClientMockGetVerdictInput{p, p1}, "Client.GetVerdict got unexpected parameters")
+
+		result := m.GetVerdictMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the ClientMock.GetVerdict")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.GetVerdictMock.mainExpectation != nil {
+
+		input := m.GetVerdictMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, ClientMockGetVerdictInput{p, p1}, "Client.GetVerdict got unexpected parameters")
+		}
+
+		result := m.GetVerdictMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the ClientMock.GetVerdict")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.GetVerdictFunc == nil {
+		m.t.Fatalf("Unexpected call to ClientMock.GetVerdict. %v %v", p, p1)
+		return
+	}
+
+	return m.GetVerdictFunc(p, p1)
+}
+
+//GetVerdictMinimockCounter returns a count of ClientMock.GetVerdictFunc invocations
+func (m *ClientMock) GetVerdictMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.GetVerdictCounter)
+}
+
+//GetVerdictMinimockPreCounter returns the value of ClientMock.GetVerdict invocations
+func (m *ClientMock) GetVerdictMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.GetVerdictPreCounter)
+}
+
+//GetVerdictFinished returns true if mock invocations count is ok
+func (m *ClientMock) GetVerdictFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.GetVerdictMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.GetVerdictCounter) == uint64(len(m.GetVerdictMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.GetVerdictMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.GetVerdictCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.GetVerdictFunc != nil {
+		return atomic.LoadUint64(&m.GetVerdictCounter) > 0
+	}
+
+	return true
+}
+
 type mClientMockHasPendingRequests struct {
 	mock              *ClientMock
 	mainExpectation   *ClientMockHasPendingRequestsExpectation
@@ -2461,6 +2940,157 @@ func (m *ClientMock) RegisterValidationFinished() bool {
 	return true
 }
 
+type mClientMockRegisterVerdict struct {
+	mock              *ClientMock
+	mainExpectation   *ClientMockRegisterVerdictExpectation
+	expectationSeries []*ClientMockRegisterVerdictExpectation
+}
+
+type ClientMockRegisterVerdictExpectation struct {
+	input  *ClientMockRegisterVerdictInput
+	result *ClientMockRegisterVerdictResult
+}
+
+type ClientMockRegisterVerdictInput struct {
+	p  context.Context
+	p1 record.Validation
+}
+
+type ClientMockRegisterVerdictResult struct {
+	r  *insolar.ID
+	r1 error
+}
+
+//Expect specifies that invocation of Client.RegisterVerdict is expected from 1 to Infinity times
+func (m *mClientMockRegisterVerdict) Expect(p context.Context, p1 record.Validation) *mClientMockRegisterVerdict {
+	m.mock.RegisterVerdictFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ClientMockRegisterVerdictExpectation{}
+	}
+	m.mainExpectation.input = &ClientMockRegisterVerdictInput{p, p1}
+	return m
+}
+
+//Return specifies results of invocation of Client.RegisterVerdict
+func (m *mClientMockRegisterVerdict) Return(r *insolar.ID, r1 error) *ClientMock {
+	m.mock.RegisterVerdictFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ClientMockRegisterVerdictExpectation{}
+	}
+	m.mainExpectation.result = &ClientMockRegisterVerdictResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of Client.RegisterVerdict is expected once
+func (m *mClientMockRegisterVerdict) ExpectOnce(p context.Context, p1 record.Validation) *ClientMockRegisterVerdictExpectation {
+	m.mock.RegisterVerdictFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &ClientMockRegisterVerdictExpectation{}
+	expectation.input = &ClientMockRegisterVerdictInput{p, p1}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *ClientMockRegisterVerdictExpectation) Return(r *insolar.ID, r1 error) {
+	e.result = &ClientMockRegisterVerdictResult{r, r1}
+}
+
+//Set uses given function f as a mock of Client.RegisterVerdict method
+func (m *mClientMockRegisterVerdict) Set(f func(p context.Context, p1 record.Validation) (r *insolar.ID, r1 error)) *ClientMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.RegisterVerdictFunc = f
+	return m.mock
+}
+
+//RegisterVerdict implements github.com/insolar/insolar/logicrunner/artifacts.Client interface
+func (m *ClientMock) RegisterVerdict(p context.Context, p1 record.Validation) (r *insolar.ID, r1 error) {
+	counter := atomic.AddUint64(&m.RegisterVerdictPreCounter, 1)
+	defer atomic.AddUint64(&m.RegisterVerdictCounter, 1)
+
+	if len(m.RegisterVerdictMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.RegisterVerdictMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to ClientMock.RegisterVerdict. %v %v", p, p1)
+			return
+		}
+
+		input := m.RegisterVerdictMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, ClientMockRegisterVerdictInput{p, p1}, "Client.RegisterVerdict got unexpected parameters")
+
+		result := m.RegisterVerdictMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the ClientMock.RegisterVerdict")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.RegisterVerdictMock.mainExpectation != nil {
+
+		input := m.RegisterVerdictMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, ClientMockRegisterVerdictInput{p, p1}, "Client.RegisterVerdict got unexpected parameters")
+		}
+
+		result := m.RegisterVerdictMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the ClientMock.RegisterVerdict")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.RegisterVerdictFunc == nil {
+		m.t.Fatalf("Unexpected call to ClientMock.RegisterVerdict. %v %v", p, p1)
+		return
+	}
+
+	return m.RegisterVerdictFunc(p, p1)
+}
+
+//RegisterVerdictMinimockCounter returns a count of ClientMock.RegisterVerdictFunc invocations
+func (m *ClientMock) RegisterVerdictMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.RegisterVerdictCounter)
+}
+
+//RegisterVerdictMinimockPreCounter returns the value of ClientMock.RegisterVerdict invocations
+func (m *ClientMock) RegisterVerdictMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.RegisterVerdictPreCounter)
+}
+
+//RegisterVerdictFinished returns true if mock invocations count is ok
+func (m *ClientMock) RegisterVerdictFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.RegisterVerdictMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.RegisterVerdictCounter) == uint64(len(m.RegisterVerdictMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.RegisterVerdictMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.RegisterVerdictCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.RegisterVerdictFunc != nil {
+		return atomic.LoadUint64(&m.RegisterVerdictCounter) > 0
+	}
+
+	return true
+}
+
 type mClientMockState struct {
 	mock              *ClientMock
 	mainExpectation   *ClientMockStateExpectation
@@ -2627,10 +3257,19 @@ func (m *ClientMock) ValidateCallCounters() {
 		m.t.Fatal("Expected call to ClientMock.GetObject")
 	}
 
+	if !m.GetObjectStateFinished() {
+		m.t.Fatal("Expected call to ClientMock.GetObjectState")
+	}
 	if !m.GetPendingsFinished() {
 		m.t.Fatal("Expected call to ClientMock.GetPendings")
 	}
 
+	if !m.GetResultFinished() {
+		m.t.Fatal("Expected call to ClientMock.GetResult")
+	}
+	if !m.GetVerdictFinished() {
+		m.t.Fatal("Expected call to ClientMock.GetVerdict")
+	}
 	if !m.HasPendingRequestsFinished() {
 		m.t.Fatal("Expected call to ClientMock.HasPendingRequests")
 	}
@@ -2663,6 +3302,9 @@ func (m *ClientMock) ValidateCallCounters() {
 		m.t.Fatal("Expected call to ClientMock.RegisterValidation")
 	}
 
+	if !m.RegisterVerdictFinished() {
+		m.t.Fatal("Expected call to ClientMock.RegisterVerdict")
+	}
 	if !m.StateFinished() {
 		m.t.Fatal("Expected call to ClientMock.State")
 	}
@@ -2712,10 +3354,19 @@ func (m *ClientMock) MinimockFinish() {
 		m.t.Fatal("Expected call to ClientMock.GetObject")
 	}
 
+	if !m.GetObjectStateFinished() {
+		m.t.Fatal("Expected call to ClientMock.GetObjectState")
+	}
 	if !m.GetPendingsFinished() {
 		m.t.Fatal("Expected call to ClientMock.GetPendings")
 	}
 
+	if !m.GetResultFinished() {
+		m.t.Fatal("Expected call to ClientMock.GetResult")
+	}
+	if !m.GetVerdictFinished() {
+		m.t.Fatal("Expected call to ClientMock.GetVerdict")
+	}
 	if !m.HasPendingRequestsFinished() {
 		m.t.Fatal("Expected call to ClientMock.HasPendingRequests")
 	}
@@ -2748,6 +3399,9 @@ func (m *ClientMock) MinimockFinish() {
 		m.t.Fatal("Expected call to ClientMock.RegisterValidation")
 	}
 
+	if !m.RegisterVerdictFinished() {
+		m.t.Fatal("Expected call to ClientMock.RegisterVerdict")
+	}
 	if !m.StateFinished() {
 		m.t.Fatal("Expected call to ClientMock.State")
 	}
@@ -2773,7 +3427,10 @@ func (m *ClientMock) MinimockWait(timeout time.Duration) {
 		ok = ok && m.GetDelegateFinished()
 		ok = ok && m.GetIncomingRequestFinished()
 		ok = ok && m.GetObjectFinished()
+		ok = ok && m.GetObjectStateFinished()
 		ok = ok && m.GetPendingsFinished()
+		ok = ok && m.GetResultFinished()
+		ok = ok && m.GetVerdictFinished()
 		ok = ok && m.HasPendingRequestsFinished()
 		ok = ok && m.InjectCodeDescriptorFinished()
 		ok = ok && m.InjectFinishFinished()
@@ -2782,6 +3439,7 @@ func (m *ClientMock) MinimockWait(timeout time.Duration) {
 		ok = ok && m.RegisterOutgoingRequestFinished()
 		ok = ok && m.RegisterResultFinished()
 		ok = ok && m.RegisterValidationFinished()
+		ok = ok && m.RegisterVerdictFinished()
 		ok = ok && m.StateFinished()
 
 		if ok {
@@ -2819,10 +3477,19 @@ func (m *ClientMock) MinimockWait(timeout time.Duration) {
 				m.t.Error("Expected call to ClientMock.GetObject")
 			}
 
+			if !m.GetObjectStateFinished() {
+				m.t.Error("Expected call to ClientMock.GetObjectState")
+			}
 			if !m.GetPendingsFinished() {
 				m.t.Error("Expected call to ClientMock.GetPendings")
 			}
 
+			if !m.GetResultFinished() {
+				m.t.Error("Expected call to ClientMock.GetResult")
+			}
+			if !m.GetVerdictFinished() {
+				m.t.Error("Expected call to ClientMock.GetVerdict")
+			}
 			if !m.HasPendingRequestsFinished() {
 				m.t.Error("Expected call to ClientMock.HasPendingRequests")
 			}
@@ -2855,6 +3522,9 @@ func (m *ClientMock) MinimockWait(timeout time.Duration) {
 				m.t.Error("Expected call to ClientMock.RegisterValidation")
 			}
 
+			if !m.RegisterVerdictFinished() {
+				m.t.Error("Expected call to ClientMock.RegisterVerdict")
+			}
 			if !m.StateFinished() {
 				m.t.Error("Expected call to ClientMock.State")
 			}
@@ -2899,10 +3569,19 @@ func (m *ClientMock) AllMocksCalled() bool {
 		return false
 	}
 
+	if !m.GetObjectStateFinished() {
+		return false
+	}
 	if !m.GetPendingsFinished() {
 		return false
 	}
 
+	if !m.GetResultFinished() {
+		return false
+	}
+	if !m.GetVerdictFinished() {
+		return false
+	}
 	if !m.HasPendingRequestsFinished() {
 		return false
 	}
@@ -2935,6 +3614,9 @@ func (m *ClientMock) AllMocksCalled() bool {
 		return false
 	}
 
+	if !m.RegisterVerdictFinished() {
+		return false
+	}
 	if !m.StateFinished() {
 		return false
 	}
@@ -301,3 +301,83 @@ func (s *amSuite) TestLedgerArtifactManager_GetPendings_Success() {
 	require.NoError(s.T(), err)
 	require.Equal(s.T(), []insolar.Reference{requestRef}, res)
 }
+
+func (s *amSuite) TestLedgerArtifactManager_RegisterVerdict_ReadBack() {
+	mc := minimock.NewController(s.T())
+	defer mc.Finish()
+
+	pulseAccessor := pulse.NewAccessorMock(s.T())
+	pulseAccessor.LatestMock.Return(*insolar.GenesisPulse, nil)
+
+	replyWith := func(pl payload.Payload) <-chan *wmMessage.Message {
+		msg, err := payload.NewMessage(pl)
+		require.NoError(s.T(), err)
+		meta := payload.Meta{Payload: msg.Payload}
+		buf, err := meta.Marshal()
+		require.NoError(s.T(), err)
+		msg.Payload = buf
+		ch := make(chan *wmMessage.Message, 1)
+		ch <- msg
+		return ch
+	}
+
+	// light node stores standalone records by ID calculated from record hash
+	stored := map[insolar.ID][]byte{}
+	sender := bus.NewSenderMock(s.T())
+	sender.LatestPulseMock.Return(*insolar.GenesisPulse, nil)
+	sender.SendRoleFunc = func(_ context.Context, msg *wmMessage.Message, role insolar.DynamicRole, ref insolar.Reference) (<-chan *wmMessage.Message, func()) {
+		require.Equal(s.T(), insolar.DynamicRoleLightExecutor, role)
+
+		pl, err := payload.Unmarshal(msg.Payload)
+		require.NoError(s.T(), err)
+		switch p := pl.(type) {
+		case *payload.SetCode:
+			h := s.scheme.ReferenceHasher()
+			_, err := h.Write(p.Record)
+			require.NoError(s.T(), err)
+			id := *insolar.NewID(insolar.GenesisPulse.PulseNumber, h.Sum(nil))
+			require.Equal(s.T(), id, *ref.Record())
+
+			virtual := record.Virtual{}
+			require.NoError(s.T(), virtual.Unmarshal(p.Record))
+			material := record.Material{Virtual: &virtual}
+			stored[id], err = material.Marshal()
+			require.NoError(s.T(), err)
+			return replyWith(&payload.ID{ID: id}), func() {}
+		case *payload.GetCode:
+			require.Equal(s.T(), p.CodeID, *ref.Record())
+			buf, ok := stored[p.CodeID]
+			if !ok {
+				return replyWith(&payload.Error{Text: "record not found"}), func() {}
+			}
+			return replyWith(&payload.Code{Record: buf}), func() {}
+		}
+		s.T().Fatalf("unexpected payload %T", pl)
+		return nil, nil
+	}
+
+	am := NewClient(sender)
+	am.PCS = s.scheme
+	am.PulseAccessor = pulseAccessor
+
+	verdict := record.Validation{
+		Object:           gen.ID(),
+		Request:          gen.Reference(),
+		Validator:        gen.Reference(),
+		Valid:            false,
+		Reason:           "result differs from the recorded one",
+		ResultHash:       []byte{1, 2, 3},
+		StateHash:        []byte{4, 5, 6},
+		VerdictSignature: []byte{7, 8, 9},
+	}
+
+	id, err := am.RegisterVerdict(s.ctx, verdict)
+	require.NoError(s.T(), err)
+
+	saved, err := am.GetVerdict(s.ctx, *id)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), verdict, *saved)
+
+	_, err = am.GetVerdict(s.ctx, gen.ID())
+	require.Error(s.T(), err)
+}
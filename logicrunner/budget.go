@@ -0,0 +1,189 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package logicrunner
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/logicrunner/artifacts"
+)
+
+// ErrBudgetExceeded is a cause of errors returned when a request goes over its execution budget.
+var ErrBudgetExceeded = errors.New("execution budget exceeded")
+
+// ErrExecutionFinished is returned to proxy calls of contract code which outlived its execution.
+var ErrExecutionFinished = errors.New("execution is finished")
+
+// ExecutionBudget tracks work done by a request against configured limits.
+// Methods of nil budget do nothing, so transcripts without budget are not limited.
+type ExecutionBudget struct {
+	limits configuration.ExecutionBudget
+
+	lock     sync.Mutex
+	ctx      context.Context
+	start    time.Time
+	finished bool
+	usage    artifacts.ExecutionUsage
+	err      error
+}
+
+func NewExecutionBudget(limits configuration.ExecutionBudget) *ExecutionBudget {
+	return &ExecutionBudget{limits: limits}
+}
+
+// Start marks the beginning of execution and returns context limited by MaxDuration.
+func (b *ExecutionBudget) Start(ctx context.Context) (context.Context, context.CancelFunc) {
+	if b == nil {
+		return ctx, func() {}
+	}
+
+	cancel := context.CancelFunc(func() {})
+	if b.limits.MaxDuration != 0 {
+		ctx, cancel = context.WithTimeout(ctx, b.limits.MaxDuration)
+	}
+
+	b.lock.Lock()
+	b.ctx = ctx
+	b.start = time.Now()
+	b.lock.Unlock()
+
+	return ctx, cancel
+}
+
+// Finish marks the end of execution, returns error if any limit was exceeded during it.
+// Contract code may keep running after it, e.g. if it was interrupted, see Check.
+func (b *ExecutionBudget) Finish() error {
+	if b == nil {
+		return nil
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.finished = true
+	if max := b.limits.MaxDuration; max != 0 && time.Since(b.start) >= max && b.err == nil {
+		b.err = errors.Wrapf(ErrBudgetExceeded, "execution time limit %s is reached", max)
+	}
+	return b.err
+}
+
+// Context returns context of execution limited by MaxDuration, or ctx if execution isn't started.
+// Proxy calls of contract code use it, so they are interrupted together with the execution.
+func (b *ExecutionBudget) Context(ctx context.Context) context.Context {
+	if b == nil {
+		return ctx
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if b.ctx == nil {
+		return ctx
+	}
+	return b.ctx
+}
+
+// Check returns error if execution is finished or interrupted. Contract code isn't stopped
+// when its execution is abandoned, so proxy calls check it before making any side effects.
+func (b *ExecutionBudget) Check() error {
+	if b == nil {
+		return nil
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if b.finished {
+		return ErrExecutionFinished
+	}
+	if b.ctx != nil && b.ctx.Err() != nil {
+		return errors.Wrap(b.ctx.Err(), "execution is interrupted")
+	}
+	return nil
+}
+
+// RouteCall spends one outgoing call.
+func (b *ExecutionBudget) RouteCall() error {
+	if b == nil {
+		return nil
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if max := b.limits.MaxRouteCalls; max != 0 && b.usage.RouteCalls >= max {
+		return b.exceeded(errors.Wrapf(ErrBudgetExceeded, "route calls limit %d is reached", max))
+	}
+	b.usage.RouteCalls++
+	return nil
+}
+
+// SaveAsChild spends one child object creation.
+func (b *ExecutionBudget) SaveAsChild() error {
+	if b == nil {
+		return nil
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if max := b.limits.MaxSaveAsChild; max != 0 && b.usage.SaveAsChildCalls >= max {
+		return b.exceeded(errors.Wrapf(ErrBudgetExceeded, "save as child limit %d is reached", max))
+	}
+	b.usage.SaveAsChildCalls++
+	return nil
+}
+
+// StateSize checks size of object memory produced by the request.
+func (b *ExecutionBudget) StateSize(size int) error {
+	if b == nil {
+		return nil
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.usage.StateSize = uint64(size)
+	if max := b.limits.MaxStateSize; max != 0 && b.usage.StateSize > max {
+		return b.exceeded(errors.Wrapf(ErrBudgetExceeded, "state size %d is over limit %d", size, max))
+	}
+	return nil
+}
+
+// Usage returns budget spent so far.
+func (b *ExecutionBudget) Usage() artifacts.ExecutionUsage {
+	if b == nil {
+		return artifacts.ExecutionUsage{}
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.usage
+}
+
+// exceeded remembers the first error, so it's reported even if contract swallows it.
+func (b *ExecutionBudget) exceeded(err error) error {
+	if b.err == nil {
+		b.err = err
+	}
+	return err
+}
@@ -0,0 +1,115 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package logicrunner
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+)
+
+func TestExecutionBudget_Nil(t *testing.T) {
+	var b *ExecutionBudget
+	ctx := inslogger.TestContext(t)
+
+	execCtx, cancel := b.Start(ctx)
+	defer cancel()
+	require.Equal(t, ctx, execCtx)
+	require.Equal(t, ctx, b.Context(ctx))
+	require.NoError(t, b.Check())
+
+	require.NoError(t, b.RouteCall())
+	require.NoError(t, b.SaveAsChild())
+	require.NoError(t, b.StateSize(1<<30))
+	require.NoError(t, b.Finish())
+	require.Zero(t, b.Usage())
+}
+
+func TestExecutionBudget_Limits(t *testing.T) {
+	b := NewExecutionBudget(configuration.ExecutionBudget{
+		MaxRouteCalls:  2,
+		MaxSaveAsChild: 1,
+		MaxStateSize:   10,
+	})
+	_, cancel := b.Start(inslogger.TestContext(t))
+	defer cancel()
+
+	require.NoError(t, b.RouteCall())
+	require.NoError(t, b.RouteCall())
+	err := b.RouteCall()
+	require.Equal(t, ErrBudgetExceeded, errors.Cause(err))
+
+	require.NoError(t, b.SaveAsChild())
+	err = b.SaveAsChild()
+	require.Equal(t, ErrBudgetExceeded, errors.Cause(err))
+
+	require.NoError(t, b.StateSize(10))
+	err = b.StateSize(11)
+	require.Equal(t, ErrBudgetExceeded, errors.Cause(err))
+
+	// the first exceeded limit is reported
+	err = b.Finish()
+	require.Equal(t, ErrBudgetExceeded, errors.Cause(err))
+	require.Contains(t, err.Error(), "route calls")
+
+	usage := b.Usage()
+	require.Equal(t, uint32(2), usage.RouteCalls)
+	require.Equal(t, uint32(1), usage.SaveAsChildCalls)
+	require.Equal(t, uint64(11), usage.StateSize)
+}
+
+func TestExecutionBudget_Duration(t *testing.T) {
+	b := NewExecutionBudget(configuration.ExecutionBudget{MaxDuration: time.Millisecond})
+	ctx, cancel := b.Start(context.Background())
+	defer cancel()
+
+	select {
+	case <-ctx.Done():
+	case <-time.After(time.Second):
+		t.Fatal("context isn't limited by MaxDuration")
+	}
+
+	// contract code which outlived the execution can't make proxy calls
+	require.Error(t, b.Check())
+	require.Equal(t, ctx, b.Context(context.Background()))
+
+	err := b.Finish()
+	require.Equal(t, ErrBudgetExceeded, errors.Cause(err))
+	require.Equal(t, ErrExecutionFinished, b.Check())
+}
+
+func TestExecutionBudget_Unlimited(t *testing.T) {
+	b := NewExecutionBudget(configuration.ExecutionBudget{})
+	ctx, cancel := b.Start(context.Background())
+	defer cancel()
+
+	for i := 0; i < 100; i++ {
+		require.NoError(t, b.RouteCall())
+		require.NoError(t, b.SaveAsChild())
+	}
+	require.NoError(t, b.StateSize(1<<30))
+	require.NoError(t, b.Check())
+	require.NoError(t, b.Finish())
+	require.NoError(t, ctx.Err())
+	require.Equal(t, uint32(100), b.Usage().RouteCalls)
+}
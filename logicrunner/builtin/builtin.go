@@ -24,6 +24,7 @@ import (
 	"github.com/tylerb/gls"
 
 	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/instrumentation/inslogger"
 	"github.com/insolar/insolar/instrumentation/instracer"
 	"github.com/insolar/insolar/logicrunner/artifacts"
 	lrCommon "github.com/insolar/insolar/logicrunner/common"
@@ -76,9 +77,6 @@ func (b *BuiltIn) CallConstructor(ctx context.Context, callCtx *insolar.LogicCal
 	ctx, span := instracer.StartSpan(ctx, "builtin.CallConstructor")
 	defer span.End()
 
-	gls.Set("callCtx", callCtx)
-	defer gls.Cleanup()
-
 	contractName, ok := b.CodeRefRegistry[codeRef]
 	if !ok {
 		return nil, errors.New("failed to find contract with reference")
@@ -90,7 +88,17 @@ func (b *BuiltIn) CallConstructor(ctx context.Context, callCtx *insolar.LogicCal
 		return nil, errors.New("failed to find contracts method")
 	}
 
-	return constructorFunc(args)
+	var (
+		data []byte
+		err  error
+	)
+	ctxErr := runWithContext(ctx, callCtx, func() {
+		data, err = constructorFunc(args)
+	})
+	if ctxErr != nil {
+		return nil, ctxErr
+	}
+	return data, err
 }
 
 func (b *BuiltIn) CallMethod(ctx context.Context, callCtx *insolar.LogicCallContext, codeRef insolar.Reference,
@@ -99,9 +107,6 @@ func (b *BuiltIn) CallMethod(ctx context.Context, callCtx *insolar.LogicCallCont
 	ctx, span := instracer.StartSpan(ctx, "builtin.CallMethod")
 	defer span.End()
 
-	gls.Set("callCtx", callCtx)
-	defer gls.Cleanup()
-
 	contractName, ok := b.CodeRefRegistry[codeRef]
 	if !ok {
 		return nil, nil, errors.New("failed to find contract with reference")
@@ -113,5 +118,44 @@ func (b *BuiltIn) CallMethod(ctx context.Context, callCtx *insolar.LogicCallCont
 		return nil, nil, errors.New("failed to find contracts method")
 	}
 
-	return methodFunc(data, args)
+	var (
+		newData []byte
+		result  insolar.Arguments
+		err     error
+	)
+	ctxErr := runWithContext(ctx, callCtx, func() {
+		newData, result, err = methodFunc(data, args)
+	})
+	if ctxErr != nil {
+		return nil, nil, ctxErr
+	}
+	return newData, result, err
+}
+
+// runWithContext runs contract code in a separate goroutine, so the call returns
+// as soon as ctx is done, even if the code is still running. Abandoned code can't
+// change anything: its execution is finished, so proxy calls it makes fail.
+func runWithContext(ctx context.Context, callCtx *insolar.LogicCallContext, f func()) error {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		gls.Set(glsCallContextKey, callCtx)
+		defer gls.Cleanup()
+
+		f()
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		logger := inslogger.FromContext(ctx)
+		logger.Warn("builtin contract execution is interrupted, code is left running")
+		go func() {
+			<-done
+			logger.Warn("interrupted builtin contract execution is finished")
+		}()
+		return ctx.Err()
+	}
 }
@@ -24,6 +24,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/insolar/insolar/application/callsite"
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/logicrunner/builtin/contract/member/helper"
 	"github.com/insolar/insolar/logicrunner/builtin/contract/member/signer"
@@ -99,7 +100,6 @@ func (m *Member) Call(signedRequest []byte) (interface{}, error) {
 	var signature string
 	var pulseTimeStamp int64
 	var rawRequest []byte
-	selfSigned := false
 
 	err := signer.UnmarshalParams(signedRequest, &rawRequest, &signature, &pulseTimeStamp)
 	if err != nil {
@@ -112,20 +112,21 @@ func (m *Member) Call(signedRequest []byte) (interface{}, error) {
 		return nil, fmt.Errorf("failed to unmarshal: %s", err.Error())
 	}
 
-	switch request.Params.CallSite {
-	case "member.create":
-		selfSigned = true
-	case "member.migrationCreate":
-		selfSigned = true
-	case "member.get":
-		selfSigned = true
-	}
+	cs, ok := callsite.Get(request.Params.CallSite)
 
-	err = m.verifySig(request, rawRequest, signature, selfSigned)
+	err = m.verifySig(request, rawRequest, signature, cs.SelfSigned)
 	if err != nil {
 		return nil, fmt.Errorf("error while verify signature: %s", err.Error())
 	}
 
+	if !ok {
+		return nil, fmt.Errorf("unknown method: '%s'", request.Params.CallSite)
+	}
+	err = cs.Params.Validate("callParams", request.Params.CallParams)
+	if err != nil {
+		return nil, fmt.Errorf("incorrect input: %s", err.Error())
+	}
+
 	switch request.Params.CallSite {
 	case "CreateHelloWorld":
 		return rootdomain.GetObject(m.RootDomain).CreateHelloWorld()
@@ -37,6 +37,7 @@ type Transcript struct {
 	ObjectDescriptor artifacts.ObjectDescriptor
 	Context          context.Context
 	LogicContext     *insolar.LogicCallContext
+	Mode             insolar.CallMode
 	Request          *record.IncomingRequest
 	RequestRef       insolar.Reference
 	RequesterNode    *insolar.Reference
@@ -44,6 +45,10 @@ type Transcript struct {
 	Deactivate       bool
 	OutgoingRequests []OutgoingRequest
 	FromLedger       bool
+	Budget           *ExecutionBudget
+	// StateHash is a hash of object state after the request is executed and saved, validators compare it
+	// with state of replayed request.
+	StateHash []byte
 }
 
 func NewTranscript(
@@ -0,0 +1,128 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package deploy
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+)
+
+// Artifact is a code ready to be deployed.
+type Artifact struct {
+	Code        []byte
+	MachineType insolar.MachineType
+	// Signature is ASN.1 ECDSA signature of SignedData by key of the builder in base64, empty for code uploaded by member.
+	Signature string
+}
+
+// SignedData returns data builder signs, lines of name, prototype, name of machine type,
+// hex of SHA-256 of source and hex of SHA-256 of code.
+func (a *Artifact) SignedData(req BuildRequest) []byte {
+	source := sha256.Sum256(req.Source)
+	code := sha256.Sum256(a.Code)
+	return []byte(fmt.Sprintf(
+		"%s\n%s\n%s\n%x\n%x", req.Name, req.Prototype, machineTypeName(a.MachineType), source, code,
+	))
+}
+
+// BuildRequest describes sources to build.
+type BuildRequest struct {
+	Name        string              `json:"name"`
+	Source      []byte              `json:"source"`
+	MachineType insolar.MachineType `json:"machineType"`
+	// Prototype is a reference of prototype the code is deployed for, it's compiled into go plugins.
+	Prototype insolar.Reference `json:"prototype"`
+}
+
+// Builder compiles sources of contracts.
+type Builder interface {
+	Build(ctx context.Context, req BuildRequest) (*Artifact, error)
+}
+
+// BuildReply is a reply of the builder service.
+type BuildReply struct {
+	Code        []byte              `json:"code"`
+	MachineType insolar.MachineType `json:"machineType"`
+	Signature   string              `json:"signature"`
+	Error       string              `json:"error"`
+}
+
+// HTTPBuilder sends sources to a sandboxed builder service.
+//
+// Service accepts BuildRequest in JSON at /build and replies with BuildReply,
+// code is signed by key of the service.
+type HTTPBuilder struct {
+	URL    string
+	client *http.Client
+}
+
+// NewHTTPBuilder creates new builder of the service at url, build is cancelled after timeout.
+func NewHTTPBuilder(url string, timeout time.Duration) *HTTPBuilder {
+	return &HTTPBuilder{
+		URL:    strings.TrimRight(url, "/"),
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+// Build sends sources to the builder service and returns compiled code.
+func (b *HTTPBuilder) Build(ctx context.Context, req BuildRequest) (*Artifact, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Build ] can't marshal request")
+	}
+
+	httpReq, err := http.NewRequest(http.MethodPost, b.URL+"/build", bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Build ] can't create request")
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	resp, err := b.client.Do(httpReq.WithContext(ctx))
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Build ] can't send request to builder")
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Build ] can't read reply")
+	}
+
+	reply := BuildReply{}
+	if err := json.Unmarshal(respBody, &reply); err != nil {
+		return nil, errors.Wrapf(err, "[ Build ] can't unmarshal reply, status %s", resp.Status)
+	}
+	if reply.Error != "" {
+		return nil, errors.Errorf("[ Build ] builder failed: %s", reply.Error)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("[ Build ] builder replied with status %s", resp.Status)
+	}
+
+	return &Artifact{Code: reply.Code, MachineType: reply.MachineType, Signature: reply.Signature}, nil
+}
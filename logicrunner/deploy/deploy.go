@@ -0,0 +1,431 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package deploy registers code of contracts uploaded by members.
+//
+// Member uploads either pre-built wasm code or sources, sources are compiled by a separate
+// sandboxed builder service, node never runs the go toolchain. Code is verified and
+// registered through artifacts.Client as code and prototype records. Every deployment
+// of a contract has a version, new versions can only be uploaded by the member who
+// uploaded the first one.
+//
+// Deployments are kept in ledger: prototype of every deployment is a child of genesis record
+// and its memory holds the deployment. Deployments of a contract are applied in order they
+// are registered in ledger, so all nodes agree on owner and versions of the contract even
+// if deployments are uploaded through different nodes at the same time.
+package deploy
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/blang/semver"
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	insolarApi "github.com/insolar/insolar/insolar/api"
+	"github.com/insolar/insolar/insolar/flow"
+	"github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/logicrunner/artifacts"
+	"github.com/insolar/insolar/logicrunner/goplugin/foundation"
+)
+
+const (
+	registerRetries = 5
+	retryDelay      = 100 * time.Millisecond
+)
+
+var (
+	// ErrInvalidSignature is returned when request isn't signed by the uploading member.
+	ErrInvalidSignature = errors.New("invalid signature of deployment")
+	// ErrVersionExists is returned when version isn't greater than the latest deployed one.
+	ErrVersionExists = errors.New("version isn't greater than deployed one")
+	// ErrNotOwner is returned when new version is uploaded by other member than the first one.
+	ErrNotOwner = errors.New("contract is deployed by other member")
+	// ErrInProgress is returned when other deployment of the contract isn't finished.
+	ErrInProgress = errors.New("other deployment of contract is in progress")
+	// ErrConflict is returned when deployment is overridden by one registered earlier through other node.
+	ErrConflict = errors.New("contract is deployed concurrently")
+)
+
+// KeyProvider returns public keys of members.
+type KeyProvider interface {
+	// PublicKey returns public key of member in PEM format.
+	PublicKey(ctx context.Context, member insolar.Reference) (string, error)
+}
+
+// machineTypes are names of machine types code can be deployed for
+var machineTypes = map[string]insolar.MachineType{
+	"goplugin": insolar.MachineTypeGoPlugin,
+	"wasm":     insolar.MachineTypeWasm,
+}
+
+// ParseMachineType returns machine type by its name, "goplugin" or "wasm".
+func ParseMachineType(name string) (insolar.MachineType, error) {
+	mt, ok := machineTypes[name]
+	if !ok {
+		return 0, errors.Errorf("machine type %q can't be deployed", name)
+	}
+	return mt, nil
+}
+
+func machineTypeName(mt insolar.MachineType) string {
+	for name, t := range machineTypes {
+		if t == mt {
+			return name
+		}
+	}
+	return fmt.Sprint(int(mt))
+}
+
+// Request is a deployment of contract signed by member.
+type Request struct {
+	Name    string
+	Version string
+	Member  insolar.Reference
+
+	MachineType insolar.MachineType
+	// Code is pre-built wasm code, it's exclusive with Source.
+	Code []byte
+	// Source is compiled by the builder service.
+	Source []byte
+
+	// Signature is ASN.1 ECDSA signature of SignedData in base64, as api requests are signed.
+	Signature string
+}
+
+// SignedData returns data member signs, lines of name, version, member, name of machine type and
+// hex of SHA-256 of code or source.
+func (r *Request) SignedData() []byte {
+	payload := r.Code
+	if len(r.Source) != 0 {
+		payload = r.Source
+	}
+	hash := sha256.Sum256(payload)
+	return []byte(fmt.Sprintf("%s\n%s\n%s\n%s\n%x", r.Name, r.Version, r.Member, machineTypeName(r.MachineType), hash))
+}
+
+func (r *Request) validate() (semver.Version, error) {
+	if r.Name == "" {
+		return semver.Version{}, errors.New("name is missing")
+	}
+	version, err := semver.Parse(r.Version)
+	if err != nil {
+		return semver.Version{}, errors.Wrap(err, "bad version")
+	}
+	if (len(r.Code) == 0) == (len(r.Source) == 0) {
+		return semver.Version{}, errors.New("either code or source has to be provided")
+	}
+	if len(r.Code) != 0 && r.MachineType != insolar.MachineTypeWasm {
+		return semver.Version{}, errors.New("pre-built code is accepted only for wasm, sources of go contracts are built by the builder")
+	}
+	if r.Signature == "" {
+		return semver.Version{}, errors.New("signature is missing")
+	}
+	return version, nil
+}
+
+// Deployment is a deployed version of contract, it's stored in memory of the prototype.
+type Deployment struct {
+	Name        string
+	Version     string
+	Member      insolar.Reference
+	Prototype   insolar.Reference
+	Code        insolar.Reference
+	MachineType insolar.MachineType
+	// CodeHash is SHA-256 of deployed code.
+	CodeHash []byte
+}
+
+// Deployer verifies uploaded contracts and registers them.
+type Deployer struct {
+	ArtifactManager artifacts.Client
+	PulseAccessor   pulse.Accessor
+	Keys            KeyProvider
+	// Builder compiles sources, only pre-built wasm code is accepted if nil.
+	Builder Builder
+
+	cfg configuration.Deploy
+
+	// inProgress serializes deployments of a contract through this node, deployments themselves are in ledger
+	lock       sync.Mutex
+	inProgress map[string]bool
+}
+
+// NewDeployer creates new Deployer.
+func NewDeployer(cfg configuration.Deploy, am artifacts.Client, pa pulse.Accessor, keys KeyProvider, builder Builder) *Deployer {
+	return &Deployer{
+		ArtifactManager: am,
+		PulseAccessor:   pa,
+		Keys:            keys,
+		Builder:         builder,
+		cfg:             cfg,
+		inProgress:      make(map[string]bool),
+	}
+}
+
+// Deploy verifies request, builds sources if needed and registers code and prototype of the contract.
+func (d *Deployer) Deploy(ctx context.Context, req *Request) (*Deployment, error) {
+	version, err := req.validate()
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Deploy ] bad request")
+	}
+
+	if err := d.checkSignature(ctx, req); err != nil {
+		return nil, err
+	}
+
+	if err := d.reserve(req.Name); err != nil {
+		return nil, err
+	}
+	defer d.release(req.Name)
+
+	deployed, err := d.Versions(ctx, req.Name)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Deploy ] can't get deployed versions")
+	}
+	if len(deployed) > 0 {
+		if err := follows(deployed[len(deployed)-1], req.Member, version); err != nil {
+			return nil, err
+		}
+	}
+
+	deployment, err := d.deploy(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// other node could register other deployment of the contract in the meantime
+	deployed, err = d.Versions(ctx, req.Name)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Deploy ] can't get deployed versions")
+	}
+	if !contains(deployed, deployment.Prototype) {
+		return nil, ErrConflict
+	}
+
+	inslogger.FromContext(ctx).Infof(
+		"[ Deploy ] contract %s %s deployed by %s, prototype %s",
+		req.Name, req.Version, req.Member, deployment.Prototype,
+	)
+	return deployment, nil
+}
+
+// Versions returns deployed versions of contract from ledger, the latest one is the last.
+func (d *Deployer) Versions(ctx context.Context, name string) ([]Deployment, error) {
+	iter, err := d.ArtifactManager.GetChildren(ctx, insolar.GenesisRecord.Ref(), nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "can't get children of genesis record")
+	}
+
+	var registered []Deployment
+	for iter.HasNext() {
+		ref, err := iter.Next()
+		if err != nil {
+			return nil, errors.Wrap(err, "can't get child of genesis record")
+		}
+		desc, err := d.ArtifactManager.GetObject(ctx, *ref)
+		if err != nil {
+			return nil, errors.Wrapf(err, "can't get object %s", ref)
+		}
+		if !desc.IsPrototype() {
+			continue
+		}
+
+		// prototypes of genesis contracts hold no deployments
+		deployment := Deployment{}
+		if err := insolar.Deserialize(desc.Memory(), &deployment); err != nil || deployment.Name != name {
+			continue
+		}
+		deployment.Prototype = *ref
+		registered = append(registered, deployment)
+	}
+
+	// children are listed from the latest one
+	for i, j := 0, len(registered)-1; i < j; i, j = i+1, j-1 {
+		registered[i], registered[j] = registered[j], registered[i]
+	}
+	return applied(registered), nil
+}
+
+// applied returns deployments that follow previous ones, deployments are in order they are registered
+func applied(registered []Deployment) []Deployment {
+	res := []Deployment{}
+	for _, deployment := range registered {
+		version, err := semver.Parse(deployment.Version)
+		if err != nil {
+			continue
+		}
+		if len(res) > 0 && follows(res[len(res)-1], deployment.Member, version) != nil {
+			continue
+		}
+		res = append(res, deployment)
+	}
+	return res
+}
+
+// follows checks that version uploaded by member may follow the latest deployment
+func follows(latest Deployment, member insolar.Reference, version semver.Version) error {
+	if latest.Member != member {
+		return ErrNotOwner
+	}
+	if !version.GT(semver.MustParse(latest.Version)) {
+		return errors.Wrapf(ErrVersionExists, "deployed version is %s", latest.Version)
+	}
+	return nil
+}
+
+func contains(deployments []Deployment, prototype insolar.Reference) bool {
+	for _, deployment := range deployments {
+		if deployment.Prototype == prototype {
+			return true
+		}
+	}
+	return false
+}
+
+func (d *Deployer) checkSignature(ctx context.Context, req *Request) error {
+	key, err := d.Keys.PublicKey(ctx, req.Member)
+	if err != nil {
+		return errors.Wrap(err, "[ Deploy ] can't get public key of member")
+	}
+	if err := foundation.VerifySignature(req.SignedData(), req.Signature, key, key, false); err != nil {
+		return errors.Wrap(ErrInvalidSignature, err.Error())
+	}
+	return nil
+}
+
+// reserve locks name of the contract until release
+func (d *Deployer) reserve(name string) error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	if d.inProgress[name] {
+		return ErrInProgress
+	}
+	d.inProgress[name] = true
+	return nil
+}
+
+func (d *Deployer) release(name string) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	delete(d.inProgress, name)
+}
+
+func (d *Deployer) deploy(ctx context.Context, req *Request) (*Deployment, error) {
+	protoReq, err := d.registerRequest(ctx, req, "prototype")
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Deploy ] can't register prototype request")
+	}
+	protoRef := *insolar.NewReference(*protoReq)
+
+	artifact := &Artifact{Code: req.Code, MachineType: req.MachineType}
+	var build *BuildRequest
+	if len(req.Source) != 0 {
+		if d.Builder == nil {
+			return nil, errors.New("[ Deploy ] builder isn't configured, only pre-built wasm code is accepted")
+		}
+		build = &BuildRequest{
+			Name:        req.Name,
+			Source:      req.Source,
+			MachineType: req.MachineType,
+			Prototype:   protoRef,
+		}
+		artifact, err = d.Builder.Build(ctx, *build)
+		if err != nil {
+			return nil, errors.Wrap(err, "[ Deploy ] can't build contract")
+		}
+		if artifact.MachineType != req.MachineType {
+			return nil, errors.Errorf("[ Deploy ] builder returned code of machine type %d", artifact.MachineType)
+		}
+	}
+
+	if err := Verify(artifact, build, d.cfg); err != nil {
+		return nil, errors.Wrap(err, "[ Deploy ] code verification failed")
+	}
+
+	codeReq, err := d.registerRequest(ctx, req, "code")
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Deploy ] can't register code request")
+	}
+	codeID, err := d.ArtifactManager.DeployCode(
+		ctx, insolar.Reference{}, *insolar.NewReference(*codeReq), artifact.Code, artifact.MachineType,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Deploy ] can't deploy code")
+	}
+	codeRef := *insolar.NewReference(*codeID)
+
+	hash := sha256.Sum256(artifact.Code)
+	deployment := &Deployment{
+		Name:        req.Name,
+		Version:     req.Version,
+		Member:      req.Member,
+		Code:        codeRef,
+		MachineType: artifact.MachineType,
+		CodeHash:    hash[:],
+	}
+	memory, err := insolar.Serialize(deployment)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Deploy ] can't serialize deployment")
+	}
+
+	err = d.ArtifactManager.ActivatePrototype(ctx, protoRef, insolar.GenesisRecord.Ref(), codeRef, memory)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Deploy ] can't activate prototype")
+	}
+
+	deployment.Prototype = protoRef
+	return deployment, nil
+}
+
+// registerRequest registers incoming request of code or prototype record, request is retried when flow is cancelled by pulse change
+func (d *Deployer) registerRequest(ctx context.Context, req *Request, kind string) (*insolar.ID, error) {
+	var err error
+	for i := 0; i < registerRetries; i++ {
+		var current insolar.Pulse
+		current, err = d.PulseAccessor.Latest(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "can't get current pulse")
+		}
+
+		// nonce makes requests of different deployments unique
+		hash := sha256.Sum256(append([]byte(kind+"\n"), req.SignedData()...))
+		nonce := *insolar.NewReference(*insolar.NewID(current.PulseNumber, hash[:insolar.RecordHashSize]))
+		request := record.IncomingRequest{
+			CallType:  record.CTSaveAsChild,
+			Prototype: &nonce,
+			Reason:    insolarApi.MakeReason(current.PulseNumber, []byte(req.Name)),
+		}
+
+		var id *insolar.ID
+		id, err = d.ArtifactManager.RegisterIncomingRequest(ctx, &request)
+		if err == nil || !strings.Contains(err.Error(), flow.ErrCancelled.Error()) {
+			return id, err
+		}
+		time.Sleep(retryDelay)
+	}
+	return nil, errors.Wrap(err, "flow cancelled, retries exceeded")
+}
@@ -0,0 +1,394 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package deploy
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/gojuno/minimock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/api/requester"
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/flow"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/logicrunner/artifacts"
+	"github.com/insolar/insolar/platformpolicy"
+	"github.com/insolar/insolar/testutils"
+)
+
+// emptyWasm is the smallest valid WebAssembly module
+var emptyWasm = []byte("\x00asm\x01\x00\x00\x00")
+
+type member struct {
+	ref insolar.Reference
+	key interface{}
+	pem string
+}
+
+func newMember(t *testing.T) member {
+	ks := platformpolicy.NewKeyProcessor()
+	sKey, err := ks.GeneratePrivateKey()
+	require.NoError(t, err)
+	pKeyString, err := ks.ExportPublicKeyPEM(ks.ExtractPublicKey(sKey))
+	require.NoError(t, err)
+	return member{ref: testutils.RandomRef(), key: sKey, pem: string(pKeyString)}
+}
+
+func (m member) request(t *testing.T, name, version string, code []byte) *Request {
+	req := &Request{
+		Name:        name,
+		Version:     version,
+		Member:      m.ref,
+		MachineType: insolar.MachineTypeWasm,
+		Code:        code,
+	}
+	var err error
+	req.Signature, err = requester.Sign(m.key, req.SignedData())
+	require.NoError(t, err)
+	return req
+}
+
+type keys map[insolar.Reference]string
+
+func (k keys) PublicKey(ctx context.Context, member insolar.Reference) (string, error) {
+	key, ok := k[member]
+	if !ok {
+		return "", errors.New("unknown member")
+	}
+	return key, nil
+}
+
+type builderFunc func(ctx context.Context, req BuildRequest) (*Artifact, error)
+
+func (f builderFunc) Build(ctx context.Context, req BuildRequest) (*Artifact, error) {
+	return f(ctx, req)
+}
+
+type refIterator struct {
+	refs []insolar.Reference
+}
+
+func (i *refIterator) HasNext() bool {
+	return len(i.refs) > 0
+}
+
+func (i *refIterator) Next() (*insolar.Reference, error) {
+	ref := i.refs[0]
+	i.refs = i.refs[1:]
+	return &ref, nil
+}
+
+// ledger keeps prototypes activated as children of genesis record
+type ledger struct {
+	lock     sync.Mutex
+	children []insolar.Reference
+	memory   map[insolar.Reference][]byte
+}
+
+func (l *ledger) activate(prototype insolar.Reference, memory []byte) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.children = append(l.children, prototype)
+	l.memory[prototype] = memory
+}
+
+func (l *ledger) mock(mc *minimock.Controller, am *artifacts.ClientMock) {
+	am.GetChildrenMock.Set(func(ctx context.Context, parent insolar.Reference, pn *insolar.PulseNumber) (artifacts.RefIterator, error) {
+		if parent != insolar.GenesisRecord.Ref() {
+			return nil, errors.New("unexpected parent")
+		}
+		l.lock.Lock()
+		defer l.lock.Unlock()
+		// children are listed from the latest one
+		iter := &refIterator{}
+		for i := len(l.children) - 1; i >= 0; i-- {
+			iter.refs = append(iter.refs, l.children[i])
+		}
+		return iter, nil
+	})
+	am.GetObjectMock.Set(func(ctx context.Context, head insolar.Reference) (artifacts.ObjectDescriptor, error) {
+		l.lock.Lock()
+		defer l.lock.Unlock()
+		desc := artifacts.NewObjectDescriptorMock(mc)
+		desc.IsPrototypeMock.Return(true)
+		desc.MemoryMock.Return(l.memory[head])
+		return desc, nil
+	})
+	am.ActivatePrototypeMock.Set(func(ctx context.Context, request, parent, code insolar.Reference, memory []byte) error {
+		if parent != insolar.GenesisRecord.Ref() {
+			return errors.New("unexpected parent")
+		}
+		l.activate(request, memory)
+		return nil
+	})
+}
+
+func newDeployer(mc *minimock.Controller, k keys, builder Builder, builderKey string) (*Deployer, *artifacts.ClientMock, *ledger) {
+	am := artifacts.NewClientMock(mc)
+	pa := pulse.NewAccessorMock(mc)
+	pa.LatestMock.Return(*insolar.GenesisPulse, nil)
+
+	// prototype of genesis contract holds no deployment
+	l := &ledger{memory: make(map[insolar.Reference][]byte)}
+	l.activate(gen.Reference(), nil)
+	l.mock(mc, am)
+
+	cfg := configuration.NewAPIRunner().Deploy
+	cfg.BuilderPublicKey = builderKey
+	return NewDeployer(cfg, am, pa, k, builder), am, l
+}
+
+func expectDeploy(am *artifacts.ClientMock, code []byte) {
+	am.RegisterIncomingRequestMock.Set(func(ctx context.Context, r *record.IncomingRequest) (*insolar.ID, error) {
+		id := gen.ID()
+		return &id, nil
+	})
+	am.DeployCodeMock.Set(func(ctx context.Context, domain, request insolar.Reference, c []byte, mt insolar.MachineType) (*insolar.ID, error) {
+		if string(c) != string(code) || mt != insolar.MachineTypeWasm {
+			return nil, errors.New("unexpected code")
+		}
+		id := gen.ID()
+		return &id, nil
+	})
+}
+
+func versions(t *testing.T, d *Deployer, name string) []string {
+	deployments, err := d.Versions(inslogger.TestContext(t), name)
+	require.NoError(t, err)
+	res := []string{}
+	for _, deployment := range deployments {
+		res = append(res, deployment.Version)
+	}
+	return res
+}
+
+func TestDeployer_Deploy(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	m := newMember(t)
+	d, am, _ := newDeployer(mc, keys{m.ref: m.pem}, nil, "")
+	expectDeploy(am, emptyWasm)
+
+	deployment, err := d.Deploy(ctx, m.request(t, "wallet", "1.0.0", emptyWasm))
+	require.NoError(t, err)
+	require.Equal(t, "1.0.0", deployment.Version)
+	require.Equal(t, m.ref, deployment.Member)
+	require.False(t, deployment.Prototype.IsEmpty())
+	require.False(t, deployment.Code.IsEmpty())
+
+	deployment, err = d.Deploy(ctx, m.request(t, "wallet", "1.1.0", emptyWasm))
+	require.NoError(t, err)
+
+	deployed, err := d.Versions(ctx, "wallet")
+	require.NoError(t, err)
+	require.Len(t, deployed, 2)
+	require.Equal(t, *deployment, deployed[1])
+	require.Equal(t, uint64(4), am.RegisterIncomingRequestCounter)
+
+	// deployments are read from ledger, so other node sees them as well
+	other := NewDeployer(d.cfg, d.ArtifactManager, d.PulseAccessor, keys{m.ref: m.pem}, nil)
+	require.Equal(t, []string{"1.0.0", "1.1.0"}, versions(t, other, "wallet"))
+	require.Empty(t, versions(t, other, "token"))
+}
+
+func TestDeployer_Deploy_Rejected(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	owner, other := newMember(t), newMember(t)
+	d, am, _ := newDeployer(mc, keys{owner.ref: owner.pem, other.ref: other.pem}, nil, "")
+	expectDeploy(am, emptyWasm)
+
+	_, err := d.Deploy(ctx, owner.request(t, "wallet", "1.0.0", emptyWasm))
+	require.NoError(t, err)
+
+	t.Run("same version", func(t *testing.T) {
+		_, err := d.Deploy(ctx, owner.request(t, "wallet", "1.0.0", emptyWasm))
+		require.Equal(t, ErrVersionExists, errors.Cause(err))
+	})
+
+	t.Run("other member", func(t *testing.T) {
+		_, err := d.Deploy(ctx, other.request(t, "wallet", "2.0.0", emptyWasm))
+		require.Equal(t, ErrNotOwner, errors.Cause(err))
+	})
+
+	t.Run("bad version", func(t *testing.T) {
+		_, err := d.Deploy(ctx, owner.request(t, "wallet", "two", emptyWasm))
+		require.Error(t, err)
+	})
+
+	t.Run("signed by other member", func(t *testing.T) {
+		req := other.request(t, "wallet", "2.0.0", emptyWasm)
+		req.Member = owner.ref
+		_, err := d.Deploy(ctx, req)
+		require.Equal(t, ErrInvalidSignature, errors.Cause(err))
+	})
+
+	t.Run("tampered code", func(t *testing.T) {
+		req := owner.request(t, "wallet", "2.0.0", emptyWasm)
+		req.Code = append(emptyWasm[:len(emptyWasm):len(emptyWasm)], 0)
+		_, err := d.Deploy(ctx, req)
+		require.Equal(t, ErrInvalidSignature, errors.Cause(err))
+	})
+
+	t.Run("invalid code", func(t *testing.T) {
+		_, err := d.Deploy(ctx, owner.request(t, "wallet", "2.0.0", []byte("not a module")))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "verification failed")
+	})
+
+	t.Run("pre-built go plugin", func(t *testing.T) {
+		req := &Request{
+			Name:        "wallet",
+			Version:     "2.0.0",
+			Member:      owner.ref,
+			MachineType: insolar.MachineTypeGoPlugin,
+			Code:        []byte("\x7fELF"),
+		}
+		var err error
+		req.Signature, err = requester.Sign(owner.key, req.SignedData())
+		require.NoError(t, err)
+		_, err = d.Deploy(ctx, req)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "pre-built code is accepted only for wasm")
+	})
+
+	require.Equal(t, []string{"1.0.0"}, versions(t, d, "wallet"))
+}
+
+func TestDeployer_Deploy_Source(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	m := newMember(t)
+	builderKey := newMember(t)
+	var proto insolar.Reference
+	signed := true
+	builder := builderFunc(func(ctx context.Context, req BuildRequest) (*Artifact, error) {
+		proto = req.Prototype
+		artifact := &Artifact{Code: emptyWasm, MachineType: req.MachineType}
+		if signed {
+			var err error
+			artifact.Signature, err = requester.Sign(builderKey.key, artifact.SignedData(req))
+			require.NoError(t, err)
+		}
+		return artifact, nil
+	})
+	d, am, _ := newDeployer(mc, keys{m.ref: m.pem}, builder, builderKey.pem)
+	expectDeploy(am, emptyWasm)
+
+	request := func(version string) *Request {
+		req := m.request(t, "wallet", version, nil)
+		req.Source = []byte("(module)")
+		req.Signature, _ = requester.Sign(m.key, req.SignedData())
+		return req
+	}
+
+	deployment, err := d.Deploy(ctx, request("1.0.0"))
+	require.NoError(t, err)
+	require.Equal(t, proto, deployment.Prototype)
+
+	signed = false
+	_, err = d.Deploy(ctx, request("2.0.0"))
+	require.Equal(t, ErrInvalidBuilderSignature, errors.Cause(err))
+
+	d.cfg.BuilderPublicKey = ""
+	_, err = d.Deploy(ctx, request("2.0.0"))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "public key of builder isn't configured")
+
+	require.Equal(t, []string{"1.0.0"}, versions(t, d, "wallet"))
+}
+
+func TestDeployer_Deploy_Conflict(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	first, second := newMember(t), newMember(t)
+	d, am, l := newDeployer(mc, keys{first.ref: first.pem, second.ref: second.pem}, nil, "")
+	expectDeploy(am, emptyWasm)
+
+	// deployment of other member is registered through other node before this one
+	memory, err := insolar.Serialize(&Deployment{Name: "wallet", Version: "1.0.0", Member: second.ref})
+	require.NoError(t, err)
+	am.ActivatePrototypeMock.Set(func(ctx context.Context, request, parent, code insolar.Reference, m []byte) error {
+		l.activate(gen.Reference(), memory)
+		l.activate(request, m)
+		return nil
+	})
+
+	_, err = d.Deploy(ctx, first.request(t, "wallet", "1.0.0", emptyWasm))
+	require.Equal(t, ErrConflict, err)
+
+	deployed, err := d.Versions(ctx, "wallet")
+	require.NoError(t, err)
+	require.Len(t, deployed, 1)
+	require.Equal(t, second.ref, deployed[0].Member)
+}
+
+func TestApplied(t *testing.T) {
+	owner, other := gen.Reference(), gen.Reference()
+	registered := []Deployment{
+		{Version: "1.0.0", Member: owner},
+		{Version: "2.0.0", Member: other},
+		{Version: "1.0.0", Member: owner},
+		{Version: "bad", Member: owner},
+		{Version: "1.1.0", Member: owner},
+		{Version: "1.0.1", Member: owner},
+		{Version: "2.0.0", Member: owner},
+	}
+
+	res := []string{}
+	for _, deployment := range applied(registered) {
+		res = append(res, deployment.Version)
+	}
+	require.Equal(t, []string{"1.0.0", "1.1.0", "2.0.0"}, res)
+}
+
+func TestDeployer_Deploy_RetryCancelled(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	m := newMember(t)
+	d, am, _ := newDeployer(mc, keys{m.ref: m.pem}, nil, "")
+	expectDeploy(am, emptyWasm)
+	am.RegisterIncomingRequestMock.Set(func(ctx context.Context, r *record.IncomingRequest) (*insolar.ID, error) {
+		if am.RegisterIncomingRequestPreCounter == 1 {
+			return nil, errors.Wrap(flow.ErrCancelled, "pulse changed")
+		}
+		id := gen.ID()
+		return &id, nil
+	})
+
+	_, err := d.Deploy(ctx, m.request(t, "wallet", "1.0.0", emptyWasm))
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), am.RegisterIncomingRequestCounter)
+}
@@ -0,0 +1,78 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package deploy
+
+import (
+	"bytes"
+	"debug/elf"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/logicrunner/goplugin/foundation"
+	"github.com/insolar/insolar/logicrunner/wasm/vm"
+)
+
+// ErrInvalidBuilderSignature is returned when code isn't signed by the builder service.
+var ErrInvalidBuilderSignature = errors.New("invalid signature of builder")
+
+// Verify checks that code isn't too large and can be loaded by executor of its machine type.
+//
+// build is a request the artifact is built by the builder service for, signature of the builder
+// is checked then, it's nil for code uploaded by member. Go plugins run natively in insgorund,
+// so they are accepted only if they are built by the builder, members can upload only wasm code.
+func Verify(artifact *Artifact, build *BuildRequest, cfg configuration.Deploy) error {
+	if len(artifact.Code) == 0 {
+		return errors.New("code is empty")
+	}
+	if cfg.MaxCodeSize > 0 && len(artifact.Code) > cfg.MaxCodeSize {
+		return errors.Errorf("code size %d exceeds limit %d", len(artifact.Code), cfg.MaxCodeSize)
+	}
+
+	if build != nil {
+		if cfg.BuilderPublicKey == "" {
+			return errors.New("public key of builder isn't configured")
+		}
+		key := cfg.BuilderPublicKey
+		if err := foundation.VerifySignature(artifact.SignedData(*build), artifact.Signature, key, key, false); err != nil {
+			return errors.Wrap(ErrInvalidBuilderSignature, err.Error())
+		}
+	}
+
+	switch artifact.MachineType {
+	case insolar.MachineTypeGoPlugin:
+		if build == nil {
+			return errors.New("go plugins are accepted only from the builder")
+		}
+		f, err := elf.NewFile(bytes.NewReader(artifact.Code))
+		if err != nil {
+			return errors.Wrap(err, "code isn't a go plugin")
+		}
+		defer f.Close()
+		if f.Type != elf.ET_DYN {
+			return errors.Errorf("code isn't a shared object, ELF type is %s", f.Type)
+		}
+	case insolar.MachineTypeWasm:
+		if _, err := vm.Decode(artifact.Code); err != nil {
+			return errors.Wrap(err, "code isn't a supported WebAssembly module")
+		}
+	default:
+		return errors.Errorf("machine type %d can't be deployed", artifact.MachineType)
+	}
+	return nil
+}
@@ -28,8 +28,10 @@ import (
 	"github.com/insolar/insolar/insolar/jet"
 	"github.com/insolar/insolar/insolar/message"
 	"github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/insolar/record"
 	"github.com/insolar/insolar/instrumentation/inslogger"
 	"github.com/insolar/insolar/logicrunner/artifacts"
+	"github.com/insolar/insolar/network/throttle"
 )
 
 type TranscriptDequeueElement struct {
@@ -257,6 +259,7 @@ type ExecutionBroker struct {
 	jetCoordinator   jet.Coordinator
 	pulseAccessor    pulse.Accessor
 	artifactsManager artifacts.Client
+	trafficGate      throttle.Gate
 
 	Ref insolar.Reference
 
@@ -381,6 +384,16 @@ func (q *ExecutionBroker) processTranscript(ctx context.Context, transcript *Tra
 		return false
 	}
 
+	// Only requests from API are throttled: nested calls are awaited by requests which already passed the gate.
+	if q.trafficGate != nil && transcript.Request.IsAPIRequest() {
+		release, err := q.trafficGate.Acquire(ctx)
+		if err != nil {
+			inslogger.FromContext(ctx).Error("[ processTranscript ] failed to pass traffic limit: ", err)
+			return false
+		}
+		defer release()
+	}
+
 	q.Execute(ctx, transcript)
 	// q.finishTask(ctx, transcript)
 	return true
@@ -697,18 +710,13 @@ func (q *ExecutionBroker) onPulseWeNotNext(ctx context.Context) []insolar.Messag
 
 	// rotation results also contain finished requests
 	rotationResults := q.Rotate(maxQueueLength)
+	if validationMsg := q.validationMessage(ctx, rotationResults.Finished); validationMsg != nil {
+		messages = append(messages, validationMsg)
+	}
+
 	if len(rotationResults.Requests) > 0 || sendExecResults {
-		// TODO: we also should send when executed something for validation
-		// TODO: now validation is disabled
 		messagesQueue := convertQueueToMessageQueue(ctx, rotationResults.Requests)
 
-		// validationMsg := &message.ValidateCaseBind{
-		// 	Reference: ref,
-		// 	Requests:  requests,
-		// 	Pulse:     pulse,
-		// }
-		// messages := append(messages, validationMsg)
-
 		ledgerHasMoreRequests := q.ledgerHasMoreRequests || rotationResults.LedgerHasMoreRequests
 		resultsMsg := &message.ExecutorResults{
 			RecordRef:             q.Ref,
@@ -738,7 +746,69 @@ func (q *ExecutionBroker) onPulseWeNext(ctx context.Context) []insolar.Message {
 
 	es.PendingConfirmed = false
 
-	return make([]insolar.Message, 0)
+	messages := make([]insolar.Message, 0)
+	if validationMsg := q.validationMessage(ctx, q.takeFinished()); validationMsg != nil {
+		messages = append(messages, validationMsg)
+	}
+	return messages
+}
+
+// takeFinished returns requests finished since the previous pulse.
+func (q *ExecutionBroker) takeFinished() []*Transcript {
+	q.stateLock.Lock()
+	defer q.stateLock.Unlock()
+
+	return q.finished.Rotate()
+}
+
+// validationMessage sends finished requests to validators. Validators fetch requests, results and
+// object states from ledger, so only references are sent.
+func (q *ExecutionBroker) validationMessage(ctx context.Context, finished []*Transcript) insolar.Message {
+	requests := make([]message.CaseBindRequest, 0, len(finished))
+	for _, transcript := range finished {
+		// result wasn't saved, there is nothing to validate
+		if transcript.StateHash == nil {
+			continue
+		}
+
+		bind := message.CaseBindRequest{
+			RequestRef: transcript.RequestRef,
+			Object:     transcript.RequestRef,
+			StateHash:  transcript.StateHash,
+		}
+		if transcript.Request.CallType == record.CTMethod {
+			if transcript.Request.Object == nil || transcript.ObjectDescriptor == nil {
+				continue
+			}
+			bind.Object = *transcript.Request.Object
+			bind.State = *transcript.ObjectDescriptor.StateID()
+		}
+		for _, out := range transcript.OutgoingRequests {
+			outgoing := message.CaseBindOutgoing{
+				Request:   out.Request,
+				Response:  out.Response,
+				NewObject: out.NewObject,
+			}
+			if out.Error != nil {
+				outgoing.Error = out.Error.Error()
+			}
+			bind.Outgoing = append(bind.Outgoing, outgoing)
+		}
+		requests = append(requests, bind)
+	}
+	if len(requests) == 0 {
+		return nil
+	}
+
+	msg := &message.ValidateCaseBind{
+		Caller:    q.jetCoordinator.Me(),
+		RecordRef: q.Ref,
+		Requests:  requests,
+	}
+	if pulse, err := q.pulseAccessor.Latest(ctx); err == nil {
+		msg.Pulse = pulse
+	}
+	return msg
 }
 
 func (q *ExecutionBroker) OnPulse(ctx context.Context, meNext bool) []insolar.Message {
@@ -826,6 +896,7 @@ func NewExecutionBroker(
 	jetCoordinator jet.Coordinator,
 	pulseAccessor pulse.Accessor,
 	artifactsManager artifacts.Client,
+	trafficGate throttle.Gate,
 ) *ExecutionBroker {
 	return &ExecutionBroker{
 		Ref: ref,
@@ -842,6 +913,7 @@ func NewExecutionBroker(
 		jetCoordinator:   jetCoordinator,
 		pulseAccessor:    pulseAccessor,
 		artifactsManager: artifactsManager,
+		trafficGate:      trafficGate,
 
 		ledgerChecked:   sync.Once{},
 		processorActive: 0,
@@ -35,6 +35,8 @@ import (
 	"github.com/insolar/insolar/insolar/record"
 	"github.com/insolar/insolar/instrumentation/inslogger"
 	"github.com/insolar/insolar/logicrunner/artifacts"
+	"github.com/insolar/insolar/network/consensus/common/capacity"
+	"github.com/insolar/insolar/network/throttle"
 	"github.com/insolar/insolar/testutils"
 	"github.com/insolar/insolar/testutils/network"
 )
@@ -306,6 +308,7 @@ func (s *ExecutionBrokerSuite) prepareLogicRunner(t *testing.T) *LogicRunner {
 	lr.PulseAccessor = ps
 	lr.NodeNetwork = nn
 	lr.RequestsExecutor = re
+	lr.TrafficGate = throttle.NewLimiter(throttle.DefaultCapacity)
 
 	_ = lr.Init(s.Context)
 
@@ -455,6 +458,58 @@ func (s *ExecutionBrokerSuite) TestImmutable_NotPending() {
 	_ = lr.Stop(s.Context)
 }
 
+func (s *ExecutionBrokerSuite) TestTrafficLimit() {
+	lr := s.prepareLogicRunner(s.T())
+	limiter := lr.TrafficGate.(*throttle.Limiter)
+	limiter.SetLimit(s.Context, capacity.LevelZero, time.Minute)
+
+	executed := make(chan insolar.Reference, 2)
+	rem := lr.RequestsExecutor.(*RequestsExecutorMock)
+	rem.ExecuteAndSaveMock.Set(func(_ context.Context, t *Transcript) (r insolar.Reply, r1 error) {
+		executed <- t.RequestRef
+		return nil, nil
+	})
+	rem.SendReplyMock.Return()
+
+	objectRef := gen.Reference()
+	b := lr.StateStorage.UpsertExecutionState(objectRef)
+	b.executionState.pending = insolar.NotPending
+
+	apiRequest := gen.Reference()
+	nestedRequest := gen.Reference()
+	b.Put(s.Context, false, NewTranscript(s.Context, apiRequest, record.IncomingRequest{
+		Immutable: true,
+		APINode:   gen.Reference(),
+	}))
+	b.Put(s.Context, false, NewTranscript(s.Context, nestedRequest, record.IncomingRequest{
+		Immutable: true,
+		Caller:    gen.Reference(),
+	}))
+
+	select {
+	case ref := <-executed:
+		s.Equal(nestedRequest, ref, "nested request should not be throttled")
+	case <-time.After(time.Minute):
+		s.FailNow("nested request is not executed")
+	}
+
+	select {
+	case <-executed:
+		s.FailNow("request from API should wait until traffic is resumed")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	limiter.Resume(s.Context)
+	select {
+	case ref := <-executed:
+		s.Equal(apiRequest, ref)
+	case <-time.After(time.Minute):
+		s.FailNow("request from API is not executed after traffic is resumed")
+	}
+
+	_ = lr.Stop(s.Context)
+}
+
 func (s *ExecutionBrokerSuite) TestImmutable_InPending() {
 	lr := s.prepareLogicRunner(s.T())
 
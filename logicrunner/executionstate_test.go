@@ -29,6 +29,7 @@ import (
 	"github.com/insolar/insolar/insolar/record"
 	"github.com/insolar/insolar/instrumentation/inslogger"
 	"github.com/insolar/insolar/logicrunner/artifacts"
+	"github.com/insolar/insolar/network/throttle"
 	"github.com/insolar/insolar/testutils"
 )
 
@@ -69,7 +70,7 @@ func newExecutionBroker(
 
 	lr := LogicRunner{
 		RequestsExecutor: NewRequestsExecutorMock(t),
-		StateStorage:     NewStateStorage(pm, re, mb, jc, ps, am),
+		StateStorage:     NewStateStorage(pm, re, mb, jc, ps, am, throttle.NewLimiter(throttle.DefaultCapacity)),
 	}
 
 	objectRef := gen.Reference()
@@ -0,0 +1,159 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package ginsider
+
+import (
+	"container/list"
+	"context"
+	"os"
+	"plugin"
+	"sync"
+
+	"go.opencensus.io/stats"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+)
+
+// DefaultMaxDiskSize is a default limit of size of cached plugin files
+const DefaultMaxDiskSize = 1 << 30
+
+type pluginRec struct {
+	sync.Mutex
+	plugin *plugin.Plugin
+
+	ref    insolar.Reference
+	path   string
+	size   int64
+	loaded bool
+	pinned bool
+	owned  bool
+	elem   *list.Element // in LRU of files, nil if file isn't counted
+}
+
+// pluginCache keeps plugins loaded by GoInsider and bounds size of their files on disk.
+//
+// Go runtime never unloads plugins, so loaded plugin stays in memory and its record is
+// never dropped, calls in progress and later calls keep using it without fetching code
+// again. Only files of least recently used plugins are removed from disk when their size
+// goes over the limit. Memory taken by plugins is released only by restart of insgorund,
+// e.g. goplugin runner pool restarts runners it spawned. Files of pinned plugins are
+// never removed.
+type pluginCache struct {
+	lock sync.Mutex
+
+	maxSize int64
+	size    int64
+	plugins int64 // loaded in memory
+
+	recs   map[insolar.Reference]*pluginRec
+	lru    *list.List // of *pluginRec with files on disk, most recently used first
+	pinned map[insolar.Reference]bool
+}
+
+func newPluginCache(maxSize int64) *pluginCache {
+	return &pluginCache{
+		maxSize: maxSize,
+		recs:    make(map[insolar.Reference]*pluginRec),
+		lru:     list.New(),
+		pinned:  make(map[insolar.Reference]bool),
+	}
+}
+
+// get returns existing record or creates a new one, record is marked as recently used
+func (c *pluginCache) get(ref insolar.Reference) *pluginRec {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	rec, ok := c.recs[ref]
+	if !ok {
+		rec = &pluginRec{ref: ref, pinned: c.pinned[ref]}
+		c.recs[ref] = rec
+	}
+	if rec.elem != nil {
+		c.lru.MoveToFront(rec.elem)
+	}
+	return rec
+}
+
+// pin protects file of plugin from removal, plugin may be not loaded yet
+func (c *pluginCache) pin(ref insolar.Reference) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.pinned[ref] = true
+	if rec, ok := c.recs[ref]; ok {
+		rec.pinned = true
+	}
+}
+
+func (c *pluginCache) setLimit(ctx context.Context, maxSize int64) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.maxSize = maxSize
+	c.evict(ctx)
+}
+
+// loaded registers file of plugin loaded from path and removes files over the limit,
+// owned file may be removed, file is counted once even if plugin is registered again
+func (c *pluginCache) loaded(ctx context.Context, rec *pluginRec, path string, owned bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if !rec.loaded {
+		rec.loaded = true
+		c.plugins++
+	}
+	if rec.elem != nil {
+		c.lru.MoveToFront(rec.elem)
+		return
+	}
+
+	rec.path, rec.owned, rec.size = path, owned, 0
+	if info, err := os.Stat(path); err == nil {
+		rec.size = info.Size()
+	}
+	c.size += rec.size
+	rec.elem = c.lru.PushFront(rec)
+
+	c.evict(ctx)
+}
+
+// evict removes files of least recently used plugins over the limit, loaded plugins stay in cache
+func (c *pluginCache) evict(ctx context.Context) {
+	elem := c.lru.Back()
+	for elem != nil && c.size > c.maxSize {
+		rec := elem.Value.(*pluginRec)
+		elem = elem.Prev()
+		if rec.pinned || !rec.owned {
+			continue
+		}
+
+		inslogger.FromContext(ctx).Debugf("Removing file of plugin %q", rec.ref)
+		if err := os.Remove(rec.path); err != nil && !os.IsNotExist(err) {
+			inslogger.FromContext(ctx).Errorf("Couldn't remove plugin file %q: %s", rec.path, err)
+			continue
+		}
+		stats.Record(ctx, statPluginCacheEvictions.M(1))
+
+		c.lru.Remove(rec.elem)
+		rec.elem = nil
+		c.size -= rec.size
+	}
+	stats.Record(ctx, statPluginCacheSize.M(c.size), statPluginCachePlugins.M(c.plugins))
+}
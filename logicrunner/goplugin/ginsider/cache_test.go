@@ -0,0 +1,129 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package ginsider
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+)
+
+func loadFake(t *testing.T, c *pluginCache, dir string, size int) (insolar.Reference, string) {
+	ref := gen.Reference()
+	path := filepath.Join(dir, ref.String())
+	require.NoError(t, ioutil.WriteFile(path, make([]byte, size), 0666))
+
+	rec := c.get(ref)
+	c.loaded(context.Background(), rec, path, true)
+	return ref, path
+}
+
+func onDisk(t *testing.T, path string) bool {
+	_, err := os.Stat(path)
+	if os.IsNotExist(err) {
+		return false
+	}
+	require.NoError(t, err)
+	return true
+}
+
+func TestPluginCache_RemovesLeastRecentlyUsedFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "plugincache-")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	c := newPluginCache(25)
+	first, firstPath := loadFake(t, c, dir, 10)
+	second, secondPath := loadFake(t, c, dir, 10)
+
+	// first becomes the most recently used
+	c.get(first)
+	third, thirdPath := loadFake(t, c, dir, 10)
+
+	require.True(t, onDisk(t, firstPath))
+	require.False(t, onDisk(t, secondPath))
+	require.True(t, onDisk(t, thirdPath))
+	require.Equal(t, int64(20), c.size)
+
+	// loaded plugins are kept in memory, records are never dropped
+	require.Len(t, c.recs, 3)
+	require.True(t, c.get(second).loaded)
+	require.Nil(t, c.get(second).elem)
+	require.Equal(t, int64(3), c.plugins)
+
+	// second is used again, its file isn't counted again
+	c.get(second)
+	c.get(third)
+	require.Equal(t, int64(20), c.size)
+	require.Equal(t, 2, c.lru.Len())
+}
+
+func TestPluginCache_LoadedTwice(t *testing.T) {
+	dir, err := ioutil.TempDir("", "plugincache-")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	c := newPluginCache(1000)
+	ref, path := loadFake(t, c, dir, 10)
+	c.loaded(context.Background(), c.get(ref), path, true)
+
+	require.Equal(t, int64(10), c.size)
+	require.Equal(t, int64(1), c.plugins)
+	require.Equal(t, 1, c.lru.Len())
+}
+
+func TestPluginCache_SetLimit(t *testing.T) {
+	dir, err := ioutil.TempDir("", "plugincache-")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	c := newPluginCache(1000)
+	_, firstPath := loadFake(t, c, dir, 10)
+	_, secondPath := loadFake(t, c, dir, 10)
+
+	c.setLimit(context.Background(), 15)
+
+	require.False(t, onDisk(t, firstPath))
+	require.True(t, onDisk(t, secondPath))
+	require.Equal(t, int64(10), c.size)
+}
+
+func TestPluginCache_Pinned(t *testing.T) {
+	dir, err := ioutil.TempDir("", "plugincache-")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	c := newPluginCache(1)
+	pinned := gen.Reference()
+	c.pin(pinned)
+	path := filepath.Join(dir, pinned.String())
+	require.NoError(t, ioutil.WriteFile(path, []byte{1}, 0666))
+	c.loaded(context.Background(), c.get(pinned), path, true)
+
+	_, otherPath := loadFake(t, c, dir, 1)
+
+	require.True(t, onDisk(t, path))
+	require.False(t, onDisk(t, otherPath))
+	require.Equal(t, int64(1), c.size)
+}
@@ -32,6 +32,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/tylerb/gls"
 	"github.com/ugorji/go/codec"
+	"go.opencensus.io/stats"
 
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/instrumentation/inslogger"
@@ -42,11 +43,6 @@ import (
 	"github.com/insolar/insolar/metrics"
 )
 
-type pluginRec struct {
-	sync.Mutex
-	plugin *plugin.Plugin
-}
-
 // GoInsider is an RPC interface to run code of plugins
 type GoInsider struct {
 	dir              string
@@ -56,8 +52,7 @@ type GoInsider struct {
 	upstreamMutex  sync.Mutex // lock UpstreamClient change
 	UpstreamClient *rpc.Client
 
-	plugins      map[insolar.Reference]*pluginRec
-	pluginsMutex sync.Mutex
+	plugins *pluginCache
 
 	lrCommon.Serializer
 }
@@ -66,7 +61,7 @@ type GoInsider struct {
 func NewGoInsider(path, network, address string) *GoInsider {
 	//TODO: check that path exist, it's a directory and writable
 	res := GoInsider{dir: path, upstreamProtocol: network, upstreamAddress: address}
-	res.plugins = make(map[insolar.Reference]*pluginRec)
+	res.plugins = newPluginCache(DefaultMaxDiskSize)
 	lrCommon.CurrentProxyCtx = &res
 	res.Serializer = lrCommon.NewCBORSerializer()
 	return &res
@@ -150,6 +145,12 @@ func (t *RPC) CallMethod(args rpctypes.DownCallMethodReq, reply *rpctypes.DownCa
 	return nil
 }
 
+// Ping is an RPC that replies as soon as the runner serves calls, used by health checks
+func (t *RPC) Ping(args rpctypes.DownPingReq, reply *rpctypes.DownPingResp) error {
+	reply.Seq = args.Seq
+	return nil
+}
+
 // CallConstructor is an RPC that runs a method on an object and
 // returns a new state of the object and result of the method
 func (t *RPC) CallConstructor(args rpctypes.DownCallConstructorReq, reply *rpctypes.DownCallConstructorResp) (err error) {
@@ -245,17 +246,31 @@ func (gi *GoInsider) ObtainCode(ctx context.Context, ref insolar.Reference) (str
 	return path, nil
 }
 
+// SetCacheLimit sets limit of size of plugin files on disk, files of least recently used
+// plugins over the limit are removed. Loaded plugins are never unloaded by Go runtime,
+// memory is released only by restart of insgorund.
+func (gi *GoInsider) SetCacheLimit(maxDiskSize int64) {
+	gi.plugins.setLimit(context.Background(), maxDiskSize)
+}
+
+// Pin protects file of plugin from removal, e.g. genesis contracts are used all the time
+func (gi *GoInsider) Pin(ref insolar.Reference) {
+	gi.plugins.pin(ref)
+}
+
 // Plugin loads Go plugin by reference and returns `*plugin.Plugin`
 // ready to lookup symbols
 func (gi *GoInsider) Plugin(ctx context.Context, ref insolar.Reference) (*plugin.Plugin, error) {
-	rec := gi.getPluginRec(ref)
+	rec := gi.plugins.get(ref)
 
 	rec.Lock()
 	defer rec.Unlock()
 
 	if rec.plugin != nil {
+		stats.Record(ctx, statPluginCacheHits.M(1))
 		return rec.plugin, nil
 	}
+	stats.Record(ctx, statPluginCacheMisses.M(1))
 
 	path, err := gi.ObtainCode(ctx, ref)
 	if err != nil {
@@ -269,22 +284,10 @@ func (gi *GoInsider) Plugin(ctx context.Context, ref insolar.Reference) (*plugin
 	}
 
 	rec.plugin = p
+	gi.plugins.loaded(ctx, rec, path, true)
 	return p, nil
 }
 
-// getPluginRec return existed gi.plugins[ref] or create a new one
-// also set gi.plugins[ref].Lock()
-func (gi *GoInsider) getPluginRec(ref insolar.Reference) *pluginRec {
-	gi.pluginsMutex.Lock()
-	defer gi.pluginsMutex.Unlock()
-
-	if gi.plugins[ref] == nil {
-		gi.plugins[ref] = &pluginRec{}
-	}
-	res := gi.plugins[ref]
-	return res
-}
-
 // MakeUpBaseReq makes base of request from current CallContext
 func MakeUpBaseReq() rpctypes.UpBaseReq {
 	callCtx, ok := gls.Get("callCtx").(*insolar.LogicCallContext)
@@ -458,9 +461,10 @@ func (gi *GoInsider) MakeErrorSerializable(e error) error {
 	return &foundation.Error{S: e.Error()}
 }
 
-// AddPlugin inject plugin by ref in gi memory
-func (gi *GoInsider) AddPlugin(ref insolar.Reference, path string) error {
-	rec := gi.getPluginRec(ref)
+// AddPlugin inject plugin by ref in gi memory, such plugin is pinned
+func (gi *GoInsider) AddPlugin(ctx context.Context, ref insolar.Reference, path string) error {
+	gi.plugins.pin(ref)
+	rec := gi.plugins.get(ref)
 
 	rec.Lock()
 	defer rec.Unlock()
@@ -474,7 +478,8 @@ func (gi *GoInsider) AddPlugin(ref insolar.Reference, path string) error {
 		return errors.Wrap(err, "[ AddPlugin ] couldn't open plugin")
 	}
 
-	inslogger.FromContext(context.TODO()).Debugf("AddPlugin plugins %+v", gi.plugins)
+	inslogger.FromContext(ctx).Debugf("AddPlugin plugin %q from file %q", ref, path)
 	rec.plugin = p
+	gi.plugins.loaded(ctx, rec, path, false)
 	return nil
 }
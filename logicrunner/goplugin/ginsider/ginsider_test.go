@@ -20,6 +20,7 @@
 package ginsider
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 	"net"
@@ -88,7 +89,7 @@ func (s *HealthCheckSuite) TestHealthCheck() {
 	s.Require().NoError(err)
 
 	healthcheckSoFile := path.Join(tmpDir, "healthcheck.so")
-	err = gi.AddPlugin(*ref, healthcheckSoFile)
+	err = gi.AddPlugin(context.Background(), *ref, healthcheckSoFile)
 	s.Require().NoError(err, "failed to add plugin by path "+healthcheckSoFile)
 
 	s.prepareGoInsider(gi, protocol, socket)
@@ -0,0 +1,68 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package ginsider
+
+import (
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+)
+
+var (
+	statPluginCacheHits      = stats.Int64("goplugin/plugins/cache/hits", "count of calls to already loaded plugins", stats.UnitDimensionless)
+	statPluginCacheMisses    = stats.Int64("goplugin/plugins/cache/misses", "count of calls that had to load plugin", stats.UnitDimensionless)
+	statPluginCacheEvictions = stats.Int64("goplugin/plugins/cache/evictions", "count of plugin files removed from cache", stats.UnitDimensionless)
+	statPluginCachePlugins   = stats.Int64("goplugin/plugins/cache/plugins", "number of plugins loaded in memory", stats.UnitDimensionless)
+	statPluginCacheSize      = stats.Int64("goplugin/plugins/cache/size", "size of plugin files in cache", stats.UnitBytes)
+)
+
+func init() {
+	err := view.Register(
+		&view.View{
+			Name:        statPluginCacheHits.Name(),
+			Description: statPluginCacheHits.Description(),
+			Measure:     statPluginCacheHits,
+			Aggregation: view.Sum(),
+		},
+		&view.View{
+			Name:        statPluginCacheMisses.Name(),
+			Description: statPluginCacheMisses.Description(),
+			Measure:     statPluginCacheMisses,
+			Aggregation: view.Sum(),
+		},
+		&view.View{
+			Name:        statPluginCacheEvictions.Name(),
+			Description: statPluginCacheEvictions.Description(),
+			Measure:     statPluginCacheEvictions,
+			Aggregation: view.Sum(),
+		},
+		&view.View{
+			Name:        statPluginCachePlugins.Name(),
+			Description: statPluginCachePlugins.Description(),
+			Measure:     statPluginCachePlugins,
+			Aggregation: view.LastValue(),
+		},
+		&view.View{
+			Name:        statPluginCacheSize.Name(),
+			Description: statPluginCacheSize.Description(),
+			Measure:     statPluginCacheSize,
+			Aggregation: view.LastValue(),
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+}
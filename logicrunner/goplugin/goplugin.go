@@ -19,8 +19,8 @@ package goplugin
 
 import (
 	"context"
+	"io"
 	"net/rpc"
-	"sync"
 	"time"
 
 	"github.com/pkg/errors"
@@ -55,90 +55,73 @@ type GoPlugin struct {
 	MessageBus      insolar.MessageBus
 	ArtifactManager artifacts.Client
 
-	clientMutex sync.Mutex
-	client      *rpc.Client
+	runners *pool
 }
 
-// NewGoPlugin returns a new started GoPlugin
+// NewGoPlugin returns a new started GoPlugin, runners spawned by the node are started as well
 func NewGoPlugin(conf *configuration.LogicRunner, eb insolar.MessageBus, am artifacts.Client) (*GoPlugin, error) {
 	gp := GoPlugin{
 		Cfg:             conf,
 		MessageBus:      eb,
 		ArtifactManager: am,
+		runners:         newPool(conf),
 	}
 
-	return &gp, nil
-}
-
-const timeout = time.Minute * 10
-
-// Downstream returns a connection to `ginsider`
-func (gp *GoPlugin) Downstream(ctx context.Context) (*rpc.Client, error) {
-	_, span := instracer.StartSpan(ctx, "GoPlugin.Downstream")
-	defer span.End()
-
-	gp.clientMutex.Lock()
-	defer gp.clientMutex.Unlock()
-
-	if gp.client != nil {
-		return gp.client, nil
-	}
-
-	inslogger.FromContext(ctx).Debug("dialing insgorund")
-	client, err := rpc.Dial(gp.Cfg.GoPlugin.RunnerProtocol, gp.Cfg.GoPlugin.RunnerListen)
-	if err != nil {
-		return nil, errors.Wrapf(
-			err, "couldn't dial '%s' over %s",
-			gp.Cfg.GoPlugin.RunnerListen, gp.Cfg.GoPlugin.RunnerProtocol,
-		)
+	if err := gp.runners.start(context.Background()); err != nil {
+		gp.runners.close()
+		return nil, err
 	}
 
-	gp.client = client
-	return gp.client, nil
+	return &gp, nil
 }
 
-func (gp *GoPlugin) CloseDownstream() {
-	gp.clientMutex.Lock()
-	defer gp.clientMutex.Unlock()
-
-	// this method can be called multiple times from callClientWithReconnect
-	if gp.client != nil {
-		gp.client.Close()
-		gp.client = nil
-	}
+// Stop stops health checks and runners spawned by the node
+func (gp *GoPlugin) Stop() {
+	gp.runners.close()
 }
 
+const timeout = time.Minute * 10
+
 func (gp *GoPlugin) callClientWithReconnect(ctx context.Context, method string, req interface{}, res interface{}) error {
-	var err error
-	var client *rpc.Client
+	ctx, span := instracer.StartSpan(ctx, "GoPlugin callClientWithReconnect")
+	defer span.End()
 
 	for {
-		client, err = gp.Downstream(ctx)
-		if err == nil {
-			ctx, span := instracer.StartSpan(ctx, "GoPlugin callClientWithReconnect")
-			defer span.End()
+		r, err := gp.runners.pick()
+		if err == errNoRunners {
+			return err
+		}
+		if err != nil {
+			inslogger.FromContext(ctx).Debugf("Can't pick insgorund, err: %s", err.Error())
+			select {
+			case <-ctx.Done():
+				return err
+			case <-time.After(restartDelay):
+				continue
+			}
+		}
 
-			inslogger.FromContext(ctx).Debug("Sending request to insgorund")
+		// nothing is sent to the runner yet, so another one may serve the call
+		client, err := r.downstream(ctx)
+		if err != nil {
+			inslogger.FromContext(ctx).Debugf("Can't connect to insgorund, err: %s", err.Error())
+			r.eject(ctx, err)
+			continue
+		}
 
-			call := <-client.Go(method, req, res, nil).Done
-			err = call.Error
+		inslogger.FromContext(ctx).Debug("Sending request to insgorund at ", r.cfg.Listen)
 
-			inslogger.FromContext(ctx).Debug("insgorund replied")
+		call := <-client.Go(method, req, res, nil).Done
 
-			if err != rpc.ErrShutdown {
-				break
-			} else {
-				inslogger.FromContext(ctx).Debug("Connection to insgorund is closed, need to reconnect")
-				gp.CloseDownstream()
-				inslogger.FromContext(ctx).Debugf("Reconnecting...")
-			}
-		} else {
-			inslogger.FromContext(ctx).Debugf("Can't connect to to insgorund, err: %s", err.Error())
-			inslogger.FromContext(ctx).Debugf("Reconnecting...")
+		inslogger.FromContext(ctx).Debug("insgorund replied")
+
+		// the call may be already executed by the runner, so it's never sent again
+		if call.Error == rpc.ErrShutdown || call.Error == io.ErrUnexpectedEOF {
+			inslogger.FromContext(ctx).Debug("Connection to insgorund is closed")
+			r.eject(ctx, call.Error)
 		}
+		return call.Error
 	}
-
-	return err
 }
 
 type CallMethodResult struct {
@@ -183,7 +166,8 @@ func (gp *GoPlugin) CallMethod(
 		Arguments: args,
 	}
 
-	resultChan := make(chan CallMethodResult)
+	// buffered, so RPC goroutine doesn't leak when we stop waiting for it
+	resultChan := make(chan CallMethodResult, 1)
 	go gp.CallMethodRPC(ctx, req, res, resultChan)
 
 	select {
@@ -192,6 +176,9 @@ func (gp *GoPlugin) CallMethod(
 			return nil, nil, errors.Wrap(callResult.Error, "problem with API call")
 		}
 		return callResult.Response.Data, callResult.Response.Ret, nil
+	case <-ctx.Done():
+		inslogger.FromContext(ctx).Debug("CallMethodRPC context is done")
+		return nil, nil, errors.Wrap(ctx.Err(), "logicrunner execution is interrupted")
 	case <-time.After(timeout):
 		inslogger.FromContext(ctx).Debug("CallMethodRPC waiting results timeout")
 		return nil, nil, errors.New("logicrunner execution timeout")
@@ -225,7 +212,8 @@ func (gp *GoPlugin) CallConstructor(
 		Arguments: args,
 	}
 
-	resultChan := make(chan CallConstructorResult)
+	// buffered, so RPC goroutine doesn't leak when we stop waiting for it
+	resultChan := make(chan CallConstructorResult, 1)
 	go gp.CallConstructorRPC(ctx, req, res, resultChan)
 
 	select {
@@ -234,6 +222,9 @@ func (gp *GoPlugin) CallConstructor(
 			return nil, errors.Wrap(callResult.Error, "problem with API call")
 		}
 		return callResult.Response.Ret, nil
+	case <-ctx.Done():
+		inslogger.FromContext(ctx).Debug("CallConstructor context is done")
+		return nil, errors.Wrap(ctx.Err(), "logicrunner execution is interrupted")
 	case <-time.After(timeout):
 		inslogger.FromContext(ctx).Debug("CallConstructor waiting results timeout")
 		return nil, errors.New("logicrunner execution timeout")
@@ -18,13 +18,17 @@ package goplugintestutils
 
 import (
 	"context"
+	"crypto"
+	"encoding/json"
 	"go/build"
 	"io/ioutil"
+	"net/http"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"regexp"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -34,11 +38,13 @@ import (
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/insolar/insolar/api/requester"
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/insolar/api"
 	"github.com/insolar/insolar/insolar/record"
 	"github.com/insolar/insolar/log"
 	"github.com/insolar/insolar/logicrunner/artifacts"
+	"github.com/insolar/insolar/logicrunner/deploy"
 	"github.com/insolar/insolar/testutils"
 )
 
@@ -305,3 +311,75 @@ func (cb *ContractsBuilder) plugin(name string) error {
 	}
 	return nil
 }
+
+// LocalBuilder compiles go contracts with insgocc and go toolchain of the host and signs them with its key,
+// it implements deploy.Builder and replaces sandboxed builder service in functests
+type LocalBuilder struct {
+	lock sync.Mutex
+	cb   *ContractsBuilder
+	key  crypto.PrivateKey
+}
+
+// NewLocalBuilder returns a new `LocalBuilder`, takes in path to insgocc and key code is signed with
+func NewLocalBuilder(icc string, key crypto.PrivateKey) *LocalBuilder {
+	return &LocalBuilder{cb: NewContractBuilder(nil, icc, nil), key: key}
+}
+
+// Build compiles go plugin of contract, proxy of the contract refers to requested prototype
+func (b *LocalBuilder) Build(ctx context.Context, req deploy.BuildRequest) (*deploy.Artifact, error) {
+	if req.MachineType != insolar.MachineTypeGoPlugin {
+		return nil, errors.Errorf("[ LocalBuilder ] can't build code of machine type %d", req.MachineType)
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	proto := req.Prototype
+	b.cb.Prototypes[req.Name] = &proto
+
+	code := regexp.MustCompile(`package\s+\S+`).ReplaceAllString(string(req.Source), "package main")
+	err := WriteFile(filepath.Join(b.cb.root, "src/contract", req.Name), "main.go", code)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ LocalBuilder ] Can't WriteFile")
+	}
+	if err := b.cb.proxy(req.Name); err != nil {
+		return nil, errors.Wrap(err, "[ LocalBuilder ] Can't call proxy")
+	}
+	if err := b.cb.wrapper(req.Name); err != nil {
+		return nil, errors.Wrap(err, "[ LocalBuilder ] Can't call wrapper")
+	}
+	if err := b.cb.plugin(req.Name); err != nil {
+		return nil, errors.Wrap(err, "[ LocalBuilder ] Can't call plugin")
+	}
+
+	pluginBinary, err := ioutil.ReadFile(filepath.Join(b.cb.root, "plugins", req.Name+".so"))
+	if err != nil {
+		return nil, errors.Wrap(err, "[ LocalBuilder ] Can't ReadFile")
+	}
+
+	artifact := &deploy.Artifact{Code: pluginBinary, MachineType: insolar.MachineTypeGoPlugin}
+	artifact.Signature, err = requester.Sign(b.key, artifact.SignedData(req))
+	if err != nil {
+		return nil, errors.Wrap(err, "[ LocalBuilder ] Can't sign code")
+	}
+	return artifact, nil
+}
+
+// ServeHTTP serves API of the builder service, see deploy.HTTPBuilder
+func (b *LocalBuilder) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	reply := deploy.BuildReply{}
+	req := deploy.BuildRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		reply.Error = err.Error()
+	} else if artifact, err := b.Build(r.Context(), req); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		reply.Error = err.Error()
+	} else {
+		reply.Code, reply.MachineType, reply.Signature = artifact.Code, artifact.MachineType, artifact.Signature
+	}
+
+	if err := json.NewEncoder(w).Encode(reply); err != nil {
+		log.Error("[ LocalBuilder ] Can't write reply: ", err)
+	}
+}
@@ -0,0 +1,375 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package goplugin
+
+import (
+	"context"
+	"net"
+	"net/rpc"
+	"os"
+	"os/exec"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/logicrunner/goplugin/rpctypes"
+)
+
+const (
+	dialTimeout         = 5 * time.Second
+	restartDelay        = time.Second
+	healthCheckInterval = 5 * time.Second
+)
+
+// ErrNoHealthyRunners is returned when every runner of the pool is unhealthy
+var ErrNoHealthyRunners = errors.New("no healthy insgorund runners")
+
+var (
+	errNoRunners = errors.New("no insgorund runners configured")
+	errStopped   = errors.New("goplugin is stopped")
+)
+
+// runner is a connection to one insgorund, optionally spawned by GoPlugin
+type runner struct {
+	cfg configuration.GoPluginRunner
+
+	lock     sync.Mutex
+	client   *rpc.Client
+	healthy  bool
+	failures int
+	cmd      *exec.Cmd
+}
+
+func newRunner(cfg configuration.GoPluginRunner) *runner {
+	return &runner{cfg: cfg, healthy: true}
+}
+
+func (r *runner) isHealthy() bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.healthy
+}
+
+// downstream returns a connection to the runner, dialing it if needed
+func (r *runner) downstream(ctx context.Context) (*rpc.Client, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.client != nil {
+		return r.client, nil
+	}
+
+	inslogger.FromContext(ctx).Debugf("dialing insgorund at %s", r.cfg.Listen)
+	conn, err := net.DialTimeout(r.cfg.Protocol, r.cfg.Listen, dialTimeout)
+	if err != nil {
+		return nil, errors.Wrapf(err, "couldn't dial '%s' over %s", r.cfg.Listen, r.cfg.Protocol)
+	}
+	r.client = rpc.NewClient(conn)
+	return r.client, nil
+}
+
+// eject closes connection and stops sending calls to the runner until it passes a health check
+func (r *runner) eject(ctx context.Context, reason error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.healthy {
+		inslogger.FromContext(ctx).Warnf("insgorund at %s is unhealthy: %s", r.cfg.Listen, reason)
+	}
+	r.healthy = false
+	if r.client != nil {
+		r.client.Close()
+		r.client = nil
+	}
+}
+
+// checked records result of a health check, returns true if spawned runner has to be restarted
+func (r *runner) checked(ctx context.Context, err error, maxFailures int) bool {
+	if err != nil {
+		r.eject(ctx, err)
+
+		r.lock.Lock()
+		defer r.lock.Unlock()
+		r.failures++
+		return r.cmd != nil && maxFailures > 0 && r.failures >= maxFailures
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if !r.healthy {
+		inslogger.FromContext(ctx).Infof("insgorund at %s is healthy again", r.cfg.Listen)
+	}
+	r.healthy, r.failures = true, 0
+	return false
+}
+
+// kill terminates spawned process, it's restarted by supervise
+func (r *runner) kill(ctx context.Context) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.cmd == nil || r.cmd.Process == nil {
+		return
+	}
+	inslogger.FromContext(ctx).Warnf("restarting insgorund at %s", r.cfg.Listen)
+	if err := r.cmd.Process.Kill(); err != nil {
+		inslogger.FromContext(ctx).Error("couldn't kill insgorund: ", err)
+	}
+	r.failures = 0
+}
+
+// pool spreads calls across runners and keeps track of their health
+type pool struct {
+	cfg     *configuration.LogicRunner
+	runners []*runner
+	next    uint32
+	pings   uint64
+
+	stop     chan struct{}
+	stopOnce sync.Once
+	wg       sync.WaitGroup
+}
+
+func runnersFromConfig(cfg *configuration.GoPlugin) []configuration.GoPluginRunner {
+	var res []configuration.GoPluginRunner
+	if cfg.RunnerListen != "" {
+		res = append(res, configuration.GoPluginRunner{Listen: cfg.RunnerListen, Protocol: cfg.RunnerProtocol})
+	}
+	return append(res, cfg.Runners...)
+}
+
+func newPool(cfg *configuration.LogicRunner) *pool {
+	p := &pool{
+		cfg:  cfg,
+		stop: make(chan struct{}),
+	}
+	for _, rc := range runnersFromConfig(cfg.GoPlugin) {
+		p.runners = append(p.runners, newRunner(rc))
+	}
+	return p
+}
+
+// start spawns local runners and starts health checks
+func (p *pool) start(ctx context.Context) error {
+	for _, r := range p.runners {
+		if r.cfg.Binary == "" {
+			continue
+		}
+		if err := p.spawn(ctx, r); err != nil {
+			return err
+		}
+		p.wg.Add(1)
+		go p.supervise(ctx, r)
+	}
+
+	p.wg.Add(1)
+	go p.healthLoop(ctx)
+	return nil
+}
+
+// pick returns next healthy runner
+func (p *pool) pick() (*runner, error) {
+	n := uint32(len(p.runners))
+	if n == 0 {
+		return nil, errNoRunners
+	}
+	start := atomic.AddUint32(&p.next, 1)
+	for i := uint32(0); i < n; i++ {
+		r := p.runners[(start+i)%n]
+		if r.isHealthy() {
+			return r, nil
+		}
+	}
+	return nil, ErrNoHealthyRunners
+}
+
+func (p *pool) spawn(ctx context.Context, r *runner) error {
+	args := []string{
+		"--listen", r.cfg.Listen,
+		"--proto", r.cfg.Protocol,
+		"--rpc", p.cfg.RPCListen,
+		"--rpc-proto", p.cfg.RPCProtocol,
+	}
+	cmd := exec.Command(r.cfg.Binary, append(args, r.cfg.Args...)...)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	// under the lock, so close either sees the process or spawn sees it's stopped
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if p.stopped() {
+		return errStopped
+	}
+
+	inslogger.FromContext(ctx).Infof("starting insgorund at %s", r.cfg.Listen)
+	if err := cmd.Start(); err != nil {
+		return errors.Wrapf(err, "couldn't start insgorund at %s", r.cfg.Listen)
+	}
+	r.cmd = cmd
+	return nil
+}
+
+func (p *pool) stopped() bool {
+	select {
+	case <-p.stop:
+		return true
+	default:
+		return false
+	}
+}
+
+// supervise restarts spawned runner when its process exits
+func (p *pool) supervise(ctx context.Context, r *runner) {
+	defer p.wg.Done()
+
+	r.lock.Lock()
+	cmd := r.cmd
+	r.lock.Unlock()
+
+	for {
+		err := cmd.Wait()
+		if p.stopped() {
+			return
+		}
+		r.eject(ctx, errors.Errorf("process exited: %v", err))
+
+		for {
+			select {
+			case <-p.stop:
+				return
+			case <-time.After(restartDelay):
+			}
+			err := p.spawn(ctx, r)
+			if err == nil {
+				break
+			}
+			if err == errStopped {
+				return
+			}
+			inslogger.FromContext(ctx).Error(err)
+		}
+
+		r.lock.Lock()
+		cmd = r.cmd
+		r.lock.Unlock()
+	}
+}
+
+func (p *pool) healthLoop(ctx context.Context) {
+	defer p.wg.Done()
+
+	interval := p.cfg.GoPlugin.HealthCheck.Interval
+	if interval <= 0 {
+		interval = healthCheckInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-p.stop:
+			return
+		case <-ticker.C:
+		}
+
+		for _, r := range p.runners {
+			err := p.healthCheck(ctx, r)
+			if r.checked(ctx, err, p.cfg.GoPlugin.HealthCheck.MaxFailures) {
+				r.kill(ctx)
+			}
+		}
+	}
+}
+
+// healthCheck calls method Check of healthcheck contract, see ginsider/healthcheck,
+// or pings the runner if contract isn't configured
+func (p *pool) healthCheck(ctx context.Context, r *runner) error {
+	client, err := r.downstream(ctx)
+	if err != nil {
+		return err
+	}
+
+	hc := p.cfg.GoPlugin.HealthCheck
+	timeout := hc.Timeout
+	if timeout <= 0 {
+		timeout = dialTimeout
+	}
+
+	if hc.Code == "" {
+		req := rpctypes.DownPingReq{Seq: atomic.AddUint64(&p.pings, 1)}
+		res := rpctypes.DownPingResp{}
+		if err := callWithTimeout(client, "RPC.Ping", req, &res, timeout); err != nil {
+			return err
+		}
+		if res.Seq != req.Seq {
+			return errors.Errorf("ping reply %d doesn't match request %d", res.Seq, req.Seq)
+		}
+		return nil
+	}
+
+	code, err := insolar.NewReferenceFromBase58(hc.Code)
+	if err != nil {
+		return errors.Wrap(err, "failed to parse healthcheck contract ref")
+	}
+
+	empty, err := insolar.Serialize([]interface{}{})
+	if err != nil {
+		return err
+	}
+	caller := insolar.Reference{}
+	req := rpctypes.DownCallMethodReq{
+		Context:   &insolar.LogicCallContext{Caller: &caller},
+		Code:      *code,
+		Data:      empty,
+		Method:    "Check",
+		Arguments: empty,
+	}
+	res := rpctypes.DownCallMethodResp{}
+	return callWithTimeout(client, "RPC.CallMethod", req, &res, timeout)
+}
+
+func callWithTimeout(client *rpc.Client, method string, req interface{}, res interface{}, timeout time.Duration) error {
+	select {
+	case call := <-client.Go(method, req, res, make(chan *rpc.Call, 1)).Done:
+		return call.Error
+	case <-time.After(timeout):
+		return errors.New("healthcheck timeout")
+	}
+}
+
+// close stops health checks and spawned runners
+func (p *pool) close() {
+	p.stopOnce.Do(func() {
+		close(p.stop)
+		for _, r := range p.runners {
+			r.lock.Lock()
+			if r.cmd != nil && r.cmd.Process != nil {
+				r.cmd.Process.Kill() // nolint: errcheck
+			}
+			if r.client != nil {
+				r.client.Close()
+				r.client = nil
+			}
+			r.lock.Unlock()
+		}
+	})
+	p.wg.Wait()
+}
@@ -0,0 +1,247 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package goplugin
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"net/rpc"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/logicrunner/goplugin/rpctypes"
+)
+
+var errUnhealthy = errors.New("unhealthy")
+
+type fakeInsgorund struct{}
+
+func (fakeInsgorund) Ping(args rpctypes.DownPingReq, reply *rpctypes.DownPingResp) error {
+	reply.Seq = args.Seq
+	return nil
+}
+
+func startFakeInsgorund(t *testing.T) string {
+	server := rpc.NewServer()
+	require.NoError(t, server.RegisterName("RPC", fakeInsgorund{}))
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go server.Accept(listener)
+	return listener.Addr().String()
+}
+
+// startSilentInsgorund accepts connections, reads requests and closes connections without replies,
+// returns address and channel of accepted connections
+func startSilentInsgorund(t *testing.T) (string, chan struct{}) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	accepted := make(chan struct{}, 10)
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			accepted <- struct{}{}
+			go func() {
+				conn.Read(make([]byte, 1024)) // nolint: errcheck
+				conn.Close()
+			}()
+		}
+	}()
+	return listener.Addr().String(), accepted
+}
+
+func closedAddress(t *testing.T) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := listener.Addr().String()
+	require.NoError(t, listener.Close())
+	return addr
+}
+
+func testPool(runners ...configuration.GoPluginRunner) *pool {
+	cfg := configuration.NewLogicRunner()
+	cfg.GoPlugin.RunnerListen = ""
+	cfg.GoPlugin.Runners = runners
+	return newPool(&cfg)
+}
+
+func TestPool_RunnersFromConfig(t *testing.T) {
+	cfg := configuration.NewLogicRunner()
+	cfg.GoPlugin.Runners = []configuration.GoPluginRunner{{Listen: "127.0.0.1:7779", Protocol: "tcp"}}
+
+	p := newPool(&cfg)
+	require.Len(t, p.runners, 2)
+	require.Equal(t, "127.0.0.1:7777", p.runners[0].cfg.Listen)
+	require.Equal(t, "127.0.0.1:7779", p.runners[1].cfg.Listen)
+
+	_, err := testPool().pick()
+	require.Equal(t, errNoRunners, err)
+}
+
+func TestPool_PickSkipsUnhealthy(t *testing.T) {
+	ctx := context.Background()
+	p := testPool(
+		configuration.GoPluginRunner{Listen: "first"},
+		configuration.GoPluginRunner{Listen: "second"},
+		configuration.GoPluginRunner{Listen: "third"},
+	)
+
+	picked := map[string]int{}
+	for i := 0; i < 6; i++ {
+		r, err := p.pick()
+		require.NoError(t, err)
+		picked[r.cfg.Listen]++
+	}
+	require.Equal(t, map[string]int{"first": 2, "second": 2, "third": 2}, picked)
+
+	p.runners[1].eject(ctx, errUnhealthy)
+	for i := 0; i < 6; i++ {
+		r, err := p.pick()
+		require.NoError(t, err)
+		require.NotEqual(t, "second", r.cfg.Listen)
+	}
+
+	p.runners[0].eject(ctx, errUnhealthy)
+	p.runners[2].eject(ctx, errUnhealthy)
+	_, err := p.pick()
+	require.Equal(t, ErrNoHealthyRunners, err)
+
+	require.False(t, p.runners[1].checked(ctx, nil, 3))
+	r, err := p.pick()
+	require.NoError(t, err)
+	require.Equal(t, "second", r.cfg.Listen)
+}
+
+func TestPool_HealthCheck(t *testing.T) {
+	ctx := context.Background()
+	addr := startFakeInsgorund(t)
+	silent, _ := startSilentInsgorund(t)
+
+	p := testPool(
+		configuration.GoPluginRunner{Listen: addr, Protocol: "tcp"},
+		configuration.GoPluginRunner{Listen: closedAddress(t), Protocol: "tcp"},
+		configuration.GoPluginRunner{Listen: silent, Protocol: "tcp"},
+	)
+	p.cfg.GoPlugin.HealthCheck.Timeout = 100 * time.Millisecond
+	defer p.close()
+
+	require.NoError(t, p.healthCheck(ctx, p.runners[0]))
+	require.Error(t, p.healthCheck(ctx, p.runners[1]))
+	// connection is accepted, but runner doesn't reply to ping
+	require.Error(t, p.healthCheck(ctx, p.runners[2]))
+
+	// only spawned runners are restarted
+	require.False(t, p.runners[1].checked(ctx, p.healthCheck(ctx, p.runners[1]), 1))
+	require.False(t, p.runners[1].isHealthy())
+}
+
+func TestGoPlugin_SentCallIsNotRetried(t *testing.T) {
+	ctx := context.Background()
+	first, firstAccepted := startSilentInsgorund(t)
+	second, secondAccepted := startSilentInsgorund(t)
+
+	p := testPool(
+		configuration.GoPluginRunner{Listen: first, Protocol: "tcp"},
+		configuration.GoPluginRunner{Listen: second, Protocol: "tcp"},
+	)
+	defer p.close()
+	gp := &GoPlugin{runners: p}
+
+	req := rpctypes.DownPingReq{Seq: 1}
+	res := rpctypes.DownPingResp{}
+	err := gp.callClientWithReconnect(ctx, "RPC.Ping", req, &res)
+	require.Error(t, err)
+
+	// the call reached one runner, that runner is ejected, the other one never got it
+	require.Equal(t, 1, len(firstAccepted)+len(secondAccepted))
+	require.NotEqual(t, p.runners[0].isHealthy(), p.runners[1].isHealthy())
+}
+
+func TestGoPlugin_DialFailureIsRetried(t *testing.T) {
+	ctx := context.Background()
+	addr := startFakeInsgorund(t)
+
+	p := testPool(
+		configuration.GoPluginRunner{Listen: closedAddress(t), Protocol: "tcp"},
+		configuration.GoPluginRunner{Listen: addr, Protocol: "tcp"},
+	)
+	defer p.close()
+	gp := &GoPlugin{runners: p}
+
+	for i := 0; i < 2; i++ {
+		req := rpctypes.DownPingReq{Seq: uint64(i + 1)}
+		res := rpctypes.DownPingResp{}
+		require.NoError(t, gp.callClientWithReconnect(ctx, "RPC.Ping", req, &res))
+		require.Equal(t, req.Seq, res.Seq)
+	}
+	require.False(t, p.runners[0].isHealthy())
+}
+
+func TestPool_RestartsSpawnedRunner(t *testing.T) {
+	shell, err := exec.LookPath("sh")
+	if err != nil {
+		t.Skip("sh isn't available")
+	}
+	dir, err := ioutil.TempDir("", "goplugin-pool-")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	// the process keeps running, but never listens, so health checks fail
+	binary := filepath.Join(dir, "insgorund")
+	require.NoError(t, ioutil.WriteFile(binary, []byte("#!"+shell+"\nexec sleep 60\n"), 0755))
+
+	p := testPool(configuration.GoPluginRunner{Listen: closedAddress(t), Protocol: "tcp", Binary: binary})
+	p.cfg.GoPlugin.HealthCheck.Interval = 10 * time.Millisecond
+	p.cfg.GoPlugin.HealthCheck.MaxFailures = 2
+	r := p.runners[0]
+	require.NoError(t, p.start(context.Background()))
+	defer p.close()
+
+	r.lock.Lock()
+	first := r.cmd
+	r.lock.Unlock()
+
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		r.lock.Lock()
+		restarted := r.cmd != first
+		r.lock.Unlock()
+		if restarted {
+			break
+		}
+		require.True(t, time.Now().Before(deadline), "runner wasn't restarted")
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// the process didn't exit by itself, it was killed after failed health checks
+	status, ok := first.ProcessState.Sys().(syscall.WaitStatus)
+	require.True(t, ok)
+	require.True(t, status.Signaled())
+	require.Equal(t, syscall.SIGKILL, status.Signal())
+	require.False(t, r.isHealthy())
+}
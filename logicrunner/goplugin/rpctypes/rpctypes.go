@@ -54,6 +54,16 @@ type DownCallConstructorResp struct {
 	Ret insolar.Arguments
 }
 
+// DownPingReq is a set of arguments for Ping RPC in the runner
+type DownPingReq struct {
+	Seq uint64
+}
+
+// DownPingResp is response from Ping RPC in the runner, Seq of request is sent back
+type DownPingResp struct {
+	Seq uint64
+}
+
 // UpBaseReq  is a base type for all insgorund -> logicrunner requests
 type UpBaseReq struct {
 	Mode            insolar.CallMode
@@ -81,6 +81,12 @@ func (s *Init) Present(ctx context.Context, f flow.Flow) error {
 			meta: meta,
 		}
 		return f.Handle(ctx, h.Present)
+	case payload.TypeObjectStateNotification:
+		h := &HandleObjectStateNotification{
+			dep:  s.dep,
+			meta: meta,
+		}
+		return f.Handle(ctx, h.Present)
 	default:
 		return fmt.Errorf("[ Init.Present ] no handler for message type %s", msgType)
 	}
@@ -145,6 +151,20 @@ func (s *Init) handleParcel(ctx context.Context, f flow.Flow) error {
 			Parcel:  parcel,
 		}
 		return f.Handle(ctx, h.Present)
+	case insolar.TypeValidateCaseBind.String():
+		h := &HandleValidateCaseBind{
+			dep:     s.dep,
+			Message: meta,
+			Parcel:  parcel,
+		}
+		return f.Handle(ctx, h.Present)
+	case insolar.TypeValidationResults.String():
+		h := &HandleValidationResults{
+			dep:     s.dep,
+			Message: meta,
+			Parcel:  parcel,
+		}
+		return f.Handle(ctx, h.Present)
 	default:
 		return fmt.Errorf("[ Init.handleParcel ] no handler for message type %s", msgType)
 	}
@@ -0,0 +1,102 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package logicrunner
+
+import (
+	"context"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/bus"
+	"github.com/insolar/insolar/insolar/flow"
+	"github.com/insolar/insolar/insolar/message"
+	"github.com/insolar/insolar/insolar/payload"
+	"github.com/insolar/insolar/insolar/reply"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/messagebus"
+	"github.com/pkg/errors"
+)
+
+type HandleValidateCaseBind struct {
+	dep *Dependencies
+
+	Message payload.Meta
+	Parcel  insolar.Parcel
+}
+
+func (h *HandleValidateCaseBind) Present(ctx context.Context, f flow.Flow) error {
+	ctx = loggerWithTargetID(ctx, h.Parcel)
+	logger := inslogger.FromContext(ctx)
+	logger.Debug("HandleValidateCaseBind.Present starts ...")
+
+	msg, ok := h.Parcel.Message().(*message.ValidateCaseBind)
+	if !ok {
+		return errors.New("[ HandleValidateCaseBind ] wrong message type")
+	}
+
+	h.dep.Sender.Reply(ctx, h.Message, bus.ReplyAsMessage(ctx, &reply.OK{}))
+
+	// replay can take as long as original execution, so it's done outside of message handling
+	go h.validate(ctx, msg, h.Message.Sender)
+	return nil
+}
+
+// validate replays requests of the case bind and sends verdicts to the executor. Every replay is limited
+// by execution budget duration.
+func (h *HandleValidateCaseBind) validate(ctx context.Context, msg *message.ValidateCaseBind, executor insolar.Reference) {
+	lr := h.dep.lr
+	logger := inslogger.FromContext(ctx)
+
+	results := &message.ValidationResults{
+		Caller:    lr.JetCoordinator.Me(),
+		RecordRef: msg.RecordRef,
+	}
+	for _, bind := range msg.Requests {
+		verdict, err := h.validateOne(ctx, bind)
+		if err != nil {
+			results.Error = errors.Wrapf(err, "couldn't validate request %s", bind.RequestRef.String()).Error()
+			break
+		}
+		results.Verdicts = append(results.Verdicts, *verdict)
+		if !verdict.Valid {
+			break
+		}
+		results.PassedStepsCount++
+	}
+
+	sender := messagebus.BuildSender(
+		lr.MessageBus.Send,
+		messagebus.RetryIncorrectPulse(lr.PulseAccessor),
+	)
+	_, err := sender(ctx, results, &insolar.MessageSendOptions{
+		Receiver: &executor,
+	})
+	if err != nil {
+		logger.Error(errors.Wrap(err, "[ HandleValidateCaseBind ] couldn't send validation results"))
+	}
+}
+
+func (h *HandleValidateCaseBind) validateOne(
+	ctx context.Context, bind message.CaseBindRequest,
+) (*message.ValidationVerdict, error) {
+	lr := h.dep.lr
+	if lr.Cfg != nil && lr.Cfg.Budget.MaxDuration != 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, lr.Cfg.Budget.MaxDuration)
+		defer cancel()
+	}
+	return lr.Validator.Validate(ctx, bind)
+}
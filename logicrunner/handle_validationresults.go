@@ -0,0 +1,79 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package logicrunner
+
+import (
+	"context"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/bus"
+	"github.com/insolar/insolar/insolar/flow"
+	"github.com/insolar/insolar/insolar/message"
+	"github.com/insolar/insolar/insolar/payload"
+	"github.com/insolar/insolar/insolar/reply"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/pkg/errors"
+)
+
+type HandleValidationResults struct {
+	dep *Dependencies
+
+	Message payload.Meta
+	Parcel  insolar.Parcel
+}
+
+func (h *HandleValidationResults) Present(ctx context.Context, f flow.Flow) error {
+	ctx = loggerWithTargetID(ctx, h.Parcel)
+	lr := h.dep.lr
+	logger := inslogger.FromContext(ctx)
+	logger.Debug("HandleValidationResults.Present starts ...")
+
+	msg, ok := h.Parcel.Message().(*message.ValidationResults)
+	if !ok {
+		return errors.New("[ HandleValidationResults ] wrong message type")
+	}
+
+	if msg.Error != "" {
+		logger.Warn("[ HandleValidationResults ] validator failed to replay requests: ", msg.Error)
+	}
+
+	for _, verdict := range msg.Verdicts {
+		node := lr.NodeNetwork.GetWorkingNode(verdict.Validator)
+		if node == nil {
+			logger.Warnf("[ HandleValidationResults ] verdict for %s from unknown validator %s",
+				verdict.Request.String(), verdict.Validator.String())
+			continue
+		}
+		if !lr.Validator.Verify(verdict, node.PublicKey()) {
+			logger.Warnf("[ HandleValidationResults ] verdict for %s has bad signature", verdict.Request.String())
+			continue
+		}
+		if !verdict.Valid {
+			logger.Errorf("[ HandleValidationResults ] request %s is invalid: %s", verdict.Request.String(), verdict.Reason)
+		}
+
+		id, err := lr.ArtifactManager.RegisterVerdict(ctx, VerdictRecord(*msg.RecordRef.Record(), verdict))
+		if err != nil {
+			logger.Error(errors.Wrapf(err, "[ HandleValidationResults ] couldn't save verdict for %s", verdict.Request.String()))
+			continue
+		}
+		logger.Debugf("[ HandleValidationResults ] verdict for %s is saved as %s", verdict.Request.String(), id.String())
+	}
+
+	h.dep.Sender.Reply(ctx, h.Message, bus.ReplyAsMessage(ctx, &reply.OK{}))
+	return nil
+}
@@ -22,6 +22,7 @@ import (
 
 	"github.com/pkg/errors"
 
+	"github.com/insolar/insolar/configuration"
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/insolar/record"
 	"github.com/insolar/insolar/instrumentation/inslogger"
@@ -39,10 +40,12 @@ type LogicExecutor interface {
 type logicExecutor struct {
 	MachinesManager  MachinesManager            `inject:""`
 	DescriptorsCache artifacts.DescriptorsCache `inject:""`
+
+	budget configuration.ExecutionBudget
 }
 
-func NewLogicExecutor() LogicExecutor {
-	return &logicExecutor{}
+func NewLogicExecutor(budget configuration.ExecutionBudget) LogicExecutor {
+	return &logicExecutor{budget: budget}
 }
 
 func (le *logicExecutor) Execute(ctx context.Context, transcript *Transcript) (artifacts.RequestResult, error) {
@@ -80,10 +83,16 @@ func (le *logicExecutor) ExecuteMethod(ctx context.Context, transcript *Transcri
 	}
 
 	transcript.LogicContext = le.genLogicCallContext(ctx, transcript, protoDesc, codeDesc)
+	transcript.Budget = NewExecutionBudget(le.budget)
 
+	execCtx, cancel := transcript.Budget.Start(ctx)
 	newData, result, err := executor.CallMethod(
-		ctx, transcript.LogicContext, *codeDesc.Ref(), objDesc.Memory(), request.Method, request.Arguments,
+		execCtx, transcript.LogicContext, *codeDesc.Ref(), objDesc.Memory(), request.Method, request.Arguments,
 	)
+	cancel()
+	if budgetErr := transcript.Budget.Finish(); budgetErr != nil {
+		return nil, budgetErr
+	}
 	if err != nil {
 		return nil, errors.Wrap(err, "executor error")
 	}
@@ -91,9 +100,15 @@ func (le *logicExecutor) ExecuteMethod(ctx context.Context, transcript *Transcri
 	res := newRequestResult(result, *objDesc.HeadRef())
 
 	if request.Immutable {
+		res.usage = transcript.Budget.Usage()
 		return res, nil
 	}
 
+	if err := transcript.Budget.StateSize(len(newData)); err != nil {
+		return nil, err
+	}
+	res.usage = transcript.Budget.Usage()
+
 	switch {
 	case transcript.Deactivate:
 		res.SetDeactivate(objDesc)
@@ -133,13 +148,23 @@ func (le *logicExecutor) ExecuteConstructor(
 	}
 
 	transcript.LogicContext = le.genLogicCallContext(ctx, transcript, protoDesc, codeDesc)
+	transcript.Budget = NewExecutionBudget(le.budget)
 
-	newData, err := executor.CallConstructor(ctx, transcript.LogicContext, *codeDesc.Ref(), request.Method, request.Arguments)
+	execCtx, cancel := transcript.Budget.Start(ctx)
+	newData, err := executor.CallConstructor(execCtx, transcript.LogicContext, *codeDesc.Ref(), request.Method, request.Arguments)
+	cancel()
+	if budgetErr := transcript.Budget.Finish(); budgetErr != nil {
+		return nil, budgetErr
+	}
 	if err != nil {
 		return nil, errors.Wrap(err, "executor error")
 	}
+	if err := transcript.Budget.StateSize(len(newData)); err != nil {
+		return nil, err
+	}
 
 	res := newRequestResult(nil, transcript.RequestRef)
+	res.usage = transcript.Budget.Usage()
 	res.SetActivate(
 		*request.Base,
 		*request.Prototype,
@@ -158,7 +183,7 @@ func (le *logicExecutor) genLogicCallContext(
 	request := transcript.Request
 	reqRef := transcript.RequestRef
 	res := &insolar.LogicCallContext{
-		Mode: insolar.ExecuteCallMode,
+		Mode: transcript.Mode,
 
 		Request: &reqRef,
 
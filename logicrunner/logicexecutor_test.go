@@ -23,6 +23,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 
+	"github.com/insolar/insolar/configuration"
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/insolar/gen"
 	"github.com/insolar/insolar/insolar/record"
@@ -32,7 +33,7 @@ import (
 )
 
 func TestLogicExecutor_New(t *testing.T) {
-	le := NewLogicExecutor()
+	le := NewLogicExecutor(configuration.NewLogicRunner().Budget)
 	require.NotNil(t, le)
 }
 
@@ -100,6 +101,7 @@ func TestLogicExecutor_ExecuteMethod(t *testing.T) {
 				sideEffectType:  artifacts.RequestSideEffectAmend,
 				memory:          []byte{1, 2, 3},
 				result:          []byte{3, 2, 1},
+				usage:           artifacts.ExecutionUsage{StateSize: 3},
 			},
 		},
 		{
@@ -134,6 +136,7 @@ func TestLogicExecutor_ExecuteMethod(t *testing.T) {
 				sideEffectType:  artifacts.RequestSideEffectNone,
 				result:          []byte{3, 2, 1},
 				objectReference: objRef,
+				usage:           artifacts.ExecutionUsage{StateSize: 3},
 			},
 		},
 		{
@@ -206,6 +209,7 @@ func TestLogicExecutor_ExecuteMethod(t *testing.T) {
 				result:          []byte{3, 2, 1},
 				objectStateID:   objRecordID,
 				objectReference: objRef,
+				usage:           artifacts.ExecutionUsage{StateSize: 3},
 			},
 		},
 		{
@@ -364,6 +368,7 @@ func TestLogicExecutor_ExecuteConstructor(t *testing.T) {
 				memory:          []byte{1, 2, 3},
 				parentReference: baseRef,
 				objectImage:     protoRef,
+				usage:           artifacts.ExecutionUsage{StateSize: 3},
 			},
 		},
 		{
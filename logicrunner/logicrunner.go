@@ -45,6 +45,8 @@ import (
 	"github.com/insolar/insolar/logicrunner/builtin"
 	lrCommon "github.com/insolar/insolar/logicrunner/common"
 	"github.com/insolar/insolar/logicrunner/goplugin"
+	"github.com/insolar/insolar/logicrunner/wasm"
+	"github.com/insolar/insolar/network/throttle"
 )
 
 const maxQueueLength = 10
@@ -79,6 +81,9 @@ type LogicRunner struct {
 	JetCoordinator             jet.Coordinator                    `inject:""`
 	RequestsExecutor           RequestsExecutor                   `inject:""`
 	MachinesManager            MachinesManager                    `inject:""`
+	Validator                  Validator                          `inject:""`
+	TrafficGate                throttle.Gate                      `inject:""`
+	Notifier                   insolar.Notifier                   `inject:""`
 	Publisher                  watermillMsg.Publisher
 	Sender                     bus.Sender
 	SenderWithRetry            *bus.WaitOKSender
@@ -87,7 +92,8 @@ type LogicRunner struct {
 
 	Cfg *configuration.LogicRunner
 
-	rpc *lrCommon.RPC
+	rpc      *lrCommon.RPC
+	goPlugin *goplugin.GoPlugin
 
 	stopLock   sync.Mutex
 	isStopping bool
@@ -159,7 +165,7 @@ func initHandlers(lr *LogicRunner) {
 func (lr *LogicRunner) initializeBuiltin(_ context.Context) error {
 	bi := builtin.NewBuiltIn(
 		lr.ArtifactManager,
-		NewRPCMethods(lr.ArtifactManager, lr.DescriptorsCache, lr.ContractRequester, lr.StateStorage),
+		NewRPCMethods(lr.ArtifactManager, lr.DescriptorsCache, lr.ContractRequester, lr.StateStorage, lr.Validator),
 	)
 	if err := lr.MachinesManager.RegisterExecutor(insolar.MachineTypeBuiltin, bi); err != nil {
 		return err
@@ -168,6 +174,19 @@ func (lr *LogicRunner) initializeBuiltin(_ context.Context) error {
 	return nil
 }
 
+func (lr *LogicRunner) initializeWasm(_ context.Context) error {
+	w := wasm.NewWasm(
+		lr.Cfg.Wasm,
+		lr.ArtifactManager,
+		NewRPCMethods(lr.ArtifactManager, lr.DescriptorsCache, lr.ContractRequester, lr.StateStorage, lr.Validator),
+	)
+	if err := lr.MachinesManager.RegisterExecutor(insolar.MachineTypeWasm, w); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (lr *LogicRunner) initializeGoPlugin(ctx context.Context) error {
 	logger := inslogger.FromContext(ctx)
 	if lr.Cfg.RPCListen == "" {
@@ -180,8 +199,10 @@ func (lr *LogicRunner) initializeGoPlugin(ctx context.Context) error {
 	}
 
 	if err := lr.MachinesManager.RegisterExecutor(insolar.MachineTypeGoPlugin, gp); err != nil {
+		gp.Stop()
 		return err
 	}
+	lr.goPlugin = gp
 
 	return nil
 }
@@ -194,9 +215,10 @@ func (lr *LogicRunner) Init(ctx context.Context) error {
 		lr.JetCoordinator,
 		lr.PulseAccessor,
 		lr.ArtifactManager,
+		lr.TrafficGate,
 	)
 	lr.rpc = lrCommon.NewRPC(
-		NewRPCMethods(lr.ArtifactManager, lr.DescriptorsCache, lr.ContractRequester, lr.StateStorage),
+		NewRPCMethods(lr.ArtifactManager, lr.DescriptorsCache, lr.ContractRequester, lr.StateStorage, lr.Validator),
 		lr.Cfg,
 	)
 
@@ -217,6 +239,12 @@ func (lr *LogicRunner) Start(ctx context.Context) error {
 		}
 	}
 
+	if lr.Cfg.Wasm != nil {
+		if err := lr.initializeWasm(ctx); err != nil {
+			return errors.Wrap(err, "Failed to initialize wasm VM")
+		}
+	}
+
 	if lr.Cfg.RPCListen != "" {
 		lr.rpc.Start(ctx)
 	}
@@ -229,6 +257,9 @@ func (lr *LogicRunner) Start(ctx context.Context) error {
 // Stop stops logic runner component and its executors
 func (lr *LogicRunner) Stop(ctx context.Context) error {
 	reterr := error(nil)
+	if lr.goPlugin != nil {
+		lr.goPlugin.Stop()
+	}
 	if err := lr.rpc.Stop(ctx); err != nil {
 		return err
 	}
@@ -47,8 +47,11 @@ type PulseManager struct {
 	PulseAccessor     pulse.Accessor            `inject:""`
 	PulseAppender     pulse.Appender            `inject:""`
 	JetModifier       jet.Modifier              `inject:""`
+	Notifier          insolar.Notifier          `inject:""`
 
 	currentPulse insolar.Pulse
+	// change keeps state of two-phase pulse change.
+	change pulse.PreparedChange
 
 	// setLock locks Set method call.
 	setLock sync.RWMutex
@@ -71,6 +74,9 @@ func (m *PulseManager) Set(ctx context.Context, newPulse insolar.Pulse) error {
 	if m.stopped {
 		return errors.New("can't call Set method on PulseManager after stop")
 	}
+	if err := m.change.Commit(newPulse.PulseNumber); err != nil {
+		return err
+	}
 
 	ctx, span := instracer.StartSpan(
 		ctx, "pulse.process", trace.WithSampler(trace.AlwaysSample()),
@@ -95,9 +101,28 @@ func (m *PulseManager) Set(ctx context.Context, newPulse insolar.Pulse) error {
 		return err
 	}
 
+	m.Notifier.NotifyPulse(ctx, newPulse)
 	return nil
 }
 
+// Prepare prepares pulse change. Prepared change is applied by Set or discarded by Cancel.
+func (m *PulseManager) Prepare(ctx context.Context, pn insolar.PulseNumber) error {
+	m.setLock.RLock()
+	defer m.setLock.RUnlock()
+	if m.stopped {
+		return errors.New("can't call Prepare method on PulseManager after stop")
+	}
+
+	return m.change.Prepare(m.currentPulse.PulseNumber, pn)
+}
+
+// Cancel discards prepared pulse change.
+func (m *PulseManager) Cancel(ctx context.Context) {
+	if pn, ok := m.change.Cancel(); ok {
+		inslogger.FromContext(ctx).Infof("pulse change to %v is cancelled", pn)
+	}
+}
+
 func (m *PulseManager) setUnderGilSection(ctx context.Context, newPulse insolar.Pulse) error {
 	m.GIL.Acquire(ctx)
 	ctx, span := instracer.StartSpan(ctx, "pulse.gil_locked")
@@ -31,6 +31,8 @@ type requestResult struct {
 	objectImage     insolar.Reference // amend + activate
 	objectStateID   insolar.ID        // amend + deactivate
 	memory          []byte            // amend + activate
+
+	usage artifacts.ExecutionUsage // every
 }
 
 func newRequestResult(result []byte, objectRef insolar.Reference) *requestResult {
@@ -88,3 +90,7 @@ func (s requestResult) Type() artifacts.RequestResultType {
 func (s *requestResult) ObjectReference() insolar.Reference {
 	return s.objectReference
 }
+
+func (s *requestResult) Usage() artifacts.ExecutionUsage {
+	return s.usage
+}
@@ -42,11 +42,12 @@ type RequestsExecutor interface {
 }
 
 type requestsExecutor struct {
-	MessageBus      insolar.MessageBus  `inject:""`
-	NodeNetwork     insolar.NodeNetwork `inject:""`
-	LogicExecutor   LogicExecutor       `inject:""`
-	ArtifactManager artifacts.Client    `inject:""`
-	PulseAccessor   pulse.Accessor      `inject:""`
+	MessageBus                 insolar.MessageBus                 `inject:""`
+	NodeNetwork                insolar.NodeNetwork                `inject:""`
+	LogicExecutor              LogicExecutor                      `inject:""`
+	ArtifactManager            artifacts.Client                   `inject:""`
+	PulseAccessor              pulse.Accessor                     `inject:""`
+	PlatformCryptographyScheme insolar.PlatformCryptographyScheme `inject:""`
 }
 
 func NewRequestsExecutor() RequestsExecutor {
@@ -71,6 +72,12 @@ func (e *requestsExecutor) ExecuteAndSave(
 		return nil, errors.Wrap(err, "couldn't save request result")
 	}
 
+	var memory []byte
+	if transcript.ObjectDescriptor != nil {
+		memory = transcript.ObjectDescriptor.Memory()
+	}
+	transcript.StateHash = StateHash(e.PlatformCryptographyScheme, result, memory)
+
 	inslogger.FromContext(ctx).Debug("saved result")
 
 	return repl, nil
@@ -72,12 +72,17 @@ func TestRequestsExecutor_ExecuteAndSave(t *testing.T) {
 	for _, test := range table {
 		test := test
 		t.Run(test.name, func(t *testing.T) {
-			re := &requestsExecutor{ArtifactManager: test.am, LogicExecutor: test.le}
+			re := &requestsExecutor{
+				ArtifactManager:            test.am,
+				LogicExecutor:              test.le,
+				PlatformCryptographyScheme: testutils.NewPlatformCryptographyScheme(),
+			}
 			res, err := re.ExecuteAndSave(ctx, test.transcript)
 			if !test.error {
 				require.NoError(t, err)
 				require.NotNil(t, res)
 				require.Equal(t, test.reply, res)
+				require.NotEmpty(t, test.transcript.StateHash)
 			} else {
 				require.Error(t, err)
 				require.Nil(t, res)
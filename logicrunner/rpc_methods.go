@@ -47,6 +47,7 @@ type ProxyImplementation interface {
 
 type RPCMethods struct {
 	ss         StateStorage
+	validator  Validator
 	execution  ProxyImplementation
 	validation ProxyImplementation
 }
@@ -56,9 +57,11 @@ func NewRPCMethods(
 	dc artifacts.DescriptorsCache,
 	cr insolar.ContractRequester,
 	ss StateStorage,
+	v Validator,
 ) *RPCMethods {
 	return &RPCMethods{
 		ss:         ss,
+		validator:  v,
 		execution:  NewExecutionProxyImplementation(dc, cr, am),
 		validation: NewValidationProxyImplementation(dc),
 	}
@@ -80,10 +83,20 @@ func (m *RPCMethods) getCurrent(
 		if transcript == nil {
 			return nil, nil, errors.Errorf("No current execution in the state for request %s", reqRef.String())
 		}
+		if err := transcript.Budget.Check(); err != nil {
+			return nil, nil, err
+		}
 
 		return m.execution, transcript, nil
+	case insolar.ValidateCallMode:
+		transcript := m.validator.Current(reqRef)
+		if transcript == nil {
+			return nil, nil, errors.Errorf("No current validation for request %s", reqRef.String())
+		}
+
+		return m.validation, transcript, nil
 	default:
-		panic("not implemented")
+		return nil, nil, errors.Errorf("Unknown call mode %s", mode.String())
 	}
 }
 
@@ -94,7 +107,7 @@ func (m *RPCMethods) GetCode(req rpctypes.UpGetCodeReq, rep *rpctypes.UpGetCodeR
 		return errors.Wrap(err, "Failed to fetch current execution")
 	}
 
-	return impl.GetCode(current.Context, current, req, rep)
+	return impl.GetCode(current.Budget.Context(current.Context), current, req, rep)
 }
 
 // RouteCall routes call from a contract to a contract through event bus.
@@ -104,7 +117,7 @@ func (m *RPCMethods) RouteCall(req rpctypes.UpRouteReq, rep *rpctypes.UpRouteRes
 		return errors.Wrap(err, "Failed to fetch current execution")
 	}
 
-	return impl.RouteCall(current.Context, current, req, rep)
+	return impl.RouteCall(current.Budget.Context(current.Context), current, req, rep)
 }
 
 // SaveAsChild is an RPC saving data as memory of a contract as child a parent
@@ -114,7 +127,7 @@ func (m *RPCMethods) SaveAsChild(req rpctypes.UpSaveAsChildReq, rep *rpctypes.Up
 		return errors.Wrap(err, "Failed to fetch current execution")
 	}
 
-	return impl.SaveAsChild(current.Context, current, req, rep)
+	return impl.SaveAsChild(current.Budget.Context(current.Context), current, req, rep)
 }
 
 // SaveAsDelegate is an RPC saving data as memory of a contract as child a parent
@@ -123,7 +136,7 @@ func (m *RPCMethods) SaveAsDelegate(req rpctypes.UpSaveAsDelegateReq, rep *rpcty
 	if err != nil {
 		return errors.Wrap(err, "Failed to fetch current execution")
 	}
-	return impl.SaveAsDelegate(current.Context, current, req, rep)
+	return impl.SaveAsDelegate(current.Budget.Context(current.Context), current, req, rep)
 }
 
 // GetObjChildrenIterator is an RPC returns an iterator over object children with specified prototype
@@ -136,7 +149,7 @@ func (m *RPCMethods) GetObjChildrenIterator(
 		return errors.Wrap(err, "Failed to fetch current execution")
 	}
 
-	return impl.GetObjChildrenIterator(current.Context, current, req, rep)
+	return impl.GetObjChildrenIterator(current.Budget.Context(current.Context), current, req, rep)
 }
 
 // GetDelegate is an RPC saving data as memory of a contract as child a parent
@@ -146,7 +159,7 @@ func (m *RPCMethods) GetDelegate(req rpctypes.UpGetDelegateReq, rep *rpctypes.Up
 		return errors.Wrap(err, "Failed to fetch current execution")
 	}
 
-	return impl.GetDelegate(current.Context, current, req, rep)
+	return impl.GetDelegate(current.Budget.Context(current.Context), current, req, rep)
 }
 
 // DeactivateObject is an RPC saving data as memory of a contract as child a parent
@@ -156,7 +169,7 @@ func (m *RPCMethods) DeactivateObject(req rpctypes.UpDeactivateObjectReq, rep *r
 		return errors.Wrap(err, "Failed to fetch current execution")
 	}
 
-	return impl.DeactivateObject(current.Context, current, req, rep)
+	return impl.DeactivateObject(current.Budget.Context(current.Context), current, req, rep)
 }
 
 type executionProxyImplementation struct {
@@ -203,6 +216,10 @@ func (m *executionProxyImplementation) RouteCall(
 		return errors.New("Try to call route from immutable method")
 	}
 
+	if err := current.Budget.RouteCall(); err != nil {
+		return err
+	}
+
 	incoming, outgoing := buildIncomingAndOutgoingCallRequests(ctx, current, req)
 
 	// Step 1. Register outgoing request.
@@ -247,6 +264,10 @@ func (m *executionProxyImplementation) SaveAsChild(
 	ctx, span := instracer.StartSpan(ctx, "RPC.SaveAsChild")
 	defer span.End()
 
+	if err := current.Budget.SaveAsChild(); err != nil {
+		return err
+	}
+
 	incoming, outgoing := buildIncomingAndOutgoingSaveAsChildRequests(ctx, current, req)
 
 	// Register outgoing request
@@ -492,13 +513,13 @@ func (m *validationProxyImplementation) GetObjChildrenIterator(
 	req rpctypes.UpGetObjChildrenIteratorReq,
 	rep *rpctypes.UpGetObjChildrenIteratorResp,
 ) error {
-	panic("won't implement, ATM")
+	return errors.New("children iteration isn't supported during validation")
 }
 
 func (m *validationProxyImplementation) GetDelegate(
 	ctx context.Context, current *Transcript, req rpctypes.UpGetDelegateReq, rep *rpctypes.UpGetDelegateResp,
 ) error {
-	panic("implement me")
+	return errors.New("delegates aren't supported during validation")
 }
 
 func (m *validationProxyImplementation) DeactivateObject(
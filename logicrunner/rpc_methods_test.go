@@ -25,6 +25,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 
+	"github.com/insolar/insolar/configuration"
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/insolar/gen"
 	"github.com/insolar/insolar/insolar/record"
@@ -41,6 +42,7 @@ func TestRPCMethods_New(t *testing.T) {
 		artifacts.NewDescriptorsCacheMock(t),
 		testutils.NewContractRequesterMock(t),
 		NewStateStorageMock(t),
+		NewValidatorMock(t),
 	)
 	require.NotNil(t, m)
 }
@@ -423,6 +425,56 @@ func TestRouteCallRegistersSaga(t *testing.T) {
 	require.Equal(t, requestRef, outreq.Reason)
 }
 
+func TestRouteCallOverBudget(t *testing.T) {
+	t.Parallel()
+
+	am := artifacts.NewClientMock(t)
+	dc := artifacts.NewDescriptorsCacheMock(t)
+	cr := testutils.NewContractRequesterMock(t)
+
+	rpcm := NewExecutionProxyImplementation(dc, cr, am)
+	ctx := context.Background()
+	transcript := NewTranscript(ctx, gen.Reference(), record.IncomingRequest{})
+	transcript.Budget = NewExecutionBudget(configuration.ExecutionBudget{MaxRouteCalls: 1})
+
+	outgoingReqID := gen.ID()
+	am.RegisterOutgoingRequestMock.Return(&outgoingReqID, nil)
+	am.RegisterResultMock.Return(nil)
+	cr.CallMethodMock.Return(&reply.CallMethod{}, nil)
+
+	err := rpcm.RouteCall(ctx, transcript, rpctypes.UpRouteReq{Wait: true}, &rpctypes.UpRouteResp{})
+	require.NoError(t, err)
+
+	// outgoing request isn't registered when budget is exhausted
+	err = rpcm.RouteCall(ctx, transcript, rpctypes.UpRouteReq{Wait: true}, &rpctypes.UpRouteResp{})
+	require.Error(t, err)
+	require.Equal(t, ErrBudgetExceeded, errors.Cause(err))
+	require.Equal(t, uint64(1), am.RegisterOutgoingRequestCounter)
+}
+
+func TestRPCMethods_FinishedExecution(t *testing.T) {
+	reqRef := gen.Reference()
+	objRef := gen.Reference()
+
+	tr := &Transcript{RequestRef: reqRef, Budget: NewExecutionBudget(configuration.ExecutionBudget{})}
+	_, cancel := tr.Budget.Start(inslogger.TestContext(t))
+	cancel()
+	require.NoError(t, tr.Budget.Finish())
+
+	execList := NewCurrentExecutionList()
+	execList.Set(reqRef, tr)
+	ss := NewStateStorageMock(t).GetExecutionStateMock.Return(&ExecutionBroker{currentList: execList})
+
+	// contract code which outlived its execution can't make side effects
+	m := &RPCMethods{ss: ss, execution: NewProxyImplementationMock(t)}
+	err := m.RouteCall(
+		rpctypes.UpRouteReq{UpBaseReq: rpctypes.UpBaseReq{Callee: objRef, Request: reqRef}},
+		&rpctypes.UpRouteResp{},
+	)
+	require.Error(t, err)
+	require.Equal(t, ErrExecutionFinished, errors.Cause(err))
+}
+
 func TestSaveAsChildRegistersOutgoingRequestWithValidReason(t *testing.T) {
 	t.Parallel()
 
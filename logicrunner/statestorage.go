@@ -24,6 +24,7 @@ import (
 	"github.com/insolar/insolar/insolar/jet"
 	"github.com/insolar/insolar/insolar/pulse"
 	"github.com/insolar/insolar/logicrunner/artifacts"
+	"github.com/insolar/insolar/network/throttle"
 )
 
 // Context of one contract execution
@@ -58,6 +59,7 @@ type stateStorage struct {
 	jetCoordinator   jet.Coordinator
 	pulseAccessor    pulse.Accessor
 	artifactsManager artifacts.Client
+	trafficGate      throttle.Gate
 
 	state map[insolar.Reference]*ObjectState // if object exists, we are validating or executing it right now
 }
@@ -91,7 +93,7 @@ func NewStateStorage(
 	jetCoordinator jet.Coordinator,
 	pulseAccessor pulse.Accessor,
 	artifactsManager artifacts.Client,
-
+	trafficGate throttle.Gate,
 ) StateStorage {
 	ss := &stateStorage{
 		state: make(map[insolar.Reference]*ObjectState),
@@ -102,6 +104,7 @@ func NewStateStorage(
 		jetCoordinator:   jetCoordinator,
 		pulseAccessor:    pulseAccessor,
 		artifactsManager: artifactsManager,
+		trafficGate:      trafficGate,
 	}
 	return ss
 }
@@ -121,6 +124,7 @@ func (ss *stateStorage) UpsertExecutionState(ref insolar.Reference) *ExecutionBr
 			ss.jetCoordinator,
 			ss.pulseAccessor,
 			ss.artifactsManager,
+			ss.trafficGate,
 		)
 	}
 	return os.ExecutionBroker
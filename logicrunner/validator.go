@@ -0,0 +1,207 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package logicrunner
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/message"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/instrumentation/instracer"
+	"github.com/insolar/insolar/logicrunner/artifacts"
+)
+
+//go:generate minimock -i github.com/insolar/insolar/logicrunner.Validator -o ./ -s _mock.go
+
+// Validator replays completed requests in validation mode and signs verdicts about their results.
+type Validator interface {
+	// Validate re-executes request from its case bind against recorded outgoing results and
+	// compares the result and state hash with recorded ones.
+	Validate(ctx context.Context, bind message.CaseBindRequest) (*message.ValidationVerdict, error)
+	// Verify checks verdict signature with validator public key.
+	Verify(verdict message.ValidationVerdict, key crypto.PublicKey) bool
+	// Current returns transcript of request being validated.
+	Current(requestRef insolar.Reference) *Transcript
+}
+
+type validator struct {
+	LogicExecutor              LogicExecutor                      `inject:""`
+	ArtifactManager            artifacts.Client                   `inject:""`
+	CryptographyService        insolar.CryptographyService        `inject:""`
+	PlatformCryptographyScheme insolar.PlatformCryptographyScheme `inject:""`
+	JetCoordinator             jet.Coordinator                    `inject:""`
+
+	validations *CurrentExecutionList
+}
+
+func NewValidator() Validator {
+	return &validator{
+		validations: NewCurrentExecutionList(),
+	}
+}
+
+func (v *validator) Current(requestRef insolar.Reference) *Transcript {
+	return v.validations.Get(requestRef)
+}
+
+func (v *validator) Validate(
+	ctx context.Context, bind message.CaseBindRequest,
+) (
+	*message.ValidationVerdict, error,
+) {
+	ctx, span := instracer.StartSpan(ctx, "Validator.Validate")
+	defer span.End()
+
+	request, err := v.ArtifactManager.GetIncomingRequest(ctx, bind.Object, bind.RequestRef)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't get request")
+	}
+	result, err := v.ArtifactManager.GetResult(ctx, bind.Object, bind.RequestRef)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't get result")
+	}
+	if result == nil {
+		return nil, errors.New("request has no result on ledger")
+	}
+
+	transcript := NewTranscript(ctx, bind.RequestRef, *request)
+	transcript.Mode = insolar.ValidateCallMode
+	for _, out := range bind.Outgoing {
+		var outErr error
+		if out.Error != "" {
+			outErr = errors.New(out.Error)
+		}
+		transcript.AddOutgoingRequest(ctx, out.Request, out.Response, out.NewObject, outErr)
+	}
+
+	var memory []byte
+	if request.CallType == record.CTMethod {
+		if request.Object == nil {
+			return nil, errors.New("method request has no object")
+		}
+		if bind.State.IsEmpty() {
+			return nil, errors.New("object state is not provided for method request")
+		}
+		objDesc, err := v.ArtifactManager.GetObjectState(ctx, *request.Object, bind.State)
+		if err != nil {
+			return nil, errors.Wrap(err, "couldn't get object state")
+		}
+		transcript.ObjectDescriptor = objDesc
+		memory = objDesc.Memory()
+	}
+
+	v.validations.SetTranscript(transcript)
+	defer v.validations.Delete(bind.RequestRef)
+
+	verdict := &message.ValidationVerdict{
+		Request:   bind.RequestRef,
+		Validator: v.JetCoordinator.Me(),
+	}
+
+	res, err := v.LogicExecutor.Execute(ctx, transcript)
+	if err != nil {
+		verdict.Reason = errors.Wrap(err, "replay failed").Error()
+	} else {
+		verdict.ResultHash = v.PlatformCryptographyScheme.IntegrityHasher().Hash(res.Result())
+		verdict.StateHash = StateHash(v.PlatformCryptographyScheme, res, memory)
+
+		switch {
+		case !bytes.Equal(verdict.ResultHash, v.PlatformCryptographyScheme.IntegrityHasher().Hash(result.Payload)):
+			verdict.Reason = "result differs from the recorded one"
+		case !bytes.Equal(verdict.StateHash, bind.StateHash):
+			verdict.Reason = "object state differs from the recorded one"
+		default:
+			verdict.Valid = true
+		}
+	}
+
+	if !verdict.Valid {
+		inslogger.FromContext(ctx).Warnf("request %s failed validation: %s", bind.RequestRef.String(), verdict.Reason)
+	}
+
+	err = v.sign(verdict)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't sign verdict")
+	}
+	return verdict, nil
+}
+
+func (v *validator) Verify(verdict message.ValidationVerdict, key crypto.PublicKey) bool {
+	signature := insolar.SignatureFromBytes(verdict.Signature)
+	verdict.Signature = nil
+	data, err := insolar.Serialize(verdict)
+	if err != nil {
+		return false
+	}
+	return v.CryptographyService.Verify(key, signature, data)
+}
+
+func (v *validator) sign(verdict *message.ValidationVerdict) error {
+	verdict.Signature = nil
+	data, err := insolar.Serialize(verdict)
+	if err != nil {
+		return errors.Wrap(err, "couldn't serialize verdict")
+	}
+	signature, err := v.CryptographyService.Sign(data)
+	if err != nil {
+		return err
+	}
+	verdict.Signature = signature.Bytes()
+	return nil
+}
+
+// VerdictRecord converts verdict about request of the object to a ledger record.
+func VerdictRecord(object insolar.ID, verdict message.ValidationVerdict) record.Validation {
+	return record.Validation{
+		Object:           object,
+		Request:          verdict.Request,
+		Validator:        verdict.Validator,
+		Valid:            verdict.Valid,
+		Reason:           verdict.Reason,
+		ResultHash:       verdict.ResultHash,
+		StateHash:        verdict.StateHash,
+		VerdictSignature: verdict.Signature,
+	}
+}
+
+// StateHash calculates hash of object state after request execution. Executor puts it to a case bind
+// and validator compares it with the hash of replayed execution.
+func StateHash(scheme insolar.PlatformCryptographyScheme, res artifacts.RequestResult, memoryBefore []byte) []byte {
+	var memory []byte
+	switch res.Type() {
+	case artifacts.RequestSideEffectActivate:
+		_, _, _, memory = res.Activate()
+	case artifacts.RequestSideEffectAmend:
+		_, _, memory = res.Amend()
+	case artifacts.RequestSideEffectDeactivate:
+		memory = nil
+	default:
+		memory = memoryBefore
+	}
+
+	h := scheme.IntegrityHasher()
+	_, _ = h.Write([]byte{byte(res.Type())})
+	_, _ = h.Write(memory)
+	return h.Sum(nil)
+}
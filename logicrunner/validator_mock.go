@@ -0,0 +1,609 @@
+package logicrunner
+
+/*
+DO NOT EDIT!
+This code was generated automatically using github.com/gojuno/minimock v1.9
+The original interface "Validator" can be found in github.com/insolar/insolar/logicrunner
+*/
+import (
+	context "context"
+	"sync/atomic"
+	"time"
+
+	crypto "crypto"
+	"github.com/gojuno/minimock"
+	insolar "github.com/insolar/insolar/insolar"
+	message "github.com/insolar/insolar/insolar/message"
+
+	testify_assert "github.com/stretchr/testify/assert"
+)
+
+//ValidatorMock implements github.com/insolar/insolar/logicrunner.Validator
+type ValidatorMock struct {
+	t minimock.Tester
+
+	CurrentFunc       func(p insolar.Reference) (r *Transcript)
+	CurrentCounter    uint64
+	CurrentPreCounter uint64
+	CurrentMock       mValidatorMockCurrent
+
+	ValidateFunc       func(p context.Context, p1 message.CaseBindRequest) (r *message.ValidationVerdict, r1 error)
+	ValidateCounter    uint64
+	ValidatePreCounter uint64
+	ValidateMock       mValidatorMockValidate
+
+	VerifyFunc       func(p message.ValidationVerdict, p1 crypto.PublicKey) (r bool)
+	VerifyCounter    uint64
+	VerifyPreCounter uint64
+	VerifyMock       mValidatorMockVerify
+}
+
+//NewValidatorMock returns a mock for github.com/insolar/insolar/logicrunner.Validator
+func NewValidatorMock(t minimock.Tester) *ValidatorMock {
+	m := &ValidatorMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CurrentMock = mValidatorMockCurrent{mock: m}
+	m.ValidateMock = mValidatorMockValidate{mock: m}
+	m.VerifyMock = mValidatorMockVerify{mock: m}
+
+	return m
+}
+
+type mValidatorMockCurrent struct {
+	mock              *ValidatorMock
+	mainExpectation   *ValidatorMockCurrentExpectation
+	expectationSeries []*ValidatorMockCurrentExpectation
+}
+
+type ValidatorMockCurrentExpectation struct {
+	input  *ValidatorMockCurrentInput
+	result *ValidatorMockCurrentResult
+}
+
+type ValidatorMockCurrentInput struct {
+	p insolar.Reference
+}
+
+type ValidatorMockCurrentResult struct {
+	r *Transcript
+}
+
+//Expect specifies that invocation of Validator.Current is expected from 1 to Infinity times
+func (m *mValidatorMockCurrent) Expect(p insolar.Reference) *mValidatorMockCurrent {
+	m.mock.CurrentFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ValidatorMockCurrentExpectation{}
+	}
+	m.mainExpectation.input = &ValidatorMockCurrentInput{p}
+	return m
+}
+
+//Return specifies results of invocation of Validator.Current
+func (m *mValidatorMockCurrent) Return(r *Transcript) *ValidatorMock {
+	m.mock.CurrentFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ValidatorMockCurrentExpectation{}
+	}
+	m.mainExpectation.result = &ValidatorMockCurrentResult{r}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of Validator.Current is expected once
+func (m *mValidatorMockCurrent) ExpectOnce(p insolar.Reference) *ValidatorMockCurrentExpectation {
+	m.mock.CurrentFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &ValidatorMockCurrentExpectation{}
+	expectation.input = &ValidatorMockCurrentInput{p}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *ValidatorMockCurrentExpectation) Return(r *Transcript) {
+	e.result = &ValidatorMockCurrentResult{r}
+}
+
+//Set uses given function f as a mock of Validator.Current method
+func (m *mValidatorMockCurrent) Set(f func(p insolar.Reference) (r *Transcript)) *ValidatorMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.CurrentFunc = f
+	return m.mock
+}
+
+//Current implements github.com/insolar/insolar/logicrunner.Validator interface
+func (m *ValidatorMock) Current(p insolar.Reference) (r *Transcript) {
+	counter := atomic.AddUint64(&m.CurrentPreCounter, 1)
+	defer atomic.AddUint64(&m.CurrentCounter, 1)
+
+	if len(m.CurrentMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.CurrentMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to ValidatorMock.Current. %v", p)
+			return
+		}
+
+		input := m.CurrentMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, ValidatorMockCurrentInput{p}, "Validator.Current got unexpected parameters")
+
+		result := m.CurrentMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the ValidatorMock.Current")
+			return
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.CurrentMock.mainExpectation != nil {
+
+		input := m.CurrentMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, ValidatorMockCurrentInput{p}, "Validator.Current got unexpected parameters")
+		}
+
+		result := m.CurrentMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the ValidatorMock.Current")
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.CurrentFunc == nil {
+		m.t.Fatalf("Unexpected call to ValidatorMock.Current. %v", p)
+		return
+	}
+
+	return m.CurrentFunc(p)
+}
+
+//CurrentMinimockCounter returns a count of ValidatorMock.CurrentFunc invocations
+func (m *ValidatorMock) CurrentMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.CurrentCounter)
+}
+
+//CurrentMinimockPreCounter returns the value of ValidatorMock.Current invocations
+func (m *ValidatorMock) CurrentMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.CurrentPreCounter)
+}
+
+//CurrentFinished returns true if mock invocations count is ok
+func (m *ValidatorMock) CurrentFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.CurrentMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.CurrentCounter) == uint64(len(m.CurrentMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.CurrentMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.CurrentCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.CurrentFunc != nil {
+		return atomic.LoadUint64(&m.CurrentCounter) > 0
+	}
+
+	return true
+}
+
+type mValidatorMockValidate struct {
+	mock              *ValidatorMock
+	mainExpectation   *ValidatorMockValidateExpectation
+	expectationSeries []*ValidatorMockValidateExpectation
+}
+
+type ValidatorMockValidateExpectation struct {
+	input  *ValidatorMockValidateInput
+	result *ValidatorMockValidateResult
+}
+
+type ValidatorMockValidateInput struct {
+	p  context.Context
+	p1 message.CaseBindRequest
+}
+
+type ValidatorMockValidateResult struct {
+	r  *message.ValidationVerdict
+	r1 error
+}
+
+//Expect specifies that invocation of Validator.Validate is expected from 1 to Infinity times
+func (m *mValidatorMockValidate) Expect(p context.Context, p1 message.CaseBindRequest) *mValidatorMockValidate {
+	m.mock.ValidateFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ValidatorMockValidateExpectation{}
+	}
+	m.mainExpectation.input = &ValidatorMockValidateInput{p, p1}
+	return m
+}
+
+//Return specifies results of invocation of Validator.Validate
+func (m *mValidatorMockValidate) Return(r *message.ValidationVerdict, r1 error) *ValidatorMock {
+	m.mock.ValidateFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ValidatorMockValidateExpectation{}
+	}
+	m.mainExpectation.result = &ValidatorMockValidateResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of Validator.Validate is expected once
+func (m *mValidatorMockValidate) ExpectOnce(p context.Context, p1 message.CaseBindRequest) *ValidatorMockValidateExpectation {
+	m.mock.ValidateFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &ValidatorMockValidateExpectation{}
+	expectation.input = &ValidatorMockValidateInput{p, p1}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *ValidatorMockValidateExpectation) Return(r *message.ValidationVerdict, r1 error) {
+	e.result = &ValidatorMockValidateResult{r, r1}
+}
+
+//Set uses given function f as a mock of Validator.Validate method
+func (m *mValidatorMockValidate) Set(f func(p context.Context, p1 message.CaseBindRequest) (r *message.ValidationVerdict, r1 error)) *ValidatorMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.ValidateFunc = f
+	return m.mock
+}
+
+//Validate implements github.com/insolar/insolar/logicrunner.Validator interface
+func (m *ValidatorMock) Validate(p context.Context, p1 message.CaseBindRequest) (r *message.ValidationVerdict, r1 error) {
+	counter := atomic.AddUint64(&m.ValidatePreCounter, 1)
+	defer atomic.AddUint64(&m.ValidateCounter, 1)
+
+	if len(m.ValidateMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.ValidateMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to ValidatorMock.Validate. %v %v", p, p1)
+			return
+		}
+
+		input := m.ValidateMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, ValidatorMockValidateInput{p, p1}, "Validator.Validate got unexpected parameters")
+
+		result := m.ValidateMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the ValidatorMock.Validate")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.ValidateMock.mainExpectation != nil {
+
+		input := m.ValidateMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, ValidatorMockValidateInput{p, p1}, "Validator.Validate got unexpected parameters")
+		}
+
+		result := m.ValidateMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the ValidatorMock.Validate")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.ValidateFunc == nil {
+		m.t.Fatalf("Unexpected call to ValidatorMock.Validate. %v %v", p, p1)
+		return
+	}
+
+	return m.ValidateFunc(p, p1)
+}
+
+//ValidateMinimockCounter returns a count of ValidatorMock.ValidateFunc invocations
+func (m *ValidatorMock) ValidateMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.ValidateCounter)
+}
+
+//ValidateMinimockPreCounter returns the value of ValidatorMock.Validate invocations
+func (m *ValidatorMock) ValidateMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.ValidatePreCounter)
+}
+
+//ValidateFinished returns true if mock invocations count is ok
+func (m *ValidatorMock) ValidateFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.ValidateMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.ValidateCounter) == uint64(len(m.ValidateMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.ValidateMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.ValidateCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.ValidateFunc != nil {
+		return atomic.LoadUint64(&m.ValidateCounter) > 0
+	}
+
+	return true
+}
+
+type mValidatorMockVerify struct {
+	mock              *ValidatorMock
+	mainExpectation   *ValidatorMockVerifyExpectation
+	expectationSeries []*ValidatorMockVerifyExpectation
+}
+
+type ValidatorMockVerifyExpectation struct {
+	input  *ValidatorMockVerifyInput
+	result *ValidatorMockVerifyResult
+}
+
+type ValidatorMockVerifyInput struct {
+	p  message.ValidationVerdict
+	p1 crypto.PublicKey
+}
+
+type ValidatorMockVerifyResult struct {
+	r bool
+}
+
+//Expect specifies that invocation of Validator.Verify is expected from 1 to Infinity times
+func (m *mValidatorMockVerify) Expect(p message.ValidationVerdict, p1 crypto.PublicKey) *mValidatorMockVerify {
+	m.mock.VerifyFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ValidatorMockVerifyExpectation{}
+	}
+	m.mainExpectation.input = &ValidatorMockVerifyInput{p, p1}
+	return m
+}
+
+//Return specifies results of invocation of Validator.Verify
+func (m *mValidatorMockVerify) Return(r bool) *ValidatorMock {
+	m.mock.VerifyFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ValidatorMockVerifyExpectation{}
+	}
+	m.mainExpectation.result = &ValidatorMockVerifyResult{r}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of Validator.Verify is expected once
+func (m *mValidatorMockVerify) ExpectOnce(p message.ValidationVerdict, p1 crypto.PublicKey) *ValidatorMockVerifyExpectation {
+	m.mock.VerifyFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &ValidatorMockVerifyExpectation{}
+	expectation.input = &ValidatorMockVerifyInput{p, p1}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *ValidatorMockVerifyExpectation) Return(r bool) {
+	e.result = &ValidatorMockVerifyResult{r}
+}
+
+//Set uses given function f as a mock of Validator.Verify method
+func (m *mValidatorMockVerify) Set(f func(p message.ValidationVerdict, p1 crypto.PublicKey) (r bool)) *ValidatorMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.VerifyFunc = f
+	return m.mock
+}
+
+//Verify implements github.com/insolar/insolar/logicrunner.Validator interface
+func (m *ValidatorMock) Verify(p message.ValidationVerdict, p1 crypto.PublicKey) (r bool) {
+	counter := atomic.AddUint64(&m.VerifyPreCounter, 1)
+	defer atomic.AddUint64(&m.VerifyCounter, 1)
+
+	if len(m.VerifyMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.VerifyMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to ValidatorMock.Verify. %v %v", p, p1)
+			return
+		}
+
+		input := m.VerifyMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, ValidatorMockVerifyInput{p, p1}, "Validator.Verify got unexpected parameters")
+
+		result := m.VerifyMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the ValidatorMock.Verify")
+			return
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.VerifyMock.mainExpectation != nil {
+
+		input := m.VerifyMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, ValidatorMockVerifyInput{p, p1}, "Validator.Verify got unexpected parameters")
+		}
+
+		result := m.VerifyMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the ValidatorMock.Verify")
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.VerifyFunc == nil {
+		m.t.Fatalf("Unexpected call to ValidatorMock.Verify. %v %v", p, p1)
+		return
+	}
+
+	return m.VerifyFunc(p, p1)
+}
+
+//VerifyMinimockCounter returns a count of ValidatorMock.VerifyFunc invocations
+func (m *ValidatorMock) VerifyMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.VerifyCounter)
+}
+
+//VerifyMinimockPreCounter returns the value of ValidatorMock.Verify invocations
+func (m *ValidatorMock) VerifyMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.VerifyPreCounter)
+}
+
+//VerifyFinished returns true if mock invocations count is ok
+func (m *ValidatorMock) VerifyFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.VerifyMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.VerifyCounter) == uint64(len(m.VerifyMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.VerifyMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.VerifyCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.VerifyFunc != nil {
+		return atomic.LoadUint64(&m.VerifyCounter) > 0
+	}
+
+	return true
+}
+
+//ValidateCallCounters checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *ValidatorMock) ValidateCallCounters() {
+
+	if !m.CurrentFinished() {
+		m.t.Fatal("Expected call to ValidatorMock.Current")
+	}
+
+	if !m.ValidateFinished() {
+		m.t.Fatal("Expected call to ValidatorMock.Validate")
+	}
+
+	if !m.VerifyFinished() {
+		m.t.Fatal("Expected call to ValidatorMock.Verify")
+	}
+
+}
+
+//CheckMocksCalled checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *ValidatorMock) CheckMocksCalled() {
+	m.Finish()
+}
+
+//Finish checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish or use Finish method of minimock.Controller
+func (m *ValidatorMock) Finish() {
+	m.MinimockFinish()
+}
+
+//MinimockFinish checks that all mocked methods of the interface have been called at least once
+func (m *ValidatorMock) MinimockFinish() {
+
+	if !m.CurrentFinished() {
+		m.t.Fatal("Expected call to ValidatorMock.Current")
+	}
+
+	if !m.ValidateFinished() {
+		m.t.Fatal("Expected call to ValidatorMock.Validate")
+	}
+
+	if !m.VerifyFinished() {
+		m.t.Fatal("Expected call to ValidatorMock.Verify")
+	}
+
+}
+
+//Wait waits for all mocked methods to be called at least once
+//Deprecated: please use MinimockWait or use Wait method of minimock.Controller
+func (m *ValidatorMock) Wait(timeout time.Duration) {
+	m.MinimockWait(timeout)
+}
+
+//MinimockWait waits for all mocked methods to be called at least once
+//this method is called by minimock.Controller
+func (m *ValidatorMock) MinimockWait(timeout time.Duration) {
+	timeoutCh := time.After(timeout)
+	for {
+		ok := true
+		ok = ok && m.CurrentFinished()
+		ok = ok && m.ValidateFinished()
+		ok = ok && m.VerifyFinished()
+
+		if ok {
+			return
+		}
+
+		select {
+		case <-timeoutCh:
+
+			if !m.CurrentFinished() {
+				m.t.Error("Expected call to ValidatorMock.Current")
+			}
+
+			if !m.ValidateFinished() {
+				m.t.Error("Expected call to ValidatorMock.Validate")
+			}
+
+			if !m.VerifyFinished() {
+				m.t.Error("Expected call to ValidatorMock.Verify")
+			}
+
+			m.t.Fatalf("Some mocks were not called on time: %s", timeout)
+			return
+		default:
+			time.Sleep(time.Millisecond)
+		}
+	}
+}
+
+//AllMocksCalled returns true if all mocked methods were called before the execution of AllMocksCalled,
+//it can be used with assert/require, i.e. assert.True(mock.AllMocksCalled())
+func (m *ValidatorMock) AllMocksCalled() bool {
+
+	if !m.CurrentFinished() {
+		return false
+	}
+
+	if !m.ValidateFinished() {
+		return false
+	}
+
+	if !m.VerifyFinished() {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,226 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package logicrunner
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gojuno/minimock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/cryptography"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/message"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/logicrunner/artifacts"
+	"github.com/insolar/insolar/platformpolicy"
+)
+
+func TestValidator_New(t *testing.T) {
+	v := NewValidator()
+	require.NotNil(t, v)
+}
+
+func newTestValidator(t *testing.T, mc *minimock.Controller, le LogicExecutor, am artifacts.Client) (*validator, insolar.Reference) {
+	kp := platformpolicy.NewKeyProcessor()
+	privateKey, err := kp.GeneratePrivateKey()
+	require.NoError(t, err)
+
+	me := gen.Reference()
+	v := NewValidator().(*validator)
+	v.LogicExecutor = le
+	v.ArtifactManager = am
+	v.CryptographyService = cryptography.NewKeyBoundCryptographyService(privateKey)
+	v.PlatformCryptographyScheme = platformpolicy.NewPlatformCryptographyScheme()
+	// validator asks for its reference only when verdict is made
+	v.JetCoordinator = jet.NewCoordinatorMock(t).MeMock.Return(me)
+	return v, me
+}
+
+func newLedgerMock(
+	mc *minimock.Controller, request record.IncomingRequest, result []byte,
+) *artifacts.ClientMock {
+	return artifacts.NewClientMock(mc).
+		GetIncomingRequestMock.Return(&request, nil).
+		GetResultMock.Return(&record.Result{Payload: result}, nil)
+}
+
+func TestValidator_Validate(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	scheme := platformpolicy.NewPlatformCryptographyScheme()
+
+	objRef := gen.Reference()
+	reqRef := gen.Reference()
+	outgoing := record.IncomingRequest{Method: "Get", Object: &objRef}
+	constructor := record.IncomingRequest{CallType: record.CTSaveAsChild, Method: "New"}
+
+	result := newRequestResult([]byte{1, 2, 3}, objRef)
+	result.SetActivate(gen.Reference(), gen.Reference(), false, []byte{4, 5, 6})
+
+	bind := message.CaseBindRequest{
+		RequestRef: reqRef,
+		Object:     reqRef,
+		Outgoing: []message.CaseBindOutgoing{
+			{Request: outgoing, Response: []byte{7}},
+		},
+		StateHash: StateHash(scheme, result, nil),
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		mc := minimock.NewController(t)
+		defer mc.Finish()
+
+		var v *validator
+		le := NewLogicExecutorMock(mc).ExecuteMock.Set(
+			func(ctx context.Context, tr *Transcript) (artifacts.RequestResult, error) {
+				require.Equal(t, insolar.ValidateCallMode, tr.Mode)
+				require.Equal(t, tr, v.Current(reqRef))
+				require.Equal(t, constructor, *tr.Request)
+				out := tr.HasOutgoingRequest(ctx, outgoing)
+				require.NotNil(t, out)
+				require.Equal(t, []byte{7}, out.Response)
+				return result, nil
+			})
+		v, me := newTestValidator(t, mc, le, newLedgerMock(mc, constructor, []byte{1, 2, 3}))
+
+		verdict, err := v.Validate(ctx, bind)
+		require.NoError(t, err)
+		require.True(t, verdict.Valid)
+		require.Equal(t, me, verdict.Validator)
+		require.Equal(t, reqRef, verdict.Request)
+		require.Nil(t, v.Current(reqRef))
+	})
+
+	t.Run("different result", func(t *testing.T) {
+		mc := minimock.NewController(t)
+		defer mc.Finish()
+
+		other := newRequestResult([]byte{3, 2, 1}, objRef)
+		other.SetActivate(gen.Reference(), gen.Reference(), false, []byte{4, 5, 6})
+		le := NewLogicExecutorMock(mc).ExecuteMock.Return(other, nil)
+		v, _ := newTestValidator(t, mc, le, newLedgerMock(mc, constructor, []byte{1, 2, 3}))
+
+		verdict, err := v.Validate(ctx, bind)
+		require.NoError(t, err)
+		require.False(t, verdict.Valid)
+		require.NotEmpty(t, verdict.Reason)
+	})
+
+	t.Run("different state", func(t *testing.T) {
+		mc := minimock.NewController(t)
+		defer mc.Finish()
+
+		other := newRequestResult([]byte{1, 2, 3}, objRef)
+		other.SetActivate(gen.Reference(), gen.Reference(), false, []byte{6, 5, 4})
+		le := NewLogicExecutorMock(mc).ExecuteMock.Return(other, nil)
+		v, _ := newTestValidator(t, mc, le, newLedgerMock(mc, constructor, []byte{1, 2, 3}))
+
+		verdict, err := v.Validate(ctx, bind)
+		require.NoError(t, err)
+		require.False(t, verdict.Valid)
+	})
+
+	t.Run("no result on ledger", func(t *testing.T) {
+		mc := minimock.NewController(t)
+		defer mc.Finish()
+
+		am := artifacts.NewClientMock(mc).
+			GetIncomingRequestMock.Return(&constructor, nil).
+			GetResultMock.Return(nil, nil)
+		v, _ := newTestValidator(t, mc, NewLogicExecutorMock(mc), am)
+
+		_, err := v.Validate(ctx, bind)
+		require.Error(t, err)
+	})
+
+	t.Run("method replayed on ledger state", func(t *testing.T) {
+		mc := minimock.NewController(t)
+		defer mc.Finish()
+
+		memory := []byte{9, 9}
+		stateID := gen.ID()
+		method := bind
+		method.Object = objRef
+		method.State = stateID
+		method.StateHash = StateHash(scheme, newRequestResult([]byte{1, 2, 3}, objRef), memory)
+
+		request := record.IncomingRequest{CallType: record.CTMethod, Object: &objRef}
+		am := newLedgerMock(mc, request, []byte{1, 2, 3})
+		am.GetObjectStateMock.Set(
+			func(_ context.Context, head insolar.Reference, state insolar.ID) (artifacts.ObjectDescriptor, error) {
+				require.Equal(t, objRef, head)
+				require.Equal(t, stateID, state)
+				return artifacts.NewObjectDescriptorMock(mc).MemoryMock.Return(memory), nil
+			})
+		le := NewLogicExecutorMock(mc).ExecuteMock.Set(
+			func(ctx context.Context, tr *Transcript) (artifacts.RequestResult, error) {
+				require.Equal(t, memory, tr.ObjectDescriptor.Memory())
+				return newRequestResult([]byte{1, 2, 3}, objRef), nil
+			})
+		v, _ := newTestValidator(t, mc, le, am)
+
+		verdict, err := v.Validate(ctx, method)
+		require.NoError(t, err)
+		require.True(t, verdict.Valid)
+	})
+
+	t.Run("method without object", func(t *testing.T) {
+		mc := minimock.NewController(t)
+		defer mc.Finish()
+
+		method := bind
+		method.Object = objRef
+		method.State = gen.ID()
+
+		am := newLedgerMock(mc, record.IncomingRequest{CallType: record.CTMethod}, []byte{1, 2, 3})
+		v, _ := newTestValidator(t, mc, NewLogicExecutorMock(mc), am)
+
+		_, err := v.Validate(ctx, method)
+		require.Error(t, err)
+	})
+}
+
+func TestValidator_Verify(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	objRef := gen.Reference()
+	reqRef := gen.Reference()
+	le := NewLogicExecutorMock(mc).ExecuteMock.Return(newRequestResult(nil, objRef), nil)
+	am := newLedgerMock(mc, record.IncomingRequest{CallType: record.CTSaveAsChild}, nil)
+	v, _ := newTestValidator(t, mc, le, am)
+
+	verdict, err := v.Validate(ctx, message.CaseBindRequest{
+		RequestRef: reqRef,
+		Object:     reqRef,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, verdict.Signature)
+
+	publicKey, err := v.CryptographyService.GetPublicKey()
+	require.NoError(t, err)
+	require.True(t, v.Verify(*verdict, publicKey))
+
+	tampered := *verdict
+	tampered.Valid = !tampered.Valid
+	require.False(t, v.Verify(tampered, publicKey))
+}
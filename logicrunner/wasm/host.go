@@ -0,0 +1,259 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package wasm
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	lrCommon "github.com/insolar/insolar/logicrunner/common"
+	"github.com/insolar/insolar/logicrunner/goplugin/rpctypes"
+	"github.com/insolar/insolar/logicrunner/wasm/vm"
+)
+
+// call holds state of a single contract call shared by host functions
+type call struct {
+	ctx     context.Context
+	callCtx *insolar.LogicCallContext
+	stub    lrCommon.LogicRunnerRPCStub
+
+	input    []byte
+	state    []byte
+	stateSet bool
+	result   []byte
+
+	returnData []byte
+}
+
+var (
+	none = []vm.ValueType{}
+	i32  = []vm.ValueType{vm.I32}
+)
+
+func params(n int) []vm.ValueType {
+	res := make([]vm.ValueType, n)
+	for i := range res {
+		res[i] = vm.I32
+	}
+	return res
+}
+
+func (c *call) imports() vm.Imports {
+	return vm.Imports{
+		HostModule: {
+			"input_size": {Type: vm.FuncType{Params: none, Results: i32}, Call: c.size(func() []byte { return c.input })},
+			"input":      {Type: vm.FuncType{Params: i32, Results: none}, Call: c.copy(func() []byte { return c.input })},
+			"state_size": {Type: vm.FuncType{Params: none, Results: i32}, Call: c.size(func() []byte { return c.state })},
+			"state":      {Type: vm.FuncType{Params: i32, Results: none}, Call: c.copy(func() []byte { return c.state })},
+			"set_state":  {Type: vm.FuncType{Params: params(2), Results: none}, Call: c.setState},
+			"set_result": {Type: vm.FuncType{Params: params(2), Results: none}, Call: c.setResult},
+			"fail":       {Type: vm.FuncType{Params: params(2), Results: none}, Call: c.fail},
+
+			"return_data_size": {Type: vm.FuncType{Params: none, Results: i32}, Call: c.size(func() []byte { return c.returnData })},
+			"return_data":      {Type: vm.FuncType{Params: i32, Results: none}, Call: c.copy(func() []byte { return c.returnData })},
+
+			"route_call":        {Type: vm.FuncType{Params: params(7), Results: i32}, Call: c.foundation(c.routeCall)},
+			"save_as_child":     {Type: vm.FuncType{Params: params(6), Results: i32}, Call: c.foundation(c.saveAsChild)},
+			"get_delegate":      {Type: vm.FuncType{Params: params(2), Results: i32}, Call: c.foundation(c.getDelegate)},
+			"deactivate_object": {Type: vm.FuncType{Params: none, Results: i32}, Call: c.foundation(c.deactivateObject)},
+		},
+	}
+}
+
+func (c *call) size(buf func() []byte) func(*vm.Instance, []uint64) ([]uint64, error) {
+	return func(_ *vm.Instance, _ []uint64) ([]uint64, error) {
+		return []uint64{uint64(len(buf()))}, nil
+	}
+}
+
+func (c *call) copy(buf func() []byte) func(*vm.Instance, []uint64) ([]uint64, error) {
+	return func(in *vm.Instance, args []uint64) ([]uint64, error) {
+		return nil, in.Write(uint32(args[0]), buf())
+	}
+}
+
+func (c *call) setState(in *vm.Instance, args []uint64) ([]uint64, error) {
+	state, err := in.Read(uint32(args[0]), uint32(args[1]))
+	if err != nil {
+		return nil, err
+	}
+	c.state, c.stateSet = state, true
+	return nil, nil
+}
+
+func (c *call) setResult(in *vm.Instance, args []uint64) ([]uint64, error) {
+	result, err := in.Read(uint32(args[0]), uint32(args[1]))
+	if err != nil {
+		return nil, err
+	}
+	c.result = result
+	return nil, nil
+}
+
+func (c *call) fail(in *vm.Instance, args []uint64) ([]uint64, error) {
+	msg, err := in.Read(uint32(args[0]), uint32(args[1]))
+	if err != nil {
+		return nil, err
+	}
+	return nil, errors.New(string(msg))
+}
+
+// foundation wraps a foundation call, its error is returned to contract and doesn't abort execution
+func (c *call) foundation(f func(in *vm.Instance, args []uint64) ([]byte, error)) func(*vm.Instance, []uint64) ([]uint64, error) {
+	return func(in *vm.Instance, args []uint64) ([]uint64, error) {
+		if err := c.ctx.Err(); err != nil {
+			return nil, errors.Wrap(err, "execution is interrupted")
+		}
+		data, err := f(in, args)
+		if err != nil {
+			c.returnData = []byte(err.Error())
+			return []uint64{1}, nil
+		}
+		c.returnData = data
+		return []uint64{0}, nil
+	}
+}
+
+func (c *call) baseReq() rpctypes.UpBaseReq {
+	req := rpctypes.UpBaseReq{Mode: c.callCtx.Mode}
+	if c.callCtx.Callee != nil {
+		req.Callee = *c.callCtx.Callee
+	}
+	if c.callCtx.Prototype != nil {
+		req.CalleePrototype = *c.callCtx.Prototype
+	}
+	if c.callCtx.Request != nil {
+		req.Request = *c.callCtx.Request
+	}
+	return req
+}
+
+func readRef(in *vm.Instance, ptr uint64) (insolar.Reference, error) {
+	var ref insolar.Reference
+	data, err := in.Read(uint32(ptr), insolar.RecordRefSize)
+	if err != nil {
+		return ref, err
+	}
+	copy(ref[:], data)
+	return ref, nil
+}
+
+func (c *call) routeCall(in *vm.Instance, args []uint64) ([]byte, error) {
+	object, err := readRef(in, args[0])
+	if err != nil {
+		return nil, err
+	}
+	prototype, err := readRef(in, args[1])
+	if err != nil {
+		return nil, err
+	}
+	method, err := in.Read(uint32(args[2]), uint32(args[3]))
+	if err != nil {
+		return nil, err
+	}
+	arguments, err := in.Read(uint32(args[4]), uint32(args[5]))
+	if err != nil {
+		return nil, err
+	}
+	flags := args[6]
+
+	req := rpctypes.UpRouteReq{
+		UpBaseReq: c.baseReq(),
+		Wait:      flags&RouteWait != 0,
+		Immutable: flags&RouteImmutable != 0,
+		Saga:      flags&RouteSaga != 0,
+		Object:    object,
+		Method:    string(method),
+		Arguments: arguments,
+		Prototype: prototype,
+	}
+	res := rpctypes.UpRouteResp{}
+	if err := c.stub.RouteCall(req, &res); err != nil {
+		return nil, errors.Wrap(err, "[ RouteCall ] on calling main API")
+	}
+	return res.Result, nil
+}
+
+func (c *call) saveAsChild(in *vm.Instance, args []uint64) ([]byte, error) {
+	parent, err := readRef(in, args[0])
+	if err != nil {
+		return nil, err
+	}
+	prototype, err := readRef(in, args[1])
+	if err != nil {
+		return nil, err
+	}
+	constructor, err := in.Read(uint32(args[2]), uint32(args[3]))
+	if err != nil {
+		return nil, err
+	}
+	arguments, err := in.Read(uint32(args[4]), uint32(args[5]))
+	if err != nil {
+		return nil, err
+	}
+
+	req := rpctypes.UpSaveAsChildReq{
+		UpBaseReq:       c.baseReq(),
+		Parent:          parent,
+		Prototype:       prototype,
+		ConstructorName: string(constructor),
+		ArgsSerialized:  arguments,
+	}
+	res := rpctypes.UpSaveAsChildResp{}
+	if err := c.stub.SaveAsChild(req, &res); err != nil {
+		return nil, errors.Wrap(err, "[ SaveAsChild ] on calling main API")
+	}
+	if res.Reference == nil {
+		return nil, errors.New("[ SaveAsChild ] reference of the child is empty")
+	}
+	return res.Reference.Bytes(), nil
+}
+
+func (c *call) getDelegate(in *vm.Instance, args []uint64) ([]byte, error) {
+	object, err := readRef(in, args[0])
+	if err != nil {
+		return nil, err
+	}
+	ofType, err := readRef(in, args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	req := rpctypes.UpGetDelegateReq{
+		UpBaseReq: c.baseReq(),
+		Object:    object,
+		OfType:    ofType,
+	}
+	res := rpctypes.UpGetDelegateResp{}
+	if err := c.stub.GetDelegate(req, &res); err != nil {
+		return nil, errors.Wrap(err, "[ GetDelegate ] on calling main API")
+	}
+	return res.Object.Bytes(), nil
+}
+
+func (c *call) deactivateObject(_ *vm.Instance, _ []uint64) ([]byte, error) {
+	req := rpctypes.UpDeactivateObjectReq{
+		UpBaseReq: c.baseReq(),
+	}
+	res := rpctypes.UpDeactivateObjectResp{}
+	if err := c.stub.DeactivateObject(req, &res); err != nil {
+		return nil, errors.Wrap(err, "[ DeactivateObject ] on calling main API")
+	}
+	return nil, nil
+}
@@ -0,0 +1,591 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package vm
+
+import (
+	"encoding/binary"
+	"math"
+	"math/bits"
+
+	"github.com/pkg/errors"
+)
+
+type label struct {
+	arity  int
+	height int
+	cont   int
+	loop   bool
+}
+
+// execute runs function body, code is already validated by scanner, so immediates are well formed
+// and operands on the stack have expected types.
+func (in *Instance) execute(fn *function, locals []uint64, results int) error {
+	code := fn.code
+	labels := []label{{arity: results, height: len(in.stack), cont: len(code)}}
+	pc := 0
+
+	for {
+		in.steps++
+		if in.steps&checkInterval == 0 {
+			if err := in.ctx.Err(); err != nil {
+				return errors.Wrap(err, "execution is interrupted")
+			}
+		}
+
+		opPC := pc
+		op := code[pc]
+		pc++
+
+		switch op {
+		case opUnreachable:
+			return trap("unreachable executed")
+		case opNop:
+		case opBlock:
+			bi := fn.blocks[opPC]
+			pc = skipBlockType(code, pc)
+			labels = append(labels, label{arity: bi.results, height: len(in.stack) - bi.params, cont: bi.endPC + 1})
+		case opLoop:
+			bi := fn.blocks[opPC]
+			pc = skipBlockType(code, pc)
+			labels = append(labels, label{arity: bi.params, height: len(in.stack) - bi.params, cont: pc, loop: true})
+		case opIf:
+			bi := fn.blocks[opPC]
+			pc = skipBlockType(code, pc)
+			cond := in.pop32()
+			l := label{arity: bi.results, height: len(in.stack) - bi.params, cont: bi.endPC + 1}
+			switch {
+			case cond != 0:
+				labels = append(labels, l)
+			case bi.elsePC != 0:
+				labels = append(labels, l)
+				pc = bi.elsePC + 1
+			default:
+				pc = bi.endPC + 1
+			}
+		case opElse:
+			// true branch of if is finished
+			l := labels[len(labels)-1]
+			labels = labels[:len(labels)-1]
+			pc = l.cont
+		case opEnd:
+			labels = labels[:len(labels)-1]
+			if len(labels) == 0 {
+				return nil
+			}
+		case opBr:
+			depth := readU32(code, &pc)
+			labels, pc = in.branch(labels, depth)
+			if len(labels) == 0 {
+				return nil
+			}
+		case opBrIf:
+			depth := readU32(code, &pc)
+			if in.pop32() != 0 {
+				labels, pc = in.branch(labels, depth)
+				if len(labels) == 0 {
+					return nil
+				}
+			}
+		case opBrTable:
+			count := readU32(code, &pc)
+			index := in.pop32()
+			var target uint32
+			found := false
+			for i := uint32(0); i < count; i++ {
+				t := readU32(code, &pc)
+				if i == index {
+					target, found = t, true
+				}
+			}
+			def := readU32(code, &pc)
+			if !found {
+				target = def
+			}
+			labels, pc = in.branch(labels, target)
+			if len(labels) == 0 {
+				return nil
+			}
+		case opReturn:
+			in.branch(labels, uint32(len(labels)-1))
+			return nil
+		case opCall:
+			if err := in.invoke(readU32(code, &pc)); err != nil {
+				return err
+			}
+		case opCallIndirect:
+			typ := readU32(code, &pc)
+			pc++ // table index
+			index := in.pop32()
+			if int64(index) >= int64(len(in.table)) {
+				return trap("undefined table element %d", index)
+			}
+			f := in.table[index]
+			if f == nullFunction {
+				return trap("uninitialized table element %d", index)
+			}
+			if !in.module.types[in.module.funcType(f)].equal(in.module.types[typ]) {
+				return trap("indirect call type mismatch")
+			}
+			if err := in.invoke(f); err != nil {
+				return err
+			}
+
+		case opDrop:
+			in.pop()
+		case opSelect, opSelectT:
+			if op == opSelectT {
+				pc += 2 // one value type
+			}
+			cond := in.pop32()
+			b := in.pop()
+			a := in.pop()
+			if cond != 0 {
+				in.push(a)
+			} else {
+				in.push(b)
+			}
+
+		case opLocalGet:
+			in.push(locals[readU32(code, &pc)])
+		case opLocalSet:
+			locals[readU32(code, &pc)] = in.pop()
+		case opLocalTee:
+			locals[readU32(code, &pc)] = in.stack[len(in.stack)-1]
+		case opGlobalGet:
+			in.push(in.globals[readU32(code, &pc)])
+		case opGlobalSet:
+			in.globals[readU32(code, &pc)] = in.pop()
+
+		case opI32Load, opI64Load, opI32Load8S, opI32Load8U, opI32Load16S, opI32Load16U,
+			opI64Load8S, opI64Load8U, opI64Load16S, opI64Load16U, opI64Load32S, opI64Load32U:
+			readU32(code, &pc) // alignment
+			offset := readU32(code, &pc)
+			if err := in.load(op, uint64(in.pop32())+uint64(offset)); err != nil {
+				return err
+			}
+		case opI32Store, opI64Store, opI32Store8, opI32Store16, opI64Store8, opI64Store16, opI64Store32:
+			readU32(code, &pc) // alignment
+			offset := readU32(code, &pc)
+			v := in.pop()
+			if err := in.store(op, uint64(in.pop32())+uint64(offset), v); err != nil {
+				return err
+			}
+		case opMemorySize:
+			pc++
+			in.push(uint64(len(in.memory) / PageSize))
+		case opMemoryGrow:
+			pc++
+			n := in.pop32()
+			pages := uint32(len(in.memory) / PageSize)
+			if uint64(pages)+uint64(n) > uint64(in.maxPages) {
+				in.push(uint64(math.MaxUint32))
+				break
+			}
+			in.memory = append(in.memory, make([]byte, int(n)*PageSize)...)
+			in.push(uint64(pages))
+
+		case opI32Const:
+			in.push(uint64(uint32(readS32(code, &pc))))
+		case opI64Const:
+			in.push(uint64(readS64(code, &pc)))
+
+		case opI32Eqz:
+			in.pushBool(in.pop32() == 0)
+		case opI64Eqz:
+			in.pushBool(in.pop() == 0)
+		case opI32Eq, opI32Ne, opI32LtS, opI32LtU, opI32GtS, opI32GtU, opI32LeS, opI32LeU, opI32GeS, opI32GeU:
+			b := in.pop32()
+			a := in.pop32()
+			in.pushBool(compare32(op, a, b))
+		case opI64Eq, opI64Ne, opI64LtS, opI64LtU, opI64GtS, opI64GtU, opI64LeS, opI64LeU, opI64GeS, opI64GeU:
+			b := in.pop()
+			a := in.pop()
+			in.pushBool(compare64(op, a, b))
+
+		case opI32Clz:
+			in.push(uint64(bits.LeadingZeros32(in.pop32())))
+		case opI32Ctz:
+			in.push(uint64(bits.TrailingZeros32(in.pop32())))
+		case opI32Popcnt:
+			in.push(uint64(bits.OnesCount32(in.pop32())))
+		case opI64Clz:
+			in.push(uint64(bits.LeadingZeros64(in.pop())))
+		case opI64Ctz:
+			in.push(uint64(bits.TrailingZeros64(in.pop())))
+		case opI64Popcnt:
+			in.push(uint64(bits.OnesCount64(in.pop())))
+		case opI32Add, opI32Sub, opI32Mul, opI32DivS, opI32DivU, opI32RemS, opI32RemU,
+			opI32And, opI32Or, opI32Xor, opI32Shl, opI32ShrS, opI32ShrU, opI32Rotl, opI32Rotr:
+			b := in.pop32()
+			a := in.pop32()
+			v, err := arith32(op, a, b)
+			if err != nil {
+				return err
+			}
+			in.push(uint64(v))
+		case opI64Add, opI64Sub, opI64Mul, opI64DivS, opI64DivU, opI64RemS, opI64RemU,
+			opI64And, opI64Or, opI64Xor, opI64Shl, opI64ShrS, opI64ShrU, opI64Rotl, opI64Rotr:
+			b := in.pop()
+			a := in.pop()
+			v, err := arith64(op, a, b)
+			if err != nil {
+				return err
+			}
+			in.push(v)
+
+		case opI32WrapI64:
+			in.push(uint64(in.pop32()))
+		case opI64ExtendI32S:
+			in.push(uint64(int64(int32(in.pop32()))))
+		case opI64ExtendI32U:
+			in.push(uint64(in.pop32()))
+		case opI32Extend8S:
+			in.push(uint64(uint32(int32(int8(in.pop())))))
+		case opI32Extend16S:
+			in.push(uint64(uint32(int32(int16(in.pop())))))
+		case opI64Extend8S:
+			in.push(uint64(int64(int8(in.pop()))))
+		case opI64Extend16S:
+			in.push(uint64(int64(int16(in.pop()))))
+		case opI64Extend32S:
+			in.push(uint64(int64(int32(in.pop()))))
+
+		case opPrefixMisc:
+			sub := readU32(code, &pc)
+			if err := in.misc(sub, &pc); err != nil {
+				return err
+			}
+
+		default:
+			return trap("unsupported instruction 0x%x", op)
+		}
+	}
+}
+
+// branch unwinds labels to the target and returns code position to continue from.
+func (in *Instance) branch(labels []label, depth uint32) ([]label, int) {
+	l := labels[len(labels)-1-int(depth)]
+	copy(in.stack[l.height:], in.stack[len(in.stack)-l.arity:])
+	in.stack = in.stack[:l.height+l.arity]
+	if l.loop {
+		return labels[:len(labels)-int(depth)], l.cont
+	}
+	return labels[:len(labels)-1-int(depth)], l.cont
+}
+
+func (in *Instance) load(op byte, addr uint64) error {
+	size := uint64(1)
+	switch op {
+	case opI64Load:
+		size = 8
+	case opI32Load, opI64Load32S, opI64Load32U:
+		size = 4
+	case opI32Load16S, opI32Load16U, opI64Load16S, opI64Load16U:
+		size = 2
+	}
+	if addr+size > uint64(len(in.memory)) {
+		return trap("out of bounds memory access")
+	}
+	m := in.memory[addr:]
+
+	switch op {
+	case opI32Load:
+		in.push(uint64(binary.LittleEndian.Uint32(m)))
+	case opI64Load:
+		in.push(binary.LittleEndian.Uint64(m))
+	case opI32Load8S:
+		in.push(uint64(uint32(int32(int8(m[0])))))
+	case opI32Load8U, opI64Load8U:
+		in.push(uint64(m[0]))
+	case opI32Load16S:
+		in.push(uint64(uint32(int32(int16(binary.LittleEndian.Uint16(m))))))
+	case opI32Load16U, opI64Load16U:
+		in.push(uint64(binary.LittleEndian.Uint16(m)))
+	case opI64Load8S:
+		in.push(uint64(int64(int8(m[0]))))
+	case opI64Load16S:
+		in.push(uint64(int64(int16(binary.LittleEndian.Uint16(m)))))
+	case opI64Load32S:
+		in.push(uint64(int64(int32(binary.LittleEndian.Uint32(m)))))
+	case opI64Load32U:
+		in.push(uint64(binary.LittleEndian.Uint32(m)))
+	}
+	return nil
+}
+
+func (in *Instance) store(op byte, addr uint64, v uint64) error {
+	size := uint64(1)
+	switch op {
+	case opI64Store:
+		size = 8
+	case opI32Store, opI64Store32:
+		size = 4
+	case opI32Store16, opI64Store16:
+		size = 2
+	}
+	if addr+size > uint64(len(in.memory)) {
+		return trap("out of bounds memory access")
+	}
+	m := in.memory[addr:]
+
+	switch size {
+	case 8:
+		binary.LittleEndian.PutUint64(m, v)
+	case 4:
+		binary.LittleEndian.PutUint32(m, uint32(v))
+	case 2:
+		binary.LittleEndian.PutUint16(m, uint16(v))
+	default:
+		m[0] = byte(v)
+	}
+	return nil
+}
+
+func (in *Instance) misc(sub uint32, pc *int) error {
+	switch sub {
+	case opMiscMemoryCopy:
+		*pc += 2
+		n := uint64(in.pop32())
+		src := uint64(in.pop32())
+		dst := uint64(in.pop32())
+		if src+n > uint64(len(in.memory)) || dst+n > uint64(len(in.memory)) {
+			return trap("out of bounds memory access")
+		}
+		copy(in.memory[dst:dst+n], in.memory[src:src+n])
+	case opMiscMemoryFill:
+		*pc++
+		n := uint64(in.pop32())
+		v := byte(in.pop32())
+		dst := uint64(in.pop32())
+		if dst+n > uint64(len(in.memory)) {
+			return trap("out of bounds memory access")
+		}
+		m := in.memory[dst : dst+n]
+		for i := range m {
+			m[i] = v
+		}
+	default:
+		return trap("unsupported instruction 0xfc 0x%x", sub)
+	}
+	return nil
+}
+
+func compare32(op byte, a, b uint32) bool {
+	switch op {
+	case opI32Eq:
+		return a == b
+	case opI32Ne:
+		return a != b
+	case opI32LtS:
+		return int32(a) < int32(b)
+	case opI32LtU:
+		return a < b
+	case opI32GtS:
+		return int32(a) > int32(b)
+	case opI32GtU:
+		return a > b
+	case opI32LeS:
+		return int32(a) <= int32(b)
+	case opI32LeU:
+		return a <= b
+	case opI32GeS:
+		return int32(a) >= int32(b)
+	default:
+		return a >= b
+	}
+}
+
+func compare64(op byte, a, b uint64) bool {
+	switch op {
+	case opI64Eq:
+		return a == b
+	case opI64Ne:
+		return a != b
+	case opI64LtS:
+		return int64(a) < int64(b)
+	case opI64LtU:
+		return a < b
+	case opI64GtS:
+		return int64(a) > int64(b)
+	case opI64GtU:
+		return a > b
+	case opI64LeS:
+		return int64(a) <= int64(b)
+	case opI64LeU:
+		return a <= b
+	case opI64GeS:
+		return int64(a) >= int64(b)
+	default:
+		return a >= b
+	}
+}
+
+func arith32(op byte, a, b uint32) (uint32, error) {
+	switch op {
+	case opI32Add:
+		return a + b, nil
+	case opI32Sub:
+		return a - b, nil
+	case opI32Mul:
+		return a * b, nil
+	case opI32DivS:
+		if b == 0 {
+			return 0, trap("integer divide by zero")
+		}
+		if int32(a) == math.MinInt32 && int32(b) == -1 {
+			return 0, trap("integer overflow")
+		}
+		return uint32(int32(a) / int32(b)), nil
+	case opI32DivU:
+		if b == 0 {
+			return 0, trap("integer divide by zero")
+		}
+		return a / b, nil
+	case opI32RemS:
+		if b == 0 {
+			return 0, trap("integer divide by zero")
+		}
+		if int32(b) == -1 {
+			return 0, nil
+		}
+		return uint32(int32(a) % int32(b)), nil
+	case opI32RemU:
+		if b == 0 {
+			return 0, trap("integer divide by zero")
+		}
+		return a % b, nil
+	case opI32And:
+		return a & b, nil
+	case opI32Or:
+		return a | b, nil
+	case opI32Xor:
+		return a ^ b, nil
+	case opI32Shl:
+		return a << (b & 31), nil
+	case opI32ShrS:
+		return uint32(int32(a) >> (b & 31)), nil
+	case opI32ShrU:
+		return a >> (b & 31), nil
+	case opI32Rotl:
+		return bits.RotateLeft32(a, int(b&31)), nil
+	default:
+		return bits.RotateLeft32(a, -int(b&31)), nil
+	}
+}
+
+func arith64(op byte, a, b uint64) (uint64, error) {
+	switch op {
+	case opI64Add:
+		return a + b, nil
+	case opI64Sub:
+		return a - b, nil
+	case opI64Mul:
+		return a * b, nil
+	case opI64DivS:
+		if b == 0 {
+			return 0, trap("integer divide by zero")
+		}
+		if int64(a) == math.MinInt64 && int64(b) == -1 {
+			return 0, trap("integer overflow")
+		}
+		return uint64(int64(a) / int64(b)), nil
+	case opI64DivU:
+		if b == 0 {
+			return 0, trap("integer divide by zero")
+		}
+		return a / b, nil
+	case opI64RemS:
+		if b == 0 {
+			return 0, trap("integer divide by zero")
+		}
+		if int64(b) == -1 {
+			return 0, nil
+		}
+		return uint64(int64(a) % int64(b)), nil
+	case opI64RemU:
+		if b == 0 {
+			return 0, trap("integer divide by zero")
+		}
+		return a % b, nil
+	case opI64And:
+		return a & b, nil
+	case opI64Or:
+		return a | b, nil
+	case opI64Xor:
+		return a ^ b, nil
+	case opI64Shl:
+		return a << (b & 63), nil
+	case opI64ShrS:
+		return uint64(int64(a) >> (b & 63)), nil
+	case opI64ShrU:
+		return a >> (b & 63), nil
+	case opI64Rotl:
+		return bits.RotateLeft64(a, int(b&63)), nil
+	default:
+		return bits.RotateLeft64(a, -int(b&63)), nil
+	}
+}
+
+func skipBlockType(code []byte, pc int) int {
+	switch ValueType(code[pc]) {
+	case 0x40, I32, I64, F32, F64:
+		return pc + 1
+	}
+	readS64(code, &pc)
+	return pc
+}
+
+func readU32(code []byte, pc *int) uint32 {
+	var (
+		res   uint32
+		shift uint
+	)
+	for {
+		b := code[*pc]
+		*pc++
+		res |= uint32(b&0x7f) << shift
+		if b&0x80 == 0 {
+			return res
+		}
+		shift += 7
+	}
+}
+
+func readS64(code []byte, pc *int) int64 {
+	var (
+		res   int64
+		shift uint
+		b     byte
+	)
+	for {
+		b = code[*pc]
+		*pc++
+		res |= int64(b&0x7f) << shift
+		shift += 7
+		if b&0x80 == 0 {
+			break
+		}
+	}
+	if shift < 64 && b&0x40 != 0 {
+		res |= -1 << shift
+	}
+	return res
+}
+
+func readS32(code []byte, pc *int) int32 {
+	return int32(readS64(code, pc))
+}
@@ -0,0 +1,290 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package vm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultMaxCallDepth is used when Config.MaxCallDepth isn't set.
+	DefaultMaxCallDepth = 1024
+	// MaxPages is the biggest number of memory pages allowed by WebAssembly.
+	MaxPages = 65536
+
+	maxStackSize  = 1 << 20
+	checkInterval = 1<<12 - 1
+	nullFunction  = ^uint32(0)
+)
+
+// Config limits resources available to an instance.
+type Config struct {
+	// MaxMemoryPages limits size of memory, zero means MaxPages.
+	MaxMemoryPages uint32
+	// MaxCallDepth limits number of nested calls, zero means DefaultMaxCallDepth.
+	MaxCallDepth int
+}
+
+// HostFunction is a function provided by the embedder and imported by a module.
+type HostFunction struct {
+	Type FuncType
+	Call func(in *Instance, args []uint64) ([]uint64, error)
+}
+
+// Imports are host functions by module and name.
+type Imports map[string]map[string]*HostFunction
+
+// Trap is an error raised when WebAssembly code can't continue execution.
+type Trap struct {
+	Reason string
+}
+
+func (t *Trap) Error() string {
+	return "wasm trap: " + t.Reason
+}
+
+func trap(format string, args ...interface{}) error {
+	return &Trap{Reason: fmt.Sprintf(format, args...)}
+}
+
+// Instance is an instantiated module with its own memory, globals and table.
+// Instance isn't safe for concurrent use.
+type Instance struct {
+	module   *Module
+	host     []*HostFunction
+	memory   []byte
+	maxPages uint32
+	maxDepth int
+	globals  []uint64
+	table    []uint32
+
+	ctx   context.Context
+	stack []uint64
+	depth int
+	steps uint64
+}
+
+// Instantiate creates an instance of the module, host functions are taken from imports.
+func Instantiate(m *Module, imports Imports, cfg Config) (*Instance, error) {
+	in := &Instance{
+		module:   m,
+		maxPages: MaxPages,
+		maxDepth: DefaultMaxCallDepth,
+		ctx:      context.Background(),
+	}
+	if cfg.MaxMemoryPages != 0 && cfg.MaxMemoryPages < in.maxPages {
+		in.maxPages = cfg.MaxMemoryPages
+	}
+	if cfg.MaxCallDepth != 0 {
+		in.maxDepth = cfg.MaxCallDepth
+	}
+
+	for _, imp := range m.imports {
+		f, ok := imports[imp.Module][imp.Name]
+		if !ok {
+			return nil, errors.Errorf("unknown import %s.%s", imp.Module, imp.Name)
+		}
+		if !f.Type.equal(m.types[imp.Type]) {
+			return nil, errors.Errorf("import %s.%s has incompatible signature", imp.Module, imp.Name)
+		}
+		in.host = append(in.host, f)
+	}
+
+	if m.memory != nil {
+		if m.memory.hasMax && m.memory.max < in.maxPages {
+			in.maxPages = m.memory.max
+		}
+		if m.memory.min > in.maxPages {
+			return nil, errors.Errorf("module requires %d memory pages, only %d allowed", m.memory.min, in.maxPages)
+		}
+		in.memory = make([]byte, int(m.memory.min)*PageSize)
+	}
+
+	in.globals = make([]uint64, len(m.globals))
+	for i, g := range m.globals {
+		in.globals[i] = g.init
+	}
+
+	if m.table != nil {
+		in.table = make([]uint32, m.table.min)
+		for i := range in.table {
+			in.table[i] = nullFunction
+		}
+	}
+	for _, el := range m.elements {
+		if uint64(el.offset)+uint64(len(el.funcs)) > uint64(len(in.table)) {
+			return nil, errors.New("element segment doesn't fit table")
+		}
+		copy(in.table[el.offset:], el.funcs)
+	}
+	for _, d := range m.data {
+		if uint64(d.offset)+uint64(len(d.init)) > uint64(len(in.memory)) {
+			return nil, errors.New("data segment doesn't fit memory")
+		}
+		copy(in.memory[d.offset:], d.init)
+	}
+
+	if m.start != nil {
+		if err := in.run(*m.start); err != nil {
+			return nil, errors.Wrap(err, "start function failed")
+		}
+	}
+	return in, nil
+}
+
+// HasFunction checks that instance exports function with provided name.
+func (in *Instance) HasFunction(name string) bool {
+	_, ok := in.module.FunctionType(name)
+	return ok
+}
+
+// Call runs exported function, execution is interrupted when ctx is done.
+func (in *Instance) Call(ctx context.Context, name string, args ...uint64) ([]uint64, error) {
+	exp, ok := in.module.exports[name]
+	if !ok || exp.kind != externalFunction {
+		return nil, errors.Errorf("function %s isn't exported", name)
+	}
+	typ := in.module.types[in.module.funcType(exp.index)]
+	if len(args) != len(typ.Params) {
+		return nil, errors.Errorf("function %s expects %d arguments, got %d", name, len(typ.Params), len(args))
+	}
+
+	in.ctx = ctx
+	defer func() {
+		in.ctx = context.Background()
+	}()
+
+	in.stack = append(in.stack[:0], args...)
+	if err := in.run(exp.index); err != nil {
+		return nil, err
+	}
+	res := make([]uint64, len(typ.Results))
+	copy(res, in.stack)
+	return res, nil
+}
+
+// Steps returns number of instructions executed by the instance.
+func (in *Instance) Steps() uint64 {
+	return in.steps
+}
+
+// Memory returns linear memory of the instance, slice is valid until memory grows.
+func (in *Instance) Memory() []byte {
+	return in.memory
+}
+
+// Read returns copy of memory region.
+func (in *Instance) Read(ptr, size uint32) ([]byte, error) {
+	if uint64(ptr)+uint64(size) > uint64(len(in.memory)) {
+		return nil, trap("out of bounds memory access")
+	}
+	res := make([]byte, size)
+	copy(res, in.memory[ptr:])
+	return res, nil
+}
+
+// Write copies data to memory.
+func (in *Instance) Write(ptr uint32, data []byte) error {
+	if uint64(ptr)+uint64(len(data)) > uint64(len(in.memory)) {
+		return trap("out of bounds memory access")
+	}
+	copy(in.memory[ptr:], data)
+	return nil
+}
+
+// run calls function converting runtime panics caused by malformed code into errors.
+func (in *Instance) run(index uint32) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = trap("invalid code: %v", r)
+		}
+	}()
+	in.depth = 0
+	return in.invoke(index)
+}
+
+func (in *Instance) invoke(index uint32) error {
+	typ := in.module.types[in.module.funcType(index)]
+	params, results := len(typ.Params), len(typ.Results)
+	base := len(in.stack) - params
+
+	if int(index) < len(in.host) {
+		imp := in.module.imports[index]
+		args := make([]uint64, params)
+		copy(args, in.stack[base:])
+		in.stack = in.stack[:base]
+
+		res, err := in.host[index].Call(in, args)
+		if err != nil {
+			return errors.Wrapf(err, "%s.%s", imp.Module, imp.Name)
+		}
+		if len(res) != results {
+			return errors.Errorf("%s.%s returned %d values instead of %d", imp.Module, imp.Name, len(res), results)
+		}
+		in.stack = append(in.stack, res...)
+		return nil
+	}
+
+	if in.depth >= in.maxDepth {
+		return trap("call stack exhausted")
+	}
+	if len(in.stack) > maxStackSize {
+		return trap("value stack exhausted")
+	}
+	in.depth++
+	defer func() {
+		in.depth--
+	}()
+
+	fn := &in.module.functions[int(index)-len(in.host)]
+	locals := make([]uint64, params+len(fn.locals))
+	copy(locals, in.stack[base:])
+	in.stack = in.stack[:base]
+
+	if err := in.execute(fn, locals, results); err != nil {
+		return err
+	}
+
+	copy(in.stack[base:], in.stack[len(in.stack)-results:])
+	in.stack = in.stack[:base+results]
+	return nil
+}
+
+func (in *Instance) push(v uint64) {
+	in.stack = append(in.stack, v)
+}
+
+func (in *Instance) pop() uint64 {
+	v := in.stack[len(in.stack)-1]
+	in.stack = in.stack[:len(in.stack)-1]
+	return v
+}
+
+func (in *Instance) pop32() uint32 {
+	return uint32(in.pop())
+}
+
+func (in *Instance) pushBool(b bool) {
+	if b {
+		in.push(1)
+	} else {
+		in.push(0)
+	}
+}
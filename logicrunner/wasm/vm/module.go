@@ -0,0 +1,590 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package vm is a small WebAssembly interpreter written in pure Go.
+//
+// It implements integer subset of WebAssembly MVP with sign-extension and bulk memory
+// copy/fill instructions. Floating point instructions are rejected when module is decoded,
+// contract execution has to be deterministic on every node. Code is validated when module
+// is decoded too, so interpreter doesn't check types and depth of operand stack.
+package vm
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+)
+
+// ValueType is a type of WebAssembly value.
+type ValueType byte
+
+const (
+	I32 ValueType = 0x7f
+	I64 ValueType = 0x7e
+	F32 ValueType = 0x7d
+	F64 ValueType = 0x7c
+)
+
+const (
+	externalFunction = 0x00
+	externalTable    = 0x01
+	externalMemory   = 0x02
+	externalGlobal   = 0x03
+)
+
+const (
+	sectionCustom   = 0
+	sectionType     = 1
+	sectionImport   = 2
+	sectionFunction = 3
+	sectionTable    = 4
+	sectionMemory   = 5
+	sectionGlobal   = 6
+	sectionExport   = 7
+	sectionStart    = 8
+	sectionElement  = 9
+	sectionCode     = 10
+	sectionData     = 11
+	sectionDataCnt  = 12
+)
+
+// PageSize is a size of WebAssembly memory page.
+const PageSize = 64 * 1024
+
+// sectionOrder is a position of a section in a module, indexes in code depend on preceding sections.
+var sectionOrder = map[byte]int{
+	sectionType:     1,
+	sectionImport:   2,
+	sectionFunction: 3,
+	sectionTable:    4,
+	sectionMemory:   5,
+	sectionGlobal:   6,
+	sectionExport:   7,
+	sectionStart:    8,
+	sectionElement:  9,
+	sectionDataCnt:  10,
+	sectionCode:     11,
+	sectionData:     12,
+}
+
+var (
+	magic   = []byte{0x00, 0x61, 0x73, 0x6d}
+	version = []byte{0x01, 0x00, 0x00, 0x00}
+)
+
+// FuncType is a signature of a function.
+type FuncType struct {
+	Params  []ValueType
+	Results []ValueType
+}
+
+func (t FuncType) equal(other FuncType) bool {
+	return bytes.Equal(valueTypes(t.Params), valueTypes(other.Params)) &&
+		bytes.Equal(valueTypes(t.Results), valueTypes(other.Results))
+}
+
+func valueTypes(vt []ValueType) []byte {
+	res := make([]byte, len(vt))
+	for i, t := range vt {
+		res[i] = byte(t)
+	}
+	return res
+}
+
+// Import is a function imported by a module, only functions can be imported.
+type Import struct {
+	Module string
+	Name   string
+	Type   uint32
+}
+
+type export struct {
+	kind  byte
+	index uint32
+}
+
+type limits struct {
+	min    uint32
+	max    uint32
+	hasMax bool
+}
+
+type global struct {
+	typ     ValueType
+	mutable bool
+	init    uint64
+}
+
+type element struct {
+	offset uint32
+	funcs  []uint32
+}
+
+type data struct {
+	offset uint32
+	init   []byte
+}
+
+type blockInfo struct {
+	elsePC  int
+	endPC   int
+	params  int
+	results int
+}
+
+type function struct {
+	typ    uint32
+	locals []ValueType
+	code   []byte
+	blocks map[int]blockInfo
+}
+
+// Module is a decoded and checked WebAssembly module, it can be instantiated multiple times.
+type Module struct {
+	types     []FuncType
+	imports   []Import
+	functions []function
+	table     *limits
+	memory    *limits
+	globals   []global
+	exports   map[string]export
+	start     *uint32
+	elements  []element
+	data      []data
+}
+
+// Imports returns functions imported by the module.
+func (m *Module) Imports() []Import {
+	return m.imports
+}
+
+// FunctionType returns signature of exported function.
+func (m *Module) FunctionType(name string) (FuncType, bool) {
+	exp, ok := m.exports[name]
+	if !ok || exp.kind != externalFunction {
+		return FuncType{}, false
+	}
+	return m.types[m.funcType(exp.index)], true
+}
+
+func (m *Module) funcType(index uint32) uint32 {
+	if int(index) < len(m.imports) {
+		return m.imports[index].Type
+	}
+	return m.functions[int(index)-len(m.imports)].typ
+}
+
+func (m *Module) funcCount() int {
+	return len(m.imports) + len(m.functions)
+}
+
+// Decode parses WebAssembly binary, validates its code and checks that module uses only supported features.
+func Decode(code []byte) (*Module, error) {
+	if len(code) < 8 || !bytes.Equal(code[:4], magic) {
+		return nil, errors.New("not a WebAssembly module")
+	}
+	if !bytes.Equal(code[4:8], version) {
+		return nil, errors.Errorf("unsupported WebAssembly version %d", binary.LittleEndian.Uint32(code[4:8]))
+	}
+
+	m := &Module{exports: map[string]export{}}
+	var (
+		funcTypes []uint32
+		lastOrder int
+	)
+
+	r := &reader{buf: code, pos: 8}
+	for !r.eof() {
+		id, err := r.byte()
+		if err != nil {
+			return nil, err
+		}
+		size, err := r.u32()
+		if err != nil {
+			return nil, err
+		}
+		content, err := r.bytes(int(size))
+		if err != nil {
+			return nil, errors.Wrapf(err, "section %d", id)
+		}
+		sr := &reader{buf: content}
+
+		if order, ok := sectionOrder[id]; ok {
+			if order <= lastOrder {
+				return nil, errors.Errorf("section %d is out of order", id)
+			}
+			lastOrder = order
+		}
+
+		switch id {
+		case sectionCustom, sectionDataCnt:
+			// nothing useful for execution
+		case sectionType:
+			err = m.decodeTypes(sr)
+		case sectionImport:
+			err = m.decodeImports(sr)
+		case sectionFunction:
+			funcTypes, err = sr.u32s()
+		case sectionTable:
+			m.table, err = decodeSingleLimits(sr, "table", true)
+		case sectionMemory:
+			m.memory, err = decodeSingleLimits(sr, "memory", false)
+		case sectionGlobal:
+			err = m.decodeGlobals(sr)
+		case sectionExport:
+			err = m.decodeExports(sr)
+		case sectionStart:
+			var start uint32
+			start, err = sr.u32()
+			m.start = &start
+		case sectionElement:
+			err = m.decodeElements(sr)
+		case sectionCode:
+			err = m.decodeCode(sr, funcTypes)
+		case sectionData:
+			err = m.decodeData(sr)
+		default:
+			err = errors.Errorf("unknown section %d", id)
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decode section %d", id)
+		}
+	}
+
+	if len(funcTypes) != len(m.functions) {
+		return nil, errors.New("function and code sections don't match")
+	}
+	if err := m.check(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (m *Module) decodeTypes(r *reader) error {
+	count, err := r.u32()
+	if err != nil {
+		return err
+	}
+	for i := uint32(0); i < count; i++ {
+		form, err := r.byte()
+		if err != nil {
+			return err
+		}
+		if form != 0x60 {
+			return errors.Errorf("unexpected function type form 0x%x", form)
+		}
+		params, err := r.valueTypes()
+		if err != nil {
+			return err
+		}
+		results, err := r.valueTypes()
+		if err != nil {
+			return err
+		}
+		m.types = append(m.types, FuncType{Params: params, Results: results})
+	}
+	return nil
+}
+
+func (m *Module) decodeImports(r *reader) error {
+	count, err := r.u32()
+	if err != nil {
+		return err
+	}
+	for i := uint32(0); i < count; i++ {
+		module, err := r.name()
+		if err != nil {
+			return err
+		}
+		name, err := r.name()
+		if err != nil {
+			return err
+		}
+		kind, err := r.byte()
+		if err != nil {
+			return err
+		}
+		if kind != externalFunction {
+			return errors.Errorf("import %s.%s: only functions can be imported", module, name)
+		}
+		typ, err := r.u32()
+		if err != nil {
+			return err
+		}
+		m.imports = append(m.imports, Import{Module: module, Name: name, Type: typ})
+	}
+	return nil
+}
+
+func decodeSingleLimits(r *reader, what string, table bool) (*limits, error) {
+	count, err := r.u32()
+	if err != nil {
+		return nil, err
+	}
+	if count != 1 {
+		return nil, errors.Errorf("only one %s is supported", what)
+	}
+	if table {
+		elemType, err := r.byte()
+		if err != nil {
+			return nil, err
+		}
+		if elemType != 0x70 {
+			return nil, errors.Errorf("unsupported table element type 0x%x", elemType)
+		}
+	}
+	return r.limits()
+}
+
+func (m *Module) decodeGlobals(r *reader) error {
+	count, err := r.u32()
+	if err != nil {
+		return err
+	}
+	for i := uint32(0); i < count; i++ {
+		typ, err := r.valueType()
+		if err != nil {
+			return err
+		}
+		mut, err := r.byte()
+		if err != nil {
+			return err
+		}
+		initType, init, err := r.constExpr()
+		if err != nil {
+			return err
+		}
+		if initType != typ {
+			return errors.Errorf("global %d is initialized with value of wrong type", i)
+		}
+		m.globals = append(m.globals, global{typ: typ, mutable: mut == 1, init: init})
+	}
+	return nil
+}
+
+func (m *Module) decodeExports(r *reader) error {
+	count, err := r.u32()
+	if err != nil {
+		return err
+	}
+	for i := uint32(0); i < count; i++ {
+		name, err := r.name()
+		if err != nil {
+			return err
+		}
+		kind, err := r.byte()
+		if err != nil {
+			return err
+		}
+		index, err := r.u32()
+		if err != nil {
+			return err
+		}
+		if _, ok := m.exports[name]; ok {
+			return errors.Errorf("duplicate export %s", name)
+		}
+		m.exports[name] = export{kind: kind, index: index}
+	}
+	return nil
+}
+
+func (m *Module) decodeElements(r *reader) error {
+	count, err := r.u32()
+	if err != nil {
+		return err
+	}
+	for i := uint32(0); i < count; i++ {
+		flags, err := r.u32()
+		if err != nil {
+			return err
+		}
+		if flags != 0 {
+			return errors.Errorf("unsupported element segment kind %d", flags)
+		}
+		offset, err := r.offsetExpr()
+		if err != nil {
+			return err
+		}
+		funcs, err := r.u32s()
+		if err != nil {
+			return err
+		}
+		m.elements = append(m.elements, element{offset: offset, funcs: funcs})
+	}
+	return nil
+}
+
+func (m *Module) decodeData(r *reader) error {
+	count, err := r.u32()
+	if err != nil {
+		return err
+	}
+	for i := uint32(0); i < count; i++ {
+		flags, err := r.u32()
+		if err != nil {
+			return err
+		}
+		switch flags {
+		case 0:
+		case 2:
+			memory, err := r.u32()
+			if err != nil {
+				return err
+			}
+			if memory != 0 {
+				return errors.New("only one memory is supported")
+			}
+		default:
+			return errors.Errorf("unsupported data segment kind %d", flags)
+		}
+		offset, err := r.offsetExpr()
+		if err != nil {
+			return err
+		}
+		size, err := r.u32()
+		if err != nil {
+			return err
+		}
+		init, err := r.bytes(int(size))
+		if err != nil {
+			return err
+		}
+		m.data = append(m.data, data{offset: offset, init: init})
+	}
+	return nil
+}
+
+func (m *Module) decodeCode(r *reader, funcTypes []uint32) error {
+	count, err := r.u32()
+	if err != nil {
+		return err
+	}
+	if int(count) != len(funcTypes) {
+		return errors.New("function and code sections don't match")
+	}
+	allTypes := make([]uint32, 0, len(m.imports)+len(funcTypes))
+	for _, imp := range m.imports {
+		allTypes = append(allTypes, imp.Type)
+	}
+	allTypes = append(allTypes, funcTypes...)
+	for i := uint32(0); i < count; i++ {
+		size, err := r.u32()
+		if err != nil {
+			return err
+		}
+		body, err := r.bytes(int(size))
+		if err != nil {
+			return err
+		}
+		br := &reader{buf: body}
+
+		groups, err := br.u32()
+		if err != nil {
+			return err
+		}
+		var locals []ValueType
+		for j := uint32(0); j < groups; j++ {
+			n, err := br.u32()
+			if err != nil {
+				return err
+			}
+			typ, err := br.valueType()
+			if err != nil {
+				return err
+			}
+			if len(locals)+int(n) > maxLocals {
+				return errors.Errorf("function %d has too many locals", i)
+			}
+			for k := uint32(0); k < n; k++ {
+				locals = append(locals, typ)
+			}
+		}
+
+		if int(funcTypes[i]) >= len(m.types) {
+			return errors.Errorf("function %d has unknown type", i)
+		}
+		typ := m.types[funcTypes[i]]
+
+		fn := function{typ: funcTypes[i], locals: locals, code: body[br.pos:]}
+		localTypes := append(append([]ValueType{}, typ.Params...), locals...)
+		fn.blocks, err = m.scan(fn.code, allTypes, typ, localTypes)
+		if err != nil {
+			return errors.Wrapf(err, "function %d", i)
+		}
+		m.functions = append(m.functions, fn)
+	}
+	return nil
+}
+
+// check verifies indexes used in the module.
+func (m *Module) check() error {
+	for _, imp := range m.imports {
+		if int(imp.Type) >= len(m.types) {
+			return errors.Errorf("import %s.%s has unknown type", imp.Module, imp.Name)
+		}
+	}
+	for i, fn := range m.functions {
+		if int(fn.typ) >= len(m.types) {
+			return errors.Errorf("function %d has unknown type", i)
+		}
+	}
+	for name, exp := range m.exports {
+		switch exp.kind {
+		case externalFunction:
+			if int(exp.index) >= m.funcCount() {
+				return errors.Errorf("export %s refers unknown function", name)
+			}
+		case externalMemory:
+			if m.memory == nil || exp.index != 0 {
+				return errors.Errorf("export %s refers unknown memory", name)
+			}
+		case externalTable:
+			if m.table == nil || exp.index != 0 {
+				return errors.Errorf("export %s refers unknown table", name)
+			}
+		case externalGlobal:
+			if int(exp.index) >= len(m.globals) {
+				return errors.Errorf("export %s refers unknown global", name)
+			}
+		default:
+			return errors.Errorf("export %s has unknown kind", name)
+		}
+	}
+	if m.start != nil {
+		if int(*m.start) >= m.funcCount() {
+			return errors.New("start function is unknown")
+		}
+		typ := m.types[m.funcType(*m.start)]
+		if len(typ.Params) != 0 || len(typ.Results) != 0 {
+			return errors.New("start function must have no params and results")
+		}
+	}
+	for _, el := range m.elements {
+		if m.table == nil {
+			return errors.New("element segment without table")
+		}
+		for _, f := range el.funcs {
+			if int(f) >= m.funcCount() {
+				return errors.New("element segment refers unknown function")
+			}
+		}
+	}
+	if len(m.data) > 0 && m.memory == nil {
+		return errors.New("data segment without memory")
+	}
+	return nil
+}
@@ -0,0 +1,526 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package vm
+
+import (
+	"github.com/pkg/errors"
+)
+
+const (
+	opUnreachable  = 0x00
+	opNop          = 0x01
+	opBlock        = 0x02
+	opLoop         = 0x03
+	opIf           = 0x04
+	opElse         = 0x05
+	opEnd          = 0x0b
+	opBr           = 0x0c
+	opBrIf         = 0x0d
+	opBrTable      = 0x0e
+	opReturn       = 0x0f
+	opCall         = 0x10
+	opCallIndirect = 0x11
+
+	opDrop    = 0x1a
+	opSelect  = 0x1b
+	opSelectT = 0x1c
+
+	opLocalGet  = 0x20
+	opLocalSet  = 0x21
+	opLocalTee  = 0x22
+	opGlobalGet = 0x23
+	opGlobalSet = 0x24
+
+	opI32Load    = 0x28
+	opI64Load    = 0x29
+	opI32Load8S  = 0x2c
+	opI32Load8U  = 0x2d
+	opI32Load16S = 0x2e
+	opI32Load16U = 0x2f
+	opI64Load8S  = 0x30
+	opI64Load8U  = 0x31
+	opI64Load16S = 0x32
+	opI64Load16U = 0x33
+	opI64Load32S = 0x34
+	opI64Load32U = 0x35
+	opI32Store   = 0x36
+	opI64Store   = 0x37
+	opI32Store8  = 0x3a
+	opI32Store16 = 0x3b
+	opI64Store8  = 0x3c
+	opI64Store16 = 0x3d
+	opI64Store32 = 0x3e
+	opMemorySize = 0x3f
+	opMemoryGrow = 0x40
+
+	opI32Const = 0x41
+	opI64Const = 0x42
+
+	opI32Eqz = 0x45
+	opI32Eq  = 0x46
+	opI32Ne  = 0x47
+	opI32LtS = 0x48
+	opI32LtU = 0x49
+	opI32GtS = 0x4a
+	opI32GtU = 0x4b
+	opI32LeS = 0x4c
+	opI32LeU = 0x4d
+	opI32GeS = 0x4e
+	opI32GeU = 0x4f
+
+	opI64Eqz = 0x50
+	opI64Eq  = 0x51
+	opI64Ne  = 0x52
+	opI64LtS = 0x53
+	opI64LtU = 0x54
+	opI64GtS = 0x55
+	opI64GtU = 0x56
+	opI64LeS = 0x57
+	opI64LeU = 0x58
+	opI64GeS = 0x59
+	opI64GeU = 0x5a
+
+	opI32Clz    = 0x67
+	opI32Ctz    = 0x68
+	opI32Popcnt = 0x69
+	opI32Add    = 0x6a
+	opI32Sub    = 0x6b
+	opI32Mul    = 0x6c
+	opI32DivS   = 0x6d
+	opI32DivU   = 0x6e
+	opI32RemS   = 0x6f
+	opI32RemU   = 0x70
+	opI32And    = 0x71
+	opI32Or     = 0x72
+	opI32Xor    = 0x73
+	opI32Shl    = 0x74
+	opI32ShrS   = 0x75
+	opI32ShrU   = 0x76
+	opI32Rotl   = 0x77
+	opI32Rotr   = 0x78
+
+	opI64Clz    = 0x79
+	opI64Ctz    = 0x7a
+	opI64Popcnt = 0x7b
+	opI64Add    = 0x7c
+	opI64Sub    = 0x7d
+	opI64Mul    = 0x7e
+	opI64DivS   = 0x7f
+	opI64DivU   = 0x80
+	opI64RemS   = 0x81
+	opI64RemU   = 0x82
+	opI64And    = 0x83
+	opI64Or     = 0x84
+	opI64Xor    = 0x85
+	opI64Shl    = 0x86
+	opI64ShrS   = 0x87
+	opI64ShrU   = 0x88
+	opI64Rotl   = 0x89
+	opI64Rotr   = 0x8a
+
+	opI32WrapI64     = 0xa7
+	opI64ExtendI32S  = 0xac
+	opI64ExtendI32U  = 0xad
+	opI32Extend8S    = 0xc0
+	opI32Extend16S   = 0xc1
+	opI64Extend8S    = 0xc2
+	opI64Extend16S   = 0xc3
+	opI64Extend32S   = 0xc4
+	opPrefixMisc     = 0xfc
+	opMiscMemoryCopy = 0x0a
+	opMiscMemoryFill = 0x0b
+)
+
+const maxLocals = 50000
+
+func isFloatOpcode(op byte) bool {
+	switch {
+	case op == 0x2a || op == 0x2b || op == 0x38 || op == 0x39 || op == 0x43 || op == 0x44:
+		return true
+	case op >= 0x5b && op <= 0x66:
+		return true
+	case op >= 0x8b && op <= 0xa6:
+		return true
+	case op >= 0xa8 && op <= 0xab:
+		return true
+	case op >= 0xae && op <= 0xbf:
+		return true
+	}
+	return false
+}
+
+// scanner checks instructions of a function body and finds boundaries of its blocks.
+// It also validates types of operands, so execution never meets stack underflow or
+// a value of a wrong type.
+type scanner struct {
+	m         *Module
+	funcTypes []uint32
+	locals    []ValueType
+	blocks    map[int]blockInfo
+	open      []int
+
+	vals  []ValueType
+	ctrls []ctrlFrame
+}
+
+// scan checks body of a function of type typ, funcTypes are types of all functions of the module
+// and locals are types of params and locals of the function.
+func (m *Module) scan(code []byte, funcTypes []uint32, typ FuncType, locals []ValueType) (map[int]blockInfo, error) {
+	s := &scanner{m: m, funcTypes: funcTypes, locals: locals, blocks: map[int]blockInfo{}}
+	s.pushCtrl(opBlock, nil, typ.Results)
+	r := &reader{buf: code}
+	for !r.eof() {
+		pc := r.pos
+		op, _ := r.byte()
+		done, err := s.instruction(r, pc, op)
+		if err != nil {
+			return nil, errors.Wrapf(err, "instruction 0x%x at %d", op, pc)
+		}
+		if done {
+			if !r.eof() {
+				return nil, errors.New("code after the end of function")
+			}
+			return s.blocks, nil
+		}
+	}
+	return nil, errors.New("function body is not terminated")
+}
+
+func (s *scanner) instruction(r *reader, pc int, op byte) (bool, error) {
+	switch {
+	case op == opBlock || op == opLoop || op == opIf:
+		typ, err := s.blockType(r)
+		if err != nil {
+			return false, err
+		}
+		if op == opIf {
+			if err := s.popExpect(I32); err != nil {
+				return false, err
+			}
+		}
+		if err := s.pops(typ.Params); err != nil {
+			return false, err
+		}
+		s.pushCtrl(op, typ.Params, typ.Results)
+		s.blocks[pc] = blockInfo{params: len(typ.Params), results: len(typ.Results)}
+		s.open = append(s.open, pc)
+	case op == opElse:
+		if len(s.open) == 0 {
+			return false, errors.New("else outside of if")
+		}
+		start := s.open[len(s.open)-1]
+		bi := s.blocks[start]
+		if r.buf[start] != opIf || bi.elsePC != 0 {
+			return false, errors.New("unexpected else")
+		}
+		bi.elsePC = pc
+		s.blocks[start] = bi
+
+		frame, err := s.popCtrl()
+		if err != nil {
+			return false, err
+		}
+		s.pushCtrl(opElse, frame.params, frame.results)
+	case op == opEnd:
+		frame, err := s.popCtrl()
+		if err != nil {
+			return false, err
+		}
+		if frame.op == opIf && !equalTypes(frame.params, frame.results) {
+			return false, errors.New("if without else must have the same params and results")
+		}
+		s.pushes(frame.results)
+
+		if len(s.open) == 0 {
+			return true, nil
+		}
+		start := s.open[len(s.open)-1]
+		s.open = s.open[:len(s.open)-1]
+		bi := s.blocks[start]
+		bi.endPC = pc
+		s.blocks[start] = bi
+	case op == opBr:
+		types, err := s.label(r)
+		if err != nil {
+			return false, err
+		}
+		if err := s.pops(types); err != nil {
+			return false, err
+		}
+		s.unreachable()
+	case op == opBrIf:
+		types, err := s.label(r)
+		if err != nil {
+			return false, err
+		}
+		if err := s.popExpect(I32); err != nil {
+			return false, err
+		}
+		if err := s.pops(types); err != nil {
+			return false, err
+		}
+		s.pushes(types)
+	case op == opBrTable:
+		count, err := r.u32()
+		if err != nil {
+			return false, err
+		}
+		var labels [][]ValueType
+		for i := uint32(0); i <= count; i++ {
+			types, err := s.label(r)
+			if err != nil {
+				return false, err
+			}
+			labels = append(labels, types)
+		}
+		if err := s.popExpect(I32); err != nil {
+			return false, err
+		}
+		def := labels[len(labels)-1]
+		for _, types := range labels {
+			if !equalTypes(types, def) {
+				return false, errors.New("labels of br_table have different types")
+			}
+		}
+		if err := s.pops(def); err != nil {
+			return false, err
+		}
+		s.unreachable()
+	case op == opCall:
+		index, err := r.u32()
+		if err != nil {
+			return false, err
+		}
+		if int(index) >= len(s.funcTypes) {
+			return false, errors.Errorf("unknown function %d", index)
+		}
+		typ := s.funcTypes[index]
+		if int(typ) >= len(s.m.types) {
+			return false, errors.Errorf("function %d has unknown type", index)
+		}
+		return false, s.call(s.m.types[typ])
+	case op == opCallIndirect:
+		typ, err := r.u32()
+		if err != nil {
+			return false, err
+		}
+		if int(typ) >= len(s.m.types) {
+			return false, errors.Errorf("unknown type %d", typ)
+		}
+		table, err := r.byte()
+		if err != nil {
+			return false, err
+		}
+		if table != 0 || s.m.table == nil {
+			return false, errors.New("unknown table")
+		}
+		if err := s.popExpect(I32); err != nil {
+			return false, err
+		}
+		return false, s.call(s.m.types[typ])
+	case op == opUnreachable:
+		s.unreachable()
+	case op == opNop:
+	case op == opReturn:
+		if err := s.pops(s.ctrls[0].results); err != nil {
+			return false, err
+		}
+		s.unreachable()
+	case op == opDrop:
+		_, err := s.pop()
+		return false, err
+	case op == opSelect:
+		return false, s.selectValue(unknownType)
+	case op == opSelectT:
+		types, err := r.valueTypes()
+		if err != nil {
+			return false, err
+		}
+		if len(types) != 1 {
+			return false, errors.New("select must have one type")
+		}
+		return false, s.selectValue(types[0])
+	case op == opLocalGet || op == opLocalSet || op == opLocalTee:
+		index, err := r.u32()
+		if err != nil {
+			return false, err
+		}
+		if int(index) >= len(s.locals) {
+			return false, errors.Errorf("unknown local %d", index)
+		}
+		typ := s.locals[index]
+		switch op {
+		case opLocalGet:
+			s.push(typ)
+		case opLocalSet:
+			return false, s.popExpect(typ)
+		default:
+			return false, s.unary(typ, typ)
+		}
+	case op == opGlobalGet || op == opGlobalSet:
+		index, err := r.u32()
+		if err != nil {
+			return false, err
+		}
+		if int(index) >= len(s.m.globals) {
+			return false, errors.Errorf("unknown global %d", index)
+		}
+		g := s.m.globals[index]
+		if op == opGlobalGet {
+			s.push(g.typ)
+			return false, nil
+		}
+		if !g.mutable {
+			return false, errors.Errorf("global %d is immutable", index)
+		}
+		return false, s.popExpect(g.typ)
+	case op >= opI32Load && op <= opI64Store32 && !isFloatOpcode(op):
+		if s.m.memory == nil {
+			return false, errors.New("no memory")
+		}
+		if _, err := r.u32(); err != nil {
+			return false, err
+		}
+		if _, err := r.u32(); err != nil {
+			return false, err
+		}
+		typ := I64
+		switch op {
+		case opI32Load, opI32Load8S, opI32Load8U, opI32Load16S, opI32Load16U, opI32Store, opI32Store8, opI32Store16:
+			typ = I32
+		}
+		if op >= opI32Store {
+			return false, s.pops([]ValueType{I32, typ})
+		}
+		return false, s.unary(I32, typ)
+	case op == opMemorySize || op == opMemoryGrow:
+		if s.m.memory == nil {
+			return false, errors.New("no memory")
+		}
+		if err := zeroByte(r); err != nil {
+			return false, err
+		}
+		if op == opMemoryGrow {
+			return false, s.unary(I32, I32)
+		}
+		s.push(I32)
+	case op == opI32Const:
+		_, err := r.s32()
+		s.push(I32)
+		return false, err
+	case op == opI64Const:
+		_, err := r.s64()
+		s.push(I64)
+		return false, err
+	case op == opI32Eqz:
+		return false, s.unary(I32, I32)
+	case op >= opI32Eq && op <= opI32GeU:
+		return false, s.binary(I32, I32)
+	case op == opI64Eqz:
+		return false, s.unary(I64, I32)
+	case op >= opI64Eq && op <= opI64GeU:
+		return false, s.binary(I64, I32)
+	case op >= opI32Clz && op <= opI32Popcnt:
+		return false, s.unary(I32, I32)
+	case op >= opI32Add && op <= opI32Rotr:
+		return false, s.binary(I32, I32)
+	case op >= opI64Clz && op <= opI64Popcnt:
+		return false, s.unary(I64, I64)
+	case op >= opI64Add && op <= opI64Rotr:
+		return false, s.binary(I64, I64)
+	case op == opI32WrapI64:
+		return false, s.unary(I64, I32)
+	case op == opI64ExtendI32S || op == opI64ExtendI32U:
+		return false, s.unary(I32, I64)
+	case op == opI32Extend8S || op == opI32Extend16S:
+		return false, s.unary(I32, I32)
+	case op >= opI64Extend8S && op <= opI64Extend32S:
+		return false, s.unary(I64, I64)
+	case op == opPrefixMisc:
+		sub, err := r.u32()
+		if err != nil {
+			return false, err
+		}
+		if s.m.memory == nil {
+			return false, errors.New("no memory")
+		}
+		switch sub {
+		case opMiscMemoryCopy:
+			if err := zeroByte(r); err != nil {
+				return false, err
+			}
+		case opMiscMemoryFill:
+		default:
+			return false, errors.Errorf("unsupported instruction 0xfc 0x%x", sub)
+		}
+		if err := zeroByte(r); err != nil {
+			return false, err
+		}
+		return false, s.pops([]ValueType{I32, I32, I32})
+	case isFloatOpcode(op):
+		return false, errors.New("floating point instructions are not supported")
+	default:
+		return false, errors.New("unsupported instruction")
+	}
+	return false, nil
+}
+
+// label reads label index and returns types of values branch to it takes.
+func (s *scanner) label(r *reader) ([]ValueType, error) {
+	depth, err := r.u32()
+	if err != nil {
+		return nil, err
+	}
+	if int(depth) > len(s.open) {
+		return nil, errors.Errorf("unknown label %d", depth)
+	}
+	return s.ctrls[len(s.ctrls)-1-int(depth)].labelTypes(), nil
+}
+
+func (s *scanner) blockType(r *reader) (FuncType, error) {
+	if r.eof() {
+		return FuncType{}, errUnexpectedEnd
+	}
+	b := r.buf[r.pos]
+	switch ValueType(b) {
+	case 0x40:
+		r.pos++
+		return FuncType{}, nil
+	case I32, I64, F32, F64:
+		r.pos++
+		return FuncType{Results: []ValueType{ValueType(b)}}, nil
+	}
+	index, err := r.sleb(33)
+	if err != nil {
+		return FuncType{}, err
+	}
+	if index < 0 || int(index) >= len(s.m.types) {
+		return FuncType{}, errors.Errorf("unknown block type %d", index)
+	}
+	return s.m.types[index], nil
+}
+
+func zeroByte(r *reader) error {
+	b, err := r.byte()
+	if err != nil {
+		return err
+	}
+	if b != 0 {
+		return errors.New("expected zero byte")
+	}
+	return nil
+}
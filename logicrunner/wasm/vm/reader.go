@@ -0,0 +1,249 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package vm
+
+import (
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+var errUnexpectedEnd = errors.New("unexpected end of data")
+
+type reader struct {
+	buf []byte
+	pos int
+}
+
+func (r *reader) eof() bool {
+	return r.pos >= len(r.buf)
+}
+
+func (r *reader) byte() (byte, error) {
+	if r.eof() {
+		return 0, errUnexpectedEnd
+	}
+	b := r.buf[r.pos]
+	r.pos++
+	return b, nil
+}
+
+func (r *reader) bytes(n int) ([]byte, error) {
+	if n < 0 || len(r.buf)-r.pos < n {
+		return nil, errUnexpectedEnd
+	}
+	b := r.buf[r.pos : r.pos+n]
+	r.pos += n
+	return b, nil
+}
+
+func (r *reader) u32() (uint32, error) {
+	v, err := r.uleb(32)
+	return uint32(v), err
+}
+
+func (r *reader) s32() (int32, error) {
+	v, err := r.sleb(32)
+	return int32(v), err
+}
+
+func (r *reader) s64() (int64, error) {
+	return r.sleb(64)
+}
+
+func (r *reader) uleb(bits uint) (uint64, error) {
+	var (
+		res   uint64
+		shift uint
+	)
+	for {
+		b, err := r.byte()
+		if err != nil {
+			return 0, err
+		}
+		res |= uint64(b&0x7f) << shift
+		shift += 7
+		if b&0x80 == 0 {
+			return res, nil
+		}
+		if shift >= bits+7 {
+			return 0, errors.New("integer representation is too long")
+		}
+	}
+}
+
+func (r *reader) sleb(bits uint) (int64, error) {
+	var (
+		res   int64
+		shift uint
+		b     byte
+		err   error
+	)
+	for {
+		b, err = r.byte()
+		if err != nil {
+			return 0, err
+		}
+		res |= int64(b&0x7f) << shift
+		shift += 7
+		if b&0x80 == 0 {
+			break
+		}
+		if shift >= bits+7 {
+			return 0, errors.New("integer representation is too long")
+		}
+	}
+	if shift < 64 && b&0x40 != 0 {
+		res |= -1 << shift
+	}
+	return res, nil
+}
+
+func (r *reader) u32s() ([]uint32, error) {
+	count, err := r.u32()
+	if err != nil {
+		return nil, err
+	}
+	if int(count) > len(r.buf)-r.pos {
+		return nil, errUnexpectedEnd
+	}
+	res := make([]uint32, count)
+	for i := range res {
+		if res[i], err = r.u32(); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (r *reader) name() (string, error) {
+	size, err := r.u32()
+	if err != nil {
+		return "", err
+	}
+	b, err := r.bytes(int(size))
+	if err != nil {
+		return "", err
+	}
+	if !utf8.Valid(b) {
+		return "", errors.New("name is not valid UTF-8")
+	}
+	return string(b), nil
+}
+
+func (r *reader) valueType() (ValueType, error) {
+	b, err := r.byte()
+	if err != nil {
+		return 0, err
+	}
+	switch t := ValueType(b); t {
+	case I32, I64, F32, F64:
+		return t, nil
+	default:
+		return 0, errors.Errorf("unknown value type 0x%x", b)
+	}
+}
+
+func (r *reader) valueTypes() ([]ValueType, error) {
+	count, err := r.u32()
+	if err != nil {
+		return nil, err
+	}
+	if int(count) > len(r.buf)-r.pos {
+		return nil, errUnexpectedEnd
+	}
+	res := make([]ValueType, count)
+	for i := range res {
+		if res[i], err = r.valueType(); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (r *reader) limits() (*limits, error) {
+	flags, err := r.byte()
+	if err != nil {
+		return nil, err
+	}
+	l := &limits{}
+	if l.min, err = r.u32(); err != nil {
+		return nil, err
+	}
+	switch flags {
+	case 0:
+	case 1:
+		l.hasMax = true
+		if l.max, err = r.u32(); err != nil {
+			return nil, err
+		}
+		if l.max < l.min {
+			return nil, errors.New("limits maximum is less than minimum")
+		}
+	default:
+		return nil, errors.Errorf("unsupported limits flags 0x%x", flags)
+	}
+	return l, nil
+}
+
+// constExpr reads constant initializer expression, only integer constants are supported.
+func (r *reader) constExpr() (ValueType, uint64, error) {
+	op, err := r.byte()
+	if err != nil {
+		return 0, 0, err
+	}
+	var (
+		typ ValueType
+		v   uint64
+	)
+	switch op {
+	case opI32Const:
+		c, err := r.s32()
+		if err != nil {
+			return 0, 0, err
+		}
+		typ, v = I32, uint64(uint32(c))
+	case opI64Const:
+		c, err := r.s64()
+		if err != nil {
+			return 0, 0, err
+		}
+		typ, v = I64, uint64(c)
+	default:
+		return 0, 0, errors.Errorf("unsupported constant expression 0x%x", op)
+	}
+	end, err := r.byte()
+	if err != nil {
+		return 0, 0, err
+	}
+	if end != opEnd {
+		return 0, 0, errors.New("constant expression is not terminated")
+	}
+	return typ, v, nil
+}
+
+// offsetExpr reads constant offset of element or data segment.
+func (r *reader) offsetExpr() (uint32, error) {
+	typ, v, err := r.constExpr()
+	if err != nil {
+		return 0, err
+	}
+	if typ != I32 {
+		return 0, errors.New("offset must be i32")
+	}
+	return uint32(v), nil
+}
@@ -0,0 +1,170 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package vm
+
+import (
+	"github.com/pkg/errors"
+)
+
+// unknownType is a type of operand popped from the stack in unreachable code, it matches any type.
+const unknownType ValueType = 0
+
+// ctrlFrame is a block being validated.
+type ctrlFrame struct {
+	op      byte
+	params  []ValueType
+	results []ValueType
+	// height is a size of operand stack at the start of the block
+	height      int
+	unreachable bool
+}
+
+// labelTypes returns types of values branch to the block takes.
+func (f ctrlFrame) labelTypes() []ValueType {
+	if f.op == opLoop {
+		return f.params
+	}
+	return f.results
+}
+
+func equalTypes(a, b []ValueType) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *scanner) push(typ ValueType) {
+	s.vals = append(s.vals, typ)
+}
+
+func (s *scanner) pushes(types []ValueType) {
+	s.vals = append(s.vals, types...)
+}
+
+func (s *scanner) pop() (ValueType, error) {
+	frame := s.ctrls[len(s.ctrls)-1]
+	if len(s.vals) == frame.height {
+		if frame.unreachable {
+			return unknownType, nil
+		}
+		return 0, errors.New("operand stack underflow")
+	}
+	typ := s.vals[len(s.vals)-1]
+	s.vals = s.vals[:len(s.vals)-1]
+	return typ, nil
+}
+
+func (s *scanner) popExpect(expected ValueType) error {
+	typ, err := s.pop()
+	if err != nil {
+		return err
+	}
+	if typ != unknownType && expected != unknownType && typ != expected {
+		return errors.Errorf("type mismatch: expected 0x%x, got 0x%x", byte(expected), byte(typ))
+	}
+	return nil
+}
+
+func (s *scanner) pops(types []ValueType) error {
+	for i := len(types) - 1; i >= 0; i-- {
+		if err := s.popExpect(types[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *scanner) unary(operand, result ValueType) error {
+	if err := s.popExpect(operand); err != nil {
+		return err
+	}
+	s.push(result)
+	return nil
+}
+
+func (s *scanner) binary(operand, result ValueType) error {
+	if err := s.pops([]ValueType{operand, operand}); err != nil {
+		return err
+	}
+	s.push(result)
+	return nil
+}
+
+func (s *scanner) call(typ FuncType) error {
+	if err := s.pops(typ.Params); err != nil {
+		return err
+	}
+	s.pushes(typ.Results)
+	return nil
+}
+
+// selectValue checks select instruction, typ is unknownType if instruction has no type immediate.
+func (s *scanner) selectValue(typ ValueType) error {
+	if err := s.popExpect(I32); err != nil {
+		return err
+	}
+	b, err := s.pop()
+	if err != nil {
+		return err
+	}
+	a, err := s.pop()
+	if err != nil {
+		return err
+	}
+	for _, t := range []ValueType{a, b} {
+		if t == unknownType {
+			continue
+		}
+		if typ != unknownType && t != typ {
+			return errors.New("operands of select have different types")
+		}
+		typ = t
+	}
+	s.push(typ)
+	return nil
+}
+
+func (s *scanner) pushCtrl(op byte, params, results []ValueType) {
+	s.ctrls = append(s.ctrls, ctrlFrame{op: op, params: params, results: results, height: len(s.vals)})
+	s.pushes(params)
+}
+
+// popCtrl finishes the current block, its results must be exactly on top of its stack.
+func (s *scanner) popCtrl() (ctrlFrame, error) {
+	frame := s.ctrls[len(s.ctrls)-1]
+	if err := s.pops(frame.results); err != nil {
+		return frame, err
+	}
+	if len(s.vals) != frame.height {
+		return frame, errors.New("values remain on the stack at the end of block")
+	}
+	s.ctrls = s.ctrls[:len(s.ctrls)-1]
+	return frame, nil
+}
+
+// unreachable marks the rest of the current block as unreachable, any operands can be popped there.
+func (s *scanner) unreachable() {
+	frame := &s.ctrls[len(s.ctrls)-1]
+	s.vals = s.vals[:frame.height]
+	frame.unreachable = true
+}
@@ -0,0 +1,334 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package vm_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/logicrunner/wasm/vm"
+	w "github.com/insolar/insolar/logicrunner/wasm/wasmtestutils"
+)
+
+var (
+	i32 = []vm.ValueType{vm.I32}
+	i64 = []vm.ValueType{vm.I64}
+)
+
+func instantiate(t *testing.T, m *w.Module, imports vm.Imports) *vm.Instance {
+	module, err := vm.Decode(m.Bytes())
+	require.NoError(t, err)
+	in, err := vm.Instantiate(module, imports, vm.Config{})
+	require.NoError(t, err)
+	return in
+}
+
+func call(t *testing.T, in *vm.Instance, name string, args ...uint64) uint64 {
+	res, err := in.Call(context.Background(), name, args...)
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	return res[0]
+}
+
+func TestDecode_Errors(t *testing.T) {
+	_, err := vm.Decode([]byte("not wasm"))
+	require.Error(t, err)
+
+	m := &w.Module{}
+	m.Function("f", nil, nil, nil, w.Op(w.F32Add))
+	_, err = vm.Decode(m.Bytes())
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "floating point")
+
+	m = &w.Module{}
+	m.Function("f", nil, nil, nil, w.Call(10))
+	_, err = vm.Decode(m.Bytes())
+	require.Error(t, err)
+}
+
+func TestDecode_Validation(t *testing.T) {
+	table := []struct {
+		name    string
+		params  []vm.ValueType
+		results []vm.ValueType
+		code    [][]byte
+		valid   bool
+	}{
+		{
+			name:    "stack underflow",
+			results: i32,
+			code:    [][]byte{w.I32Const(1), w.Op(w.I32Add)},
+		},
+		{
+			name:    "operand type mismatch",
+			results: i32,
+			code:    [][]byte{w.I32Const(1), w.I64Const(2), w.Op(w.I32Add)},
+		},
+		{
+			name:    "wrong result type",
+			results: i32,
+			code:    [][]byte{w.I64Const(1)},
+		},
+		{
+			name:    "local type mismatch",
+			params:  i64,
+			results: i32,
+			code:    [][]byte{w.LocalGet(0)},
+		},
+		{
+			name: "values left on stack",
+			code: [][]byte{w.I32Const(1)},
+		},
+		{
+			name: "block leaves value",
+			code: [][]byte{w.Op(w.Block, w.Void), w.I32Const(1), w.Op(w.End)},
+		},
+		{
+			name:    "if without else has result",
+			results: i32,
+			code:    [][]byte{w.I32Const(1), w.Op(w.If, byte(vm.I32)), w.I32Const(2), w.Op(w.End)},
+		},
+		{
+			name:    "br_table labels of different types",
+			results: i32,
+			code: [][]byte{
+				w.Op(w.Block, byte(vm.I32)),
+				w.Op(w.Block, w.Void),
+				w.I32Const(1), w.I32Const(0), w.Op(w.BrTable, 1, 0, 1),
+				w.Op(w.End),
+				w.I32Const(2),
+				w.Op(w.End),
+			},
+		},
+		{
+			name:    "operands after unreachable",
+			results: i32,
+			code:    [][]byte{w.Op(w.Unreachable), w.Op(w.I32Add)},
+			valid:   true,
+		},
+		{
+			name:    "branch out of function",
+			results: i32,
+			code:    [][]byte{w.I32Const(1), w.Op(w.Br, 0)},
+			valid:   true,
+		},
+		{
+			name:  "drop",
+			code:  [][]byte{w.I64Const(1), w.Op(w.Drop)},
+			valid: true,
+		},
+	}
+
+	for _, test := range table {
+		t.Run(test.name, func(t *testing.T) {
+			m := &w.Module{}
+			m.Function("f", test.params, test.results, nil, test.code...)
+			_, err := vm.Decode(m.Bytes())
+			if test.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestDecode_SectionOrder(t *testing.T) {
+	emptyTypes := []byte{1, 1, 0}
+	code := w.Concat([]byte("\x00asm"), []byte{1, 0, 0, 0}, emptyTypes, emptyTypes)
+	_, err := vm.Decode(code)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "out of order")
+}
+
+func TestInstance_Arithmetic(t *testing.T) {
+	m := &w.Module{}
+	m.Function("sub", []vm.ValueType{vm.I32, vm.I32}, i32, nil,
+		w.LocalGet(0), w.LocalGet(1), w.Op(w.I32Sub),
+	)
+	m.Function("div", []vm.ValueType{vm.I32, vm.I32}, i32, nil,
+		w.LocalGet(0), w.LocalGet(1), w.Op(w.I32DivS),
+	)
+	in := instantiate(t, m, nil)
+
+	require.Equal(t, uint64(0xffffffff), call(t, in, "sub", 1, 2))
+	require.Equal(t, uint64(0xfffffffe), call(t, in, "div", uint64(uint32(0xfffffffa)), 3))
+
+	_, err := in.Call(context.Background(), "div", 1, 0)
+	require.IsType(t, &vm.Trap{}, errors.Cause(err))
+}
+
+func TestInstance_Loop(t *testing.T) {
+	// factorial of n with a loop
+	m := &w.Module{}
+	m.Function("fac", i64, i64, i64,
+		w.I64Const(1), w.LocalSet(1),
+		w.Op(w.Block, w.Void),
+		w.Op(w.Loop, w.Void),
+		w.LocalGet(0), w.Op(w.I64Eqz), w.Op(w.BrIf, 1),
+		w.LocalGet(1), w.LocalGet(0), w.Op(w.I64Mul), w.LocalSet(1),
+		w.LocalGet(0), w.I64Const(1), w.Op(w.I64Sub), w.LocalSet(0),
+		w.Op(w.Br, 0),
+		w.Op(w.End),
+		w.Op(w.End),
+		w.LocalGet(1),
+	)
+	in := instantiate(t, m, nil)
+
+	require.Equal(t, uint64(3628800), call(t, in, "fac", 10))
+	require.Equal(t, uint64(1), call(t, in, "fac", 0))
+}
+
+func TestInstance_Recursion(t *testing.T) {
+	// fib(n) = n < 2 ? n : fib(n-1) + fib(n-2)
+	m := &w.Module{}
+	m.Function("fib", i32, i32, nil,
+		w.LocalGet(0), w.I32Const(2), w.Op(w.I32LtS),
+		w.Op(w.If, byte(vm.I32)),
+		w.LocalGet(0),
+		w.Op(w.Else),
+		w.LocalGet(0), w.I32Const(1), w.Op(w.I32Sub), w.Call(0),
+		w.LocalGet(0), w.I32Const(2), w.Op(w.I32Sub), w.Call(0),
+		w.Op(w.I32Add),
+		w.Op(w.End),
+	)
+	m.Function("forever", nil, nil, nil, w.Call(1))
+	in := instantiate(t, m, nil)
+
+	require.Equal(t, uint64(55), call(t, in, "fib", 10))
+
+	_, err := in.Call(context.Background(), "forever")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "call stack exhausted")
+}
+
+func TestInstance_BrTable(t *testing.T) {
+	// returns 10, 20 or 30 for 0, 1 and everything else
+	m := &w.Module{}
+	m.Function("switch", i32, i32, nil,
+		w.Op(w.Block, w.Void),
+		w.Op(w.Block, w.Void),
+		w.Op(w.Block, w.Void),
+		w.LocalGet(0), w.Op(w.BrTable, 2, 0, 1, 2),
+		w.Op(w.End),
+		w.I32Const(10), w.Op(w.Return),
+		w.Op(w.End),
+		w.I32Const(20), w.Op(w.Return),
+		w.Op(w.End),
+		w.I32Const(30),
+	)
+	in := instantiate(t, m, nil)
+
+	require.Equal(t, uint64(10), call(t, in, "switch", 0))
+	require.Equal(t, uint64(20), call(t, in, "switch", 1))
+	require.Equal(t, uint64(30), call(t, in, "switch", 7))
+}
+
+func TestInstance_CallIndirect(t *testing.T) {
+	m := &w.Module{}
+	ten := m.Function("", nil, i32, nil, w.I32Const(10))
+	twenty := m.Function("", nil, i32, nil, w.I32Const(20))
+	m.Function("dispatch", i32, i32, nil, w.LocalGet(0), w.CallIndirect(0))
+	m.Table(ten, twenty)
+	in := instantiate(t, m, nil)
+
+	require.Equal(t, uint64(10), call(t, in, "dispatch", 0))
+	require.Equal(t, uint64(20), call(t, in, "dispatch", 1))
+
+	_, err := in.Call(context.Background(), "dispatch", 2)
+	require.IsType(t, &vm.Trap{}, errors.Cause(err))
+}
+
+func TestInstance_Memory(t *testing.T) {
+	m := &w.Module{}
+	m.Memory(1)
+	m.Data(8, []byte{1, 2, 3, 4})
+	m.Function("load", i32, i32, nil, w.LocalGet(0), w.Mem(w.I32Load, 0))
+	m.Function("store", []vm.ValueType{vm.I32, vm.I32}, nil, nil, w.LocalGet(0), w.LocalGet(1), w.Mem(w.I32Store, 4))
+	m.Function("grow", i32, i32, nil, w.LocalGet(0), w.Op(w.MemoryGrow, 0x00))
+
+	module, err := vm.Decode(m.Bytes())
+	require.NoError(t, err)
+	in, err := vm.Instantiate(module, nil, vm.Config{MaxMemoryPages: 2})
+	require.NoError(t, err)
+
+	require.Equal(t, uint64(0x04030201), call(t, in, "load", 8))
+
+	_, err = in.Call(context.Background(), "store", 0, 0xaabbccdd)
+	require.NoError(t, err)
+	data, err := in.Read(4, 4)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0xdd, 0xcc, 0xbb, 0xaa}, data)
+
+	_, err = in.Call(context.Background(), "load", vm.PageSize-2)
+	require.IsType(t, &vm.Trap{}, errors.Cause(err))
+
+	require.Equal(t, uint64(1), call(t, in, "grow", 1))
+	require.Equal(t, uint64(0xffffffff), call(t, in, "grow", 1))
+	require.Len(t, in.Memory(), 2*vm.PageSize)
+}
+
+func TestInstance_HostFunctions(t *testing.T) {
+	m := &w.Module{}
+	double := m.Import("env", "double", i32, i32)
+	fail := m.Import("env", "fail", nil, nil)
+	m.Function("quad", i32, i32, nil, w.LocalGet(0), w.Call(double), w.Call(double))
+	m.Function("fail", nil, nil, nil, w.Call(fail))
+
+	imports := vm.Imports{"env": {
+		"double": {
+			Type: vm.FuncType{Params: i32, Results: i32},
+			Call: func(in *vm.Instance, args []uint64) ([]uint64, error) {
+				return []uint64{args[0] * 2}, nil
+			},
+		},
+		"fail": {
+			Type: vm.FuncType{},
+			Call: func(in *vm.Instance, args []uint64) ([]uint64, error) {
+				return nil, errors.New("host failure")
+			},
+		},
+	}}
+	in := instantiate(t, m, imports)
+
+	require.Equal(t, uint64(28), call(t, in, "quad", 7))
+
+	_, err := in.Call(context.Background(), "fail")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "host failure")
+
+	module, err := vm.Decode(m.Bytes())
+	require.NoError(t, err)
+	_, err = vm.Instantiate(module, vm.Imports{}, vm.Config{})
+	require.Error(t, err)
+}
+
+func TestInstance_Interrupt(t *testing.T) {
+	m := &w.Module{}
+	m.Function("loop", nil, nil, nil, w.Op(w.Loop, w.Void, w.Br, 0, w.End))
+	in := instantiate(t, m, nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := in.Call(ctx, "loop")
+	require.Equal(t, context.DeadlineExceeded, errors.Cause(err))
+	require.True(t, in.Steps() > 0)
+}
@@ -0,0 +1,217 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package wasm is an executor of contracts compiled to WebAssembly.
+//
+// Contract is a module that exports function "<Method>" for every method and
+// "constructor_<Name>" for every constructor, all without parameters and results.
+// Module talks to the executor through host functions of "insolar" module:
+//
+//	input_size() i32, input(ptr)             - serialized arguments of the call
+//	state_size() i32, state(ptr)             - memory of the object, empty in constructors
+//	set_state(ptr, len)                      - new memory of the object
+//	set_result(ptr, len)                     - serialized result of the method
+//	fail(ptr, len)                           - aborts the call with an error message
+//	return_data_size() i32, return_data(ptr) - output of the last foundation call
+//
+// Foundation calls return 0 on success and put their output into return data,
+// on failure they return 1 and the error message is put there instead:
+//
+//	route_call(obj, proto, method, method_len, args, args_len, flags) i32 - flags: 1 wait, 2 immutable, 4 saga
+//	save_as_child(parent, proto, ctor, ctor_len, args, args_len) i32      - returns reference of the child
+//	get_delegate(obj, type) i32                                           - returns reference of the delegate
+//	deactivate_object() i32
+//
+// References are passed as pointers to insolar.RecordRefSize bytes.
+package wasm
+
+import (
+	"container/list"
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/instrumentation/instracer"
+	"github.com/insolar/insolar/logicrunner/artifacts"
+	lrCommon "github.com/insolar/insolar/logicrunner/common"
+	"github.com/insolar/insolar/logicrunner/wasm/vm"
+)
+
+const (
+	// HostModule is a name of module contracts import host functions from.
+	HostModule = "insolar"
+	// ConstructorPrefix is prepended to constructor name to get name of exported function.
+	ConstructorPrefix = "constructor_"
+)
+
+// Flags of route_call host function.
+const (
+	RouteWait = 1 << iota
+	RouteImmutable
+	RouteSaga
+)
+
+// Wasm is a logic executor of WebAssembly contracts
+type Wasm struct {
+	Cfg             *configuration.Wasm
+	ArtifactManager artifacts.Client
+
+	stub lrCommon.LogicRunnerRPCStub
+
+	modulesLock sync.Mutex
+	modulesSize int
+	modules     map[insolar.Reference]*list.Element
+	modulesLRU  *list.List // of *cachedModule, most recently used first
+}
+
+type cachedModule struct {
+	ref    insolar.Reference
+	module *vm.Module
+	size   int
+}
+
+// NewWasm returns a new WebAssembly executor
+func NewWasm(cfg *configuration.Wasm, am artifacts.Client, stub lrCommon.LogicRunnerRPCStub) *Wasm {
+	return &Wasm{
+		Cfg:             cfg,
+		ArtifactManager: am,
+		stub:            stub,
+		modules:         make(map[insolar.Reference]*list.Element),
+		modulesLRU:      list.New(),
+	}
+}
+
+// CallMethod runs a method on an object
+func (w *Wasm) CallMethod(
+	ctx context.Context, callCtx *insolar.LogicCallContext,
+	code insolar.Reference, data []byte,
+	method string, args insolar.Arguments,
+) (
+	[]byte, insolar.Arguments, error,
+) {
+	ctx, span := instracer.StartSpan(ctx, "wasm.CallMethod "+method)
+	defer span.End()
+
+	c := &call{callCtx: callCtx, stub: w.stub, input: args, state: data}
+	if err := w.run(ctx, c, code, method); err != nil {
+		return nil, nil, err
+	}
+	return c.state, c.result, nil
+}
+
+// CallConstructor runs a constructor of a contract
+func (w *Wasm) CallConstructor(
+	ctx context.Context, callCtx *insolar.LogicCallContext,
+	code insolar.Reference, name string, args insolar.Arguments,
+) (
+	[]byte, error,
+) {
+	ctx, span := instracer.StartSpan(ctx, "wasm.CallConstructor "+name)
+	defer span.End()
+
+	c := &call{callCtx: callCtx, stub: w.stub, input: args}
+	if err := w.run(ctx, c, code, ConstructorPrefix+name); err != nil {
+		return nil, err
+	}
+	if !c.stateSet {
+		return nil, errors.Errorf("constructor %s didn't set object state", name)
+	}
+	return c.state, nil
+}
+
+func (w *Wasm) run(ctx context.Context, c *call, code insolar.Reference, name string) error {
+	module, err := w.module(ctx, code)
+	if err != nil {
+		return err
+	}
+
+	typ, ok := module.FunctionType(name)
+	if !ok {
+		return errors.Errorf("function %s isn't exported by contract", name)
+	}
+	if len(typ.Params) != 0 || len(typ.Results) != 0 {
+		return errors.Errorf("function %s has wrong signature", name)
+	}
+
+	instance, err := vm.Instantiate(module, c.imports(), vm.Config{
+		MaxMemoryPages: w.Cfg.MaxMemoryPages,
+		MaxCallDepth:   w.Cfg.MaxCallDepth,
+	})
+	if err != nil {
+		return errors.Wrap(err, "couldn't instantiate contract")
+	}
+
+	c.ctx = ctx
+	if _, err := instance.Call(ctx, name); err != nil {
+		return errors.Wrap(err, "contract execution failed")
+	}
+	return nil
+}
+
+// module returns decoded code, modules are cached as code records never change
+func (w *Wasm) module(ctx context.Context, code insolar.Reference) (*vm.Module, error) {
+	w.modulesLock.Lock()
+	elem, ok := w.modules[code]
+	if ok {
+		w.modulesLRU.MoveToFront(elem)
+	}
+	w.modulesLock.Unlock()
+	if ok {
+		return elem.Value.(*cachedModule).module, nil
+	}
+
+	desc, err := w.ArtifactManager.GetCode(ctx, code)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't get code")
+	}
+	if desc.MachineType() != insolar.MachineTypeWasm {
+		return nil, errors.Errorf("code has machine type %d, not wasm", desc.MachineType())
+	}
+	binary, err := desc.Code()
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't get code")
+	}
+	module, err := vm.Decode(binary)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't decode code")
+	}
+
+	w.cacheModule(code, module, len(binary))
+	return module, nil
+}
+
+// cacheModule keeps decoded module, least recently used modules are dropped when
+// total size of their code is over ModuleCacheSize. Running instances keep their modules.
+func (w *Wasm) cacheModule(code insolar.Reference, module *vm.Module, size int) {
+	w.modulesLock.Lock()
+	defer w.modulesLock.Unlock()
+
+	if _, ok := w.modules[code]; ok {
+		// decoded concurrently
+		return
+	}
+	w.modules[code] = w.modulesLRU.PushFront(&cachedModule{ref: code, module: module, size: size})
+	w.modulesSize += size
+
+	for w.modulesSize > w.Cfg.ModuleCacheSize && w.modulesLRU.Len() > 0 {
+		cached := w.modulesLRU.Remove(w.modulesLRU.Back()).(*cachedModule)
+		delete(w.modules, cached.ref)
+		w.modulesSize -= cached.size
+	}
+}
@@ -0,0 +1,288 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package wasm
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/logicrunner/artifacts"
+	"github.com/insolar/insolar/logicrunner/goplugin/rpctypes"
+	w "github.com/insolar/insolar/logicrunner/wasm/wasmtestutils"
+)
+
+type fakeStub struct {
+	routeReq    rpctypes.UpRouteReq
+	routeResult insolar.Arguments
+	err         error
+}
+
+func (s *fakeStub) GetCode(rpctypes.UpGetCodeReq, *rpctypes.UpGetCodeResp) error {
+	return errors.New("not implemented")
+}
+
+func (s *fakeStub) RouteCall(req rpctypes.UpRouteReq, resp *rpctypes.UpRouteResp) error {
+	s.routeReq = req
+	resp.Result = s.routeResult
+	return s.err
+}
+
+func (s *fakeStub) SaveAsChild(rpctypes.UpSaveAsChildReq, *rpctypes.UpSaveAsChildResp) error {
+	return errors.New("not implemented")
+}
+
+func (s *fakeStub) SaveAsDelegate(rpctypes.UpSaveAsDelegateReq, *rpctypes.UpSaveAsDelegateResp) error {
+	return errors.New("not implemented")
+}
+
+func (s *fakeStub) GetObjChildrenIterator(rpctypes.UpGetObjChildrenIteratorReq, *rpctypes.UpGetObjChildrenIteratorResp) error {
+	return errors.New("not implemented")
+}
+
+func (s *fakeStub) GetDelegate(rpctypes.UpGetDelegateReq, *rpctypes.UpGetDelegateResp) error {
+	return errors.New("not implemented")
+}
+
+func (s *fakeStub) DeactivateObject(rpctypes.UpDeactivateObjectReq, *rpctypes.UpDeactivateObjectResp) error {
+	return s.err
+}
+
+// contract is a module with all host functions imported
+type contract struct {
+	w.Module
+	host map[string]uint32
+}
+
+func newContract() *contract {
+	c := &contract{host: map[string]uint32{}}
+	imports := []struct {
+		name            string
+		params, results int
+	}{
+		{"input_size", 0, 1}, {"input", 1, 0}, {"state_size", 0, 1}, {"state", 1, 0},
+		{"set_state", 2, 0}, {"set_result", 2, 0}, {"fail", 2, 0},
+		{"return_data_size", 0, 1}, {"return_data", 1, 0},
+		{"route_call", 7, 1}, {"deactivate_object", 0, 1},
+	}
+	for _, imp := range imports {
+		c.host[imp.name] = c.Import(HostModule, imp.name, params(imp.params), params(imp.results))
+	}
+	c.Memory(1)
+	return c
+}
+
+func (c *contract) call(name string, args ...int32) []byte {
+	var code []byte
+	for _, a := range args {
+		code = append(code, w.I32Const(a)...)
+	}
+	return append(code, w.Call(c.host[name])...)
+}
+
+func newWasm(t *testing.T, mc *minimock.Controller, c *contract, stub *fakeStub) (*Wasm, insolar.Reference) {
+	code := gen.Reference()
+
+	desc := artifacts.NewCodeDescriptorMock(mc)
+	desc.MachineTypeMock.Return(insolar.MachineTypeWasm)
+	desc.CodeMock.Return(c.Bytes(), nil)
+
+	am := artifacts.NewClientMock(mc)
+	am.GetCodeMock.Return(desc, nil)
+
+	return NewWasm(configuration.NewLogicRunner().Wasm, am, stub), code
+}
+
+func TestWasm_CallMethod(t *testing.T) {
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	c := newContract()
+	// Echo returns its arguments, state is left untouched
+	c.Function("Echo", nil, nil, nil,
+		c.call("input", 0),
+		w.I32Const(0), w.Call(c.host["input_size"]), w.Call(c.host["set_result"]),
+	)
+	// Set replaces state with arguments
+	c.Function("Set", nil, nil, nil,
+		c.call("input", 0),
+		w.I32Const(0), w.Call(c.host["input_size"]), w.Call(c.host["set_state"]),
+	)
+	c.Function("Fail", nil, nil, nil, c.call("fail", 0, 5))
+	c.Data(0, []byte("oops!"))
+	c.Function("Args", params(1), nil, nil)
+
+	wasm, code := newWasm(t, mc, c, &fakeStub{})
+	ctx := context.Background()
+	callCtx := &insolar.LogicCallContext{}
+
+	state, result, err := wasm.CallMethod(ctx, callCtx, code, []byte("state"), "Echo", []byte("args"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("state"), state)
+	require.Equal(t, insolar.Arguments("args"), result)
+
+	state, result, err = wasm.CallMethod(ctx, callCtx, code, []byte("state"), "Set", []byte("new state"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("new state"), state)
+	require.Nil(t, result)
+
+	_, _, err = wasm.CallMethod(ctx, callCtx, code, nil, "Fail", nil)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "oops!")
+
+	_, _, err = wasm.CallMethod(ctx, callCtx, code, nil, "Unknown", nil)
+	require.Error(t, err)
+
+	_, _, err = wasm.CallMethod(ctx, callCtx, code, nil, "Args", nil)
+	require.Error(t, err)
+}
+
+func TestWasm_CallConstructor(t *testing.T) {
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	c := newContract()
+	c.Function(ConstructorPrefix+"New", nil, nil, nil,
+		c.call("input", 0),
+		w.I32Const(0), w.Call(c.host["input_size"]), w.Call(c.host["set_state"]),
+	)
+	c.Function(ConstructorPrefix+"Empty", nil, nil, nil)
+
+	wasm, code := newWasm(t, mc, c, &fakeStub{})
+	ctx := context.Background()
+
+	state, err := wasm.CallConstructor(ctx, &insolar.LogicCallContext{}, code, "New", []byte("memory"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("memory"), state)
+
+	_, err = wasm.CallConstructor(ctx, &insolar.LogicCallContext{}, code, "Empty", nil)
+	require.Error(t, err)
+}
+
+func TestWasm_RouteCall(t *testing.T) {
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	object, prototype := gen.Reference(), gen.Reference()
+
+	c := newContract()
+	c.Data(0, object.Bytes())
+	c.Data(insolar.RecordRefSize, prototype.Bytes())
+	c.Data(2*insolar.RecordRefSize, []byte("Get"))
+	c.Data(2*insolar.RecordRefSize+3, []byte("args"))
+	c.Function("Call", nil, nil, nil,
+		c.call("route_call", 0, insolar.RecordRefSize, 2*insolar.RecordRefSize, 3, 2*insolar.RecordRefSize+3, 4, RouteWait|RouteSaga),
+		w.Op(w.If, w.Void),
+		c.call("return_data", 1024),
+		w.I32Const(1024), w.Call(c.host["return_data_size"]), w.Call(c.host["fail"]),
+		w.Op(w.End),
+		c.call("return_data", 1024),
+		w.I32Const(1024), w.Call(c.host["return_data_size"]), w.Call(c.host["set_result"]),
+	)
+
+	stub := &fakeStub{routeResult: []byte("result")}
+	wasm, code := newWasm(t, mc, c, stub)
+	ctx := context.Background()
+	callee, request := gen.Reference(), gen.Reference()
+	callCtx := &insolar.LogicCallContext{Mode: insolar.ExecuteCallMode, Callee: &callee, Request: &request}
+
+	_, result, err := wasm.CallMethod(ctx, callCtx, code, nil, "Call", nil)
+	require.NoError(t, err)
+	require.Equal(t, insolar.Arguments("result"), result)
+	require.Equal(t, rpctypes.UpRouteReq{
+		UpBaseReq: rpctypes.UpBaseReq{Mode: insolar.ExecuteCallMode, Callee: callee, Request: request},
+		Wait:      true,
+		Saga:      true,
+		Object:    object,
+		Method:    "Get",
+		Arguments: []byte("args"),
+		Prototype: prototype,
+	}, stub.routeReq)
+
+	stub.err = errors.New("route failed")
+	_, _, err = wasm.CallMethod(ctx, callCtx, code, nil, "Call", nil)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "route failed")
+}
+
+func TestWasm_Interrupt(t *testing.T) {
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	c := newContract()
+	c.Function("Loop", nil, nil, nil, w.Op(w.Loop, w.Void, w.Br, 0, w.End))
+
+	wasm, code := newWasm(t, mc, c, &fakeStub{})
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, _, err := wasm.CallMethod(ctx, &insolar.LogicCallContext{}, code, nil, "Loop", nil)
+	require.Equal(t, context.DeadlineExceeded, errors.Cause(err))
+}
+
+func TestWasm_WrongMachineType(t *testing.T) {
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	desc := artifacts.NewCodeDescriptorMock(mc)
+	desc.MachineTypeMock.Return(insolar.MachineTypeGoPlugin)
+	am := artifacts.NewClientMock(mc)
+	am.GetCodeMock.Return(desc, nil)
+
+	wasm := NewWasm(configuration.NewLogicRunner().Wasm, am, &fakeStub{})
+	_, _, err := wasm.CallMethod(context.Background(), &insolar.LogicCallContext{}, gen.Reference(), nil, "Get", nil)
+	require.Error(t, err)
+}
+
+func TestWasm_ModuleCache(t *testing.T) {
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	c := newContract()
+	c.Function("Get", nil, nil, nil)
+	size := len(c.Bytes())
+
+	desc := artifacts.NewCodeDescriptorMock(mc)
+	desc.MachineTypeMock.Return(insolar.MachineTypeWasm)
+	desc.CodeMock.Return(c.Bytes(), nil)
+	am := artifacts.NewClientMock(mc)
+	am.GetCodeMock.Return(desc, nil)
+
+	cfg := *configuration.NewLogicRunner().Wasm
+	cfg.ModuleCacheSize = 2 * size
+	wasm := NewWasm(&cfg, am, &fakeStub{})
+
+	first, second, third := gen.Reference(), gen.Reference(), gen.Reference()
+	for _, code := range []insolar.Reference{first, second, first, third} {
+		_, err := wasm.module(context.Background(), code)
+		require.NoError(t, err)
+	}
+
+	// second module is the least recently used one
+	require.Equal(t, uint64(3), am.GetCodeCounter)
+	require.Len(t, wasm.modules, 2)
+	require.Contains(t, wasm.modules, first)
+	require.Contains(t, wasm.modules, third)
+	require.Equal(t, 2*size, wasm.modulesSize)
+}
@@ -0,0 +1,126 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package wasmtestutils
+
+// Opcodes used in tests.
+const (
+	Unreachable = 0x00
+	Block       = 0x02
+	Loop        = 0x03
+	If          = 0x04
+	Else        = 0x05
+	End         = 0x0b
+	Br          = 0x0c
+	BrIf        = 0x0d
+	BrTable     = 0x0e
+	Return      = 0x0f
+	Drop        = 0x1a
+
+	Void = 0x40
+
+	I32Load    = 0x28
+	I32Store   = 0x36
+	I64Store   = 0x37
+	MemoryGrow = 0x40
+
+	I32Eqz  = 0x45
+	I32GtU  = 0x4b
+	I32LtS  = 0x48
+	I64Eqz  = 0x50
+	I32Add  = 0x6a
+	I32Sub  = 0x6b
+	I32Mul  = 0x6c
+	I32DivS = 0x6d
+	I64Sub  = 0x7d
+	I64Mul  = 0x7e
+
+	F32Add = 0x92
+)
+
+// Concat joins byte slices.
+func Concat(parts ...[]byte) []byte {
+	var res []byte
+	for _, p := range parts {
+		res = append(res, p...)
+	}
+	return res
+}
+
+// ULEB encodes unsigned LEB128 integer.
+func ULEB(v uint32) []byte {
+	var res []byte
+	for {
+		b := byte(v & 0x7f)
+		v >>= 7
+		if v != 0 {
+			res = append(res, b|0x80)
+			continue
+		}
+		return append(res, b)
+	}
+}
+
+// SLEB encodes signed LEB128 integer.
+func SLEB(v int64) []byte {
+	var res []byte
+	for {
+		b := byte(v & 0x7f)
+		v >>= 7
+		if (v == 0 && b&0x40 == 0) || (v == -1 && b&0x40 != 0) {
+			return append(res, b)
+		}
+		res = append(res, b|0x80)
+	}
+}
+
+// Op returns instruction without immediates.
+func Op(ops ...byte) []byte {
+	return ops
+}
+
+func I32Const(v int32) []byte {
+	return Concat([]byte{0x41}, SLEB(int64(v)))
+}
+
+func I64Const(v int64) []byte {
+	return Concat([]byte{0x42}, SLEB(v))
+}
+
+func LocalGet(i uint32) []byte {
+	return Concat([]byte{0x20}, ULEB(i))
+}
+
+func LocalSet(i uint32) []byte {
+	return Concat([]byte{0x21}, ULEB(i))
+}
+
+func LocalTee(i uint32) []byte {
+	return Concat([]byte{0x22}, ULEB(i))
+}
+
+func Call(f uint32) []byte {
+	return Concat([]byte{0x10}, ULEB(f))
+}
+
+func CallIndirect(typ uint32) []byte {
+	return Concat([]byte{0x11}, ULEB(typ), []byte{0x00})
+}
+
+// Mem returns memory instruction with zero alignment and provided offset.
+func Mem(op byte, offset uint32) []byte {
+	return Concat([]byte{op, 0x00}, ULEB(offset))
+}
@@ -0,0 +1,184 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package wasmtestutils builds binary WebAssembly modules for tests.
+package wasmtestutils
+
+import (
+	"github.com/insolar/insolar/logicrunner/wasm/vm"
+)
+
+type function struct {
+	export string
+	typ    uint32
+	locals []vm.ValueType
+	code   []byte
+}
+
+type importedFunction struct {
+	module string
+	name   string
+	typ    uint32
+}
+
+type dataSegment struct {
+	offset uint32
+	data   []byte
+}
+
+// Module is a builder of WebAssembly module. All imports have to be added before functions,
+// because imported functions go first in the function index space.
+type Module struct {
+	types     []vm.FuncType
+	imports   []importedFunction
+	functions []function
+	memory    *uint32
+	data      []dataSegment
+	table     []uint32
+}
+
+// Import adds imported function and returns its index.
+func (m *Module) Import(module, name string, params, results []vm.ValueType) uint32 {
+	m.imports = append(m.imports, importedFunction{module: module, name: name, typ: m.addType(params, results)})
+	return uint32(len(m.imports) - 1)
+}
+
+// Function adds function with body code, it's exported if export isn't empty. Returns index of the function.
+func (m *Module) Function(export string, params, results, locals []vm.ValueType, code ...[]byte) uint32 {
+	var body []byte
+	for _, c := range code {
+		body = append(body, c...)
+	}
+	m.functions = append(m.functions, function{
+		export: export,
+		typ:    m.addType(params, results),
+		locals: locals,
+		code:   append(body, End),
+	})
+	return uint32(len(m.imports) + len(m.functions) - 1)
+}
+
+// Memory adds exported memory "memory" with provided number of pages.
+func (m *Module) Memory(pages uint32) {
+	m.memory = &pages
+}
+
+// Data adds data segment.
+func (m *Module) Data(offset uint32, data []byte) {
+	m.data = append(m.data, dataSegment{offset: offset, data: data})
+}
+
+// Table adds table filled with functions.
+func (m *Module) Table(functions ...uint32) {
+	m.table = functions
+}
+
+func (m *Module) addType(params, results []vm.ValueType) uint32 {
+	m.types = append(m.types, vm.FuncType{Params: params, Results: results})
+	return uint32(len(m.types) - 1)
+}
+
+// Bytes returns binary representation of the module.
+func (m *Module) Bytes() []byte {
+	res := []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}
+
+	var types [][]byte
+	for _, t := range m.types {
+		types = append(types, Concat([]byte{0x60}, valueTypes(t.Params), valueTypes(t.Results)))
+	}
+	res = append(res, section(1, types)...)
+
+	var imports [][]byte
+	for _, imp := range m.imports {
+		imports = append(imports, Concat(name(imp.module), name(imp.name), []byte{0x00}, ULEB(imp.typ)))
+	}
+	res = append(res, section(2, imports)...)
+
+	var funcs [][]byte
+	for _, f := range m.functions {
+		funcs = append(funcs, ULEB(f.typ))
+	}
+	res = append(res, section(3, funcs)...)
+
+	if m.table != nil {
+		res = append(res, section(4, [][]byte{Concat([]byte{0x70, 0x00}, ULEB(uint32(len(m.table))))})...)
+	}
+	if m.memory != nil {
+		res = append(res, section(5, [][]byte{Concat([]byte{0x00}, ULEB(*m.memory))})...)
+	}
+
+	var exports [][]byte
+	for i, f := range m.functions {
+		if f.export != "" {
+			exports = append(exports, Concat(name(f.export), []byte{0x00}, ULEB(uint32(len(m.imports)+i))))
+		}
+	}
+	if m.memory != nil {
+		exports = append(exports, Concat(name("memory"), []byte{0x02, 0x00}))
+	}
+	res = append(res, section(7, exports)...)
+
+	if m.table != nil {
+		var funcs []byte
+		for _, f := range m.table {
+			funcs = append(funcs, ULEB(f)...)
+		}
+		elem := Concat([]byte{0x00}, I32Const(0), []byte{End}, ULEB(uint32(len(m.table))), funcs)
+		res = append(res, section(9, [][]byte{elem})...)
+	}
+
+	var code [][]byte
+	for _, f := range m.functions {
+		body := ULEB(uint32(len(f.locals)))
+		for _, l := range f.locals {
+			body = append(body, 0x01, byte(l))
+		}
+		body = append(body, f.code...)
+		code = append(code, Concat(ULEB(uint32(len(body))), body))
+	}
+	res = append(res, section(10, code)...)
+
+	var data [][]byte
+	for _, d := range m.data {
+		data = append(data, Concat([]byte{0x00}, I32Const(int32(d.offset)), []byte{End}, ULEB(uint32(len(d.data))), d.data))
+	}
+	res = append(res, section(11, data)...)
+
+	return res
+}
+
+func section(id byte, entries [][]byte) []byte {
+	if len(entries) == 0 {
+		return nil
+	}
+	content := ULEB(uint32(len(entries)))
+	for _, e := range entries {
+		content = append(content, e...)
+	}
+	return Concat([]byte{id}, ULEB(uint32(len(content))), content)
+}
+
+func valueTypes(types []vm.ValueType) []byte {
+	res := ULEB(uint32(len(types)))
+	for _, t := range types {
+		res = append(res, byte(t))
+	}
+	return res
+}
+
+func name(s string) []byte {
+	return Concat(ULEB(uint32(len(s))), []byte(s))
+}
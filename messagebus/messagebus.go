@@ -64,6 +64,8 @@ var transferredToWatermill = map[insolar.MessageType]struct{}{
 	insolar.TypeAdditionalCallFromPreviousExecutor: {},
 	insolar.TypeHeavyPayload:                       {},
 	insolar.TypeGetObjectIndex:                     {},
+	insolar.TypeValidateCaseBind:                   {},
+	insolar.TypeValidationResults:                  {},
 }
 
 // MessageBus is component that routes application logic requests,
@@ -54,6 +54,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/instrumentation/inslogger"
 	"github.com/insolar/insolar/network/consensus/common/capacity"
 	"github.com/insolar/insolar/network/consensus/common/pulse"
@@ -63,10 +64,24 @@ import (
 	"github.com/insolar/insolar/network/consensus/gcpv2/api/power"
 )
 
-type ConsensusControlFeeder struct{}
+type TrafficLimiter interface {
+	SetLimit(ctx context.Context, level capacity.Level, duration time.Duration)
+	Resume(ctx context.Context)
+}
+
+type ConsensusControlFeeder struct {
+	trafficLimiter     TrafficLimiter
+	terminationHandler insolar.TerminationHandler
+}
 
-func NewConsensusControlFeeder() *ConsensusControlFeeder {
-	return &ConsensusControlFeeder{}
+func NewConsensusControlFeeder(
+	trafficLimiter TrafficLimiter,
+	terminationHandler insolar.TerminationHandler,
+) *ConsensusControlFeeder {
+	return &ConsensusControlFeeder{
+		trafficLimiter:     trafficLimiter,
+		terminationHandler: terminationHandler,
+	}
 }
 
 func (cf *ConsensusControlFeeder) GetRequiredPowerLevel() power.Request {
@@ -80,25 +95,44 @@ func (cf *ConsensusControlFeeder) OnAppliedPowerLevel(pw member.Power, effective
 }
 
 func (cf *ConsensusControlFeeder) GetRequiredGracefulLeave() (bool, uint32) {
-	return false, 0
+	ctx := context.TODO()
+
+	reason, required := cf.terminationHandler.RequiredLeave(ctx)
+	return required, uint32(reason)
 }
 
 func (cf *ConsensusControlFeeder) OnAppliedGracefulLeave(exitCode uint32, effectiveSince pulse.Number) {
 	ctx := context.TODO()
 
-	inslogger.FromContext(ctx).Info(">>> Graceful leave applied")
+	inslogger.FromContext(ctx).Infof(">>> Graceful leave applied: reason %d, effective since %d", exitCode, effectiveSince)
+	cf.terminationHandler.OnLeaveApproved(ctx)
 }
 
 func (cf *ConsensusControlFeeder) SetTrafficLimit(level capacity.Level, duration time.Duration) {
-	panic("implement me")
+	ctx := context.TODO()
+
+	if cf.trafficLimiter == nil {
+		inslogger.FromContext(ctx).Warnf(">>> Traffic limiter is not set, ignoring limit %d for %s", level, duration)
+		return
+	}
+	cf.trafficLimiter.SetLimit(ctx, level, duration)
 }
 
 func (cf *ConsensusControlFeeder) ResumeTraffic() {
-	panic("implement me")
+	ctx := context.TODO()
+
+	if cf.trafficLimiter == nil {
+		inslogger.FromContext(ctx).Warn(">>> Traffic limiter is not set, nothing to resume")
+		return
+	}
+	cf.trafficLimiter.Resume(ctx)
 }
 
 func (cf *ConsensusControlFeeder) PulseDetected() {
-	panic("implement me")
+	// Detected pulse is not a new pulse yet, traffic limit is released by ResumeTraffic or when it expires.
+	ctx := context.TODO()
+
+	inslogger.FromContext(ctx).Debug(">>> Pulse detected")
 }
 
 func (cf *ConsensusControlFeeder) ConsensusFinished(report api.UpstreamReport, expectedCensus census.Operational) {
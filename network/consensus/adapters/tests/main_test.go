@@ -71,6 +71,7 @@ import (
 	"github.com/insolar/insolar/network/consensus/adapters"
 	"github.com/insolar/insolar/network/node"
 	"github.com/insolar/insolar/network/nodenetwork"
+	"github.com/insolar/insolar/network/throttle"
 	transport2 "github.com/insolar/insolar/network/transport"
 	"github.com/insolar/insolar/platformpolicy"
 	"github.com/insolar/insolar/testutils"
@@ -130,7 +131,9 @@ func TestConsensusMain(t *testing.T) {
 			StateUpdater: &stateUpdater{
 				nodeKeeper: nodeKeeper,
 			},
-			DatagramTransport: delayTransport,
+			TrafficLimiter:     throttle.NewLimiter(throttle.DefaultCapacity),
+			TerminationHandler: testutils.NewTerminationHandlerMock(t).RequiredLeaveMock.Return(0, false),
+			DatagramTransport:  delayTransport,
 		}).Install(datagramHandler, pulseHandler)
 
 		ctx, _ = inslogger.WithFields(ctx, map[string]interface{}{
@@ -318,6 +321,14 @@ type pulseChanger struct {
 	nodeKeeper network2.NodeKeeper
 }
 
+func (pc *pulseChanger) PreparePulseChange(ctx context.Context, pulseNumber insolar.PulseNumber) {
+	inslogger.FromContext(ctx).Info(">>>>>> Prepare pulse change called")
+}
+
+func (pc *pulseChanger) CancelPulseChange(ctx context.Context) {
+	inslogger.FromContext(ctx).Info(">>>>>> Cancel pulse change called")
+}
+
 func (pc *pulseChanger) ChangePulse(ctx context.Context, pulse insolar.Pulse) {
 	inslogger.FromContext(ctx).Info(">>>>>> Change pulse called")
 	err := pc.nodeKeeper.MoveSyncToActive(ctx, pulse.PulseNumber)
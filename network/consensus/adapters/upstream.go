@@ -52,6 +52,7 @@ package adapters
 
 import (
 	"context"
+	"sync"
 
 	"github.com/insolar/insolar/network/consensus/common/cryptkit"
 	"github.com/insolar/insolar/network/consensus/common/longbits"
@@ -69,7 +70,9 @@ type StateGetter interface {
 }
 
 type PulseChanger interface {
+	PreparePulseChange(ctx context.Context, pulseNumber insolar.PulseNumber)
 	ChangePulse(ctx context.Context, newPulse insolar.Pulse)
+	CancelPulseChange(ctx context.Context)
 }
 
 type StateUpdater interface {
@@ -80,6 +83,9 @@ type UpstreamPulseController struct {
 	stateGetter  StateGetter
 	pulseChanger PulseChanger
 	stateUpdater StateUpdater
+
+	lock     sync.Mutex
+	prepared *api.UpstreamReport
 }
 
 func NewUpstreamPulseController(stateGetter StateGetter, pulseChanger PulseChanger, stateUpdater StateUpdater) *UpstreamPulseController {
@@ -107,7 +113,15 @@ func (u *UpstreamPulseController) ConsensusFinished(report api.UpstreamReport, e
 }
 
 func (u *UpstreamPulseController) PreparePulseChange(report api.UpstreamReport) <-chan proofs.NodeStateHash {
-	nshChan := make(chan proofs.NodeStateHash)
+	u.lock.Lock()
+	u.prepared = &report
+	u.lock.Unlock()
+
+	ctx := contextFromReport(report)
+	u.pulseChanger.PreparePulseChange(ctx, insolar.PulseNumber(report.PulseNumber))
+
+	// Buffered, because the channel is not read if pulse change is cancelled.
+	nshChan := make(chan proofs.NodeStateHash, 1)
 
 	go awaitState(nshChan, u.stateGetter)
 
@@ -115,6 +129,10 @@ func (u *UpstreamPulseController) PreparePulseChange(report api.UpstreamReport)
 }
 
 func (u *UpstreamPulseController) CommitPulseChange(report api.UpstreamReport, pulseData pulse.Data, activeCensus census.Operational) {
+	u.lock.Lock()
+	u.prepared = nil
+	u.lock.Unlock()
+
 	ctx := contextFromReport(report)
 	p := NewPulse(pulseData)
 
@@ -122,7 +140,17 @@ func (u *UpstreamPulseController) CommitPulseChange(report api.UpstreamReport, p
 }
 
 func (u *UpstreamPulseController) CancelPulseChange() {
-	panic("implement me")
+	u.lock.Lock()
+	report := u.prepared
+	u.prepared = nil
+	u.lock.Unlock()
+
+	if report == nil {
+		return
+	}
+
+	ctx := contextFromReport(*report)
+	u.pulseChanger.CancelPulseChange(ctx)
 }
 
 func awaitState(c chan<- proofs.NodeStateHash, stater StateGetter) {
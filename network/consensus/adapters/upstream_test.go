@@ -0,0 +1,125 @@
+//
+// Modified BSD 3-Clause Clear License
+//
+// Copyright (c) 2019 Insolar Technologies GmbH
+//
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without modification,
+// are permitted (subject to the limitations in the disclaimer below) provided that
+// the following conditions are met:
+//  * Redistributions of source code must retain the above copyright notice, this list
+//    of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright notice, this list
+//    of conditions and the following disclaimer in the documentation and/or other materials
+//    provided with the distribution.
+//  * Neither the name of Insolar Technologies GmbH nor the names of its contributors
+//    may be used to endorse or promote products derived from this software without
+//    specific prior written permission.
+//
+// NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED
+// BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS
+// AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES,
+// INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL
+// THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT,
+// INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+// BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS
+// OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+// ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+//
+// Notwithstanding any other provisions of this license, it is prohibited to:
+//    (a) use this software,
+//
+//    (b) prepare modifications and derivative works of this software,
+//
+//    (c) distribute this software (including without limitation in source code, binary or
+//        object code form), and
+//
+//    (d) reproduce copies of this software
+//
+//    for any commercial purposes, and/or
+//
+//    for the purposes of making available this software to third parties as a service,
+//    including, without limitation, any software-as-a-service, platform-as-a-service,
+//    infrastructure-as-a-service or other similar online service, irrespective of
+//    whether it competes with the products or services of Insolar Technologies GmbH.
+//
+
+package adapters
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/network/consensus/common/longbits"
+	"github.com/insolar/insolar/network/consensus/common/pulse"
+	"github.com/insolar/insolar/network/consensus/gcpv2/api"
+)
+
+type testPulseChanger struct {
+	lock      sync.Mutex
+	prepared  []insolar.PulseNumber
+	changed   []insolar.PulseNumber
+	cancelled int
+}
+
+func (c *testPulseChanger) PreparePulseChange(ctx context.Context, pulseNumber insolar.PulseNumber) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.prepared = append(c.prepared, pulseNumber)
+}
+
+func (c *testPulseChanger) ChangePulse(ctx context.Context, newPulse insolar.Pulse) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.changed = append(c.changed, newPulse.PulseNumber)
+}
+
+func (c *testPulseChanger) CancelPulseChange(ctx context.Context) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.cancelled++
+}
+
+type testStateGetter struct{}
+
+func (testStateGetter) State() []byte {
+	return make([]byte, 64)
+}
+
+func TestUpstreamPulseController_CancelPulseChange(t *testing.T) {
+	changer := &testPulseChanger{}
+	u := NewUpstreamPulseController(testStateGetter{}, changer, nil)
+
+	pn := pulse.Number(insolar.GenesisPulse.PulseNumber + 10)
+
+	// Nothing is prepared, nothing to cancel.
+	u.CancelPulseChange()
+	require.Equal(t, 0, changer.cancelled)
+
+	// Cancelled round, state hash is not read.
+	_ = u.PreparePulseChange(api.UpstreamReport{PulseNumber: pn})
+	u.CancelPulseChange()
+	require.Equal(t, []insolar.PulseNumber{insolar.PulseNumber(pn)}, changer.prepared)
+	require.Equal(t, 1, changer.cancelled)
+
+	// Cancel is not repeated.
+	u.CancelPulseChange()
+	require.Equal(t, 1, changer.cancelled)
+
+	// Next round is committed.
+	nshChan := u.PreparePulseChange(api.UpstreamReport{PulseNumber: pn})
+	require.NotNil(t, <-nshChan)
+	u.CommitPulseChange(api.UpstreamReport{PulseNumber: pn}, *pulse.NewPulsarData(pn, 10, 10, longbits.Bits256{}), nil)
+	u.CancelPulseChange()
+
+	require.Equal(t, []insolar.PulseNumber{insolar.PulseNumber(pn)}, changer.changed)
+	require.Equal(t, 1, changer.cancelled)
+}
@@ -84,9 +84,11 @@ type Dep struct {
 	NodeKeeper         network.NodeKeeper
 	DatagramTransport  transport.DatagramTransport
 
-	StateGetter  adapters.StateGetter
-	PulseChanger adapters.PulseChanger
-	StateUpdater adapters.StateUpdater
+	StateGetter        adapters.StateGetter
+	PulseChanger       adapters.PulseChanger
+	StateUpdater       adapters.StateUpdater
+	TrafficLimiter     adapters.TrafficLimiter
+	TerminationHandler insolar.TerminationHandler
 }
 
 func (cd *Dep) verify() {
@@ -183,7 +185,7 @@ func New(ctx context.Context, dep Dep) Consensus {
 			consensus.roundStrategyFactory,
 		),
 		&core.SequentialCandidateFeeder{},
-		adapters.NewConsensusControlFeeder(),
+		adapters.NewConsensusControlFeeder(dep.TrafficLimiter, dep.TerminationHandler),
 	)
 	consensus.packetParserFactory = serialization.NewPacketParserFactory(
 		consensus.transportCryptographyFactory.GetDigestFactory().GetPacketDigester(),
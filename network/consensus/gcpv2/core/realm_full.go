@@ -61,6 +61,7 @@ import (
 	"github.com/insolar/insolar/network/consensus/common/pulse"
 	"github.com/insolar/insolar/network/consensus/gcpv2/api"
 	census2 "github.com/insolar/insolar/network/consensus/gcpv2/api/census"
+	"github.com/insolar/insolar/network/consensus/gcpv2/api/member"
 	"github.com/insolar/insolar/network/consensus/gcpv2/api/misbehavior"
 	"github.com/insolar/insolar/network/consensus/gcpv2/api/phases"
 	"github.com/insolar/insolar/network/consensus/gcpv2/api/profiles"
@@ -383,6 +384,10 @@ func (r *coreRealm) UpstreamPreparePulseChange() <-chan proofs.NodeStateHash {
 	return r.upstream.PreparePulseChange(report)
 }
 
+func (r *coreRealm) UpstreamCancelPulseChange() {
+	r.upstream.CancelPulseChange()
+}
+
 func (r *FullRealm) CommitPulseChange() {
 	if !r.pulseData.PulseNumber.IsTimePulse() {
 		panic("pulse number was not set")
@@ -477,7 +482,11 @@ func (r *FullRealm) prepareRegularMembers(pop census2.PopulationBuilder) {
 		}
 		rs := na.GetRequestedState()
 		p.SetPower(rs.RequestedPower)
-		p.SetOpMode(rs.RequestedMode)
+		if rs.IsLeaving {
+			p.SetOpModeAndLeaveReason(rs.LeaveReason)
+		} else {
+			p.SetOpMode(rs.RequestedMode)
+		}
 	}
 }
 
@@ -495,6 +504,10 @@ func (r *FullRealm) FinishRound(builder census2.Builder, csh proofs.CloudStateHa
 
 	if local.GetOpMode().IsEvicted() {
 		r.notifyConsensusFinished(local, nil)
+		if local.GetOpMode() == member.ModeEvictedGracefully {
+			// leave requested by this node was accepted by the consensus
+			r.controlFeeder.OnAppliedGracefulLeave(local.GetLeaveReason(), r.GetNextPulseNumber())
+		}
 		return
 	}
 
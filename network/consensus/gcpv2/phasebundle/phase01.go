@@ -192,6 +192,13 @@ func (c *Phase01Controller) workerSendPhase0(ctx context.Context) (proofs.NodeSt
 		Also size of Ph1 claims should be considered too.
 	*/
 	var nsh proofs.NodeStateHash
+	nshReceived := false
+	defer func() {
+		if !nshReceived {
+			// Round is stopped before node state is ready, so the prepared pulse change is rolled back.
+			c.R.UpstreamCancelPulseChange()
+		}
+	}()
 
 	select {
 	case <-ctx.Done():
@@ -199,6 +206,7 @@ func (c *Phase01Controller) workerSendPhase0(ctx context.Context) (proofs.NodeSt
 	case <-time.After(c.R.AdjustedAfter(c.R.GetTimings().StartPhase0At)):
 		break
 	case nsh = <-nshChannel:
+		nshReceived = true
 		return nsh, 0
 	}
 
@@ -219,6 +227,7 @@ func (c *Phase01Controller) workerSendPhase0(ctx context.Context) (proofs.NodeSt
 		case <-ctx.Done():
 			return nil, -1
 		case nsh = <-nshChannel:
+			nshReceived = true
 			return nsh, lastIndex + 1
 		default:
 		}
@@ -228,6 +237,7 @@ func (c *Phase01Controller) workerSendPhase0(ctx context.Context) (proofs.NodeSt
 	case <-ctx.Done():
 		return nil, -1
 	case nsh = <-nshChannel:
+		nshReceived = true
 		return nsh, 0
 	}
 }
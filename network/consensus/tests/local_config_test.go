@@ -60,6 +60,12 @@ import (
 
 const defaultNshGenerationDelay = time.Millisecond * 0
 
+/* Node state hash of some rounds is generated too late, so such rounds are cancelled */
+const (
+	cancelledRoundProbability = 0.05
+	cancelledRoundNshDelay    = time.Minute
+)
+
 var RoundTimingsFor1s = api.RoundTimings{
 	StartPhase0At: 100 * time.Millisecond, // Not scaled
 
@@ -53,8 +53,10 @@ package tests
 import (
 	"context"
 	"math/rand"
+	"sync"
 	"time"
 
+	"github.com/insolar/insolar/instrumentation/inslogger"
 	"github.com/insolar/insolar/network/consensus/common/cryptkit"
 	"github.com/insolar/insolar/network/consensus/common/longbits"
 	"github.com/insolar/insolar/network/consensus/common/pulse"
@@ -72,16 +74,29 @@ var _ api.UpstreamController = &EmuUpstreamPulseController{}
 type EmuUpstreamPulseController struct {
 	ctx      context.Context
 	nshDelay time.Duration
+
+	mutex     sync.Mutex
+	prepared  pulse.Number
+	cancelled int
 }
 
 func (r *EmuUpstreamPulseController) PreparePulseChange(report api.UpstreamReport) <-chan proofs.NodeStateHash {
+	r.mutex.Lock()
+	r.prepared = report.PulseNumber
+	r.mutex.Unlock()
+
+	nshDelay := r.nshDelay
+	if rand.Float32() < cancelledRoundProbability {
+		nshDelay = cancelledRoundNshDelay
+	}
+
 	c := make(chan proofs.NodeStateHash, 1)
 	nsh := NewEmuNodeStateHash(rand.Uint64())
-	if r.nshDelay == 0 {
+	if nshDelay == 0 {
 		c <- nsh
 		close(c)
 	} else {
-		time.AfterFunc(r.nshDelay, func() {
+		time.AfterFunc(nshDelay, func() {
 			c <- nsh
 			close(c)
 		})
@@ -89,10 +104,24 @@ func (r *EmuUpstreamPulseController) PreparePulseChange(report api.UpstreamRepor
 	return c
 }
 
-func (*EmuUpstreamPulseController) CommitPulseChange(report api.UpstreamReport, pd pulse.Data, activeCensus census.Operational) {
+func (r *EmuUpstreamPulseController) CommitPulseChange(report api.UpstreamReport, pd pulse.Data, activeCensus census.Operational) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.prepared = 0
 }
 
-func (*EmuUpstreamPulseController) CancelPulseChange() {
+func (r *EmuUpstreamPulseController) CancelPulseChange() {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.prepared == 0 {
+		inslogger.FromContext(r.ctx).Error("pulse change is cancelled, but was not prepared")
+		return
+	}
+	r.cancelled++
+	inslogger.FromContext(r.ctx).Infof("pulse change to %v is cancelled, total cancelled: %d", r.prepared, r.cancelled)
+	r.prepared = 0
 }
 
 func (*EmuUpstreamPulseController) ConsensusFinished(report api.UpstreamReport, expectedCensus census.Operational) {
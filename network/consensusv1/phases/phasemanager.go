@@ -62,6 +62,7 @@ import (
 	"github.com/insolar/insolar/instrumentation/inslogger"
 	"github.com/insolar/insolar/network"
 	"github.com/insolar/insolar/network/merkle"
+	"github.com/insolar/insolar/network/storage"
 )
 
 type PhaseManager interface {
@@ -73,8 +74,9 @@ type Phases struct {
 	SecondPhase SecondPhase `inject:""`
 	ThirdPhase  ThirdPhase  `inject:""`
 
-	NodeKeeper network.NodeKeeper `inject:""`
-	Calculator merkle.Calculator  `inject:""`
+	NodeKeeper        network.NodeKeeper        `inject:""`
+	Calculator        merkle.Calculator         `inject:""`
+	CloudHashAppender storage.CloudHashAppender `inject:""`
 
 	lastPulse insolar.PulseNumber
 	lock      sync.Mutex
@@ -151,6 +153,10 @@ func (pm *Phases) OnPulse(ctx context.Context, pulse *insolar.Pulse, pulseStartT
 		return errors.Wrap(err, "[ NET Consensus ] Error calculating cloud hash")
 	}
 	pm.NodeKeeper.SetCloudHash(hash)
+	err = pm.CloudHashAppender.Append(ctx, pulse.PulseNumber, hash)
+	if err != nil {
+		logger.Error("[ NET Consensus ] Failed to save cloud hash: ", err)
+	}
 
 	logger.Info("[ NET Consensus ] Done")
 
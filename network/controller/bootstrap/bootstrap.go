@@ -63,6 +63,7 @@ import (
 	"github.com/insolar/insolar/insolar/pulse"
 	"github.com/insolar/insolar/network/hostnetwork/packet"
 	"github.com/insolar/insolar/network/node"
+	"github.com/insolar/insolar/network/storage"
 	"github.com/insolar/insolar/network/utils"
 	"github.com/insolar/insolar/version"
 
@@ -83,6 +84,9 @@ import (
 
 // const updateScheduleETA time.Duration = 60 // seconds
 
+// pulseHistoryDepth is how many pulses before the latest one are compared by joiner and discovery node.
+const pulseHistoryDepth = 10
+
 var (
 	ErrReconnectRequired = errors.New("NetworkNode should connect via consensus bootstrap")
 )
@@ -113,6 +117,10 @@ type Bootstrap struct {
 	PulseAccessor pulse.Accessor              `inject:""`
 	Cryptography  insolar.CryptographyService `inject:""`
 
+	NetworkPulses    storage.PulseAccessor    `inject:""`
+	PulseCalculator  storage.PulseCalculator  `inject:""`
+	PulseRangeHasher storage.PulseRangeHasher `inject:""`
+
 	options *common.Options
 	pinger  *pinger.Pinger
 
@@ -496,6 +504,7 @@ func (bc *Bootstrap) startBootstrap(ctx context.Context, address string,
 		LastNodePulse: lastPulse.PulseNumber,
 		Permission:    perm,
 	}
+	bc.addPulseHistory(ctx, request)
 	future, err := bc.Network.SendRequestToHost(ctx, types.Bootstrap, request, bootstrapHost)
 	if err != nil {
 		return nil, errors.Wrapf(err, "Failed to send bootstrap request to address %s", address)
@@ -558,6 +567,10 @@ func (bc *Bootstrap) processBootstrap(ctx context.Context, request network.Recei
 		lastPulse = *insolar.GenesisPulse
 	}
 
+	if err := bc.checkPulseHistory(ctx, data); err != nil {
+		return bc.rejectBootstrapRequest(ctx, request, err.Error()), nil
+	}
+
 	var perm *packet.Permission
 	if bc.nodeShouldReconnectAsJoiner(data.JoinClaim.NodeRef) { //nolint
 		code = packet.ReconnectRequired
@@ -588,6 +601,56 @@ func (bc *Bootstrap) processBootstrap(ctx context.Context, request network.Recei
 		}), nil
 }
 
+// addPulseHistory attaches hash of the last pulses stored by the node, so discovery node can compare it with its own
+// history. Node without stored pulses sends no history.
+func (bc *Bootstrap) addPulseHistory(ctx context.Context, request *packet.BootstrapRequest) {
+	logger := inslogger.FromContext(ctx)
+
+	latest, err := bc.NetworkPulses.Latest(ctx)
+	if err != nil {
+		logger.Debug("No pulse history to send on bootstrap: ", err.Error())
+		return
+	}
+
+	history := insolar.PulseRange{Begin: latest.PulseNumber, End: latest.PulseNumber}
+	for i := 0; i < pulseHistoryDepth; i++ {
+		prev, err := bc.PulseCalculator.Backwards(ctx, history.Begin, 1)
+		if err != nil {
+			break
+		}
+		history.Begin = prev.PulseNumber
+	}
+
+	hash, err := bc.PulseRangeHasher.GetRangeHash(history)
+	if err != nil {
+		logger.Warn("Failed to calculate pulse history hash: ", err.Error())
+		return
+	}
+
+	request.HistoryBegin = history.Begin
+	request.HistoryEnd = history.End
+	request.HistoryHash = hash
+}
+
+// checkPulseHistory compares pulse history of the joiner with the local one. History is accepted if the joiner has
+// none or local storage doesn't cover it; it is rejected only if both nodes have the same range with different pulses.
+func (bc *Bootstrap) checkPulseHistory(ctx context.Context, data *packet.BootstrapRequest) error {
+	if len(data.HistoryHash) == 0 {
+		return nil
+	}
+
+	history := insolar.PulseRange{Begin: data.HistoryBegin, End: data.HistoryEnd}
+	ok, err := bc.PulseRangeHasher.ValidateRangeHash(history, data.HistoryHash)
+	if err != nil {
+		inslogger.FromContext(ctx).Debugf("Can't compare pulse history %s of joiner: %s", history.String(), err.Error())
+		return nil
+	}
+	if !ok {
+		return errors.Errorf("pulse history %s of joiner differs from history of discovery node", history.String())
+	}
+	return nil
+}
+
 func (bc *Bootstrap) rejectBootstrapRequest(ctx context.Context, request network.Packet, reason string) network.Packet {
 	inslogger.FromContext(ctx).Errorf("Rejected bootstrap request from node %s: %s", request.GetSender(), reason)
 	return bc.Network.BuildResponse(ctx, request, &packet.BootstrapResponse{Code: packet.Rejected, RejectReason: reason})
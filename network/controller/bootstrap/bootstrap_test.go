@@ -0,0 +1,156 @@
+//
+// Modified BSD 3-Clause Clear License
+//
+// Copyright (c) 2019 Insolar Technologies GmbH
+//
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without modification,
+// are permitted (subject to the limitations in the disclaimer below) provided that
+// the following conditions are met:
+//  * Redistributions of source code must retain the above copyright notice, this list
+//    of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright notice, this list
+//    of conditions and the following disclaimer in the documentation and/or other materials
+//    provided with the distribution.
+//  * Neither the name of Insolar Technologies GmbH nor the names of its contributors
+//    may be used to endorse or promote products derived from this software without
+//    specific prior written permission.
+//
+// NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED
+// BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS
+// AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES,
+// INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL
+// THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT,
+// INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+// BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS
+// OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+// ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+//
+// Notwithstanding any other provisions of this license, it is prohibited to:
+//    (a) use this software,
+//
+//    (b) prepare modifications and derivative works of this software,
+//
+//    (c) distribute this software (including without limitation in source code, binary or
+//        object code form), and
+//
+//    (d) reproduce copies of this software
+//
+//    for any commercial purposes, and/or
+//
+//    for the purposes of making available this software to third parties as a service,
+//    including, without limitation, any software-as-a-service, platform-as-a-service,
+//    infrastructure-as-a-service or other similar online service, irrespective of
+//    whether it competes with the products or services of Insolar Technologies GmbH.
+//
+
+package bootstrap
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/network/hostnetwork/packet"
+	"github.com/insolar/insolar/network/storage"
+	networkUtils "github.com/insolar/insolar/testutils/network"
+)
+
+func TestBootstrap_addPulseHistory(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	latest := insolar.PulseNumber(insolar.FirstPulseNumber + 100)
+	first := insolar.PulseNumber(insolar.FirstPulseNumber + 70)
+
+	t.Run("no pulses", func(t *testing.T) {
+		pulses := networkUtils.NewPulseAccessorMock(t)
+		pulses.LatestMock.Return(insolar.Pulse{}, storage.ErrNotFound)
+		bc := &Bootstrap{NetworkPulses: pulses}
+
+		request := &packet.BootstrapRequest{}
+		bc.addPulseHistory(ctx, request)
+		assert.Empty(t, request.HistoryHash)
+	})
+
+	t.Run("history is shorter than depth", func(t *testing.T) {
+		pulses := networkUtils.NewPulseAccessorMock(t)
+		pulses.LatestMock.Return(insolar.Pulse{PulseNumber: latest}, nil)
+		calc := networkUtils.NewPulseCalculatorMock(t)
+		calc.BackwardsMock.Set(func(_ context.Context, pn insolar.PulseNumber, steps int) (insolar.Pulse, error) {
+			require.Equal(t, 1, steps)
+			if pn == first {
+				return insolar.Pulse{}, storage.ErrNotFound
+			}
+			return insolar.Pulse{PulseNumber: pn - 10}, nil
+		})
+		hasher := networkUtils.NewPulseRangeHasherMock(t)
+		hasher.GetRangeHashMock.Expect(insolar.PulseRange{Begin: first, End: latest}).Return([]byte{1, 2, 3}, nil)
+		bc := &Bootstrap{NetworkPulses: pulses, PulseCalculator: calc, PulseRangeHasher: hasher}
+
+		request := &packet.BootstrapRequest{}
+		bc.addPulseHistory(ctx, request)
+		assert.Equal(t, first, request.HistoryBegin)
+		assert.Equal(t, latest, request.HistoryEnd)
+		assert.Equal(t, []byte{1, 2, 3}, request.HistoryHash)
+	})
+
+	t.Run("history is limited by depth", func(t *testing.T) {
+		pulses := networkUtils.NewPulseAccessorMock(t)
+		pulses.LatestMock.Return(insolar.Pulse{PulseNumber: latest}, nil)
+		calc := networkUtils.NewPulseCalculatorMock(t)
+		calc.BackwardsMock.Set(func(_ context.Context, pn insolar.PulseNumber, steps int) (insolar.Pulse, error) {
+			return insolar.Pulse{PulseNumber: pn - 1}, nil
+		})
+		hasher := networkUtils.NewPulseRangeHasherMock(t)
+		hasher.GetRangeHashMock.Expect(insolar.PulseRange{Begin: latest - pulseHistoryDepth, End: latest}).Return([]byte{1}, nil)
+		bc := &Bootstrap{NetworkPulses: pulses, PulseCalculator: calc, PulseRangeHasher: hasher}
+
+		request := &packet.BootstrapRequest{}
+		bc.addPulseHistory(ctx, request)
+		assert.Equal(t, latest-pulseHistoryDepth, request.HistoryBegin)
+		assert.Equal(t, uint64(pulseHistoryDepth), calc.BackwardsCounter)
+	})
+}
+
+func TestBootstrap_checkPulseHistory(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	history := insolar.PulseRange{Begin: insolar.FirstPulseNumber, End: insolar.FirstPulseNumber + 10}
+	request := &packet.BootstrapRequest{
+		HistoryBegin: history.Begin,
+		HistoryEnd:   history.End,
+		HistoryHash:  []byte{1, 2, 3},
+	}
+
+	t.Run("no history", func(t *testing.T) {
+		bc := &Bootstrap{PulseRangeHasher: networkUtils.NewPulseRangeHasherMock(t)}
+		assert.NoError(t, bc.checkPulseHistory(ctx, &packet.BootstrapRequest{}))
+	})
+
+	t.Run("same history", func(t *testing.T) {
+		hasher := networkUtils.NewPulseRangeHasherMock(t)
+		hasher.ValidateRangeHashMock.Expect(history, request.HistoryHash).Return(true, nil)
+		bc := &Bootstrap{PulseRangeHasher: hasher}
+		assert.NoError(t, bc.checkPulseHistory(ctx, request))
+	})
+
+	t.Run("unknown history", func(t *testing.T) {
+		hasher := networkUtils.NewPulseRangeHasherMock(t)
+		hasher.ValidateRangeHashMock.Return(false, storage.ErrNotFound)
+		bc := &Bootstrap{PulseRangeHasher: hasher}
+		assert.NoError(t, bc.checkPulseHistory(ctx, request))
+	})
+
+	t.Run("different history", func(t *testing.T) {
+		hasher := networkUtils.NewPulseRangeHasherMock(t)
+		hasher.ValidateRangeHashMock.Return(false, nil)
+		bc := &Bootstrap{PulseRangeHasher: hasher}
+		assert.Error(t, bc.checkPulseHistory(ctx, request))
+	})
+}
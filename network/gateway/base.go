@@ -58,6 +58,7 @@ import (
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/log"
 	"github.com/insolar/insolar/network"
+	"github.com/insolar/insolar/network/controller"
 )
 
 // Base is abstract class for gateways
@@ -71,6 +72,7 @@ type Base struct {
 	CryptographyService insolar.CryptographyService
 	CertificateManager  insolar.CertificateManager
 	PulseManager        insolar.PulseManager
+	RPC                 controller.RPCController
 }
 
 // NewGateway creates new gateway on top of existing
@@ -176,7 +176,7 @@ func TestComplete_GetCert(t *testing.T) {
 	cs := mockCryptographyService(t, true)
 	pm := mockPulseManager(t)
 
-	ge := NewNoNetwork(gatewayer, pm, nodekeeper, cr, cs, hn, cm)
+	ge := NewNoNetwork(gatewayer, pm, nodekeeper, cr, cs, hn, cm, network.NewRPCControllerMock(t))
 	ge = ge.NewGateway(insolar.CompleteNetworkState)
 	ctx := context.Background()
 	result, err := ge.Auther().GetCert(ctx, &nodeRef)
@@ -214,7 +214,7 @@ func TestComplete_handler(t *testing.T) {
 
 	hn := network.NewHostNetworkMock(t)
 
-	ge := NewNoNetwork(gatewayer, pm, nodekeeper, cr, cs, hn, cm)
+	ge := NewNoNetwork(gatewayer, pm, nodekeeper, cr, cs, hn, cm, network.NewRPCControllerMock(t))
 	ge = ge.NewGateway(insolar.CompleteNetworkState)
 	ctx := context.Background()
 
@@ -71,7 +71,7 @@ func emtygateway(t *testing.T) network.Gateway {
 	return NewNoNetwork(testnet.NewGatewayerMock(t), mockPulseManager(t),
 		testnet.NewNodeKeeperMock(t), testutils.NewContractRequesterMock(t),
 		testutils.NewCryptographyServiceMock(t), testnet.NewHostNetworkMock(t),
-		testutils.NewCertificateManagerMock(t))
+		testutils.NewCertificateManagerMock(t), testnet.NewRPCControllerMock(t))
 }
 
 func TestSwitch(t *testing.T) {
@@ -85,7 +85,7 @@ func TestSwitch(t *testing.T) {
 	ge := NewNoNetwork(gatewayer, pm,
 		nodekeeper, testutils.NewContractRequesterMock(t),
 		testutils.NewCryptographyServiceMock(t), testnet.NewHostNetworkMock(t),
-		testutils.NewCertificateManagerMock(t))
+		testutils.NewCertificateManagerMock(t), testnet.NewRPCControllerMock(t))
 
 	require.NotNil(t, ge)
 	require.Equal(t, "NoNetworkState", ge.GetState().String())
@@ -104,7 +104,7 @@ func TestSwitch(t *testing.T) {
 	cref := testutils.RandomRef()
 
 	for _, state := range []insolar.NetworkState{insolar.NoNetworkState,
-		insolar.AuthorizationNetworkState, insolar.JetlessNetworkState, insolar.VoidNetworkState} {
+		insolar.AuthorizationNetworkState, insolar.VoidNetworkState} {
 		ge = ge.NewGateway(state)
 		require.Equal(t, state, ge.GetState())
 		ge.Run(ctx)
@@ -137,7 +137,7 @@ func TestDumbComplete_GetCert(t *testing.T) {
 		nodekeeper, CR,
 		testutils.NewCryptographyServiceMock(t),
 		testnet.NewHostNetworkMock(t),
-		CM)
+		CM, testnet.NewRPCControllerMock(t))
 
 	require.NotNil(t, ge)
 	require.Equal(t, "NoNetworkState", ge.GetState().String())
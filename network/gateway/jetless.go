@@ -52,21 +52,170 @@ package gateway
 
 import (
 	"context"
+	"sync"
+
+	"github.com/pkg/errors"
 
 	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/network"
 )
 
 func NewJetless(b *Base) *Jetless {
 	return &Jetless{Base: b}
 }
 
+// Jetless is a network state in which every jet needs proof of stored data completeness.
+// Gateway requests hashes of jet drops for the last finalized pulse from heavy nodes, checks that light nodes
+// store the same drops and switches network to CompleteNetworkState when all drops are confirmed.
+// Failed verification is retried on the next pulse.
 type Jetless struct {
 	*Base
+
+	lock      sync.RWMutex
+	verifying bool
+	progress  network.VerificationProgress
 }
 
 func (g *Jetless) Run(ctx context.Context) {
+	g.startVerification(ctx)
 }
 
 func (g *Jetless) GetState() insolar.NetworkState {
 	return insolar.JetlessNetworkState
 }
+
+func (g *Jetless) OnPulse(ctx context.Context, pu insolar.Pulse) error {
+	g.startVerification(ctx)
+	return nil
+}
+
+// VerificationProgress returns progress of stored data verification.
+func (g *Jetless) VerificationProgress() network.VerificationProgress {
+	g.lock.RLock()
+	defer g.lock.RUnlock()
+
+	return g.progress
+}
+
+func (g *Jetless) startVerification(ctx context.Context) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	if g.verifying {
+		return
+	}
+	g.verifying = true
+	g.progress.Error = ""
+
+	// verification outlives pulse processing, so only logger is taken from the context
+	ctx = inslogger.SetLogger(context.Background(), inslogger.FromContext(ctx))
+	go func() {
+		err := g.verify(ctx)
+
+		g.lock.Lock()
+		g.verifying = false
+		if err != nil {
+			g.progress.Error = err.Error()
+		}
+		g.lock.Unlock()
+
+		if err != nil {
+			inslogger.FromContext(ctx).Warn("Gateway.Jetless: stored data verification failed: ", err)
+			return
+		}
+
+		inslogger.FromContext(ctx).Info("Gateway.Jetless: stored data is verified")
+		if g.Network.Gateway().GetState() == insolar.JetlessNetworkState {
+			g.Network.SetGateway(g.Network.Gateway().NewGateway(insolar.CompleteNetworkState))
+		}
+	}()
+}
+
+func (g *Jetless) verify(ctx context.Context) error {
+	accessor := g.Nodekeeper.GetAccessor()
+
+	hashes, err := g.requestDropHashes(ctx, accessor.GetWorkingNodesByRole(insolar.DynamicRoleHeavyExecutor))
+	if err != nil {
+		return errors.Wrap(err, "failed to get jet drop hashes")
+	}
+
+	pending := make(map[insolar.JetID]struct{}, len(hashes.Hashes))
+	for _, h := range hashes.Hashes {
+		pending[h.JetID] = struct{}{}
+	}
+	g.setProgress(hashes.Pulse, len(pending), 0)
+
+	for _, light := range accessor.GetWorkingNodesByRole(insolar.DynamicRoleLightExecutor) {
+		if len(pending) == 0 {
+			break
+		}
+
+		confirmation, err := g.confirmDrops(ctx, light, hashes)
+		if err != nil {
+			inslogger.FromContext(ctx).Warnf("Gateway.Jetless: failed to confirm drops on node %s: %s", light, err)
+			continue
+		}
+		for _, jetID := range confirmation.Confirmed {
+			delete(pending, jetID)
+		}
+		g.setProgress(hashes.Pulse, len(hashes.Hashes), len(hashes.Hashes)-len(pending))
+	}
+
+	if len(pending) > 0 {
+		return errors.Errorf("%d of %d jet drops for pulse %d are not confirmed by light nodes",
+			len(pending), len(hashes.Hashes), hashes.Pulse)
+	}
+	return nil
+}
+
+func (g *Jetless) setProgress(pn insolar.PulseNumber, jets, confirmed int) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	g.progress.Pulse = pn
+	g.progress.Jets = jets
+	g.progress.Confirmed = confirmed
+}
+
+// requestDropHashes returns drop hashes from the first heavy node which replied.
+func (g *Jetless) requestDropHashes(ctx context.Context, heavies []insolar.Reference) (jet.DropHashes, error) {
+	if len(heavies) == 0 {
+		return jet.DropHashes{}, errors.New("no working heavy nodes")
+	}
+
+	var lastErr error
+	for _, heavy := range heavies {
+		res, err := g.RPC.SendBytes(ctx, heavy, jet.DropHashesProcedure, nil)
+		if err != nil {
+			lastErr = errors.Wrapf(err, "failed to request node %s", heavy)
+			continue
+		}
+		var hashes jet.DropHashes
+		err = insolar.Deserialize(res, &hashes)
+		if err != nil {
+			lastErr = errors.Wrapf(err, "failed to deserialize reply of node %s", heavy)
+			continue
+		}
+		return hashes, nil
+	}
+	return jet.DropHashes{}, lastErr
+}
+
+func (g *Jetless) confirmDrops(ctx context.Context, light insolar.Reference, hashes jet.DropHashes) (jet.DropsConfirmation, error) {
+	args, err := insolar.Serialize(hashes)
+	if err != nil {
+		return jet.DropsConfirmation{}, errors.Wrap(err, "failed to serialize drop hashes")
+	}
+	res, err := g.RPC.SendBytes(ctx, light, jet.ConfirmDropsProcedure, args)
+	if err != nil {
+		return jet.DropsConfirmation{}, err
+	}
+	var confirmation jet.DropsConfirmation
+	err = insolar.Deserialize(res, &confirmation)
+	if err != nil {
+		return jet.DropsConfirmation{}, errors.Wrap(err, "failed to deserialize confirmation")
+	}
+	return confirmation, nil
+}
@@ -0,0 +1,191 @@
+//
+// Modified BSD 3-Clause Clear License
+//
+// Copyright (c) 2019 Insolar Technologies GmbH
+//
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without modification,
+// are permitted (subject to the limitations in the disclaimer below) provided that
+// the following conditions are met:
+//  * Redistributions of source code must retain the above copyright notice, this list
+//    of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright notice, this list
+//    of conditions and the following disclaimer in the documentation and/or other materials
+//    provided with the distribution.
+//  * Neither the name of Insolar Technologies GmbH nor the names of its contributors
+//    may be used to endorse or promote products derived from this software without
+//    specific prior written permission.
+//
+// NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED
+// BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS
+// AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES,
+// INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL
+// THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT,
+// INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+// BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS
+// OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+// ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+//
+// Notwithstanding any other provisions of this license, it is prohibited to:
+//    (a) use this software,
+//
+//    (b) prepare modifications and derivative works of this software,
+//
+//    (c) distribute this software (including without limitation in source code, binary or
+//        object code form), and
+//
+//    (d) reproduce copies of this software
+//
+//    for any commercial purposes, and/or
+//
+//    for the purposes of making available this software to third parties as a service,
+//    including, without limitation, any software-as-a-service, platform-as-a-service,
+//    infrastructure-as-a-service or other similar online service, irrespective of
+//    whether it competes with the products or services of Insolar Technologies GmbH.
+//
+
+package gateway
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/network"
+	"github.com/insolar/insolar/testutils"
+	testnet "github.com/insolar/insolar/testutils/network"
+)
+
+type jetlessNodes struct {
+	heavy  insolar.Reference
+	lights []insolar.Reference
+}
+
+func newJetless(t *testing.T, nodes jetlessNodes, rpc *testnet.RPCControllerMock) (*Jetless, *testnet.GatewayerMock) {
+	accessor := testnet.NewAccessorMock(t)
+	accessor.GetWorkingNodesByRoleFunc = func(role insolar.DynamicRole) []insolar.Reference {
+		switch role {
+		case insolar.DynamicRoleHeavyExecutor:
+			if nodes.heavy.IsEmpty() {
+				return nil
+			}
+			return []insolar.Reference{nodes.heavy}
+		case insolar.DynamicRoleLightExecutor:
+			return nodes.lights
+		}
+		return nil
+	}
+	nodekeeper := testnet.NewNodeKeeperMock(t)
+	nodekeeper.GetAccessorMock.Return(accessor)
+	gatewayer := testnet.NewGatewayerMock(t)
+
+	b := &Base{Network: gatewayer, Nodekeeper: nodekeeper, RPC: rpc}
+	return b.NewGateway(insolar.JetlessNetworkState).(*Jetless), gatewayer
+}
+
+func mockDropsRPC(t *testing.T, nodes jetlessNodes, hashes jet.DropHashes, stored map[insolar.Reference][]insolar.JetID) *testnet.RPCControllerMock {
+	rpc := testnet.NewRPCControllerMock(t)
+	rpc.SendBytesFunc = func(_ context.Context, node insolar.Reference, method string, args []byte) ([]byte, error) {
+		switch method {
+		case jet.DropHashesProcedure:
+			require.Equal(t, nodes.heavy, node)
+			return insolar.Serialize(hashes)
+		case jet.ConfirmDropsProcedure:
+			var requested jet.DropHashes
+			require.NoError(t, insolar.Deserialize(args, &requested))
+			require.Equal(t, hashes, requested)
+			jets, ok := stored[node]
+			if !ok {
+				return nil, errors.New("node is unavailable")
+			}
+			return insolar.Serialize(jet.DropsConfirmation{Confirmed: jets})
+		}
+		t.Fatalf("unexpected method %s", method)
+		return nil, nil
+	}
+	return rpc
+}
+
+func TestJetless_verify(t *testing.T) {
+	ctx := context.Background()
+	nodes := jetlessNodes{
+		heavy:  testutils.RandomRef(),
+		lights: []insolar.Reference{testutils.RandomRef(), testutils.RandomRef()},
+	}
+	left, right := jet.NewIDFromString("0"), jet.NewIDFromString("1")
+	hashes := jet.DropHashes{
+		Pulse: insolar.GenesisPulse.PulseNumber + 10,
+		Hashes: []jet.DropHash{
+			{JetID: left, Hash: []byte{1}},
+			{JetID: right, Hash: []byte{2}},
+		},
+	}
+
+	t.Run("all drops confirmed", func(t *testing.T) {
+		rpc := mockDropsRPC(t, nodes, hashes, map[insolar.Reference][]insolar.JetID{
+			nodes.lights[0]: {left},
+			nodes.lights[1]: {right},
+		})
+		g, _ := newJetless(t, nodes, rpc)
+
+		require.NoError(t, g.verify(ctx))
+		require.Equal(t, network.VerificationProgress{Pulse: hashes.Pulse, Jets: 2, Confirmed: 2}, g.VerificationProgress())
+	})
+
+	t.Run("unavailable light node", func(t *testing.T) {
+		rpc := mockDropsRPC(t, nodes, hashes, map[insolar.Reference][]insolar.JetID{
+			nodes.lights[1]: {right},
+		})
+		g, _ := newJetless(t, nodes, rpc)
+
+		require.Error(t, g.verify(ctx))
+		require.Equal(t, network.VerificationProgress{Pulse: hashes.Pulse, Jets: 2, Confirmed: 1}, g.VerificationProgress())
+	})
+
+	t.Run("no heavy nodes", func(t *testing.T) {
+		g, _ := newJetless(t, jetlessNodes{}, testnet.NewRPCControllerMock(t))
+
+		require.Error(t, g.verify(ctx))
+	})
+}
+
+func TestJetless_SwitchesToComplete(t *testing.T) {
+	ctx := context.Background()
+	nodes := jetlessNodes{
+		heavy:  testutils.RandomRef(),
+		lights: []insolar.Reference{testutils.RandomRef()},
+	}
+	hashes := jet.DropHashes{
+		Pulse:  insolar.GenesisPulse.PulseNumber + 10,
+		Hashes: []jet.DropHash{{JetID: jet.NewIDFromString("0"), Hash: []byte{1}}},
+	}
+	rpc := mockDropsRPC(t, nodes, hashes, map[insolar.Reference][]insolar.JetID{
+		nodes.lights[0]: {jet.NewIDFromString("0")},
+	})
+	g, gatewayer := newJetless(t, nodes, rpc)
+
+	switched := make(chan insolar.NetworkState, 1)
+	gatewayer.GatewayMock.Return(g)
+	gatewayer.SetGatewayFunc = func(ng network.Gateway) {
+		switched <- ng.GetState()
+	}
+
+	g.Run(ctx)
+
+	select {
+	case state := <-switched:
+		require.Equal(t, insolar.CompleteNetworkState, state)
+	case <-time.After(5 * time.Second):
+		t.Fatal("network is not switched to complete state")
+	}
+	require.Empty(t, g.VerificationProgress().Error)
+}
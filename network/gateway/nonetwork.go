@@ -57,6 +57,7 @@ import (
 
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/network"
+	"github.com/insolar/insolar/network/controller"
 )
 
 // TODO Slightly ugly, decide how to inject anything without exporting Base
@@ -65,12 +66,12 @@ import (
 func NewNoNetwork(n network.Gatewayer, pm insolar.PulseManager,
 	nk network.NodeKeeper, cr insolar.ContractRequester,
 	cs insolar.CryptographyService, hn network.HostNetwork,
-	cm insolar.CertificateManager) network.Gateway {
+	cm insolar.CertificateManager, rpc controller.RPCController) network.Gateway {
 	return (&Base{
 		Network: n, PulseManager: pm,
 		Nodekeeper: nk, ContractRequester: cr,
 		CryptographyService: cs, HostNetwork: hn,
-		CertificateManager: cm,
+		CertificateManager: cm, RPC: rpc,
 	}).NewGateway(insolar.NoNetworkState)
 }
 
@@ -1166,6 +1166,9 @@ type BootstrapRequest struct {
 	JoinClaim     *github_com_insolar_insolar_network_consensusv1_packets.NodeJoinClaim `protobuf:"bytes,1,opt,name=JoinClaim,proto3,customtype=github.com/insolar/insolar/network/consensusv1/packets.NodeJoinClaim" json:"JoinClaim,omitempty"`
 	LastNodePulse github_com_insolar_insolar_insolar.PulseNumber                        `protobuf:"varint,2,opt,name=LastNodePulse,proto3,customtype=github.com/insolar/insolar/insolar.PulseNumber" json:"LastNodePulse"`
 	Permission    *Permission                                                           `protobuf:"bytes,3,opt,name=Permission,proto3" json:"Permission,omitempty"`
+	HistoryBegin  github_com_insolar_insolar_insolar.PulseNumber                        `protobuf:"varint,4,opt,name=HistoryBegin,proto3,customtype=github.com/insolar/insolar/insolar.PulseNumber" json:"HistoryBegin"`
+	HistoryEnd    github_com_insolar_insolar_insolar.PulseNumber                        `protobuf:"varint,5,opt,name=HistoryEnd,proto3,customtype=github.com/insolar/insolar/insolar.PulseNumber" json:"HistoryEnd"`
+	HistoryHash   []byte                                                                `protobuf:"bytes,6,opt,name=HistoryHash,proto3" json:"HistoryHash,omitempty"`
 }
 
 func (m *BootstrapRequest) Reset()      { *m = BootstrapRequest{} }
@@ -1796,105 +1799,107 @@ func init() {
 }
 
 var fileDescriptor_c3f826366adfd81c = []byte{
-	// 1557 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4f, 0x6f, 0xdb, 0xc6,
-	0x12, 0x17, 0x2d, 0x59, 0xb2, 0x26, 0x92, 0x4d, 0xef, 0xcb, 0x1f, 0x3a, 0x78, 0xa0, 0x05, 0x22,
-	0x2f, 0xd1, 0xcb, 0x4b, 0x94, 0x57, 0x27, 0x0d, 0x62, 0x34, 0x40, 0x61, 0xd9, 0x69, 0xed, 0x34,
-	0x35, 0x84, 0x95, 0xd3, 0x06, 0x68, 0x7b, 0xa0, 0xc9, 0xb5, 0xcc, 0x46, 0xe6, 0x2a, 0x4b, 0x2a,
-	0x85, 0xdb, 0x4b, 0x6f, 0x05, 0x8a, 0x1e, 0xfa, 0x31, 0x7a, 0xed, 0xb7, 0x08, 0x50, 0xa0, 0x48,
-	0x6f, 0x81, 0x51, 0x18, 0xb5, 0x73, 0xe9, 0x31, 0xb7, 0xf6, 0x58, 0xec, 0x72, 0xc9, 0x5d, 0x4a,
-	0x4e, 0xe2, 0x26, 0xe9, 0xc5, 0xda, 0xfd, 0xed, 0xcc, 0xec, 0xee, 0xfc, 0x66, 0x66, 0x87, 0x86,
-	0x0b, 0x21, 0x89, 0xbf, 0xa0, 0xec, 0xfe, 0x95, 0x6d, 0x1a, 0xc5, 0xe9, 0x78, 0xe0, 0x7a, 0xf7,
-	0x49, 0x2c, 0x7f, 0x5a, 0x03, 0x46, 0x63, 0x8a, 0xca, 0xc9, 0xec, 0xec, 0xe5, 0x5e, 0x10, 0x6f,
-	0x0f, 0x37, 0x5b, 0x1e, 0xdd, 0xb9, 0xd2, 0xa3, 0x3d, 0x7a, 0x45, 0x2c, 0x6f, 0x0e, 0xb7, 0xc4,
-	0x4c, 0x4c, 0xc4, 0x28, 0x51, 0x3b, 0x7b, 0x4d, 0x13, 0x0f, 0xc2, 0x88, 0xf6, 0x5d, 0x36, 0xf6,
-	0x3b, 0x18, 0xf6, 0x23, 0x92, 0xfc, 0x4d, 0xb4, 0x9c, 0x6f, 0x8b, 0x50, 0xee, 0x88, 0xfd, 0xd0,
-	0xbf, 0xa1, 0x3a, 0xa0, 0xfd, 0xdd, 0x1d, 0xca, 0x06, 0xdb, 0x96, 0xd9, 0x30, 0x9a, 0x93, 0x58,
-	0x01, 0x68, 0x03, 0xca, 0x5d, 0x12, 0xfa, 0x84, 0x59, 0x27, 0x1b, 0x46, 0xb3, 0xd6, 0xbe, 0xb9,
-	0xb7, 0x3f, 0x7f, 0xe3, 0x05, 0x5b, 0x1e, 0x75, 0x5b, 0x3e, 0x6e, 0xad, 0xd2, 0x28, 0xc6, 0xd2,
-	0x16, 0xba, 0x07, 0x53, 0x98, 0x78, 0x24, 0x78, 0x48, 0x98, 0x75, 0xea, 0x0d, 0xd8, 0xcd, 0xac,
-	0xf1, 0xdb, 0x60, 0xf2, 0x60, 0x48, 0xa2, 0x78, 0x6d, 0xc5, 0x3a, 0xdd, 0x30, 0x9a, 0x25, 0xac,
-	0x00, 0x64, 0x41, 0x65, 0x83, 0xb9, 0x1e, 0x59, 0x5b, 0xb1, 0xce, 0x34, 0x8c, 0x66, 0x15, 0xa7,
-	0x53, 0x84, 0xa0, 0xb4, 0xb1, 0x3b, 0x20, 0x96, 0xd5, 0x30, 0x9a, 0x75, 0x2c, 0xc6, 0xe8, 0x7f,
-	0x50, 0x91, 0xaa, 0xd6, 0x5c, 0xc3, 0x68, 0x9e, 0x58, 0x98, 0x69, 0x49, 0xc6, 0x24, 0xbc, 0x5a,
-	0xc0, 0xa9, 0x04, 0x6a, 0xf1, 0x2b, 0x45, 0x03, 0x1a, 0x46, 0xc4, 0x3a, 0x2b, 0xa4, 0x4d, 0x25,
-	0x9d, 0xe0, 0xab, 0x05, 0x9c, 0xc9, 0xb4, 0xab, 0x50, 0xe9, 0xb8, 0xbb, 0x7d, 0xea, 0xfa, 0xce,
-	0x93, 0x62, 0xb6, 0x11, 0x72, 0xa0, 0xd4, 0x09, 0xc2, 0x9e, 0x65, 0x08, 0x13, 0xb5, 0xd4, 0x04,
-	0xc7, 0x56, 0x0b, 0x58, 0xac, 0xa1, 0xf3, 0x50, 0xc4, 0x9d, 0x65, 0x6b, 0x42, 0x88, 0xa0, 0x6c,
-	0x97, 0xce, 0xb2, 0x3a, 0x16, 0x17, 0x40, 0x0b, 0x50, 0x59, 0x76, 0x23, 0xcf, 0xf5, 0x89, 0x55,
-	0x14, 0xb2, 0xa7, 0x53, 0x59, 0x09, 0x6b, 0xd7, 0x90, 0x08, 0xba, 0x04, 0x93, 0x1d, 0x1e, 0x27,
-	0x56, 0x49, 0x68, 0x9c, 0xcc, 0x0e, 0xc0, 0x41, 0x25, 0x9f, 0x08, 0xa1, 0x1b, 0x50, 0x6d, 0x53,
-	0x1a, 0x47, 0x31, 0x73, 0x07, 0xd6, 0xa4, 0xd0, 0xb0, 0x52, 0x8d, 0x6c, 0x41, 0x69, 0x29, 0x61,
-	0xae, 0xb9, 0x34, 0x8c, 0xb7, 0x29, 0x0b, 0xbe, 0x24, 0x56, 0x39, 0xaf, 0x99, 0x2d, 0x68, 0x9a,
-	0x19, 0x86, 0xde, 0xe6, 0x8e, 0xee, 0x05, 0x51, 0x4c, 0x98, 0x55, 0x11, 0x8a, 0x67, 0x94, 0xa3,
-	0x13, 0x5c, 0xe9, 0x65, 0xa2, 0xdc, 0x19, 0xef, 0x93, 0x90, 0x44, 0x41, 0x64, 0x4d, 0xe5, 0x9d,
-	0x21, 0x61, 0xcd, 0x19, 0x12, 0xe1, 0x5b, 0x75, 0x83, 0x5e, 0xb8, 0x4c, 0x58, 0x6c, 0x55, 0xf3,
-	0x5b, 0xa5, 0xb8, 0xb6, 0x55, 0x0a, 0x71, 0x6a, 0x25, 0xec, 0xfc, 0x5a, 0x54, 0x61, 0x71, 0x2c,
-	0x6e, 0x2f, 0xe8, 0xdc, 0xfe, 0x2b, 0xc7, 0x6d, 0x16, 0x44, 0x82, 0xdc, 0xcb, 0x30, 0xd9, 0x76,
-	0xa3, 0xc0, 0x93, 0xd4, 0x9e, 0xca, 0xdc, 0xce, 0x41, 0x4d, 0x38, 0x91, 0x42, 0x8b, 0x3a, 0x53,
-	0x09, 0xb7, 0x73, 0x47, 0x30, 0x95, 0xa9, 0x69, 0x54, 0x2d, 0xea, 0x54, 0x4d, 0xe6, 0x55, 0x35,
-	0xaa, 0x94, 0xaa, 0xe2, 0xea, 0xba, 0xc6, 0xd5, 0x08, 0xc9, 0x8a, 0x2b, 0x3d, 0x39, 0x24, 0x59,
-	0x57, 0x15, 0x59, 0x23, 0x14, 0x67, 0x64, 0x65, 0x5a, 0x19, 0x5b, 0xd7, 0x35, 0xb6, 0xa6, 0xf2,
-	0x9b, 0x29, 0xb6, 0xd4, 0x66, 0x29, 0xc6, 0x3d, 0x79, 0x8b, 0x31, 0xca, 0x24, 0xc5, 0x99, 0x27,
-	0x05, 0xa8, 0x7b, 0x52, 0x00, 0x6d, 0x50, 0x8c, 0x3a, 0xe5, 0x84, 0x51, 0xe7, 0x06, 0x80, 0x4a,
-	0x3f, 0x74, 0x1a, 0xca, 0x1f, 0x92, 0x78, 0x9b, 0xfa, 0x82, 0xe9, 0x2a, 0x96, 0x33, 0x5e, 0x63,
-	0x56, 0xdc, 0xd8, 0x15, 0xe4, 0xd6, 0xb0, 0x18, 0x3b, 0xbf, 0x18, 0x59, 0x92, 0xa2, 0xdb, 0x50,
-	0x59, 0xa7, 0x3e, 0x59, 0xf3, 0x23, 0xcb, 0x68, 0x14, 0x9b, 0xb5, 0xf6, 0xff, 0xf7, 0xf6, 0xe7,
-	0x2f, 0xbd, 0xbc, 0xbe, 0xb7, 0x30, 0xd9, 0x22, 0x8c, 0x84, 0x1e, 0xc1, 0xa9, 0x01, 0x74, 0x07,
-	0x2a, 0xb7, 0xc2, 0x98, 0xd1, 0xc1, 0x6e, 0xb2, 0x5d, 0x7b, 0xe1, 0xd1, 0xfe, 0x7c, 0x61, 0x6f,
-	0x7f, 0xfe, 0xe2, 0x31, 0xec, 0x49, 0x4d, 0x9c, 0x9a, 0x40, 0x97, 0x60, 0x16, 0x93, 0x41, 0x3f,
-	0xf0, 0xdc, 0x38, 0xa0, 0xe1, 0x7b, 0xae, 0x17, 0x53, 0x26, 0x02, 0xaf, 0x8e, 0xc7, 0x17, 0x9c,
-	0xaf, 0x60, 0x3a, 0x5f, 0x60, 0xf4, 0xba, 0x6b, 0xe4, 0xeb, 0xee, 0xb9, 0x97, 0xd4, 0xb2, 0x24,
-	0xd8, 0xff, 0x3b, 0x5a, 0xc9, 0x66, 0x46, 0x2b, 0x59, 0xba, 0xee, 0x7c, 0x06, 0x35, 0xbd, 0x56,
-	0xa1, 0x0b, 0x69, 0x41, 0x4b, 0xb2, 0x6e, 0xb6, 0x95, 0x3c, 0x83, 0x02, 0xeb, 0xf0, 0xb7, 0x30,
-	0xad, 0x65, 0xe7, 0xa0, 0x2e, 0x0e, 0xd5, 0x1d, 0xb8, 0xa1, 0x46, 0x53, 0x1e, 0x74, 0xbe, 0x9b,
-	0x00, 0x73, 0xb4, 0xb2, 0xa1, 0x2d, 0xa8, 0xde, 0xa6, 0x41, 0xb8, 0xdc, 0x77, 0x83, 0x1d, 0xb1,
-	0x4f, 0xad, 0xbd, 0xba, 0xb7, 0x3f, 0xbf, 0x72, 0x8c, 0xf7, 0xcc, 0xe3, 0x71, 0x14, 0x46, 0xc3,
-	0xe8, 0xe1, 0x5b, 0xb2, 0x1d, 0x88, 0x5a, 0x9c, 0xc3, 0xcc, 0x1e, 0x56, 0xa6, 0xd1, 0xa7, 0x50,
-	0xbf, 0xe3, 0x46, 0x31, 0x5f, 0x4f, 0xee, 0xc4, 0x8f, 0x58, 0x6f, 0x5f, 0x97, 0xd4, 0xb6, 0x8e,
-	0x41, 0xad, 0xd0, 0x5b, 0x1f, 0xee, 0x6c, 0x12, 0x86, 0xf3, 0xc6, 0xd0, 0x02, 0x40, 0x87, 0xb0,
-	0x9d, 0x20, 0x8a, 0x02, 0x1a, 0x4a, 0x3f, 0x67, 0x8c, 0xa8, 0x15, 0xac, 0x49, 0x39, 0xd7, 0xc0,
-	0x1c, 0xad, 0xd6, 0xa8, 0x01, 0x27, 0x78, 0x5e, 0x05, 0x5b, 0x3c, 0x2a, 0x12, 0xbf, 0xd7, 0xb0,
-	0x0e, 0x39, 0x3f, 0x1a, 0x30, 0x33, 0x52, 0xab, 0xf9, 0xc3, 0xdd, 0x25, 0xc2, 0xa8, 0x0c, 0x92,
-	0x12, 0x56, 0x00, 0x0f, 0xa0, 0x8f, 0x08, 0x13, 0x07, 0x9b, 0x48, 0x02, 0x48, 0x4e, 0xf3, 0xbe,
-	0x2f, 0xfe, 0x63, 0xbe, 0x77, 0x7e, 0x36, 0x60, 0x3a, 0xff, 0x52, 0xa0, 0x0d, 0xa8, 0x72, 0x0f,
-	0xaa, 0xf0, 0x7a, 0x75, 0x2a, 0x94, 0x21, 0x7e, 0xa1, 0x95, 0x20, 0xf2, 0xe8, 0x43, 0xc2, 0xd2,
-	0xdc, 0x7d, 0x83, 0x17, 0xca, 0x4c, 0x3b, 0x2e, 0xcc, 0x8c, 0x3c, 0x62, 0x68, 0x3d, 0x29, 0x40,
-	0x98, 0x6c, 0xc9, 0x28, 0xbe, 0x26, 0xaf, 0xf3, 0x0a, 0x45, 0x08, 0x93, 0x2d, 0xe7, 0x1d, 0x38,
-	0xa1, 0xbd, 0x5c, 0xbc, 0x2e, 0x62, 0x12, 0x0d, 0xfb, 0xb1, 0x8c, 0x09, 0x39, 0x43, 0x27, 0xd3,
-	0x02, 0x9c, 0x50, 0x9b, 0x4c, 0x1c, 0xa2, 0x87, 0x23, 0x5a, 0xcc, 0xda, 0x25, 0x99, 0xc8, 0x73,
-	0xe3, 0x91, 0x29, 0x05, 0xda, 0x25, 0x7e, 0x6a, 0x9c, 0xca, 0x8b, 0xc8, 0x0a, 0x7a, 0xa1, 0x1b,
-	0x0f, 0x19, 0x91, 0x49, 0xad, 0x00, 0xe7, 0x27, 0x03, 0x66, 0xc7, 0x4c, 0xa0, 0x26, 0xcc, 0x70,
-	0xa7, 0x11, 0xd6, 0x19, 0x6e, 0xf6, 0x03, 0xef, 0x03, 0xb2, 0x2b, 0xcf, 0x3c, 0x0a, 0x23, 0x13,
-	0x8a, 0x77, 0x37, 0x92, 0x02, 0x56, 0xc4, 0x7c, 0xc8, 0xe3, 0x1f, 0x13, 0x8f, 0x86, 0x21, 0xf1,
-	0xe2, 0x0d, 0x2a, 0x62, 0xb2, 0x8a, 0x75, 0x08, 0xdd, 0x83, 0x5a, 0xc6, 0x03, 0x77, 0x76, 0xe9,
-	0x35, 0x9c, 0x9d, 0xb3, 0xe4, 0xfc, 0x31, 0x01, 0xb3, 0x63, 0xcf, 0x39, 0x6a, 0x42, 0x69, 0x99,
-	0xfa, 0x49, 0x8c, 0x4e, 0xab, 0x9e, 0x2e, 0x5d, 0xe7, 0x6b, 0x58, 0x48, 0x20, 0x07, 0x6a, 0x98,
-	0x7c, 0x4e, 0xbc, 0x18, 0x13, 0x37, 0xca, 0x92, 0x2d, 0x87, 0xf1, 0x1b, 0xdf, 0xda, 0x58, 0x92,
-	0xe5, 0x9f, 0x0f, 0x79, 0xe9, 0x5c, 0x8a, 0xa2, 0xa0, 0x17, 0x76, 0xb7, 0x29, 0xe3, 0x8d, 0x77,
-	0x49, 0xac, 0xe5, 0x41, 0xb4, 0x09, 0xe6, 0xdd, 0x81, 0xef, 0xc6, 0xa4, 0x1b, 0x84, 0x9e, 0x2c,
-	0x60, 0x93, 0xaf, 0x95, 0x35, 0x63, 0xf6, 0x92, 0xf3, 0xfb, 0x01, 0x23, 0x5e, 0xcc, 0x3f, 0x0c,
-	0x44, 0xd3, 0x21, 0xce, 0xaf, 0x30, 0xce, 0xcf, 0x7a, 0x92, 0x30, 0x5d, 0xde, 0xd1, 0x54, 0xc4,
-	0x59, 0x75, 0x68, 0xa4, 0x12, 0x4e, 0x1d, 0xab, 0x12, 0xbe, 0x0b, 0xf5, 0x5c, 0xeb, 0xc5, 0x4b,
-	0x56, 0x77, 0xe8, 0x79, 0x24, 0x8a, 0x84, 0xdf, 0xa7, 0x70, 0x3a, 0x7d, 0x4e, 0xbc, 0x7f, 0x63,
-	0xc0, 0xec, 0x58, 0x3b, 0x85, 0x2e, 0xe7, 0xa8, 0x9b, 0x3b, 0xb2, 0xcb, 0xd3, 0xf8, 0x3b, 0xd2,
-	0x34, 0x37, 0x22, 0x5e, 0xb4, 0xe2, 0xd1, 0xcd, 0x9b, 0x78, 0xbb, 0xb9, 0x80, 0xec, 0x49, 0x3e,
-	0x56, 0x07, 0xc9, 0x96, 0x5e, 0x52, 0x9f, 0xc7, 0x22, 0x60, 0xe2, 0x88, 0x08, 0x70, 0x1e, 0x80,
-	0x39, 0xda, 0xf6, 0xfd, 0xdd, 0x0b, 0x5a, 0xbc, 0xb7, 0x8e, 0xd9, 0xee, 0x5a, 0x28, 0x53, 0x2e,
-	0x9d, 0xaa, 0xab, 0x17, 0x75, 0xaf, 0x7e, 0x02, 0x33, 0x23, 0x2d, 0x23, 0x5a, 0xd0, 0xbe, 0xd4,
-	0x8c, 0x17, 0x7d, 0x0a, 0xa8, 0xaf, 0xb5, 0xe7, 0x50, 0x76, 0x1e, 0xcc, 0xd1, 0xce, 0x92, 0x37,
-	0x79, 0x1c, 0x93, 0xe5, 0x42, 0x8c, 0x9d, 0xff, 0x40, 0x3d, 0xd7, 0x4c, 0x2a, 0x73, 0x86, 0x66,
-	0xee, 0x22, 0xe1, 0xc1, 0xab, 0x6e, 0x8c, 0x6a, 0x30, 0xb5, 0xe4, 0x79, 0x64, 0x10, 0x13, 0xdf,
-	0x2c, 0xf0, 0x59, 0x92, 0x86, 0xc4, 0x37, 0x0d, 0x34, 0x0d, 0x90, 0x06, 0x35, 0xf1, 0xcd, 0x09,
-	0x74, 0x8a, 0x77, 0x68, 0xb2, 0xc2, 0xf0, 0xe3, 0x07, 0x8c, 0xf8, 0x66, 0x11, 0x21, 0x98, 0x96,
-	0x39, 0xe2, 0x6d, 0x13, 0x7f, 0xd8, 0x27, 0x66, 0xe9, 0xe2, 0x22, 0xcc, 0x8e, 0x79, 0x17, 0xd5,
-	0xa1, 0xba, 0x4c, 0xc3, 0xad, 0x80, 0xed, 0x88, 0xcd, 0x00, 0xca, 0x2b, 0x24, 0x0c, 0xc4, 0x56,
-	0x55, 0x98, 0x14, 0x3e, 0x36, 0x27, 0xda, 0x37, 0x1f, 0x1d, 0xd8, 0x85, 0xc7, 0x07, 0x76, 0xe1,
-	0xc9, 0x81, 0x5d, 0x78, 0x76, 0x60, 0x1b, 0x7f, 0x1e, 0xd8, 0x85, 0xaf, 0x0f, 0x6d, 0xe3, 0x87,
-	0x43, 0xdb, 0x78, 0x74, 0x68, 0x1b, 0x8f, 0x0f, 0x6d, 0xe3, 0xb7, 0x43, 0xdb, 0xf8, 0xfd, 0xd0,
-	0x2e, 0x3c, 0x3b, 0xb4, 0x8d, 0xef, 0x9f, 0xda, 0x85, 0xc7, 0x4f, 0xed, 0xc2, 0x93, 0xa7, 0x76,
-	0x61, 0xb3, 0x2c, 0xfe, 0xf7, 0x70, 0xf5, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x1d, 0x65, 0x2c,
-	0xb7, 0x13, 0x11, 0x00, 0x00,
+	// 1597 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4f, 0x6f, 0x1b, 0xb7,
+	0x12, 0xd7, 0x5a, 0xb2, 0x64, 0x4d, 0x24, 0x5b, 0xe2, 0xcb, 0x9f, 0x4d, 0xf0, 0x20, 0x0b, 0x8b,
+	0xbc, 0x44, 0x2f, 0x4d, 0x94, 0xd6, 0x49, 0x83, 0x18, 0x0d, 0x50, 0x58, 0xb6, 0x5b, 0x3b, 0x4d,
+	0x0d, 0x81, 0x72, 0xd2, 0xa0, 0x7f, 0x0e, 0xeb, 0x5d, 0x5a, 0xda, 0x46, 0x5e, 0x2a, 0xdc, 0x55,
+	0x0a, 0xb7, 0x97, 0xde, 0x0a, 0xf4, 0xd4, 0x8f, 0xd1, 0x6b, 0xbf, 0x45, 0x80, 0x02, 0x45, 0x7a,
+	0x0b, 0x8c, 0xc2, 0xa8, 0x95, 0x4b, 0x8f, 0xb9, 0xb5, 0xc7, 0x82, 0x5c, 0xee, 0x92, 0x2b, 0x39,
+	0x89, 0x1b, 0xa7, 0x17, 0x8b, 0xfc, 0x71, 0x66, 0x48, 0xce, 0x6f, 0x66, 0x38, 0x6b, 0xb8, 0xe8,
+	0x93, 0xf0, 0x2b, 0xca, 0x1e, 0x5c, 0xed, 0xd1, 0x20, 0x8c, 0xc7, 0x03, 0xdb, 0x79, 0x40, 0x42,
+	0xf9, 0xd3, 0x1c, 0x30, 0x1a, 0x52, 0x94, 0x8f, 0x66, 0xe7, 0xae, 0x74, 0xbd, 0xb0, 0x37, 0xdc,
+	0x6a, 0x3a, 0x74, 0xe7, 0x6a, 0x97, 0x76, 0xe9, 0x55, 0xb1, 0xbc, 0x35, 0xdc, 0x16, 0x33, 0x31,
+	0x11, 0xa3, 0x48, 0xed, 0xdc, 0x75, 0x4d, 0xdc, 0xf3, 0x03, 0xda, 0xb7, 0xd9, 0xc4, 0xef, 0x60,
+	0xd8, 0x0f, 0x48, 0xf4, 0x37, 0xd2, 0xb2, 0xbe, 0xcf, 0x42, 0xbe, 0x2d, 0xf6, 0x43, 0xff, 0x85,
+	0xe2, 0x80, 0xf6, 0x77, 0x77, 0x28, 0x1b, 0xf4, 0xcc, 0x4a, 0xdd, 0x68, 0x4c, 0x63, 0x05, 0xa0,
+	0x4d, 0xc8, 0x77, 0x88, 0xef, 0x12, 0x66, 0x9e, 0xac, 0x1b, 0x8d, 0x52, 0xeb, 0xd6, 0xde, 0xfe,
+	0xfc, 0xcd, 0x97, 0x6c, 0x79, 0xd8, 0x6d, 0xf9, 0xb8, 0xb9, 0x46, 0x83, 0x10, 0x4b, 0x5b, 0xe8,
+	0x3e, 0xcc, 0x60, 0xe2, 0x10, 0xef, 0x11, 0x61, 0xe6, 0xa9, 0x37, 0x60, 0x37, 0xb1, 0xc6, 0x6f,
+	0x83, 0xc9, 0xc3, 0x21, 0x09, 0xc2, 0xf5, 0x15, 0xf3, 0x74, 0xdd, 0x68, 0xe4, 0xb0, 0x02, 0x90,
+	0x09, 0x85, 0x4d, 0x66, 0x3b, 0x64, 0x7d, 0xc5, 0x3c, 0x53, 0x37, 0x1a, 0x45, 0x1c, 0x4f, 0x11,
+	0x82, 0xdc, 0xe6, 0xee, 0x80, 0x98, 0x66, 0xdd, 0x68, 0x94, 0xb1, 0x18, 0xa3, 0xb7, 0xa0, 0x20,
+	0x55, 0xcd, 0xb3, 0x75, 0xa3, 0x71, 0x62, 0x61, 0xae, 0x29, 0x19, 0x93, 0xf0, 0x5a, 0x06, 0xc7,
+	0x12, 0xa8, 0xc9, 0xaf, 0x14, 0x0c, 0xa8, 0x1f, 0x10, 0xf3, 0x9c, 0x90, 0xae, 0x28, 0xe9, 0x08,
+	0x5f, 0xcb, 0xe0, 0x44, 0xa6, 0x55, 0x84, 0x42, 0xdb, 0xde, 0xed, 0x53, 0xdb, 0xb5, 0x9e, 0x66,
+	0x93, 0x8d, 0x90, 0x05, 0xb9, 0xb6, 0xe7, 0x77, 0x4d, 0x43, 0x98, 0x28, 0xc5, 0x26, 0x38, 0xb6,
+	0x96, 0xc1, 0x62, 0x0d, 0x5d, 0x80, 0x2c, 0x6e, 0x2f, 0x9b, 0x53, 0x42, 0x04, 0x25, 0xbb, 0xb4,
+	0x97, 0xd5, 0xb1, 0xb8, 0x00, 0x5a, 0x80, 0xc2, 0xb2, 0x1d, 0x38, 0xb6, 0x4b, 0xcc, 0xac, 0x90,
+	0x3d, 0x1d, 0xcb, 0x4a, 0x58, 0xbb, 0x86, 0x44, 0xd0, 0x65, 0x98, 0x6e, 0xf3, 0x38, 0x31, 0x73,
+	0x42, 0xe3, 0x64, 0x72, 0x00, 0x0e, 0x2a, 0xf9, 0x48, 0x08, 0xdd, 0x84, 0x62, 0x8b, 0xd2, 0x30,
+	0x08, 0x99, 0x3d, 0x30, 0xa7, 0x85, 0x86, 0x19, 0x6b, 0x24, 0x0b, 0x4a, 0x4b, 0x09, 0x73, 0xcd,
+	0xa5, 0x61, 0xd8, 0xa3, 0xcc, 0xfb, 0x9a, 0x98, 0xf9, 0xb4, 0x66, 0xb2, 0xa0, 0x69, 0x26, 0x18,
+	0x7a, 0x97, 0x3b, 0xba, 0xeb, 0x05, 0x21, 0x61, 0x66, 0x41, 0x28, 0x9e, 0x51, 0x8e, 0x8e, 0x70,
+	0xa5, 0x97, 0x88, 0x72, 0x67, 0x7c, 0x48, 0x7c, 0x12, 0x78, 0x81, 0x39, 0x93, 0x76, 0x86, 0x84,
+	0x35, 0x67, 0x48, 0x84, 0x6f, 0xd5, 0xf1, 0xba, 0xfe, 0x32, 0x61, 0xa1, 0x59, 0x4c, 0x6f, 0x15,
+	0xe3, 0xda, 0x56, 0x31, 0xc4, 0xa9, 0x95, 0xb0, 0xf5, 0x5b, 0x56, 0x85, 0xc5, 0x91, 0xb8, 0xbd,
+	0xa8, 0x73, 0xfb, 0x9f, 0x14, 0xb7, 0x49, 0x10, 0x09, 0x72, 0xaf, 0xc0, 0x74, 0xcb, 0x0e, 0x3c,
+	0x47, 0x52, 0x7b, 0x2a, 0x71, 0x3b, 0x07, 0x35, 0xe1, 0x48, 0x0a, 0x2d, 0xea, 0x4c, 0x45, 0xdc,
+	0x9e, 0x3d, 0x84, 0xa9, 0x44, 0x4d, 0xa3, 0x6a, 0x51, 0xa7, 0x6a, 0x3a, 0xad, 0xaa, 0x51, 0xa5,
+	0x54, 0x15, 0x57, 0x37, 0x34, 0xae, 0xc6, 0x48, 0x56, 0x5c, 0xe9, 0xc9, 0x21, 0xc9, 0xba, 0xa6,
+	0xc8, 0x1a, 0xa3, 0x38, 0x21, 0x2b, 0xd1, 0x4a, 0xd8, 0xba, 0xa1, 0xb1, 0x35, 0x93, 0xde, 0x4c,
+	0xb1, 0xa5, 0x36, 0x8b, 0x31, 0xee, 0xc9, 0x55, 0xc6, 0x28, 0x33, 0x8b, 0x69, 0x4f, 0x0a, 0x50,
+	0xf7, 0xa4, 0x00, 0x5a, 0xa0, 0x18, 0xb5, 0xf2, 0x11, 0xa3, 0xd6, 0x4d, 0x00, 0x95, 0x7e, 0xe8,
+	0x34, 0xe4, 0x3f, 0x26, 0x61, 0x8f, 0xba, 0x82, 0xe9, 0x22, 0x96, 0x33, 0x5e, 0x63, 0x56, 0xec,
+	0xd0, 0x16, 0xe4, 0x96, 0xb0, 0x18, 0x5b, 0xbf, 0x1a, 0x49, 0x92, 0xa2, 0xdb, 0x50, 0xd8, 0xa0,
+	0x2e, 0x59, 0x77, 0x03, 0xd3, 0xa8, 0x67, 0x1b, 0xa5, 0xd6, 0xdb, 0x7b, 0xfb, 0xf3, 0x97, 0x5f,
+	0x5d, 0xdf, 0x9b, 0x98, 0x6c, 0x13, 0x46, 0x7c, 0x87, 0xe0, 0xd8, 0x00, 0xba, 0x03, 0x85, 0x55,
+	0x3f, 0x64, 0x74, 0xb0, 0x1b, 0x6d, 0xd7, 0x5a, 0x78, 0xbc, 0x3f, 0x9f, 0xd9, 0xdb, 0x9f, 0xbf,
+	0x74, 0x04, 0x7b, 0x52, 0x13, 0xc7, 0x26, 0xd0, 0x65, 0xa8, 0x62, 0x32, 0xe8, 0x7b, 0x8e, 0x1d,
+	0x7a, 0xd4, 0xff, 0xc0, 0x76, 0x42, 0xca, 0x44, 0xe0, 0x95, 0xf1, 0xe4, 0x82, 0xf5, 0x0d, 0xcc,
+	0xa6, 0x0b, 0x8c, 0x5e, 0x77, 0x8d, 0x74, 0xdd, 0x3d, 0xff, 0x8a, 0x5a, 0x16, 0x05, 0xfb, 0xff,
+	0xc7, 0x2b, 0xd9, 0xdc, 0x78, 0x25, 0x8b, 0xd7, 0xad, 0x2f, 0xa0, 0xa4, 0xd7, 0x2a, 0x74, 0x31,
+	0x2e, 0x68, 0x51, 0xd6, 0x55, 0x9b, 0xd1, 0x33, 0x28, 0xb0, 0x36, 0xa3, 0x21, 0x8d, 0x6b, 0xd9,
+	0x79, 0x28, 0x8b, 0x43, 0x75, 0x06, 0xb6, 0xaf, 0xd1, 0x94, 0x06, 0xad, 0x51, 0x16, 0x2a, 0xe3,
+	0x95, 0x0d, 0x6d, 0x43, 0xf1, 0x36, 0xf5, 0xfc, 0xe5, 0xbe, 0xed, 0xed, 0x88, 0x7d, 0x4a, 0xad,
+	0xb5, 0xbd, 0xfd, 0xf9, 0x95, 0x23, 0xbc, 0x67, 0x0e, 0x8f, 0x23, 0x3f, 0x18, 0x06, 0x8f, 0xde,
+	0x91, 0xed, 0x40, 0xd0, 0xe4, 0x1c, 0x26, 0xf6, 0xb0, 0x32, 0x8d, 0x3e, 0x87, 0xf2, 0x1d, 0x3b,
+	0x08, 0xf9, 0x7a, 0x74, 0x27, 0x7e, 0xc4, 0x72, 0xeb, 0x86, 0xa4, 0xb6, 0x79, 0x04, 0x6a, 0x85,
+	0xde, 0xc6, 0x70, 0x67, 0x8b, 0x30, 0x9c, 0x36, 0x86, 0x16, 0x00, 0xda, 0x84, 0xed, 0x78, 0x41,
+	0xe0, 0x51, 0xdf, 0xcc, 0xa6, 0x19, 0x51, 0x2b, 0x58, 0x93, 0x42, 0x9f, 0x42, 0x69, 0xcd, 0x0b,
+	0x42, 0xca, 0x76, 0x5b, 0xa4, 0xeb, 0xf9, 0x66, 0xee, 0x58, 0x07, 0x4a, 0xd9, 0x42, 0xf7, 0x00,
+	0xe4, 0x7c, 0xd5, 0x77, 0xcd, 0xe9, 0x63, 0x59, 0xd6, 0x2c, 0xa1, 0x3a, 0x9c, 0x90, 0xb3, 0x35,
+	0x3b, 0xe8, 0x89, 0xba, 0x54, 0xc2, 0x3a, 0x64, 0x5d, 0x87, 0xca, 0xf8, 0x1b, 0xc4, 0xb5, 0x78,
+	0xb5, 0xf0, 0xb6, 0x79, 0xac, 0x47, 0xd1, 0x54, 0xc2, 0x3a, 0x64, 0xfd, 0x64, 0xc0, 0xdc, 0xd8,
+	0x0b, 0xc4, 0xdb, 0x91, 0x0e, 0x11, 0xae, 0x92, 0xa1, 0x9f, 0xc3, 0x0a, 0xe0, 0x69, 0x71, 0x8f,
+	0x30, 0xe1, 0xee, 0xa9, 0x28, 0x2d, 0xe4, 0x34, 0x1d, 0x51, 0xd9, 0x7f, 0x2d, 0xa2, 0xac, 0x5f,
+	0x0c, 0x98, 0x4d, 0xbf, 0x7f, 0x68, 0x13, 0x8a, 0x3c, 0x2e, 0x54, 0xd2, 0xbc, 0xbe, 0xd7, 0x95,
+	0x21, 0x7e, 0xa1, 0x15, 0x2f, 0x70, 0xe8, 0x23, 0xc2, 0xe2, 0x8a, 0xf4, 0x06, 0x2f, 0x94, 0x98,
+	0xb6, 0x6c, 0x98, 0x1b, 0x7b, 0x9a, 0xd1, 0x46, 0x54, 0x56, 0x31, 0xd9, 0x96, 0xb9, 0x79, 0x5d,
+	0x5e, 0xe7, 0x35, 0x4a, 0x2b, 0x26, 0xdb, 0xd6, 0x7b, 0x70, 0x42, 0x7b, 0x8f, 0x79, 0xb5, 0xc7,
+	0x24, 0x18, 0xf6, 0x43, 0x19, 0x13, 0x72, 0x86, 0x4e, 0xc6, 0xcf, 0x4a, 0x44, 0x6d, 0x34, 0xb1,
+	0x88, 0x9e, 0x64, 0x68, 0x31, 0x69, 0x02, 0x4d, 0x23, 0xfd, 0xb0, 0x2a, 0x21, 0x29, 0xd0, 0xca,
+	0xf1, 0x53, 0xe3, 0x58, 0x5e, 0x44, 0x96, 0xd7, 0xf5, 0xed, 0x70, 0xc8, 0x88, 0x2c, 0x55, 0x0a,
+	0xb0, 0x7e, 0x36, 0xa0, 0x3a, 0x61, 0x02, 0x35, 0x60, 0x8e, 0x3b, 0x8d, 0xb0, 0xf6, 0x70, 0xab,
+	0xef, 0x39, 0x1f, 0x91, 0x5d, 0x79, 0xe6, 0x71, 0x18, 0x55, 0x20, 0x7b, 0x77, 0x33, 0x2a, 0xcb,
+	0x59, 0xcc, 0x87, 0x3c, 0xfe, 0x31, 0x71, 0xa8, 0xef, 0x13, 0x27, 0xdc, 0xa4, 0x22, 0x26, 0x8b,
+	0x58, 0x87, 0xd0, 0x7d, 0x28, 0x25, 0x3c, 0x70, 0x67, 0xe7, 0x8e, 0xe1, 0xec, 0x94, 0x25, 0xeb,
+	0xcf, 0x29, 0xa8, 0x4e, 0x34, 0x29, 0xa8, 0x01, 0xb9, 0x65, 0xea, 0x46, 0x31, 0x3a, 0xab, 0x3a,
+	0xd5, 0x78, 0x9d, 0xaf, 0x61, 0x21, 0x81, 0x2c, 0x28, 0x61, 0xf2, 0x25, 0x71, 0x42, 0x4c, 0xec,
+	0x20, 0x49, 0xb6, 0x14, 0xc6, 0x6f, 0xbc, 0xba, 0xb9, 0x24, 0x1f, 0x35, 0x3e, 0xe4, 0x0f, 0xc2,
+	0x52, 0x10, 0x78, 0x5d, 0xbf, 0xd3, 0xa3, 0x8c, 0x7f, 0x4e, 0x88, 0xe2, 0x86, 0xd3, 0x20, 0xda,
+	0x82, 0xca, 0xdd, 0x81, 0x6b, 0x87, 0xa4, 0xe3, 0xf9, 0x8e, 0x2c, 0xcb, 0xc7, 0xab, 0x55, 0x13,
+	0xf6, 0xa2, 0xf3, 0xbb, 0x1e, 0x23, 0x4e, 0xc8, 0x3f, 0x77, 0xcc, 0x7c, 0x7c, 0x7e, 0x85, 0x71,
+	0x7e, 0x36, 0xa2, 0x84, 0xe9, 0xf0, 0x3e, 0xad, 0x20, 0xce, 0xaa, 0x43, 0x63, 0xf5, 0x7d, 0xe6,
+	0x28, 0xf5, 0xdd, 0x7a, 0x1f, 0xca, 0xa9, 0x86, 0x92, 0x97, 0xac, 0xce, 0xd0, 0x71, 0x48, 0x10,
+	0x08, 0xbf, 0xcf, 0xe0, 0x78, 0xfa, 0x82, 0x78, 0xff, 0xce, 0x80, 0xea, 0x44, 0x93, 0x88, 0xae,
+	0xa4, 0xa8, 0x3b, 0x7b, 0x68, 0xef, 0xaa, 0xf1, 0x77, 0xa8, 0x69, 0x6e, 0x44, 0xbc, 0xd3, 0xd9,
+	0xc3, 0x5b, 0x52, 0xd1, 0x91, 0x70, 0x01, 0xd9, 0x69, 0x7d, 0x02, 0xd5, 0x89, 0xa5, 0x57, 0xd4,
+	0xe7, 0x89, 0x08, 0x98, 0x3a, 0x24, 0x02, 0xac, 0x87, 0x50, 0x19, 0x6f, 0x66, 0xff, 0xe9, 0x05,
+	0x4d, 0xfe, 0xc5, 0x10, 0xb2, 0xdd, 0x75, 0x5f, 0xa6, 0x5c, 0x3c, 0x55, 0x57, 0xcf, 0xea, 0x5e,
+	0xfd, 0x0c, 0xe6, 0xc6, 0x1a, 0x61, 0xb4, 0xa0, 0x7d, 0x7f, 0x1a, 0x2f, 0xfb, 0xc0, 0x51, 0xdf,
+	0xa0, 0x2f, 0xa0, 0xec, 0x02, 0x54, 0xc6, 0xfb, 0x65, 0xde, 0xba, 0x72, 0x4c, 0x96, 0x0b, 0x31,
+	0xb6, 0xfe, 0x07, 0xe5, 0x54, 0x8b, 0xac, 0xcc, 0x19, 0x9a, 0xb9, 0x4b, 0x04, 0x4a, 0xb1, 0x84,
+	0xb8, 0x6b, 0x09, 0x66, 0x96, 0x1c, 0x87, 0x0c, 0x42, 0xe2, 0x56, 0x32, 0x7c, 0x16, 0xa5, 0x21,
+	0x71, 0x2b, 0x06, 0x9a, 0x05, 0x88, 0x83, 0x9a, 0xb8, 0x95, 0x29, 0x74, 0x0a, 0xaa, 0x49, 0x85,
+	0xe1, 0xc7, 0xf7, 0x18, 0x71, 0x2b, 0x59, 0x84, 0x60, 0x56, 0xe6, 0x88, 0xd3, 0x23, 0xee, 0xb0,
+	0x4f, 0x2a, 0xb9, 0x4b, 0x8b, 0x50, 0x9d, 0xf0, 0x2e, 0x2a, 0x43, 0x71, 0x99, 0xfa, 0xdb, 0x1e,
+	0xdb, 0x11, 0x9b, 0x01, 0xe4, 0x57, 0x88, 0xef, 0x89, 0xad, 0x8a, 0x30, 0x2d, 0x7c, 0x5c, 0x99,
+	0x6a, 0xdd, 0x7a, 0x7c, 0x50, 0xcb, 0x3c, 0x39, 0xa8, 0x65, 0x9e, 0x1e, 0xd4, 0x32, 0xcf, 0x0f,
+	0x6a, 0xc6, 0x5f, 0x07, 0xb5, 0xcc, 0xb7, 0xa3, 0x9a, 0xf1, 0xe3, 0xa8, 0x66, 0x3c, 0x1e, 0xd5,
+	0x8c, 0x27, 0xa3, 0x9a, 0xf1, 0xfb, 0xa8, 0x66, 0xfc, 0x31, 0xaa, 0x65, 0x9e, 0x8f, 0x6a, 0xc6,
+	0x0f, 0xcf, 0x6a, 0x99, 0x27, 0xcf, 0x6a, 0x99, 0xa7, 0xcf, 0x6a, 0x99, 0xad, 0xbc, 0xf8, 0x8f,
+	0xca, 0xb5, 0xbf, 0x07, 0x00, 0x89, 0xa6, 0x23, 0xaf, 0xe9, 0x11, 0x00, 0x00,
 }
 
 func (x ResponseCode) String() string {
@@ -2679,6 +2684,15 @@ func (this *BootstrapRequest) Equal(that interface{}) bool {
 	if !this.Permission.Equal(that1.Permission) {
 		return false
 	}
+	if !this.HistoryBegin.Equal(that1.HistoryBegin) {
+		return false
+	}
+	if !this.HistoryEnd.Equal(that1.HistoryEnd) {
+		return false
+	}
+	if !bytes.Equal(this.HistoryHash, that1.HistoryHash) {
+		return false
+	}
 	return true
 }
 func (this *AuthorizeRequest) Equal(that interface{}) bool {
@@ -3382,13 +3396,16 @@ func (this *BootstrapRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 7)
+	s := make([]string, 0, 10)
 	s = append(s, "&packet.BootstrapRequest{")
 	s = append(s, "JoinClaim: "+fmt.Sprintf("%#v", this.JoinClaim)+",\n")
 	s = append(s, "LastNodePulse: "+fmt.Sprintf("%#v", this.LastNodePulse)+",\n")
 	if this.Permission != nil {
 		s = append(s, "Permission: "+fmt.Sprintf("%#v", this.Permission)+",\n")
 	}
+	s = append(s, "HistoryBegin: "+fmt.Sprintf("%#v", this.HistoryBegin)+",\n")
+	s = append(s, "HistoryEnd: "+fmt.Sprintf("%#v", this.HistoryEnd)+",\n")
+	s = append(s, "HistoryHash: "+fmt.Sprintf("%#v", this.HistoryHash)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -4199,6 +4216,22 @@ func (m *BootstrapRequest) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n31
 	}
+	if m.HistoryBegin != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(m.HistoryBegin))
+	}
+	if m.HistoryEnd != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(m.HistoryEnd))
+	}
+	if len(m.HistoryHash) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.HistoryHash)))
+		i += copy(dAtA[i:], m.HistoryHash)
+	}
 	return i, nil
 }
 
@@ -5118,6 +5151,16 @@ func (m *BootstrapRequest) Size() (n int) {
 		l = m.Permission.Size()
 		n += 1 + l + sovPacket(uint64(l))
 	}
+	if m.HistoryBegin != 0 {
+		n += 1 + sovPacket(uint64(m.HistoryBegin))
+	}
+	if m.HistoryEnd != 0 {
+		n += 1 + sovPacket(uint64(m.HistoryEnd))
+	}
+	l = len(m.HistoryHash)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
 	return n
 }
 
@@ -5696,6 +5739,9 @@ func (this *BootstrapRequest) String() string {
 		`JoinClaim:` + fmt.Sprintf("%v", this.JoinClaim) + `,`,
 		`LastNodePulse:` + fmt.Sprintf("%v", this.LastNodePulse) + `,`,
 		`Permission:` + strings.Replace(fmt.Sprintf("%v", this.Permission), "Permission", "Permission", 1) + `,`,
+		`HistoryBegin:` + fmt.Sprintf("%v", this.HistoryBegin) + `,`,
+		`HistoryEnd:` + fmt.Sprintf("%v", this.HistoryEnd) + `,`,
+		`HistoryHash:` + fmt.Sprintf("%v", this.HistoryHash) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -7609,6 +7655,78 @@ func (m *BootstrapRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryBegin", wireType)
+			}
+			m.HistoryBegin = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoryBegin |= github_com_insolar_insolar_insolar.PulseNumber(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryEnd", wireType)
+			}
+			m.HistoryEnd = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoryEnd |= github_com_insolar_insolar_insolar.PulseNumber(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HistoryHash = append(m.HistoryHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.HistoryHash == nil {
+				m.HistoryHash = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPacket(dAtA[iNdEx:])
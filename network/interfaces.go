@@ -293,6 +293,23 @@ type Auther interface {
 	FilterJoinerNodes(certificate insolar.Certificate, nodes []insolar.NetworkNode) []insolar.NetworkNode
 }
 
+// VerificationProgress describes progress of stored data verification in JetlessNetworkState
+type VerificationProgress struct {
+	// Pulse is the last finalized pulse which drops are verified
+	Pulse insolar.PulseNumber
+	// Jets is a count of jet drops to verify
+	Jets int
+	// Confirmed is a count of jet drops confirmed by light nodes
+	Confirmed int
+	// Error describes why the last verification attempt failed
+	Error string
+}
+
+// DataVerifier is a Gateway which verifies completeness of stored data before network becomes complete
+type DataVerifier interface {
+	VerificationProgress() VerificationProgress
+}
+
 // Rules are responsible for a majority and minimum roles checking
 //go:generate minimock -i github.com/insolar/insolar/network.Rules -o ../testutils/network -s _mock.go
 type Rules interface {
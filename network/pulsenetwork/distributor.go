@@ -87,16 +87,25 @@ type distributor struct {
 	pulseRequestTimeout       time.Duration
 	randomNodesCount          int
 
+	retryCount      int
+	retryBackoff    time.Duration
+	retryMaxBackoff time.Duration
+	quorum          int
+
 	publicAddress   string
 	pulsarHost      *host.Host
 	bootstrapHosts  []string
 	futureManager   future.Manager
 	responseHandler future.PacketHandler
 	pool            pool.ConnectionPool
+
+	// resolvedHosts caches bootstrap hosts with known NodeID, responses are accepted from them only
+	resolvedHostsLock sync.Mutex
+	resolvedHosts     map[string]*host.Host
 }
 
 // NewDistributor creates a new distributor object of pulses
-func NewDistributor(conf configuration.PulseDistributor) (insolar.PulseDistributor, error) {
+func NewDistributor(conf configuration.PulseDistributor) (insolar.ReportingPulseDistributor, error) {
 
 	futureManager := future.NewManager()
 
@@ -108,9 +117,15 @@ func NewDistributor(conf configuration.PulseDistributor) (insolar.PulseDistribut
 		pulseRequestTimeout:       time.Duration(conf.PulseRequestTimeout) * time.Millisecond,
 		randomNodesCount:          conf.RandomNodesCount,
 
+		retryCount:      conf.PulseRetryCount,
+		retryBackoff:    time.Duration(conf.PulseRetryBackoff) * time.Millisecond,
+		retryMaxBackoff: time.Duration(conf.PulseRetryMaxBackoff) * time.Millisecond,
+		quorum:          conf.Quorum,
+
 		bootstrapHosts:  conf.BootstrapHosts,
 		futureManager:   futureManager,
 		responseHandler: future.NewPacketHandler(futureManager),
+		resolvedHosts:   map[string]*host.Host{},
 	}
 
 	return result, nil
@@ -147,9 +162,20 @@ func (d *distributor) Start(ctx context.Context) error {
 	return nil
 }
 
-// Distribute starts a fire-and-forget process of pulse distribution to bootstrap hosts
+// Distribute distributes pulse to bootstrap hosts and waits for acknowledgements
 func (d *distributor) Distribute(ctx context.Context, pulse insolar.Pulse) {
+	d.DistributeWithReport(ctx, pulse)
+}
+
+// DistributeWithReport sends pulse to all bootstrap hosts in parallel, every host is retried with backoff
+// until it acknowledges the pulse or attempts are over.
+func (d *distributor) DistributeWithReport(ctx context.Context, pulse insolar.Pulse) insolar.PulseDeliveryReport {
 	logger := inslogger.FromContext(ctx)
+	report := insolar.PulseDeliveryReport{
+		PulseNumber: pulse.PulseNumber,
+		Quorum:      d.quorum,
+		StartedAt:   time.Now(),
+	}
 	defer func() {
 		if r := recover(); r != nil {
 			logger.Errorf("sendPulseToNetwork failed with panic: %v", r)
@@ -165,41 +191,125 @@ func (d *distributor) Distribute(ctx context.Context, pulse insolar.Pulse) {
 	)
 	defer span.End()
 
-	// TODO: Move to config reader
-	bootstrapHosts := make([]*host.Host, 0, len(d.bootstrapHosts))
-	for _, node := range d.bootstrapHosts {
-		bootstrapHost, err := host.NewHost(node)
-		if err != nil {
-			logger.Error(err, "[ Distribute ] failed to create bootstrap node host")
-			continue
-		}
-		bootstrapHosts = append(bootstrapHosts, bootstrapHost)
-	}
-
+	bootstrapHosts := d.getBootstrapHosts(ctx)
 	if len(bootstrapHosts) == 0 {
 		logger.Error("[ Distribute ] no bootstrap hosts to distribute")
-		return
+		return report
 	}
 
-	d.pool.Reset()
-
+	report.Hosts = make([]insolar.PulseHostDelivery, len(bootstrapHosts))
 	wg := sync.WaitGroup{}
 	wg.Add(len(bootstrapHosts))
 
-	for _, bootstrapHost := range bootstrapHosts {
-		go func(ctx context.Context, pulse insolar.Pulse, bootstrapHost *host.Host) {
+	for i, bootstrapHost := range bootstrapHosts {
+		go func(i int, bootstrapHost *host.Host) {
 			defer wg.Done()
 
-			err := d.sendPulseToHost(ctx, &pulse, bootstrapHost)
-			if err != nil {
-				logger.Errorf("[ Distribute pulse %d ] Failed to send pulse: %s", pulse.PulseNumber, err)
+			delivery := d.deliverToHost(ctx, pulse, bootstrapHost)
+			report.Hosts[i] = delivery
+			if !delivery.Delivered {
+				logger.Errorf("[ Distribute pulse %d ] Failed to send pulse to node %s after %d attempts: %s",
+					pulse.PulseNumber, delivery.Address, delivery.Attempts, delivery.Error)
 				return
 			}
-			logger.Infof("[ Distribute pulse %d ] Successfully sent pulse to node %s", pulse.PulseNumber, bootstrapHost)
-		}(ctx, pulse, bootstrapHost)
+			logger.Infof("[ Distribute pulse %d ] Successfully sent pulse to node %s", pulse.PulseNumber, delivery.Address)
+		}(i, bootstrapHost)
 	}
 
 	wg.Wait()
+
+	for _, delivery := range report.Hosts {
+		if delivery.Delivered {
+			report.Delivered++
+		}
+	}
+	report.Duration = time.Since(report.StartedAt)
+	if !report.QuorumReached() {
+		logger.Errorf("[ Distribute pulse %d ] Quorum isn't reached: %d of %d hosts acknowledged pulse, quorum is %d",
+			pulse.PulseNumber, report.Delivered, len(report.Hosts), report.Quorum)
+	}
+	return report
+}
+
+// getBootstrapHosts returns bootstrap hosts, NodeID of hosts is kept between pulses.
+func (d *distributor) getBootstrapHosts(ctx context.Context) []*host.Host {
+	logger := inslogger.FromContext(ctx)
+
+	d.resolvedHostsLock.Lock()
+	defer d.resolvedHostsLock.Unlock()
+
+	// TODO: Move to config reader
+	bootstrapHosts := make([]*host.Host, 0, len(d.bootstrapHosts))
+	for _, node := range d.bootstrapHosts {
+		bootstrapHost, ok := d.resolvedHosts[node]
+		if !ok {
+			var err error
+			bootstrapHost, err = host.NewHost(node)
+			if err != nil {
+				logger.Error(err, "[ Distribute ] failed to create bootstrap node host")
+				continue
+			}
+			d.resolvedHosts[node] = bootstrapHost
+		}
+		// copy to prevent data race with pulses distributed in parallel
+		hostCopy := *bootstrapHost
+		bootstrapHosts = append(bootstrapHosts, &hostCopy)
+	}
+	return bootstrapHosts
+}
+
+func (d *distributor) rememberNodeID(address string, nodeID insolar.Reference) {
+	d.resolvedHostsLock.Lock()
+	defer d.resolvedHostsLock.Unlock()
+
+	for _, resolved := range d.resolvedHosts {
+		if resolved.Address.String() == address {
+			resolved.NodeID = nodeID
+		}
+	}
+}
+
+// deliverToHost sends pulse to host until it's acknowledged or retries are over.
+func (d *distributor) deliverToHost(ctx context.Context, pulse insolar.Pulse, bootstrapHost *host.Host) insolar.PulseHostDelivery {
+	delivery := insolar.PulseHostDelivery{Address: bootstrapHost.Address.String()}
+	started := time.Now()
+	backoff := d.retryBackoff
+
+	for attempt := 0; attempt <= d.retryCount; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				delivery.Error = ctx.Err().Error()
+				delivery.Latency = time.Since(started)
+				return delivery
+			case <-time.After(backoff):
+			}
+			backoff *= 2
+			if d.retryMaxBackoff > 0 && backoff > d.retryMaxBackoff {
+				backoff = d.retryMaxBackoff
+			}
+		}
+		delivery.Attempts++
+
+		err := d.sendPulseToHost(ctx, &pulse, bootstrapHost)
+		if err == nil {
+			delivery.Delivered = true
+			delivery.Error = ""
+			break
+		}
+		delivery.Error = err.Error()
+		inslogger.FromContext(ctx).Warnf("[ Distribute pulse %d ] attempt %d to send pulse to node %s failed: %s",
+			pulse.PulseNumber, delivery.Attempts, delivery.Address, err)
+		// connection could be broken, so it's dropped for this host only, other hosts keep their connections
+		d.pool.CloseConnection(ctx, bootstrapHost)
+		// node could be restarted with other identity, so it should be pinged again
+		bootstrapHost.NodeID = insolar.Reference{}
+		// pool keys connections by host with NodeID, connection used for ping is kept without it
+		d.pool.CloseConnection(ctx, bootstrapHost)
+	}
+
+	delivery.Latency = time.Since(started)
+	return delivery
 }
 
 func (d *distributor) generateID() types.RequestID {
@@ -224,33 +334,53 @@ func (d *distributor) pingHost(ctx context.Context, host *host.Host) error {
 	result, err := pingCall.WaitResponse(d.pingRequestTimeout)
 	if err != nil {
 		logger.Error(err)
-		panic(err.Error())
 		return errors.Wrap(err, "[ pingHost ] failed to get ping result")
 	}
 
 	host.NodeID = result.GetSender()
+	d.rememberNodeID(host.Address.String(), host.NodeID)
 	logger.Debugf("ping request is done")
 
 	return nil
 }
 
-func (d *distributor) sendPulseToHost(ctx context.Context, p *insolar.Pulse, host *host.Host) error {
+func (d *distributor) sendPulseToHost(ctx context.Context, p *insolar.Pulse, host *host.Host) (err error) {
 	logger := inslogger.FromContext(ctx)
 	defer func() {
 		if x := recover(); x != nil {
 			logger.Errorf("sendPulseToHost failed with panic: %v", x)
+			err = errors.Errorf("sendPulseToHost failed with panic: %v", x)
 		}
 	}()
 
 	ctx, span := instracer.StartSpan(ctx, "distributor.sendPulseToHosts")
 	defer span.End()
 
+	// responses are accepted only from hosts with known NodeID
+	if host.NodeID.IsEmpty() {
+		err := d.pingHost(ctx, host)
+		if err != nil {
+			return err
+		}
+	}
+
 	pulseRequest := NewPulsePacket(ctx, p, d.pulsarHost, host, uint64(d.generateID()))
 
-	_, err := d.sendRequestToHost(ctx, pulseRequest, host)
+	call, err := d.sendRequestToHost(ctx, pulseRequest, host)
 	if err != nil {
 		return err
 	}
+	result, err := call.WaitResponse(d.pulseRequestTimeout)
+	if err != nil {
+		return errors.Wrap(err, "failed to get pulse acknowledgement")
+	}
+	response := result.GetResponse()
+	if response == nil || response.GetBasic() == nil {
+		return errors.New("got invalid pulse acknowledgement")
+	}
+	if !response.GetBasic().Success {
+		return errors.Errorf("pulse is rejected: %s", response.GetBasic().Error)
+	}
 	return nil
 }
 
@@ -258,12 +388,14 @@ func (d *distributor) sendRequestToHost(ctx context.Context, packet *packet.Pack
 	inslogger.FromContext(ctx).Debugf("Send %s request to %s with RequestID = %d",
 		packet.GetType(), receiver.String(), packet.GetRequestID())
 
+	f := d.futureManager.Create(packet)
 	err := hostnetwork.SendPacket(ctx, d.pool, packet)
 	if err != nil {
+		f.Cancel()
 		return nil, errors.Wrap(err, "Failed to send transport packet")
 	}
 	metrics.NetworkPacketSentTotal.WithLabelValues(packet.GetType().String()).Inc()
-	return nil, nil
+	return f, nil
 }
 
 func NewPulsePacket(ctx context.Context, p *insolar.Pulse, pulsarHost, to *host.Host, id uint64) *packet.Packet {
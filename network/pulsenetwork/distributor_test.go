@@ -52,7 +52,9 @@ package pulsenetwork
 
 import (
 	"context"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -150,5 +152,117 @@ func TestDistributor_Distribute(t *testing.T) {
 
 	d.Distribute(ctx, insolar.Pulse{PulseNumber: PULSENUMBER})
 	d.Distribute(ctx, insolar.Pulse{PulseNumber: PULSENUMBER})
-	d.Distribute(ctx, insolar.Pulse{PulseNumber: PULSENUMBER})
+	report := d.DistributeWithReport(ctx, insolar.Pulse{PulseNumber: PULSENUMBER})
+
+	assert.EqualValues(t, PULSENUMBER, report.PulseNumber)
+	assert.True(t, report.QuorumReached())
+	require.Len(t, report.Hosts, 1)
+	assert.True(t, report.Hosts[0].Delivered)
+	assert.Equal(t, 1, report.Hosts[0].Attempts)
+}
+
+func TestDistributor_DistributeWithReport_Retries(t *testing.T) {
+	n1, err := createHostNetwork(t)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	var pulses uint32
+	handler := func(ctx context.Context, r network.ReceivedPacket) (network.Packet, error) {
+		if r.GetType() == types.Ping {
+			return n1.BuildResponse(ctx, r, &packet.Ping{}), nil
+		}
+		// only the last attempt is acknowledged
+		if atomic.AddUint32(&pulses, 1) < 3 {
+			return n1.BuildResponse(ctx, r, &packet.BasicResponse{Success: false, Error: "not ready"}), nil
+		}
+		return n1.BuildResponse(ctx, r, &packet.BasicResponse{Success: true}), nil
+	}
+	n1.RegisterRequestHandler(types.Ping, handler)
+	n1.RegisterRequestHandler(types.Pulse, handler)
+
+	err = n1.Start(ctx)
+	require.NoError(t, err)
+	defer func() {
+		err = n1.Stop(ctx)
+		require.NoError(t, err)
+	}()
+
+	pulsarCfg := configuration.NewPulsar()
+	pulsarCfg.DistributionTransport.Address = "127.0.0.1:0"
+	pulsarCfg.PulseDistributor.BootstrapHosts = []string{n1.PublicAddress()}
+	pulsarCfg.PulseDistributor.PulseRetryCount = 2
+	pulsarCfg.PulseDistributor.PulseRetryBackoff = 10
+
+	d, err := NewDistributor(pulsarCfg.PulseDistributor)
+	require.NoError(t, err)
+
+	cm := component.NewManager(nil)
+	cm.Inject(d, transport.NewFactory(pulsarCfg.DistributionTransport))
+	err = cm.Init(ctx)
+	require.NoError(t, err)
+	err = cm.Start(ctx)
+	require.NoError(t, err)
+	defer func() {
+		err = cm.Stop(ctx)
+		require.NoError(t, err)
+	}()
+
+	report := d.DistributeWithReport(ctx, insolar.Pulse{PulseNumber: PULSENUMBER})
+	require.Len(t, report.Hosts, 1)
+	assert.True(t, report.Hosts[0].Delivered)
+	assert.Equal(t, 3, report.Hosts[0].Attempts)
+	assert.False(t, report.Lost())
+
+	report = d.DistributeWithReport(ctx, insolar.Pulse{PulseNumber: PULSENUMBER})
+	assert.Equal(t, 1, report.Delivered)
+
+	pulsarCfg.PulseDistributor.BootstrapHosts = []string{"127.0.0.1:1"}
+	pulsarCfg.PulseDistributor.PulseRetryCount = 1
+	unreachable, err := NewDistributor(pulsarCfg.PulseDistributor)
+	require.NoError(t, err)
+	cm = component.NewManager(nil)
+	cm.Inject(unreachable, transport.NewFactory(pulsarCfg.DistributionTransport))
+	require.NoError(t, cm.Init(ctx))
+	require.NoError(t, cm.Start(ctx))
+	defer cm.Stop(ctx)
+
+	report = unreachable.DistributeWithReport(ctx, insolar.Pulse{PulseNumber: PULSENUMBER})
+	assert.True(t, report.Lost())
+	assert.False(t, report.QuorumReached())
+	require.Len(t, report.Hosts, 1)
+	assert.Equal(t, 2, report.Hosts[0].Attempts)
+	assert.NotEmpty(t, report.Hosts[0].Error)
+}
+
+func TestDistributor_DistributeWithReport_Canceled(t *testing.T) {
+	pulsarCfg := configuration.NewPulsar()
+	pulsarCfg.DistributionTransport.Address = "127.0.0.1:0"
+	pulsarCfg.PulseDistributor.BootstrapHosts = []string{"127.0.0.1:1"}
+	pulsarCfg.PulseDistributor.PulseRetryCount = 5
+	pulsarCfg.PulseDistributor.PulseRetryBackoff = 60000
+	pulsarCfg.PulseDistributor.PulseRetryMaxBackoff = 60000
+
+	d, err := NewDistributor(pulsarCfg.PulseDistributor)
+	require.NoError(t, err)
+
+	cm := component.NewManager(nil)
+	cm.Inject(d, transport.NewFactory(pulsarCfg.DistributionTransport))
+	require.NoError(t, cm.Init(context.Background()))
+	require.NoError(t, cm.Start(context.Background()))
+	defer cm.Stop(context.Background())
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		cancel()
+	}()
+
+	started := time.Now()
+	report := d.DistributeWithReport(ctx, insolar.Pulse{PulseNumber: PULSENUMBER})
+	assert.True(t, time.Since(started) < 30*time.Second, "backoff isn't interrupted by context")
+	require.Len(t, report.Hosts, 1)
+	assert.False(t, report.Hosts[0].Delivered)
+	assert.Equal(t, 1, report.Hosts[0].Attempts)
+	assert.Equal(t, context.Canceled.Error(), report.Hosts[0].Error)
 }
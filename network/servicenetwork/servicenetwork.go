@@ -82,6 +82,7 @@ import (
 	"github.com/insolar/insolar/network/hostnetwork"
 	"github.com/insolar/insolar/network/merkle"
 	"github.com/insolar/insolar/network/routing"
+	"github.com/insolar/insolar/network/storage"
 	"github.com/insolar/insolar/network/transport"
 	"github.com/insolar/insolar/network/utils"
 )
@@ -99,12 +100,14 @@ type ServiceNetwork struct {
 	CertificateManager  insolar.CertificateManager  `inject:""`
 	PulseManager        insolar.PulseManager        `inject:""`
 	PulseAccessor       pulse.Accessor              `inject:""`
+	PulseAppender       storage.PulseAppender       `inject:""`
 	CryptographyService insolar.CryptographyService `inject:""`
 	NodeKeeper          network.NodeKeeper          `inject:""`
 	TerminationHandler  insolar.TerminationHandler  `inject:""`
 	Pub                 message.Publisher           `inject:""`
 	ContractRequester   insolar.ContractRequester   `inject:""`
 	Rules               network.Rules               `inject:""`
+	Notifier            insolar.Notifier            `inject:""`
 
 	// subcomponents
 	PhaseManager phases.PhaseManager           `inject:"subcomponent"`
@@ -157,6 +160,7 @@ func (n *ServiceNetwork) SetGateway(g network.Gateway) {
 	} else {
 		n.OperableFunc(ctx, true)
 	}
+	n.Notifier.NotifyNetworkState(ctx, g.GetState())
 	n.gateway.Run(ctx)
 }
 
@@ -252,7 +256,7 @@ func (n *ServiceNetwork) Start(ctx context.Context) error {
 	n.RemoteProcedureRegister(deliverWatermillMsg, n.processIncoming)
 
 	n.SetGateway(gateway.NewNoNetwork(n, n.PulseManager, n.NodeKeeper, n.ContractRequester,
-		n.CryptographyService, n.HostNetwork, n.CertificateManager))
+		n.CryptographyService, n.HostNetwork, n.CertificateManager, n.RPC))
 
 	logger.Info("Service network started")
 	return nil
@@ -314,8 +318,6 @@ func (n *ServiceNetwork) HandlePulse(ctx context.Context, newPulse insolar.Pulse
 		return
 	}
 
-	//TODO: use network pulsestorage here
-
 	if n.CurrentPulse.PulseNumber != insolar.GenesisPulse.PulseNumber && !isNextPulse(&n.CurrentPulse, &newPulse) {
 		logger.Infof("Incorrect pulse number. Current: %+v. New: %+v", n.CurrentPulse, newPulse)
 		return
@@ -330,6 +332,11 @@ func (n *ServiceNetwork) ChangePulse(ctx context.Context, newPulse insolar.Pulse
 	logger := inslogger.FromContext(ctx)
 	n.CurrentPulse = newPulse
 
+	// Pulse history is compared by joining nodes and discovery nodes on bootstrap.
+	if err := n.PulseAppender.Append(ctx, newPulse); err != nil {
+		logger.Warn("Failed to append pulse to network pulse storage: ", err.Error())
+	}
+
 	if err := n.NodeKeeper.MoveSyncToActive(ctx, newPulse.PulseNumber); err != nil {
 		logger.Warn("MoveSyncToActive failed: ", err.Error())
 	}
@@ -339,6 +346,18 @@ func (n *ServiceNetwork) ChangePulse(ctx context.Context, newPulse insolar.Pulse
 	}
 }
 
+// PreparePulseChange prepares pulse manager for two-phase pulse change.
+func (n *ServiceNetwork) PreparePulseChange(ctx context.Context, pulseNumber insolar.PulseNumber) {
+	if err := n.PulseManager.Prepare(ctx, pulseNumber); err != nil {
+		inslogger.FromContext(ctx).Error(errors.Wrap(err, "Failed to prepare pulse change"))
+	}
+}
+
+// CancelPulseChange discards prepared pulse change, current pulse continues.
+func (n *ServiceNetwork) CancelPulseChange(ctx context.Context) {
+	n.PulseManager.Cancel(ctx)
+}
+
 func (n *ServiceNetwork) shoudIgnorePulse(newPulse insolar.Pulse) bool {
 	return n.isDiscovery && !n.NodeKeeper.GetConsensusInfo().IsJoiner() &&
 		newPulse.PulseNumber <= n.Bootstrapper.GetLastPulse()+insolar.PulseNumber(n.skip)
@@ -289,7 +289,10 @@ func TestServiceNetwork_StartStop(t *testing.T) {
 	cm.Inject(serviceNetwork, nk, certManager, testutils.NewCryptographyServiceMock(t), pulse.NewAccessorMock(t),
 		testutils.NewTerminationHandlerMock(t), testutils.NewPulseManagerMock(t), &PublisherMock{},
 		testutils.NewMessageBusMock(t), testutils.NewContractRequesterMock(t), rules.NewRules(),
-		bus.NewSenderMock(t), &stater{}, testutils.NewPlatformCryptographyScheme(), testutils.NewKeyProcessorMock(t))
+		bus.NewSenderMock(t), &stater{}, testutils.NewPlatformCryptographyScheme(), testutils.NewKeyProcessorMock(t),
+		networkUtils.NewCloudHashAppenderMock(t), testutils.NewNotifierMock(t).NotifyNetworkStateMock.Return(),
+		networkUtils.NewPulseAppenderMock(t), networkUtils.NewPulseAccessorMock(t), networkUtils.NewPulseCalculatorMock(t),
+		networkUtils.NewPulseRangeHasherMock(t))
 	err = serviceNetwork.Init(ctx)
 	require.NoError(t, err)
 	err = serviceNetwork.Start(ctx)
@@ -335,11 +338,19 @@ func TestServiceNetwork_SetGateway(t *testing.T) {
 	hn.RegisterRequestHandlerMock.Return()
 	sn.HostNetwork = hn
 
+	var states []insolar.NetworkState
+	notifier := testutils.NewNotifierMock(t)
+	notifier.NotifyNetworkStateFunc = func(_ context.Context, state insolar.NetworkState) {
+		states = append(states, state)
+	}
+	sn.Notifier = notifier
+
 	// initial set
 	sn.SetGateway(gateway.NewNoNetwork(sn, sn.PulseManager, sn.NodeKeeper, sn.ContractRequester,
-		sn.CryptographyService, sn.HostNetwork, sn.CertificateManager))
+		sn.CryptographyService, sn.HostNetwork, sn.CertificateManager, sn.RPC))
 	assert.Equal(t, 1, tick)
 	assert.False(t, op)
+	assert.Equal(t, []insolar.NetworkState{insolar.NoNetworkState}, states)
 
 	type Test struct {
 		state insolar.NetworkState
@@ -354,6 +365,6 @@ func TestServiceNetwork_SetGateway(t *testing.T) {
 		sn.SetGateway(sn.Gateway().NewGateway(T.state))
 		assert.Equal(t, i+1, tick)
 		assert.Equal(t, T.lock, op)
-
+		assert.Equal(t, T.state, states[len(states)-1])
 	}
 }
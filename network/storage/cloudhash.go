@@ -52,9 +52,11 @@ package storage
 
 import (
 	"context"
+	"math"
 	"sync"
 
 	"github.com/insolar/insolar/insolar"
+	"github.com/pkg/errors"
 )
 
 //go:generate minimock -i github.com/insolar/insolar/network/storage.CloudHashAccessor -o ../../testutils/network -s _mock.go
@@ -65,6 +67,13 @@ type CloudHashAccessor interface {
 	Latest(ctx context.Context) ([]byte, error)
 }
 
+//go:generate minimock -i github.com/insolar/insolar/network/storage.CloudHashRangeAccessor -o ../../testutils/network -s _mock.go
+
+// CloudHashRangeAccessor provides method for iterating CloudHashes over range of pulses.
+type CloudHashRangeAccessor interface {
+	ForRange(ctx context.Context, pr insolar.PulseRange) ([]CloudHash, error)
+}
+
 //go:generate minimock -i github.com/insolar/insolar/network/storage.CloudHashAppender -o ../../testutils/network -s _mock.go
 
 // CloudHashAppender provides method for appending CloudHash to storage.
@@ -72,34 +81,91 @@ type CloudHashAppender interface {
 	Append(ctx context.Context, pulse insolar.PulseNumber, cloudHash []byte) error
 }
 
+// CloudHash is a cloud hash agreed by consensus in a pulse.
+type CloudHash struct {
+	PulseNumber insolar.PulseNumber
+	Hash        []byte
+}
+
 // NewCloudHashStorage constructor creates CloudHashStorage
 func NewCloudHashStorage() *CloudHashStorage {
 	return &CloudHashStorage{}
 }
 
+// CloudHashStorage persists cloud hashes indexed by pulse number.
 type CloudHashStorage struct {
 	DB   DB `inject:""`
 	lock sync.RWMutex
 }
 
+type cloudHashKey insolar.PulseNumber
+
+func (k cloudHashKey) Scope() Scope {
+	return ScopeCloudHash
+}
+
+func (k cloudHashKey) ID() []byte {
+	return insolar.PulseNumber(k).Bytes()
+}
+
+// ForPulseNumber returns cloud hash for provided pulse. If there is no cloud hash for the pulse, ErrNotFound will be
+// returned.
 func (c *CloudHashStorage) ForPulseNumber(ctx context.Context, pulse insolar.PulseNumber) ([]byte, error) {
 	c.lock.RLock()
 	defer c.lock.RUnlock()
 
-	result, err := c.DB.Get(pulseKey(pulse))
-	if err != nil {
-		return nil, err
-	}
-	return result, err
+	return c.DB.Get(cloudHashKey(pulse))
 }
 
-func (c *CloudHashStorage) Latest(context.Context) ([]byte, error) {
-	panic("implement me")
+// Latest returns cloud hash of the biggest stored pulse. If storage is empty, ErrNotFound will be returned.
+func (c *CloudHashStorage) Latest(ctx context.Context) ([]byte, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	it := c.DB.NewIterator(cloudHashKey(math.MaxUint32), true)
+	defer it.Close()
+
+	if !it.Next() {
+		return nil, ErrNotFound
+	}
+	return it.Value(), nil
 }
 
-func (c *CloudHashStorage) Append(ctx context.Context, pulse insolar.PulseNumber, cloudHash []byte) error {
+// ForRange returns cloud hashes of all stored pulses from the range (inclusive) ordered by pulse number.
+func (c *CloudHashStorage) ForRange(ctx context.Context, pr insolar.PulseRange) ([]CloudHash, error) {
+	if pr.Begin > pr.End {
+		return nil, ErrBadPulseRange
+	}
+
 	c.lock.RLock()
 	defer c.lock.RUnlock()
 
-	return c.DB.Set(pulseKey(pulse), cloudHash)
+	it := c.DB.NewIterator(cloudHashKey(pr.Begin), false)
+	defer it.Close()
+
+	var result []CloudHash
+	for it.Next() {
+		pn := insolar.NewPulseNumber(it.Key())
+		if pn > pr.End {
+			break
+		}
+		result = append(result, CloudHash{PulseNumber: pn, Hash: it.Value()})
+	}
+	return result, nil
+}
+
+// Append saves cloud hash for provided pulse. Cloud hash for a pulse can't be overwritten.
+func (c *CloudHashStorage) Append(ctx context.Context, pulse insolar.PulseNumber, cloudHash []byte) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	_, err := c.DB.Get(cloudHashKey(pulse))
+	if err == nil {
+		return errors.Wrapf(ErrOverride, "cloud hash for pulse %v already exists", pulse)
+	}
+	if err != ErrNotFound {
+		return err
+	}
+
+	return c.DB.Set(cloudHashKey(pulse), cloudHash)
 }
@@ -92,3 +92,51 @@ func TestCloudHashtStorage(t *testing.T) {
 
 	err = cm.Stop(ctx)
 }
+
+func TestCloudHashStorage_LatestAndRange(t *testing.T) {
+	tmpdir, err := ioutil.TempDir("", "bdb-test-")
+	defer os.RemoveAll(tmpdir)
+	require.NoError(t, err)
+
+	ctx := inslogger.TestContext(t)
+	cm := component.NewManager(nil)
+	badgerDB, err := NewBadgerDB(configuration.ServiceNetwork{CacheDirectory: tmpdir})
+	require.NoError(t, err)
+	defer badgerDB.Stop(ctx)
+	cs := NewCloudHashStorage()
+
+	cm.Register(badgerDB, cs)
+	cm.Inject()
+
+	_, err = cs.Latest(ctx)
+	assert.Equal(t, ErrNotFound, err)
+
+	for pn := insolar.PulseNumber(insolar.FirstPulseNumber); pn < insolar.FirstPulseNumber+50; pn += 10 {
+		err = cs.Append(ctx, pn, pn.Bytes())
+		require.NoError(t, err)
+	}
+
+	latest, err := cs.Latest(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, insolar.PulseNumber(insolar.FirstPulseNumber + 40).Bytes(), latest)
+
+	hashes, err := cs.ForRange(ctx, insolar.PulseRange{Begin: insolar.FirstPulseNumber + 5, End: insolar.FirstPulseNumber + 30})
+	require.NoError(t, err)
+	assert.Equal(t, []CloudHash{
+		{PulseNumber: insolar.FirstPulseNumber + 10, Hash: insolar.PulseNumber(insolar.FirstPulseNumber + 10).Bytes()},
+		{PulseNumber: insolar.FirstPulseNumber + 20, Hash: insolar.PulseNumber(insolar.FirstPulseNumber + 20).Bytes()},
+		{PulseNumber: insolar.FirstPulseNumber + 30, Hash: insolar.PulseNumber(insolar.FirstPulseNumber + 30).Bytes()},
+	}, hashes)
+
+	_, err = cs.ForRange(ctx, insolar.PulseRange{Begin: insolar.FirstPulseNumber + 30, End: insolar.FirstPulseNumber})
+	assert.Equal(t, ErrBadPulseRange, err)
+
+	err = cs.Append(ctx, insolar.FirstPulseNumber, []byte{42})
+	assert.Error(t, err)
+	hash, err := cs.ForPulseNumber(ctx, insolar.FirstPulseNumber)
+	require.NoError(t, err)
+	assert.Equal(t, insolar.PulseNumber(insolar.FirstPulseNumber).Bytes(), hash)
+
+	_, err = cs.ForPulseNumber(ctx, insolar.FirstPulseNumber+1)
+	assert.Equal(t, ErrNotFound, err)
+}
@@ -53,11 +53,12 @@ package storage
 import (
 	"bytes"
 	"context"
-
-	"github.com/insolar/insolar/insolar"
-
+	"encoding/binary"
+	"sort"
 	"sync"
 
+	"github.com/insolar/insolar/insolar"
+	"github.com/pkg/errors"
 	"github.com/ugorji/go/codec"
 )
 
@@ -98,16 +99,93 @@ func NewPulseStorage() *PulseStorage {
 }
 
 type PulseStorage struct {
-	DB   DB `inject:""`
-	lock sync.RWMutex
+	DB                 DB                                 `inject:""`
+	CryptographyScheme insolar.PlatformCryptographyScheme `inject:""`
+	lock               sync.RWMutex
+}
+
+// GetRangeHash calculates hash of the pulse chain from Begin to End inclusive. Chain is traversed by Next links, so
+// both pulses must be stored and linked. The hash is calculated incrementally: every next pulse is mixed into the hash
+// of the previous sub-range, so extending a range by one pulse costs a single hash operation.
+func (p *PulseStorage) GetRangeHash(pr insolar.PulseRange) ([]byte, error) {
+	if pr.Begin > pr.End {
+		return nil, ErrBadPulseRange
+	}
+
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	node, err := p.get(pr.Begin)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get pulse %v", pr.Begin)
+	}
+
+	hash := p.extendRangeHash(nil, node.Pulse)
+	for node.Pulse.PulseNumber != pr.End {
+		if node.Next == nil || *node.Next > pr.End {
+			return nil, errors.Wrapf(ErrNotFound, "pulse chain %s is broken after pulse %v", pr.String(), node.Pulse.PulseNumber)
+		}
+		next := *node.Next
+		node, err = p.get(next)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get pulse %v", next)
+		}
+		hash = p.extendRangeHash(hash, node.Pulse)
+	}
+
+	return hash, nil
+}
+
+// ValidateRangeHash checks that provided hash is equal to the hash of the locally stored pulse chain for the range.
+func (p *PulseStorage) ValidateRangeHash(pr insolar.PulseRange, hash []byte) (bool, error) {
+	local, err := p.GetRangeHash(pr)
+	if err != nil {
+		return false, err
+	}
+	return bytes.Equal(local, hash), nil
 }
 
-func (p *PulseStorage) GetRangeHash(insolar.PulseRange) ([]byte, error) {
-	panic("implement me")
+// extendRangeHash mixes pulse into the range hash: H(prev || H(pulse)). Hash of a range of one pulse is H(H(pulse)).
+func (p *PulseStorage) extendRangeHash(prev []byte, pulse insolar.Pulse) []byte {
+	pulseHash := p.CryptographyScheme.IntegrityHasher().Hash(pulseBytes(pulse))
+
+	hasher := p.CryptographyScheme.IntegrityHasher()
+	_, _ = hasher.Write(prev)
+	_, _ = hasher.Write(pulseHash)
+	return hasher.Sum(nil)
+}
+
+// pulseBytes returns deterministic binary representation of a pulse. Signs are written in order of their keys.
+// Variable-length fields are prefixed with their length, so different pulses can't produce the same bytes.
+func pulseBytes(pulse insolar.Pulse) []byte {
+	buf := bytes.NewBuffer(nil)
+	buf.Write(pulse.PulseNumber.Bytes())
+	buf.Write(pulse.PrevPulseNumber.Bytes())
+	buf.Write(pulse.NextPulseNumber.Bytes())
+	_ = binary.Write(buf, binary.BigEndian, pulse.PulseTimestamp)
+	_ = binary.Write(buf, binary.BigEndian, int64(pulse.EpochPulseNumber))
+	buf.Write(pulse.OriginID[:])
+	buf.Write(pulse.Entropy[:])
+
+	keys := make([]string, 0, len(pulse.Signs))
+	for key := range pulse.Signs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		sign := pulse.Signs[key]
+		writeWithLength(buf, []byte(key))
+		writeWithLength(buf, []byte(sign.ChosenPublicKey))
+		buf.Write(sign.PulseNumber.Bytes())
+		buf.Write(sign.Entropy[:])
+		writeWithLength(buf, sign.Signature)
+	}
+	return buf.Bytes()
 }
 
-func (p *PulseStorage) ValidateRangeHash(insolar.PulseRange, []byte) (bool, error) {
-	panic("implement me")
+func writeWithLength(buf *bytes.Buffer, data []byte) {
+	_ = binary.Write(buf, binary.BigEndian, uint32(len(data)))
+	buf.Write(data)
 }
 
 // Forwards calculates steps pulses forwards from provided Pulse. If calculated Pulse does not exist, ErrNotFound will
@@ -59,6 +59,7 @@ import (
 	"github.com/insolar/insolar/configuration"
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/platformpolicy"
 	"github.com/stretchr/testify/require"
 
 	"github.com/stretchr/testify/assert"
@@ -75,7 +76,7 @@ func TestNewPulseStorage(t *testing.T) {
 	defer badgerDB.Stop(ctx)
 	ps := NewPulseStorage()
 
-	cm.Register(badgerDB, ps)
+	cm.Register(badgerDB, ps, platformpolicy.NewPlatformCryptographyScheme())
 	cm.Inject()
 
 	pulse := insolar.Pulse{PulseNumber: 15}
@@ -90,3 +91,105 @@ func TestNewPulseStorage(t *testing.T) {
 	err = cm.Stop(ctx)
 
 }
+
+func TestPulseStorage_RangeHash(t *testing.T) {
+	tmpdir, err := ioutil.TempDir("", "bdb-test-")
+	defer os.RemoveAll(tmpdir)
+	require.NoError(t, err)
+
+	ctx := inslogger.TestContext(t)
+	cm := component.NewManager(nil)
+	badgerDB, err := NewBadgerDB(configuration.ServiceNetwork{CacheDirectory: tmpdir})
+	require.NoError(t, err)
+	defer badgerDB.Stop(ctx)
+	ps := NewPulseStorage()
+
+	cm.Register(badgerDB, ps, platformpolicy.NewPlatformCryptographyScheme())
+	cm.Inject()
+
+	for pn := insolar.PulseNumber(insolar.FirstPulseNumber); pn < insolar.FirstPulseNumber+50; pn += 10 {
+		err = ps.Append(ctx, insolar.Pulse{
+			PulseNumber:     pn,
+			PrevPulseNumber: pn - 10,
+			NextPulseNumber: pn + 10,
+			Entropy:         insolar.Entropy{byte(pn)},
+		})
+		require.NoError(t, err)
+	}
+
+	full := insolar.PulseRange{Begin: insolar.FirstPulseNumber, End: insolar.FirstPulseNumber + 40}
+	hash, err := ps.GetRangeHash(full)
+	require.NoError(t, err)
+	require.NotEmpty(t, hash)
+
+	t.Run("deterministic", func(t *testing.T) {
+		again, err := ps.GetRangeHash(full)
+		require.NoError(t, err)
+		assert.Equal(t, hash, again)
+	})
+
+	t.Run("incremental", func(t *testing.T) {
+		sub, err := ps.GetRangeHash(insolar.PulseRange{Begin: full.Begin, End: full.End - 10})
+		require.NoError(t, err)
+		assert.NotEqual(t, hash, sub)
+
+		last, err := ps.ForPulseNumber(ctx, full.End)
+		require.NoError(t, err)
+		node, err := ps.get(last)
+		require.NoError(t, err)
+		assert.Equal(t, hash, ps.extendRangeHash(sub, node.Pulse))
+	})
+
+	t.Run("validate", func(t *testing.T) {
+		ok, err := ps.ValidateRangeHash(full, hash)
+		require.NoError(t, err)
+		assert.True(t, ok)
+
+		ok, err = ps.ValidateRangeHash(full, append([]byte{}, hash[1:]...))
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("single pulse", func(t *testing.T) {
+		_, err := ps.GetRangeHash(insolar.PulseRange{Begin: full.Begin, End: full.Begin})
+		require.NoError(t, err)
+	})
+
+	t.Run("bad range", func(t *testing.T) {
+		_, err := ps.GetRangeHash(insolar.PulseRange{Begin: full.End, End: full.Begin})
+		assert.Equal(t, ErrBadPulseRange, err)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := ps.GetRangeHash(insolar.PulseRange{Begin: full.Begin, End: full.End + 5})
+		assert.Error(t, err)
+
+		_, err = ps.ValidateRangeHash(insolar.PulseRange{Begin: full.Begin - 1, End: full.End}, hash)
+		assert.Error(t, err)
+	})
+}
+
+func TestPulseBytes_SignsAreNotAmbiguous(t *testing.T) {
+	first := insolar.Pulse{
+		PulseNumber: insolar.FirstPulseNumber,
+		Signs: map[string]insolar.PulseSenderConfirmation{
+			"key": {ChosenPublicKey: "ab", Signature: []byte("c")},
+		},
+	}
+	second := insolar.Pulse{
+		PulseNumber: insolar.FirstPulseNumber,
+		Signs: map[string]insolar.PulseSenderConfirmation{
+			"key": {ChosenPublicKey: "a", Signature: []byte("bc")},
+		},
+	}
+	third := insolar.Pulse{
+		PulseNumber: insolar.FirstPulseNumber,
+		Signs: map[string]insolar.PulseSenderConfirmation{
+			"keya": {ChosenPublicKey: "b", Signature: []byte("c")},
+		},
+	}
+
+	assert.NotEqual(t, pulseBytes(first), pulseBytes(second))
+	assert.NotEqual(t, pulseBytes(first), pulseBytes(third))
+	assert.Equal(t, pulseBytes(first), pulseBytes(first))
+}
@@ -52,7 +52,9 @@ package storage
 
 import (
 	"context"
+	"os"
 	"path/filepath"
+	"sync"
 
 	"github.com/dgraph-io/badger"
 	"github.com/insolar/insolar/configuration"
@@ -64,6 +66,10 @@ var (
 	// ErrNotFound is returned when value was not found.
 	ErrNotFound = errors.New("value not found")
 	ErrBadPulse = errors.New("pulse should be bigger than latest")
+	// ErrOverride is returned when trying to overwrite immutable value.
+	ErrOverride = errors.New("value can't be overridden")
+	// ErrBadPulseRange is returned when range begin is greater than range end.
+	ErrBadPulseRange = errors.New("pulse range begin should not be bigger than end")
 )
 
 type pulseKey insolar.PulseNumber
@@ -77,9 +83,24 @@ func (k pulseKey) ID() []byte {
 }
 
 // DB provides a simple key-value store interface for persisting data.
+// It is internally ordered (lexicographically by key bytes), so it can be walked with Iterator.
 type DB interface {
 	Get(key Key) (value []byte, err error)
 	Set(key Key, value []byte) error
+	NewIterator(pivot Key, reverse bool) Iterator
+}
+
+// Iterator provides an interface for walking through the storage record sequence (where records are sorted
+// lexicographically). Iteration is limited by pivot scope.
+type Iterator interface {
+	// Next moves the iterator to the next key-value pair.
+	Next() bool
+	// Close frees resources within the iterator and invalidates it.
+	Close()
+	// Key returns only the second part of the composite key - (ID) without scope id.
+	Key() []byte
+	// Value returns value itself.
+	Value() []byte
 }
 
 // Key represents a key for the key-value store. Scope is required to separate different DB clients and should be
@@ -100,6 +121,8 @@ func (s Scope) Bytes() []byte {
 const (
 	// ScopePulse is the scope for pulse storage.
 	ScopePulse Scope = 1
+	// ScopeCloudHash is the scope for cloud hash storage.
+	ScopeCloudHash Scope = 2
 )
 
 type BadgerDB struct {
@@ -113,6 +136,9 @@ func NewBadgerDB(conf configuration.ServiceNetwork) (*BadgerDB, error) {
 	if err != nil {
 		return nil, err
 	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, errors.Wrap(err, "failed to create cache directory")
+	}
 
 	ops := badger.DefaultOptions(dir)
 	bdb, err := badger.Open(ops)
@@ -172,6 +198,60 @@ func (b *BadgerDB) Set(key Key, value []byte) error {
 	return nil
 }
 
+// NewIterator returns new Iterator over the store. Iterator starts from pivot key and walks through keys of pivot
+// scope in ascending or (if reverse is true) descending order.
+func (b *BadgerDB) NewIterator(pivot Key, reverse bool) Iterator {
+	bi := badgerIterator{pivot: pivot}
+	bi.txn = b.db.NewTransaction(false)
+	opts := badger.DefaultIteratorOptions
+	opts.Reverse = reverse
+	bi.it = bi.txn.NewIterator(opts)
+	return &bi
+}
+
+type badgerIterator struct {
+	once  sync.Once
+	pivot Key
+	txn   *badger.Txn
+	it    *badger.Iterator
+	key   []byte
+	value []byte
+}
+
+func (bi *badgerIterator) Close() {
+	bi.it.Close()
+	bi.txn.Discard()
+}
+
+func (bi *badgerIterator) Next() bool {
+	scope := bi.pivot.Scope().Bytes()
+	bi.once.Do(func() {
+		bi.it.Seek(append(bi.pivot.Scope().Bytes(), bi.pivot.ID()...))
+	})
+	if !bi.it.ValidForPrefix(scope) {
+		return false
+	}
+
+	key := bi.it.Item().KeyCopy(nil)
+	value, err := bi.it.Item().ValueCopy(nil)
+	if err != nil {
+		return false
+	}
+	bi.key = key[len(scope):]
+	bi.value = value
+
+	bi.it.Next()
+	return true
+}
+
+func (bi *badgerIterator) Key() []byte {
+	return bi.key
+}
+
+func (bi *badgerIterator) Value() []byte {
+	return bi.value
+}
+
 // Stop gracefully stops all disk writes. After calling this, it's safe to kill the process without losing data.
 func (b *BadgerDB) Stop(ctx context.Context) error {
 	return b.db.Close()
@@ -140,3 +140,51 @@ func TestBadgerDB_Set(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, expectedValue, value)
 }
+
+func TestBadgerDB_NewIterator(t *testing.T) {
+	t.Parallel()
+
+	ctx := inslogger.TestContext(t)
+
+	tmpdir, err := ioutil.TempDir("", "bdb-test-")
+	defer os.RemoveAll(tmpdir)
+	require.NoError(t, err)
+
+	db, err := NewBadgerDB(configuration.ServiceNetwork{CacheDirectory: tmpdir})
+	defer db.Stop(ctx)
+	require.NoError(t, err)
+
+	for i := byte(1); i <= 5; i++ {
+		err = db.Set(testBadgerKey{id: []byte{i}, scope: 10}, []byte{i * 10})
+		require.NoError(t, err)
+	}
+	// Keys from other scopes should not be visited.
+	err = db.Set(testBadgerKey{id: []byte{1}, scope: 11}, []byte{1})
+	require.NoError(t, err)
+	err = db.Set(testBadgerKey{id: []byte{9}, scope: 9}, []byte{9})
+	require.NoError(t, err)
+
+	t.Run("forward", func(t *testing.T) {
+		it := db.NewIterator(testBadgerKey{id: []byte{2}, scope: 10}, false)
+		defer it.Close()
+
+		var keys, values []byte
+		for it.Next() {
+			keys = append(keys, it.Key()...)
+			values = append(values, it.Value()...)
+		}
+		assert.Equal(t, []byte{2, 3, 4, 5}, keys)
+		assert.Equal(t, []byte{20, 30, 40, 50}, values)
+	})
+
+	t.Run("reverse", func(t *testing.T) {
+		it := db.NewIterator(testBadgerKey{id: []byte{4}, scope: 10}, true)
+		defer it.Close()
+
+		var keys []byte
+		for it.Next() {
+			keys = append(keys, it.Key()...)
+		}
+		assert.Equal(t, []byte{4, 3, 2, 1}, keys)
+	})
+}
@@ -52,7 +52,6 @@ package termination
 
 import (
 	"context"
-	"fmt"
 	"sync"
 
 	"github.com/insolar/insolar/insolar/pulse"
@@ -65,6 +64,9 @@ type terminationHandler struct {
 	sync.Mutex
 	done        chan insolar.LeaveApproved
 	terminating bool
+	reason      insolar.LeaveReason
+	// leavePulse is a pulse since which leave should be announced, zero means immediately.
+	leavePulse insolar.PulseNumber
 
 	Network       insolar.Network `inject:""`
 	PulseAccessor pulse.Accessor  `inject:""`
@@ -76,36 +78,79 @@ func NewHandler(nw insolar.Network) insolar.TerminationHandler {
 
 // TODO take ETA by role of node
 func (t *terminationHandler) Leave(ctx context.Context, leaveAfterPulses insolar.PulseNumber) {
-	doneChan := t.leave(ctx, leaveAfterPulses)
+	doneChan := t.leave(ctx, insolar.LeaveReasonShutdown, leaveAfterPulses)
 	<-doneChan
 }
 
-func (t *terminationHandler) leave(ctx context.Context, leaveAfterPulses insolar.PulseNumber) chan insolar.LeaveApproved {
+// RequestLeave asks network to remove the node with provided reason. Leave is announced after provided number of
+// pulses, so light and virtual nodes hand off their work to other nodes on pulse change before they leave.
+func (t *terminationHandler) RequestLeave(
+	ctx context.Context, reason insolar.LeaveReason, leaveAfterPulses insolar.PulseNumber,
+) <-chan insolar.LeaveApproved {
+	return t.leave(ctx, reason, leaveAfterPulses)
+}
+
+func (t *terminationHandler) leave(
+	ctx context.Context, reason insolar.LeaveReason, leaveAfterPulses insolar.PulseNumber,
+) chan insolar.LeaveApproved {
 	t.Lock()
 	defer t.Unlock()
 
 	if !t.terminating {
 		t.terminating = true
 		t.done = make(chan insolar.LeaveApproved, 1)
+		t.reason = reason
+
+		var pulse insolar.Pulse
+		var err error
+		if leaveAfterPulses != 0 {
+			pulse, err = t.PulseAccessor.Latest(ctx)
+			if err != nil {
+				// node without pulse has no work to hand off, so it leaves immediately
+				inslogger.FromContext(ctx).Warn("terminationHandler.Leave() failed to get latest pulse, leaving immediately: ", err)
+				leaveAfterPulses = 0
+			}
+		}
 
 		if leaveAfterPulses == 0 {
 			inslogger.FromContext(ctx).Debug("terminationHandler.Leave() with 0")
+			t.leavePulse = 0
 			t.Network.Leave(ctx, 0)
 		} else {
-			pulse, err := t.PulseAccessor.Latest(ctx)
-			if err != nil {
-				panic(fmt.Sprintf("smth goes wrong. There is no pulse in the storage. err - %v", err))
-			}
 			pulseDelta := pulse.NextPulseNumber - pulse.PulseNumber
 
 			inslogger.FromContext(ctx).Debugf("terminationHandler.Leave() with leaveAfterPulses: %+v, in pulse %+v", leaveAfterPulses, pulse.PulseNumber+leaveAfterPulses*pulseDelta)
-			t.Network.Leave(ctx, pulse.PulseNumber+leaveAfterPulses*pulseDelta)
+			t.leavePulse = pulse.PulseNumber + leaveAfterPulses*pulseDelta
+			t.Network.Leave(ctx, t.leavePulse)
 		}
 	}
 
 	return t.done
 }
 
+// RequiredLeave returns reason of requested leave once the pulse it was requested for is reached.
+func (t *terminationHandler) RequiredLeave(ctx context.Context) (insolar.LeaveReason, bool) {
+	t.Lock()
+	defer t.Unlock()
+
+	if !t.terminating {
+		return insolar.LeaveReasonUnknown, false
+	}
+	if t.leavePulse == 0 {
+		return t.reason, true
+	}
+
+	pulse, err := t.PulseAccessor.Latest(ctx)
+	if err != nil {
+		inslogger.FromContext(ctx).Error("terminationHandler.RequiredLeave() failed to get latest pulse: ", err)
+		return insolar.LeaveReasonUnknown, false
+	}
+	if pulse.PulseNumber < t.leavePulse {
+		return insolar.LeaveReasonUnknown, false
+	}
+	return t.reason, true
+}
+
 func (t *terminationHandler) OnLeaveApproved(ctx context.Context) {
 	t.Lock()
 	defer t.Unlock()
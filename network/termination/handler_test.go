@@ -113,7 +113,7 @@ type LeaveTestSuite struct {
 
 func (s *LeaveTestSuite) TestLeaveNow() {
 	s.network.LeaveMock.Expect(s.ctx, 0)
-	s.handler.leave(s.ctx, 0)
+	s.handler.leave(s.ctx, insolar.LeaveReasonShutdown, 0)
 
 	s.HandlerIsTerminating()
 }
@@ -126,11 +126,62 @@ func (s *LeaveTestSuite) TestLeaveEta() {
 
 	s.pulseAccessor.LatestMock.Return(*testPulse, nil)
 	s.network.LeaveMock.Expect(s.ctx, mockPulseNumber+leaveAfter*pulseDelta)
-	s.handler.leave(s.ctx, leaveAfter)
+	s.handler.leave(s.ctx, insolar.LeaveReasonShutdown, leaveAfter)
 
 	s.HandlerIsTerminating()
 }
 
+func (s *LeaveTestSuite) TestLeaveEtaWithoutPulse() {
+	s.pulseAccessor.LatestMock.Return(insolar.Pulse{}, pulse.ErrNotFound)
+	s.network.LeaveMock.Expect(s.ctx, 0)
+	s.handler.leave(s.ctx, insolar.LeaveReasonLogOff, 1)
+
+	s.HandlerIsTerminating()
+	s.Equal(insolar.PulseNumber(0), s.handler.leavePulse)
+}
+
+func TestRequiredLeave(t *testing.T) {
+	suite.Run(t, new(RequiredLeaveTestSuite))
+}
+
+type RequiredLeaveTestSuite struct {
+	CommonTestSuite
+}
+
+func (s *RequiredLeaveTestSuite) TestNotRequested() {
+	_, required := s.handler.RequiredLeave(s.ctx)
+	s.False(required)
+}
+
+func (s *RequiredLeaveTestSuite) TestLeaveNow() {
+	s.network.LeaveMock.Expect(s.ctx, 0)
+	s.handler.RequestLeave(s.ctx, insolar.LeaveReasonLogOff, 0)
+
+	reason, required := s.handler.RequiredLeave(s.ctx)
+	s.True(required)
+	s.Equal(insolar.LeaveReasonLogOff, reason)
+}
+
+func (s *RequiredLeaveTestSuite) TestLeaveEta() {
+	testPulse := insolar.Pulse{PulseNumber: 2000000000, NextPulseNumber: 2000000010}
+	s.pulseAccessor.LatestMock.Return(testPulse, nil)
+	s.network.LeaveMock.Expect(s.ctx, testPulse.PulseNumber+20)
+	s.handler.RequestLeave(s.ctx, insolar.LeaveReasonLogOff, 2)
+
+	_, required := s.handler.RequiredLeave(s.ctx)
+	s.False(required)
+
+	testPulse.PulseNumber += 20
+	s.pulseAccessor.LatestMock.Return(testPulse, nil)
+	reason, required := s.handler.RequiredLeave(s.ctx)
+	s.True(required)
+	s.Equal(insolar.LeaveReasonLogOff, reason)
+
+	s.handler.OnLeaveApproved(s.ctx)
+	_, required = s.handler.RequiredLeave(s.ctx)
+	s.False(required)
+}
+
 func TestOnLeaveApproved(t *testing.T) {
 	suite.Run(t, new(OnLeaveApprovedTestSuite))
 }
@@ -72,6 +72,7 @@ import (
 
 	"github.com/insolar/insolar/insolar/bus"
 	"github.com/insolar/insolar/network/servicenetwork"
+	"github.com/insolar/insolar/network/storage"
 
 	"github.com/insolar/insolar/certificate"
 	"github.com/insolar/insolar/component"
@@ -87,6 +88,7 @@ import (
 	"github.com/insolar/insolar/network/transport"
 	"github.com/insolar/insolar/platformpolicy"
 	"github.com/insolar/insolar/testutils"
+	networkUtils "github.com/insolar/insolar/testutils/network"
 )
 
 var (
@@ -468,6 +470,13 @@ func (p *pulseManagerMock) Set(ctx context.Context, pulse insolar.Pulse) error {
 	return p.keeper.MoveSyncToActive(ctx, pulse.PulseNumber)
 }
 
+func (p *pulseManagerMock) Prepare(ctx context.Context, pn insolar.PulseNumber) error {
+	return nil
+}
+
+func (p *pulseManagerMock) Cancel(ctx context.Context) {
+}
+
 type staterMock struct {
 	stateFunc func() []byte
 }
@@ -536,9 +545,18 @@ func (s *testSuite) preInitNode(node *networkNode) {
 	mb := testutils.NewMessageBusMock(t)
 	mb.MustRegisterMock.Return()
 
+	cloudHashes := networkUtils.NewCloudHashAppenderMock(t)
+	cloudHashes.AppendMock.Return(nil)
+
+	notifier := testutils.NewNotifierMock(t)
+	notifier.NotifyNetworkStateMock.Return()
+
+	networkDB, err := storage.NewBadgerDB(cfg.Service)
+	s.Require().NoError(err)
+
 	node.componentManager.Inject(realKeeper, newPulseManagerMock(realKeeper.(network.NodeKeeper)), pubMock,
 		&amMock, certManager, cryptographyService, serviceNetwork, keyProc, terminationHandler,
-		mb, testutils.NewContractRequesterMock(t), senderMock)
+		mb, testutils.NewContractRequesterMock(t), senderMock, cloudHashes, notifier, networkDB, storage.NewPulseStorage())
 
 	serviceNetwork.SetOperableFunc(func(ctx context.Context, operable bool) {
 	})
@@ -0,0 +1,234 @@
+//
+// Modified BSD 3-Clause Clear License
+//
+// Copyright (c) 2019 Insolar Technologies GmbH
+//
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without modification,
+// are permitted (subject to the limitations in the disclaimer below) provided that
+// the following conditions are met:
+//  * Redistributions of source code must retain the above copyright notice, this list
+//    of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright notice, this list
+//    of conditions and the following disclaimer in the documentation and/or other materials
+//    provided with the distribution.
+//  * Neither the name of Insolar Technologies GmbH nor the names of its contributors
+//    may be used to endorse or promote products derived from this software without
+//    specific prior written permission.
+//
+// NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED
+// BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS
+// AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES,
+// INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL
+// THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT,
+// INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+// BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS
+// OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+// ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+//
+// Notwithstanding any other provisions of this license, it is prohibited to:
+//    (a) use this software,
+//
+//    (b) prepare modifications and derivative works of this software,
+//
+//    (c) distribute this software (including without limitation in source code, binary or
+//        object code form), and
+//
+//    (d) reproduce copies of this software
+//
+//    for any commercial purposes, and/or
+//
+//    for the purposes of making available this software to third parties as a service,
+//    including, without limitation, any software-as-a-service, platform-as-a-service,
+//    infrastructure-as-a-service or other similar online service, irrespective of
+//    whether it competes with the products or services of Insolar Technologies GmbH.
+//
+
+package throttle
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"go.opencensus.io/stats"
+
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/network/consensus/common/capacity"
+)
+
+// DefaultCapacity is a number of requests processed simultaneously when traffic is not limited.
+const DefaultCapacity = 100
+
+// Gate is an intake of application traffic.
+type Gate interface {
+	// Acquire blocks until request can be processed or ctx is done. Returned func should be called when processing
+	// is finished.
+	Acquire(ctx context.Context) (release func(), err error)
+}
+
+// Limiter throttles application traffic on consensus request. Traffic is limited by a number of requests processed
+// simultaneously, which is a percent of capacity provided by capacity.Level.
+type Limiter struct {
+	capacity int
+
+	lock       sync.Mutex
+	level      capacity.Level
+	limit      int
+	inFlight   int
+	changed    chan struct{}
+	generation uint64
+	timer      *time.Timer
+}
+
+// NewLimiter creates Limiter with provided capacity. Traffic is not limited initially.
+func NewLimiter(size int) *Limiter {
+	return &Limiter{
+		capacity: size,
+		level:    capacity.LevelNormal,
+		limit:    size,
+		changed:  make(chan struct{}),
+	}
+}
+
+// SetLimit limits traffic to provided level for provided duration. After duration passes limit is released
+// automatically. LevelNormal and LevelMax remove the limit, duration doesn't apply to them.
+func (l *Limiter) SetLimit(ctx context.Context, level capacity.Level, duration time.Duration) {
+	if level >= capacity.LevelNormal {
+		l.Resume(ctx)
+		return
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.generation++
+	if l.timer != nil {
+		l.timer.Stop()
+	}
+	generation := l.generation
+	l.timer = time.AfterFunc(duration, func() {
+		l.expire(ctx, generation)
+	})
+
+	l.apply(ctx, level)
+	inslogger.FromContext(ctx).Infof("[ SetLimit ] traffic limited to %d%% for %s", level.DefaultPercent(), duration)
+}
+
+// Resume releases traffic limit.
+func (l *Limiter) Resume(ctx context.Context) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.generation++
+	if l.timer != nil {
+		l.timer.Stop()
+		l.timer = nil
+	}
+
+	if l.level == capacity.LevelNormal {
+		return
+	}
+	l.apply(ctx, capacity.LevelNormal)
+	inslogger.FromContext(ctx).Info("[ Resume ] traffic limit released")
+}
+
+// Level returns current traffic level.
+func (l *Limiter) Level() capacity.Level {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	return l.level
+}
+
+// Acquire blocks until request can be processed according to current limit or ctx is done.
+func (l *Limiter) Acquire(ctx context.Context) (func(), error) {
+	throttled := false
+	for {
+		l.lock.Lock()
+		if l.inFlight < l.limit {
+			l.inFlight++
+			stats.Record(ctx, statInFlight.M(int64(l.inFlight)))
+			l.lock.Unlock()
+			return func() { l.release(ctx) }, nil
+		}
+		changed := l.changed
+		l.lock.Unlock()
+
+		if !throttled {
+			throttled = true
+			stats.Record(ctx, statThrottled.M(1))
+		}
+
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// Middleware returns a watermill middleware passing messages for which gated returns true through the limiter.
+// Only new requests should be gated: replies are awaited by requests which already passed the limiter, so
+// throttling them leads to deadlock when all slots are taken by waiting requests.
+func (l *Limiter) Middleware(gated func(msg *message.Message) bool) message.HandlerMiddleware {
+	return func(h message.HandlerFunc) message.HandlerFunc {
+		return func(msg *message.Message) ([]*message.Message, error) {
+			if !gated(msg) {
+				return h(msg)
+			}
+
+			release, err := l.Acquire(msg.Context())
+			if err != nil {
+				return nil, err
+			}
+			defer release()
+
+			return h(msg)
+		}
+	}
+}
+
+func (l *Limiter) release(ctx context.Context) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.inFlight--
+	stats.Record(ctx, statInFlight.M(int64(l.inFlight)))
+	l.notify()
+}
+
+func (l *Limiter) expire(ctx context.Context, generation uint64) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if generation != l.generation {
+		return
+	}
+	l.timer = nil
+	l.apply(ctx, capacity.LevelNormal)
+	inslogger.FromContext(ctx).Info("[ expire ] traffic limit expired")
+}
+
+// apply should be called under lock.
+func (l *Limiter) apply(ctx context.Context, level capacity.Level) {
+	// LevelNormal is a full capacity, so 0, 25, 75 and 100 percents are applied.
+	l.level = level
+	l.limit = l.capacity * level.DefaultPercent() / capacity.LevelNormal.DefaultPercent()
+	if l.limit > l.capacity {
+		l.limit = l.capacity
+	}
+	stats.Record(ctx, statLevel.M(int64(level)), statLimit.M(int64(l.limit)))
+	l.notify()
+}
+
+// notify wakes up waiting Acquire calls, should be called under lock.
+func (l *Limiter) notify() {
+	close(l.changed)
+	l.changed = make(chan struct{})
+}
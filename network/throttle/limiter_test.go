@@ -0,0 +1,173 @@
+//
+// Modified BSD 3-Clause Clear License
+//
+// Copyright (c) 2019 Insolar Technologies GmbH
+//
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without modification,
+// are permitted (subject to the limitations in the disclaimer below) provided that
+// the following conditions are met:
+//  * Redistributions of source code must retain the above copyright notice, this list
+//    of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright notice, this list
+//    of conditions and the following disclaimer in the documentation and/or other materials
+//    provided with the distribution.
+//  * Neither the name of Insolar Technologies GmbH nor the names of its contributors
+//    may be used to endorse or promote products derived from this software without
+//    specific prior written permission.
+//
+// NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED
+// BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS
+// AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES,
+// INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL
+// THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT,
+// INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+// BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS
+// OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+// ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+//
+// Notwithstanding any other provisions of this license, it is prohibited to:
+//    (a) use this software,
+//
+//    (b) prepare modifications and derivative works of this software,
+//
+//    (c) distribute this software (including without limitation in source code, binary or
+//        object code form), and
+//
+//    (d) reproduce copies of this software
+//
+//    for any commercial purposes, and/or
+//
+//    for the purposes of making available this software to third parties as a service,
+//    including, without limitation, any software-as-a-service, platform-as-a-service,
+//    infrastructure-as-a-service or other similar online service, irrespective of
+//    whether it competes with the products or services of Insolar Technologies GmbH.
+//
+
+package throttle
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/network/consensus/common/capacity"
+)
+
+func TestLimiter_Acquire(t *testing.T) {
+	ctx := context.Background()
+	l := NewLimiter(4)
+
+	var releases []func()
+	for i := 0; i < 4; i++ {
+		release, err := l.Acquire(ctx)
+		require.NoError(t, err)
+		releases = append(releases, release)
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	_, err := l.Acquire(timeoutCtx)
+	assert.Equal(t, context.DeadlineExceeded, err)
+
+	releases[0]()
+	_, err = l.Acquire(ctx)
+	assert.NoError(t, err)
+}
+
+func TestLimiter_SetLimit(t *testing.T) {
+	ctx := context.Background()
+	l := NewLimiter(4)
+
+	l.SetLimit(ctx, capacity.LevelReduced, time.Minute)
+	assert.Equal(t, capacity.LevelReduced, l.Level())
+	assert.Equal(t, 3, l.limit)
+
+	l.SetLimit(ctx, capacity.LevelZero, time.Minute)
+	assert.Equal(t, 0, l.limit)
+
+	acquired := make(chan struct{})
+	go func() {
+		release, err := l.Acquire(ctx)
+		require.NoError(t, err)
+		release()
+		close(acquired)
+	}()
+
+	select {
+	case <-acquired:
+		t.Fatal("acquired while traffic is stopped")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	l.Resume(ctx)
+	assert.Equal(t, capacity.LevelNormal, l.Level())
+	select {
+	case <-acquired:
+	case <-time.After(time.Second):
+		t.Fatal("not acquired after traffic resumed")
+	}
+}
+
+func TestLimiter_SetLimit_Expires(t *testing.T) {
+	ctx := context.Background()
+	l := NewLimiter(4)
+
+	l.SetLimit(ctx, capacity.LevelZero, time.Hour)
+	l.SetLimit(ctx, capacity.LevelMinimal, 10*time.Millisecond)
+	assert.Equal(t, 1, l.limit)
+
+	deadline := time.Now().Add(time.Second)
+	for l.Level() != capacity.LevelNormal && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	assert.Equal(t, capacity.LevelNormal, l.Level())
+	assert.Equal(t, 4, l.limit)
+}
+
+func TestLimiter_SetLimit_NormalResumes(t *testing.T) {
+	ctx := context.Background()
+	l := NewLimiter(4)
+
+	l.SetLimit(ctx, capacity.LevelZero, time.Hour)
+	l.SetLimit(ctx, capacity.LevelMax, time.Hour)
+	assert.Equal(t, capacity.LevelNormal, l.Level())
+	assert.Equal(t, 4, l.limit)
+}
+
+func TestLimiter_Middleware(t *testing.T) {
+	ctx := context.Background()
+	l := NewLimiter(4)
+	l.SetLimit(ctx, capacity.LevelZero, time.Minute)
+
+	handled := 0
+	h := l.Middleware(func(msg *message.Message) bool {
+		return msg.Metadata.Get("type") == "request"
+	})(func(msg *message.Message) ([]*message.Message, error) {
+		handled++
+		return nil, nil
+	})
+
+	reply := message.NewMessage("1", nil)
+	reply.Metadata.Set("type", "reply")
+	_, err := h(reply)
+	require.NoError(t, err)
+	assert.Equal(t, 1, handled)
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	request := message.NewMessage("2", nil)
+	request.Metadata.Set("type", "request")
+	request.SetContext(timeoutCtx)
+	_, err = h(request)
+	assert.Equal(t, context.DeadlineExceeded, err)
+	assert.Equal(t, 1, handled)
+}
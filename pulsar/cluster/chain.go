@@ -0,0 +1,113 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cluster
+
+import (
+	"bytes"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/platformpolicy"
+	"github.com/insolar/insolar/pulsar"
+)
+
+// CheckChain checks that pulses produced by a pulsar form a chain: numbers grow, every pulse refers
+// to the previous one, has entropy and is signed by at least minSigns pulsars with valid signatures.
+// Genesis pulse is skipped.
+func CheckChain(pulses []pulsar.HistoryPulse, minSigns int) error {
+	scheme := platformpolicy.NewPlatformCryptographyScheme()
+	keyProcessor := platformpolicy.NewKeyProcessor()
+	emptyEntropy := make([]byte, insolar.EntropySize)
+
+	var prev *pulsar.HistoryPulse
+	for i := range pulses {
+		pulse := &pulses[i]
+		if pulse.PulseNumber == insolar.FirstPulseNumber {
+			continue
+		}
+
+		if prev != nil {
+			if pulse.PulseNumber <= prev.PulseNumber {
+				return errors.Errorf("pulse %d goes after pulse %d", pulse.PulseNumber, prev.PulseNumber)
+			}
+			if pulse.PrevPulseNumber != prev.PulseNumber {
+				return errors.Errorf("pulse %d refers to %d as previous, but previous is %d",
+					pulse.PulseNumber, pulse.PrevPulseNumber, prev.PulseNumber)
+			}
+		}
+		if pulse.NextPulseNumber <= pulse.PulseNumber {
+			return errors.Errorf("pulse %d has wrong next pulse number %d", pulse.PulseNumber, pulse.NextPulseNumber)
+		}
+		if bytes.Equal(pulse.Entropy, emptyEntropy) {
+			return errors.Errorf("pulse %d has empty entropy", pulse.PulseNumber)
+		}
+		if len(pulse.Signs) < minSigns {
+			return errors.Errorf("pulse %d is signed by %d pulsars, expected at least %d",
+				pulse.PulseNumber, len(pulse.Signs), minSigns)
+		}
+
+		for _, sign := range pulse.Signs {
+			publicKey, err := keyProcessor.ImportPublicKeyPEM([]byte(sign.PublicKey))
+			if err != nil {
+				return errors.Wrapf(err, "pulse %d has sign with bad public key", pulse.PulseNumber)
+			}
+			confirmation := pulsar.PulseSenderConfirmationPayload{
+				PulseSenderConfirmation: insolar.PulseSenderConfirmation{
+					PulseNumber:     pulse.PulseNumber,
+					ChosenPublicKey: sign.ChosenPublicKey,
+					Signature:       sign.Signature,
+				},
+			}
+			copy(confirmation.Entropy[:], sign.Entropy)
+			hash, err := confirmation.Hash(scheme.IntegrityHasher())
+			if err != nil {
+				return errors.Wrapf(err, "failed to hash sign of pulse %d", pulse.PulseNumber)
+			}
+			verifier := scheme.DataVerifier(publicKey, scheme.IntegrityHasher())
+			if !verifier.Verify(insolar.SignatureFromBytes(sign.Signature), hash) {
+				return errors.Errorf("pulse %d has invalid sign", pulse.PulseNumber)
+			}
+		}
+
+		prev = pulse
+	}
+	return nil
+}
+
+// CheckAgreement checks that pulsars saved the same entropy for every pulse number they have in common.
+func CheckAgreement(chains map[int][]pulsar.HistoryPulse) error {
+	type saved struct {
+		pulsar  int
+		entropy []byte
+	}
+	seen := map[insolar.PulseNumber]saved{}
+	for index, chain := range chains {
+		for _, pulse := range chain {
+			first, ok := seen[pulse.PulseNumber]
+			if !ok {
+				seen[pulse.PulseNumber] = saved{pulsar: index, entropy: pulse.Entropy}
+				continue
+			}
+			if !bytes.Equal(first.entropy, pulse.Entropy) {
+				return errors.Errorf("pulsars %d and %d saved different entropy for pulse %d",
+					first.pulsar, index, pulse.PulseNumber)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cluster
+
+import (
+	"crypto"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/platformpolicy"
+	"github.com/insolar/insolar/pulsar"
+)
+
+type testSigner struct {
+	privateKey crypto.PrivateKey
+	publicKey  string
+}
+
+func newTestSigners(t *testing.T, count int) []testSigner {
+	keyProcessor := platformpolicy.NewKeyProcessor()
+	var signers []testSigner
+	for i := 0; i < count; i++ {
+		privateKey, err := keyProcessor.GeneratePrivateKey()
+		require.NoError(t, err)
+		publicKey, err := keyProcessor.ExportPublicKeyPEM(keyProcessor.ExtractPublicKey(privateKey))
+		require.NoError(t, err)
+		signers = append(signers, testSigner{privateKey: privateKey, publicKey: string(publicKey)})
+	}
+	return signers
+}
+
+func newTestChain(t *testing.T, signers []testSigner, length int) []pulsar.HistoryPulse {
+	scheme := platformpolicy.NewPlatformCryptographyScheme()
+	chain := []pulsar.HistoryPulse{{
+		PulseNumber:     insolar.FirstPulseNumber,
+		NextPulseNumber: insolar.FirstPulseNumber + 10,
+		Entropy:         make([]byte, insolar.EntropySize),
+	}}
+
+	prev := insolar.PulseNumber(insolar.FirstPulseNumber)
+	for i := 0; i < length; i++ {
+		pn := prev + 10
+		var entropy insolar.Entropy
+		entropy[0] = byte(i + 1)
+		pulse := pulsar.HistoryPulse{
+			PulseNumber:     pn,
+			PrevPulseNumber: prev,
+			NextPulseNumber: pn + 10,
+			Entropy:         entropy[:],
+		}
+		for _, signer := range signers {
+			payload := pulsar.PulseSenderConfirmationPayload{
+				PulseSenderConfirmation: insolar.PulseSenderConfirmation{
+					PulseNumber:     pn,
+					ChosenPublicKey: signers[0].publicKey,
+					Entropy:         entropy,
+				},
+			}
+			hash, err := payload.Hash(scheme.IntegrityHasher())
+			require.NoError(t, err)
+			signature, err := scheme.DataSigner(signer.privateKey, scheme.IntegrityHasher()).Sign(hash)
+			require.NoError(t, err)
+			pulse.Signs = append(pulse.Signs, pulsar.HistorySign{
+				PublicKey:       signer.publicKey,
+				ChosenPublicKey: signers[0].publicKey,
+				Entropy:         entropy[:],
+				Signature:       signature.Bytes(),
+			})
+		}
+		chain = append(chain, pulse)
+		prev = pn
+	}
+	return chain
+}
+
+func TestCheckChain(t *testing.T) {
+	signers := newTestSigners(t, 3)
+
+	chain := newTestChain(t, signers, 3)
+	require.NoError(t, CheckChain(chain, 3))
+	require.Error(t, CheckChain(chain, 4))
+}
+
+func TestCheckChain_BrokenLink(t *testing.T) {
+	chain := newTestChain(t, newTestSigners(t, 2), 3)
+	chain[2].PrevPulseNumber++
+
+	require.Error(t, CheckChain(chain, 2))
+}
+
+func TestCheckChain_EmptyEntropy(t *testing.T) {
+	chain := newTestChain(t, newTestSigners(t, 2), 3)
+	chain[1].Entropy = make([]byte, insolar.EntropySize)
+
+	require.Error(t, CheckChain(chain, 2))
+}
+
+func TestCheckChain_ForgedSign(t *testing.T) {
+	signers := newTestSigners(t, 2)
+	chain := newTestChain(t, signers, 3)
+	chain[3].Signs[1].Entropy = chain[2].Entropy
+
+	require.Error(t, CheckChain(chain, 2))
+}
+
+func TestCheckAgreement(t *testing.T) {
+	signers := newTestSigners(t, 2)
+	first := newTestChain(t, signers, 3)
+	second := newTestChain(t, signers, 2)
+
+	require.NoError(t, CheckAgreement(map[int][]pulsar.HistoryPulse{0: first, 1: second}))
+
+	second[2].Entropy = []byte{42}
+	require.Error(t, CheckAgreement(map[int][]pulsar.HistoryPulse{0: first, 1: second}))
+}
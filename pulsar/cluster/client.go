@@ -0,0 +1,85 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cluster
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// client calls JSON-RPC services of pulsard history endpoint.
+type client struct {
+	url  string
+	http *http.Client
+}
+
+func newClient(address string) *client {
+	return &client{
+		url:  "http://" + address + "/api/rpc",
+		http: &http.Client{},
+	}
+}
+
+type rpcRequest struct {
+	Version string      `json:"jsonrpc"`
+	Method  string      `json:"method"`
+	Params  interface{} `json:"params"`
+	ID      uint64      `json:"id"`
+}
+
+type rpcResponse struct {
+	Result *json.RawMessage `json:"result"`
+	Error  *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+func (c *client) call(ctx context.Context, method string, params interface{}, result interface{}) error {
+	body, err := json.Marshal(rpcRequest{Version: "2.0", Method: method, Params: params, ID: 1})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request")
+	}
+	req, err := http.NewRequest(http.MethodPost, c.url, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "failed to create request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.http.Do(req.WithContext(ctx))
+	if err != nil {
+		return errors.Wrapf(err, "failed to call %s", method)
+	}
+	defer resp.Body.Close()
+
+	var reply rpcResponse
+	err = json.NewDecoder(resp.Body).Decode(&reply)
+	if err != nil {
+		return errors.Wrap(err, "failed to decode response")
+	}
+	if reply.Error != nil {
+		return errors.Errorf("%s failed: %s", method, reply.Error.Message)
+	}
+	if reply.Result == nil {
+		return errors.Errorf("%s returned empty result", method)
+	}
+	return json.Unmarshal(*reply.Result, result)
+}
@@ -0,0 +1,456 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/platformpolicy"
+	"github.com/insolar/insolar/pulsar"
+)
+
+// pollInterval is an interval of polling pulsars' status and history.
+const pollInterval = 20 * time.Millisecond
+
+// Config is a configuration of a cluster.
+type Config struct {
+	// Size is a number of pulsars.
+	Size int
+	// PulsardPath is a path to pulsard binary.
+	PulsardPath string
+	// WorkDir contains configs, keys, storages and logs of pulsars. Temporary directory is created if it's empty.
+	WorkDir string
+	// Pulsar is a template of pulsar configuration, addresses, storage and neighbours are set by the cluster.
+	Pulsar configuration.Pulsar
+}
+
+// NewConfig creates configuration of a cluster with short rounds suitable for tests.
+func NewConfig(size int, pulsardPath string) Config {
+	cfg := configuration.NewPulsar()
+	cfg.PulseTime = 3000
+	cfg.ReceivingSignTimeout = 300
+	cfg.ReceivingNumberTimeout = 300
+	cfg.ReceivingVectorTimeout = 300
+	cfg.ReceivingSignsForChosenTimeout = 300
+	cfg.PulseDistributor.BootstrapHosts = nil
+	cfg.DistributionTransport.Address = "127.0.0.1:0"
+
+	return Config{
+		Size:        size,
+		PulsardPath: pulsardPath,
+		Pulsar:      cfg,
+	}
+}
+
+// Node is a single pulsard process of the cluster.
+type Node struct {
+	Index      int
+	PublicKey  string
+	Config     configuration.Configuration
+	ConfigPath string
+	LogPath    string
+
+	client *client
+
+	lock   sync.Mutex
+	cmd    *exec.Cmd
+	exited chan struct{}
+}
+
+// Alive checks if process of the pulsar is running.
+func (n *Node) Alive() bool {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	if n.cmd == nil {
+		return false
+	}
+	select {
+	case <-n.exited:
+		return false
+	default:
+		return true
+	}
+}
+
+// Cluster is a set of pulsard processes connected through proxies.
+type Cluster struct {
+	cfg   Config
+	Nodes []*Node
+
+	// links are proxies for connections from one pulsar to another
+	links map[[2]int]*link
+
+	lock        sync.Mutex
+	partitioned map[int]bool
+}
+
+// New generates keys and configs of pulsars and starts proxies between them. Processes are started by Start.
+func New(cfg Config) (*Cluster, error) {
+	if cfg.Size < 1 {
+		return nil, errors.New("cluster size should be positive")
+	}
+	if cfg.WorkDir == "" {
+		dir, err := ioutil.TempDir("", "pulsar-cluster-")
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create work dir")
+		}
+		cfg.WorkDir = dir
+	}
+
+	c := &Cluster{cfg: cfg, links: map[[2]int]*link{}, partitioned: map[int]bool{}}
+	keyProcessor := platformpolicy.NewKeyProcessor()
+	listenAddresses := make([]string, cfg.Size)
+	for i := 0; i < cfg.Size; i++ {
+		node, err := c.newNode(i, keyProcessor)
+		if err != nil {
+			c.closeLinks()
+			return nil, err
+		}
+		c.Nodes = append(c.Nodes, node)
+
+		listenAddresses[i], err = freeAddress()
+		if err != nil {
+			c.closeLinks()
+			return nil, err
+		}
+	}
+
+	for from := range c.Nodes {
+		for to := range c.Nodes {
+			if from == to {
+				continue
+			}
+			l, err := newLink(listenAddresses[to])
+			if err != nil {
+				c.closeLinks()
+				return nil, errors.Wrap(err, "failed to start proxy")
+			}
+			c.links[[2]int{from, to}] = l
+		}
+	}
+
+	for i, node := range c.Nodes {
+		err := c.writeConfig(node, listenAddresses[i])
+		if err != nil {
+			c.closeLinks()
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+func (c *Cluster) newNode(index int, keyProcessor insolar.KeyProcessor) (*Node, error) {
+	dir := c.nodeDir(index)
+	err := os.MkdirAll(dir, 0700)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create pulsar dir")
+	}
+
+	privateKey, err := keyProcessor.GeneratePrivateKey()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate key")
+	}
+	privatePEM, err := keyProcessor.ExportPrivateKeyPEM(privateKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to export private key")
+	}
+	publicPEM, err := keyProcessor.ExportPublicKeyPEM(keyProcessor.ExtractPublicKey(privateKey))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to export public key")
+	}
+	keys, err := json.Marshal(map[string]string{
+		"private_key": string(privatePEM),
+		"public_key":  string(publicPEM),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal keys")
+	}
+	keysPath := filepath.Join(dir, "keys.json")
+	err = ioutil.WriteFile(keysPath, keys, 0600)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to write keys")
+	}
+
+	historyAddress, err := freeAddress()
+	if err != nil {
+		return nil, err
+	}
+	cfg := configuration.NewConfiguration()
+	cfg.KeysPath = keysPath
+	cfg.Pulsar = c.cfg.Pulsar
+	cfg.Pulsar.Storage.DataDirectory = filepath.Join(dir, "data")
+	cfg.Pulsar.HistoryListenAddress = historyAddress
+
+	return &Node{
+		Index:      index,
+		PublicKey:  string(publicPEM),
+		Config:     cfg,
+		ConfigPath: filepath.Join(dir, "pulsar.yaml"),
+		LogPath:    filepath.Join(dir, "pulsar.log"),
+		client:     newClient(historyAddress),
+	}, nil
+}
+
+func (c *Cluster) writeConfig(node *Node, listenAddress string) error {
+	connectionType := c.cfg.Pulsar.ConnectionType
+	if connectionType == "" {
+		connectionType = configuration.TCP
+	}
+	node.Config.Pulsar.ConnectionType = connectionType
+	node.Config.Pulsar.MainListenerAddress = listenAddress
+	node.Config.Pulsar.Neighbours = nil
+	for _, neighbour := range c.Nodes {
+		if neighbour.Index == node.Index {
+			continue
+		}
+		node.Config.Pulsar.Neighbours = append(node.Config.Pulsar.Neighbours, configuration.PulsarNodeAddress{
+			Address:        c.links[[2]int{node.Index, neighbour.Index}].Address(),
+			ConnectionType: connectionType,
+			PublicKey:      neighbour.PublicKey,
+		})
+	}
+
+	err := ioutil.WriteFile(node.ConfigPath, []byte(configuration.ToString(node.Config)), 0600)
+	return errors.Wrap(err, "failed to write config")
+}
+
+func (c *Cluster) nodeDir(index int) string {
+	return filepath.Join(c.cfg.WorkDir, fmt.Sprintf("pulsar_%d", index))
+}
+
+// WorkDir returns directory with configs, keys, storages and logs of pulsars.
+func (c *Cluster) WorkDir() string {
+	return c.cfg.WorkDir
+}
+
+// Start starts all pulsars and waits for their history endpoints.
+func (c *Cluster) Start(ctx context.Context) error {
+	for i := range c.Nodes {
+		err := c.StartPulsar(i)
+		if err != nil {
+			return err
+		}
+	}
+	for i := range c.Nodes {
+		err := c.waitForStatus(ctx, i, func(pulsar.StatusReply) bool { return true })
+		if err != nil {
+			return errors.Wrapf(err, "pulsar %d isn't started", i)
+		}
+	}
+	return nil
+}
+
+// StartPulsar starts process of the pulsar, it's used to restart killed pulsar as well.
+func (c *Cluster) StartPulsar(index int) error {
+	node := c.Nodes[index]
+	node.lock.Lock()
+	defer node.lock.Unlock()
+
+	logFile, err := os.OpenFile(node.LogPath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return errors.Wrap(err, "failed to open log file")
+	}
+	cmd := exec.Command(c.cfg.PulsardPath, "--config", node.ConfigPath)
+	cmd.Stdout = logFile
+	cmd.Stderr = logFile
+	err = cmd.Start()
+	if err != nil {
+		_ = logFile.Close()
+		return errors.Wrapf(err, "failed to start pulsar %d", index)
+	}
+
+	exited := make(chan struct{})
+	go func() {
+		_ = cmd.Wait()
+		_ = logFile.Close()
+		close(exited)
+	}()
+	node.cmd = cmd
+	node.exited = exited
+	return nil
+}
+
+// Kill kills process of the pulsar immediately.
+func (c *Cluster) Kill(index int) error {
+	node := c.Nodes[index]
+	if !node.Alive() {
+		return nil
+	}
+	err := node.cmd.Process.Kill()
+	if err != nil {
+		return errors.Wrapf(err, "failed to kill pulsar %d", index)
+	}
+	<-node.exited
+	return nil
+}
+
+// Partition drops all connections to and from the pulsar.
+func (c *Cluster) Partition(index int) {
+	c.setPartitioned(index, true)
+}
+
+// Heal restores connections to and from the pulsar.
+func (c *Cluster) Heal(index int) {
+	c.setPartitioned(index, false)
+}
+
+func (c *Cluster) setPartitioned(index int, partitioned bool) {
+	c.lock.Lock()
+	c.partitioned[index] = partitioned
+	c.lock.Unlock()
+
+	for pair, l := range c.links {
+		if pair[0] == index || pair[1] == index {
+			l.SetBlocked(partitioned)
+		}
+	}
+}
+
+// Stop kills all pulsars and proxies. Work dir is kept for investigation.
+func (c *Cluster) Stop() error {
+	var result error
+	for i := range c.Nodes {
+		err := c.Kill(i)
+		if err != nil && result == nil {
+			result = err
+		}
+	}
+	c.closeLinks()
+	return result
+}
+
+func (c *Cluster) closeLinks() {
+	for _, l := range c.links {
+		_ = l.Close()
+	}
+}
+
+// Status returns state of the pulsar's state machine.
+func (c *Cluster) Status(ctx context.Context, index int) (pulsar.StatusReply, error) {
+	var reply pulsar.StatusReply
+	err := c.Nodes[index].client.call(ctx, "status.get", pulsar.StatusArgs{}, &reply)
+	return reply, err
+}
+
+// Pulses returns pulses saved by the pulsar.
+func (c *Cluster) Pulses(ctx context.Context, index int) ([]pulsar.HistoryPulse, error) {
+	var pulses []pulsar.HistoryPulse
+	from := insolar.PulseNumber(insolar.FirstPulseNumber)
+	for {
+		var reply pulsar.HistoryRangeReply
+		err := c.Nodes[index].client.call(ctx, "pulse.range", pulsar.HistoryRangeArgs{
+			From: from,
+			To:   insolar.PulseNumber(math.MaxUint32),
+		}, &reply)
+		if err != nil {
+			return nil, err
+		}
+		if len(reply.Pulses) == 0 {
+			return pulses, nil
+		}
+		pulses = append(pulses, reply.Pulses...)
+		from = reply.Pulses[len(reply.Pulses)-1].PulseNumber + 1
+	}
+}
+
+func (c *Cluster) isPartitioned(index int) bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.partitioned[index]
+}
+
+// WaitForPulses waits until every alive and connected pulsar saves count pulses after genesis
+// and returns their chains.
+func (c *Cluster) WaitForPulses(ctx context.Context, count int) (map[int][]pulsar.HistoryPulse, error) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		chains := map[int][]pulsar.HistoryPulse{}
+		ready := true
+		for _, node := range c.Nodes {
+			if !node.Alive() || c.isPartitioned(node.Index) {
+				continue
+			}
+			pulses, err := c.Pulses(ctx, node.Index)
+			if err != nil {
+				ready = false
+				break
+			}
+			chains[node.Index] = pulses
+			// genesis pulse is saved on start
+			if len(pulses)-1 < count {
+				ready = false
+				break
+			}
+		}
+		if ready {
+			return chains, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, errors.Wrapf(ctx.Err(), "pulsars didn't produce %d pulses", count)
+		case <-ticker.C:
+		}
+	}
+}
+
+func (c *Cluster) waitForStatus(ctx context.Context, index int, condition func(pulsar.StatusReply) bool) error {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		status, err := c.Status(ctx, index)
+		if err == nil && condition(status) {
+			return nil
+		}
+		if !c.Nodes[index].Alive() {
+			return errors.Errorf("pulsar %d exited, see %s", index, c.Nodes[index].LogPath)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func freeAddress() (string, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return "", errors.Wrap(err, "failed to find free port")
+	}
+	defer listener.Close()
+	return listener.Addr().String(), nil
+}
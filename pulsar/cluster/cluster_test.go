@@ -0,0 +1,120 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// +build slowtest
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/pulsar"
+)
+
+// pulsardPathEnv points to prebuilt pulsard binary, otherwise pulsard is built before tests.
+const pulsardPathEnv = "PULSARD_PATH"
+
+// pulsardPath is a path to pulsard binary used by tests.
+var pulsardPath string
+
+func TestMain(m *testing.M) {
+	os.Exit(testMain(m))
+}
+
+func testMain(m *testing.M) int {
+	pulsardPath = os.Getenv(pulsardPathEnv)
+	if pulsardPath == "" {
+		dir, err := ioutil.TempDir("", "pulsar-cluster-")
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "failed to create dir for pulsard:", err)
+			return 1
+		}
+		defer os.RemoveAll(dir) // nolint: errcheck
+
+		pulsardPath = filepath.Join(dir, "pulsard")
+		out, err := exec.Command("go", "build", "-o", pulsardPath, "github.com/insolar/insolar/cmd/pulsard").CombinedOutput()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "failed to build pulsard: %s\n%s", err, out)
+			return 1
+		}
+	}
+	return m.Run()
+}
+
+func newTestCluster(t *testing.T, size int) *Cluster {
+	c, err := New(NewConfig(size, pulsardPath))
+	require.NoError(t, err)
+	return c
+}
+
+func TestCluster_Pulses(t *testing.T) {
+	c := newTestCluster(t, 3)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	require.NoError(t, c.Start(ctx))
+	defer c.Stop()
+
+	chains, err := c.WaitForPulses(ctx, 3)
+	require.NoError(t, err)
+	for index, chain := range chains {
+		require.NoError(t, CheckChain(chain, 3), "pulsar %d", index)
+	}
+	require.NoError(t, CheckAgreement(chains))
+}
+
+func TestCluster_KillMidRound(t *testing.T) {
+	c := newTestCluster(t, 4)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	require.NoError(t, c.Start(ctx))
+	defer c.Stop()
+
+	err := c.InjectFault(ctx, Fault{Kind: Kill, Pulsar: 3, State: pulsar.WaitingForVectors})
+	require.NoError(t, err)
+
+	chains, err := c.WaitForPulses(ctx, 3)
+	require.NoError(t, err)
+	require.Len(t, chains, 3)
+	require.NoError(t, CheckAgreement(chains))
+}
+
+func TestCluster_PartitionMidRound(t *testing.T) {
+	c := newTestCluster(t, 4)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	require.NoError(t, c.Start(ctx))
+	defer c.Stop()
+
+	err := c.InjectFault(ctx, Fault{Kind: Partition, Pulsar: 0})
+	require.NoError(t, err)
+
+	chains, err := c.WaitForPulses(ctx, 3)
+	require.NoError(t, err)
+	require.NoError(t, CheckAgreement(chains))
+
+	c.Heal(0)
+}
@@ -0,0 +1,33 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package cluster is a harness which runs a cluster of real pulsard processes on localhost.
+//
+// Every pulsar gets generated keys, its own storage and a config with all other pulsars as neighbours.
+// Connections between pulsars go through proxies, so a pulsar can be partitioned from the others.
+// Faults are injected when a pulsar's state machine reaches a chosen state, i.e. in the middle of a round.
+// Produced pulse chains are read back through the pulse history endpoint and checked by CheckChain
+// and CheckAgreement.
+//
+// Usage:
+//
+//   c, err := cluster.New(cluster.NewConfig(3, "bin/pulsard"))
+//   err = c.Start(ctx)
+//   defer c.Stop()
+//   err = c.InjectFault(ctx, cluster.Fault{Kind: cluster.Kill, Pulsar: 2, State: pulsar.WaitingForVectors})
+//   chains, err := c.WaitForPulses(ctx, 5)
+//   err = cluster.CheckAgreement(chains)
+package cluster
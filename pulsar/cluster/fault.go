@@ -0,0 +1,72 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cluster
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/pulsar"
+)
+
+// FaultKind is a kind of injected fault.
+type FaultKind int
+
+const (
+	// Kill kills process of the pulsar.
+	Kill FaultKind = iota
+	// Partition drops all connections to and from the pulsar, process keeps running.
+	Partition
+)
+
+// Fault describes a fault injected into a pulsar when it reaches State.
+type Fault struct {
+	Kind   FaultKind
+	Pulsar int
+	// State is a state of the pulsar's state machine at which fault is injected.
+	// WaitingForEntropySigns is used if it's zero.
+	State pulsar.State
+}
+
+// InjectFault waits until the pulsar reaches state of the fault and injects the fault in the middle of the round.
+func (c *Cluster) InjectFault(ctx context.Context, fault Fault) error {
+	if fault.Pulsar < 0 || fault.Pulsar >= len(c.Nodes) {
+		return errors.Errorf("there is no pulsar %d", fault.Pulsar)
+	}
+	state := fault.State
+	if state == pulsar.Failed {
+		state = pulsar.WaitingForEntropySigns
+	}
+
+	err := c.waitForStatus(ctx, fault.Pulsar, func(status pulsar.StatusReply) bool {
+		return status.State == state.String()
+	})
+	if err != nil {
+		return errors.Wrapf(err, "pulsar %d didn't reach state %s", fault.Pulsar, state)
+	}
+
+	switch fault.Kind {
+	case Kill:
+		return c.Kill(fault.Pulsar)
+	case Partition:
+		c.Partition(fault.Pulsar)
+		return nil
+	default:
+		return errors.Errorf("unknown fault kind %d", fault.Kind)
+	}
+}
@@ -0,0 +1,138 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cluster
+
+import (
+	"io"
+	"net"
+	"sync"
+)
+
+// link is a tcp proxy for connections from one pulsar to another. Blocked link drops all connections.
+type link struct {
+	listener net.Listener
+	target   string
+
+	lock    sync.Mutex
+	blocked bool
+	conns   map[net.Conn]struct{}
+}
+
+func newLink(target string) (*link, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	l := &link{
+		listener: listener,
+		target:   target,
+		conns:    map[net.Conn]struct{}{},
+	}
+	go l.serve()
+	return l, nil
+}
+
+// Address returns address pulsars should connect to.
+func (l *link) Address() string {
+	return l.listener.Addr().String()
+}
+
+func (l *link) serve() {
+	for {
+		conn, err := l.listener.Accept()
+		if err != nil {
+			return
+		}
+		go l.proxy(conn)
+	}
+}
+
+func (l *link) proxy(in net.Conn) {
+	if l.isBlocked() {
+		_ = in.Close()
+		return
+	}
+	out, err := net.Dial("tcp", l.target)
+	if err != nil {
+		_ = in.Close()
+		return
+	}
+	if !l.track(in, out) {
+		return
+	}
+	defer l.untrack(in, out)
+
+	done := make(chan struct{}, 2)
+	pipe := func(dst, src net.Conn) {
+		_, _ = io.Copy(dst, src)
+		done <- struct{}{}
+	}
+	go pipe(out, in)
+	go pipe(in, out)
+	<-done
+}
+
+func (l *link) track(conns ...net.Conn) bool {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if l.blocked {
+		for _, conn := range conns {
+			_ = conn.Close()
+		}
+		return false
+	}
+	for _, conn := range conns {
+		l.conns[conn] = struct{}{}
+	}
+	return true
+}
+
+func (l *link) untrack(conns ...net.Conn) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	for _, conn := range conns {
+		_ = conn.Close()
+		delete(l.conns, conn)
+	}
+}
+
+func (l *link) isBlocked() bool {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	return l.blocked
+}
+
+// SetBlocked blocks or unblocks link, existing connections are dropped on block.
+func (l *link) SetBlocked(blocked bool) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.blocked = blocked
+	if blocked {
+		for conn := range l.conns {
+			_ = conn.Close()
+			delete(l.conns, conn)
+		}
+	}
+}
+
+func (l *link) Close() error {
+	l.SetBlocked(true)
+	return l.listener.Close()
+}
@@ -0,0 +1,85 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cluster
+
+import (
+	"bufio"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func startEchoServer(t *testing.T) net.Listener {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				line, err := bufio.NewReader(conn).ReadString('\n')
+				if err != nil {
+					return
+				}
+				_, _ = conn.Write([]byte(line))
+			}()
+		}
+	}()
+	return listener
+}
+
+func echo(address string) (string, error) {
+	conn, err := net.DialTimeout("tcp", address, time.Second)
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close()
+	_ = conn.SetDeadline(time.Now().Add(time.Second))
+
+	_, err = conn.Write([]byte("ping\n"))
+	if err != nil {
+		return "", err
+	}
+	return bufio.NewReader(conn).ReadString('\n')
+}
+
+func TestLink_Block(t *testing.T) {
+	server := startEchoServer(t)
+	defer server.Close()
+
+	l, err := newLink(server.Addr().String())
+	require.NoError(t, err)
+	defer l.Close()
+
+	reply, err := echo(l.Address())
+	require.NoError(t, err)
+	require.Equal(t, "ping\n", reply)
+
+	l.SetBlocked(true)
+	_, err = echo(l.Address())
+	require.Error(t, err)
+
+	l.SetBlocked(false)
+	reply, err = echo(l.Address())
+	require.NoError(t, err)
+	require.Equal(t, "ping\n", reply)
+}
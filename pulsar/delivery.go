@@ -0,0 +1,106 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package pulsar
+
+import (
+	"context"
+
+	"go.opencensus.io/stats"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+)
+
+// maxDeliveryReports is a number of the latest delivery reports kept by pulsar.
+const maxDeliveryReports = 100
+
+// distributePulse distributes pulse to the network. If distributor reports about delivery,
+// pulse which reached no host is distributed again while it's still the latest one.
+func (currentPulsar *Pulsar) distributePulse(ctx context.Context, pulse insolar.Pulse) {
+	distributor, ok := currentPulsar.PulseDistributor.(insolar.ReportingPulseDistributor)
+	if !ok {
+		currentPulsar.PulseDistributor.Distribute(ctx, pulse)
+		return
+	}
+
+	logger := inslogger.FromContext(ctx)
+	report := distributor.DistributeWithReport(ctx, pulse)
+	recordDeliveryStats(ctx, report)
+	for i := 0; i < currentPulsar.Config.PulseDistributor.RedistributeCount && report.Lost(); i++ {
+		if currentPulsar.GetLastPulse().PulseNumber > pulse.PulseNumber {
+			break
+		}
+		logger.Warnf("[ distributePulse ] pulse %d reached no host, distributing it again", pulse.PulseNumber)
+		stats.Record(ctx, statPulseRedistributed.M(1))
+		report = distributor.DistributeWithReport(ctx, pulse)
+		recordDeliveryStats(ctx, report)
+	}
+
+	currentPulsar.addDeliveryReport(report)
+}
+
+func recordDeliveryStats(ctx context.Context, report insolar.PulseDeliveryReport) {
+	stats.Record(
+		ctx,
+		statPulseDeliveryAcknowledged.M(int64(report.Delivered)),
+		statPulseDeliveryFailed.M(int64(len(report.Hosts)-report.Delivered)),
+		statPulseDeliveryTime.M(float64(report.Duration.Nanoseconds())/1e6),
+	)
+	if !report.QuorumReached() {
+		stats.Record(ctx, statPulseDeliveryNoQuorum.M(1))
+	}
+	if report.Lost() {
+		stats.Record(ctx, statPulseDeliveryLost.M(1))
+	}
+}
+
+func (currentPulsar *Pulsar) addDeliveryReport(report insolar.PulseDeliveryReport) {
+	currentPulsar.deliveryReportsLock.Lock()
+	defer currentPulsar.deliveryReportsLock.Unlock()
+
+	currentPulsar.deliveryReports = append(currentPulsar.deliveryReports, report)
+	if len(currentPulsar.deliveryReports) > maxDeliveryReports {
+		currentPulsar.deliveryReports = currentPulsar.deliveryReports[len(currentPulsar.deliveryReports)-maxDeliveryReports:]
+	}
+}
+
+// DeliveryReport returns delivery report of the pulse if it's still kept by pulsar.
+func (currentPulsar *Pulsar) DeliveryReport(pn insolar.PulseNumber) (insolar.PulseDeliveryReport, bool) {
+	currentPulsar.deliveryReportsLock.RLock()
+	defer currentPulsar.deliveryReportsLock.RUnlock()
+
+	for i := len(currentPulsar.deliveryReports) - 1; i >= 0; i-- {
+		if currentPulsar.deliveryReports[i].PulseNumber == pn {
+			return currentPulsar.deliveryReports[i], true
+		}
+	}
+	return insolar.PulseDeliveryReport{}, false
+}
+
+// LostPulses returns numbers of kept pulses which reached no host.
+func (currentPulsar *Pulsar) LostPulses() []insolar.PulseNumber {
+	currentPulsar.deliveryReportsLock.RLock()
+	defer currentPulsar.deliveryReportsLock.RUnlock()
+
+	var lost []insolar.PulseNumber
+	for _, report := range currentPulsar.deliveryReports {
+		if report.Lost() {
+			lost = append(lost, report.PulseNumber)
+		}
+	}
+	return lost
+}
@@ -0,0 +1,92 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package pulsar
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gojuno/minimock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/testutils"
+)
+
+func TestPulsar_DistributePulse_RedistributesLostPulse(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	pulse := insolar.Pulse{PulseNumber: insolar.FirstPulseNumber + 10}
+	lost := insolar.PulseDeliveryReport{
+		PulseNumber: pulse.PulseNumber,
+		Quorum:      1,
+		Hosts:       []insolar.PulseHostDelivery{{Address: "127.0.0.1:1", Attempts: 2}},
+	}
+	delivered := insolar.PulseDeliveryReport{
+		PulseNumber: pulse.PulseNumber,
+		Quorum:      1,
+		Delivered:   1,
+		Hosts:       []insolar.PulseHostDelivery{{Address: "127.0.0.1:1", Attempts: 1, Delivered: true}},
+	}
+	reports := []insolar.PulseDeliveryReport{lost, delivered}
+
+	distributor := testutils.NewReportingPulseDistributorMock(mc)
+	distributor.DistributeWithReportFunc = func(_ context.Context, p insolar.Pulse) insolar.PulseDeliveryReport {
+		require.Equal(t, pulse.PulseNumber, p.PulseNumber)
+		report := reports[0]
+		reports = reports[1:]
+		return report
+	}
+
+	config := configuration.NewPulsar()
+	config.PulseDistributor.RedistributeCount = 2
+	pulsar := &Pulsar{PulseDistributor: distributor, Config: config}
+	pulsar.SetLastPulse(&pulse)
+
+	pulsar.distributePulse(ctx, pulse)
+
+	assert.Equal(t, uint64(2), distributor.DistributeWithReportCounter)
+	report, ok := pulsar.DeliveryReport(pulse.PulseNumber)
+	require.True(t, ok)
+	assert.True(t, report.QuorumReached())
+	assert.Empty(t, pulsar.LostPulses())
+}
+
+func TestPulsar_DistributePulse_KeepsLostPulse(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	pulse := insolar.Pulse{PulseNumber: insolar.FirstPulseNumber + 10}
+	distributor := testutils.NewReportingPulseDistributorMock(mc)
+	distributor.DistributeWithReportMock.Return(insolar.PulseDeliveryReport{PulseNumber: pulse.PulseNumber, Quorum: 1})
+
+	config := configuration.NewPulsar()
+	config.PulseDistributor.RedistributeCount = 1
+	pulsar := &Pulsar{PulseDistributor: distributor, Config: config}
+	pulsar.SetLastPulse(&pulse)
+
+	pulsar.distributePulse(ctx, pulse)
+
+	assert.Equal(t, uint64(2), distributor.DistributeWithReportCounter)
+	assert.Equal(t, []insolar.PulseNumber{pulse.PulseNumber}, pulsar.LostPulses())
+}
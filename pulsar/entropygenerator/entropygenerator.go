@@ -18,6 +18,12 @@ package entropygenerator
 
 import (
 	"crypto/rand"
+	"fmt"
+	"io"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
 
 	"github.com/insolar/insolar/insolar"
 )
@@ -27,18 +33,72 @@ type EntropyGenerator interface {
 	GenerateEntropy() insolar.Entropy
 }
 
+// Provenance describes how entropy value was produced, it's kept for later audit.
+type Provenance struct {
+	// Generator is a type of generator.
+	Generator string
+	// Sources are names of sources contributed to the value.
+	Sources []string
+	// Sequence is a number of the value among values produced by the generator instance, starts from 1.
+	Sequence uint64
+	// Seed is set for deterministic generators only.
+	Seed *int64
+	// GeneratedAt is time of generation.
+	GeneratedAt time.Time
+}
+
+// AuditableEntropyGenerator is an EntropyGenerator which reports provenance of generated values.
+// Unlike GenerateEntropy it returns error if entropy can't be generated.
+type AuditableEntropyGenerator interface {
+	EntropyGenerator
+	GenerateAuditableEntropy() (insolar.Entropy, Provenance, error)
+}
+
+// GenerateWithProvenance generates entropy with provenance.
+// Provenance of generators which are not auditable contains only generator type.
+func GenerateWithProvenance(generator EntropyGenerator) (insolar.Entropy, Provenance, error) {
+	if auditable, ok := generator.(AuditableEntropyGenerator); ok {
+		return auditable.GenerateAuditableEntropy()
+	}
+	return generator.GenerateEntropy(), Provenance{
+		Generator:   fmt.Sprintf("%T", generator),
+		GeneratedAt: time.Now(),
+	}, nil
+}
+
 // StandardEntropyGenerator is the base impl of EntropyGenerator with using of crypto/rand
 type StandardEntropyGenerator struct {
+	sequence uint64
 }
 
 // GenerateEntropy generate entropy with using of EntropyGenerator
 func (generator *StandardEntropyGenerator) GenerateEntropy() insolar.Entropy {
-	entropy := make([]byte, insolar.EntropySize)
-	_, err := rand.Read(entropy)
+	result, err := readCryptoEntropy()
 	if err != nil {
 		panic(err)
 	}
-	var result insolar.Entropy
-	copy(result[:], entropy[:insolar.EntropySize])
 	return result
 }
+
+// GenerateAuditableEntropy generates entropy and its provenance.
+func (generator *StandardEntropyGenerator) GenerateAuditableEntropy() (insolar.Entropy, Provenance, error) {
+	entropy, err := readCryptoEntropy()
+	if err != nil {
+		return insolar.Entropy{}, Provenance{}, err
+	}
+	return entropy, Provenance{
+		Generator:   StandardType,
+		Sources:     []string{CryptoSourceName},
+		Sequence:    atomic.AddUint64(&generator.sequence, 1),
+		GeneratedAt: time.Now(),
+	}, nil
+}
+
+func readCryptoEntropy() (insolar.Entropy, error) {
+	var result insolar.Entropy
+	_, err := io.ReadFull(rand.Reader, result[:])
+	if err != nil {
+		return insolar.Entropy{}, errors.Wrap(err, "failed to read crypto/rand")
+	}
+	return result, nil
+}
@@ -0,0 +1,203 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package entropygenerator
+
+import (
+	"encoding/binary"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/sha3"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/log"
+)
+
+// Types of entropy generators.
+const (
+	StandardType = "standard"
+	MixingType   = "mixing"
+	FileType     = "file"
+	SeededType   = "seeded"
+)
+
+// NewEntropyGenerator creates entropy generator described by config.
+func NewEntropyGenerator(cfg configuration.EntropyGenerator) (EntropyGenerator, error) {
+	switch cfg.Type {
+	case "", StandardType:
+		return &StandardEntropyGenerator{}, nil
+	case MixingType:
+		names := cfg.Sources
+		if len(names) == 0 {
+			names = []string{CryptoSourceName, TimeSourceName}
+		}
+		sources := make([]Source, 0, len(names))
+		for _, name := range names {
+			switch name {
+			case CryptoSourceName:
+				sources = append(sources, NewCryptoSource())
+			case TimeSourceName:
+				sources = append(sources, NewTimeSource())
+			case FileSourceName:
+				if cfg.FilePath == "" {
+					return nil, errors.New("file path is required for file entropy source")
+				}
+				sources = append(sources, NewFileSource(cfg.FilePath))
+			default:
+				return nil, errors.Errorf("unknown entropy source %s", name)
+			}
+		}
+		return NewMixingEntropyGenerator(sources...), nil
+	case FileType:
+		if cfg.FilePath == "" {
+			return nil, errors.New("file path is required for file entropy generator")
+		}
+		return NewFileEntropyGenerator(NewFileSource(cfg.FilePath)), nil
+	case SeededType:
+		return NewSeededEntropyGenerator(cfg.Seed), nil
+	default:
+		return nil, errors.Errorf("unknown entropy generator type %s", cfg.Type)
+	}
+}
+
+// MixingEntropyGenerator hashes output of several sources, so entropy is good while at least one source is good.
+// Failed sources are skipped, generation fails if all sources failed.
+type MixingEntropyGenerator struct {
+	sources  []Source
+	sequence uint64
+}
+
+// NewMixingEntropyGenerator creates generator mixing provided sources.
+func NewMixingEntropyGenerator(sources ...Source) *MixingEntropyGenerator {
+	return &MixingEntropyGenerator{sources: sources}
+}
+
+// GenerateEntropy generates entropy. It falls back to standard generator if all sources failed.
+func (g *MixingEntropyGenerator) GenerateEntropy() insolar.Entropy {
+	entropy, _, err := g.GenerateAuditableEntropy()
+	if err != nil {
+		return fallbackEntropy(MixingType, err)
+	}
+	return entropy
+}
+
+// GenerateAuditableEntropy generates entropy and its provenance.
+func (g *MixingEntropyGenerator) GenerateAuditableEntropy() (insolar.Entropy, Provenance, error) {
+	hash := sha3.New512()
+	used := make([]string, 0, len(g.sources))
+	buf := make([]byte, insolar.EntropySize)
+	for _, source := range g.sources {
+		if _, err := source.Read(buf); err != nil {
+			log.Warnf("[ MixingEntropyGenerator ] entropy source %s failed: %s", source.Name(), err)
+			continue
+		}
+		_, _ = hash.Write([]byte(source.Name()))
+		_, _ = hash.Write(buf)
+		used = append(used, source.Name())
+	}
+	if len(used) == 0 {
+		return insolar.Entropy{}, Provenance{}, errors.New("all entropy sources failed")
+	}
+
+	var entropy insolar.Entropy
+	copy(entropy[:], hash.Sum(nil))
+	return entropy, Provenance{
+		Generator:   MixingType,
+		Sources:     used,
+		Sequence:    atomic.AddUint64(&g.sequence, 1),
+		GeneratedAt: time.Now(),
+	}, nil
+}
+
+// FileEntropyGenerator takes entropy from file as is, it stands for hardware RNG.
+type FileEntropyGenerator struct {
+	source   *FileSource
+	sequence uint64
+}
+
+// NewFileEntropyGenerator creates generator reading entropy from file source.
+func NewFileEntropyGenerator(source *FileSource) *FileEntropyGenerator {
+	return &FileEntropyGenerator{source: source}
+}
+
+// GenerateEntropy generates entropy. It falls back to standard generator if file can't be read.
+func (g *FileEntropyGenerator) GenerateEntropy() insolar.Entropy {
+	entropy, _, err := g.GenerateAuditableEntropy()
+	if err != nil {
+		return fallbackEntropy(FileType, err)
+	}
+	return entropy
+}
+
+// GenerateAuditableEntropy generates entropy and its provenance. It fails if file can't be read,
+// e.g. when writer of FIFO is gone.
+func (g *FileEntropyGenerator) GenerateAuditableEntropy() (insolar.Entropy, Provenance, error) {
+	var entropy insolar.Entropy
+	if _, err := g.source.Read(entropy[:]); err != nil {
+		return insolar.Entropy{}, Provenance{}, err
+	}
+	return entropy, Provenance{
+		Generator:   FileType,
+		Sources:     []string{g.source.Name()},
+		Sequence:    atomic.AddUint64(&g.sequence, 1),
+		GeneratedAt: time.Now(),
+	}, nil
+}
+
+// fallbackEntropy is used when EntropyGenerator interface is called, it can't report errors.
+func fallbackEntropy(generator string, err error) insolar.Entropy {
+	log.Errorf("[ %s entropy generator ] failed, falling back to %s generator: %s", generator, StandardType, err)
+	return (&StandardEntropyGenerator{}).GenerateEntropy()
+}
+
+// SeededEntropyGenerator generates the same sequence of entropy values for the same seed.
+// It's predictable and must be used only for reproducible test networks.
+type SeededEntropyGenerator struct {
+	seed     int64
+	sequence uint64
+}
+
+// NewSeededEntropyGenerator creates deterministic generator.
+func NewSeededEntropyGenerator(seed int64) *SeededEntropyGenerator {
+	return &SeededEntropyGenerator{seed: seed}
+}
+
+// GenerateEntropy generates entropy.
+func (g *SeededEntropyGenerator) GenerateEntropy() insolar.Entropy {
+	entropy, _, _ := g.GenerateAuditableEntropy()
+	return entropy
+}
+
+// GenerateAuditableEntropy generates entropy and its provenance.
+// Value is a hash of seed and sequence number, so it can be recalculated from provenance.
+func (g *SeededEntropyGenerator) GenerateAuditableEntropy() (insolar.Entropy, Provenance, error) {
+	sequence := atomic.AddUint64(&g.sequence, 1)
+
+	var input [16]byte
+	binary.BigEndian.PutUint64(input[:8], uint64(g.seed))
+	binary.BigEndian.PutUint64(input[8:], sequence)
+
+	seed := g.seed
+	return sha3.Sum512(input[:]), Provenance{
+		Generator:   SeededType,
+		Sequence:    sequence,
+		Seed:        &seed,
+		GeneratedAt: time.Now(),
+	}, nil
+}
@@ -0,0 +1,179 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package entropygenerator
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+)
+
+type failingSource struct{}
+
+func (failingSource) Name() string {
+	return "failing"
+}
+
+func (failingSource) Read(p []byte) (int, error) {
+	return 0, errors.New("test error")
+}
+
+func TestNewEntropyGenerator(t *testing.T) {
+	generator, err := NewEntropyGenerator(configuration.EntropyGenerator{})
+	require.NoError(t, err)
+	assert.IsType(t, &StandardEntropyGenerator{}, generator)
+
+	generator, err = NewEntropyGenerator(configuration.EntropyGenerator{Type: MixingType})
+	require.NoError(t, err)
+	_, provenance, err := GenerateWithProvenance(generator)
+	require.NoError(t, err)
+	assert.Equal(t, []string{CryptoSourceName, TimeSourceName}, provenance.Sources)
+
+	_, err = NewEntropyGenerator(configuration.EntropyGenerator{Type: MixingType, Sources: []string{FileSourceName}})
+	assert.Error(t, err)
+
+	_, err = NewEntropyGenerator(configuration.EntropyGenerator{Type: FileType})
+	assert.Error(t, err)
+
+	_, err = NewEntropyGenerator(configuration.EntropyGenerator{Type: "unknown"})
+	assert.Error(t, err)
+}
+
+func TestMixingEntropyGenerator_SkipsFailedSources(t *testing.T) {
+	generator := NewMixingEntropyGenerator(failingSource{}, NewCryptoSource(), NewTimeSource())
+
+	first, provenance, err := generator.GenerateAuditableEntropy()
+	require.NoError(t, err)
+	assert.Equal(t, MixingType, provenance.Generator)
+	assert.Equal(t, []string{CryptoSourceName, TimeSourceName}, provenance.Sources)
+	assert.Equal(t, uint64(1), provenance.Sequence)
+
+	second, provenance, err := generator.GenerateAuditableEntropy()
+	require.NoError(t, err)
+	assert.NotEqual(t, first, second)
+	assert.Equal(t, uint64(2), provenance.Sequence)
+
+	failed := NewMixingEntropyGenerator(failingSource{})
+	_, _, err = failed.GenerateAuditableEntropy()
+	assert.Error(t, err)
+	// plain interface falls back to standard generator
+	assert.NotEqual(t, insolar.Entropy{}, failed.GenerateEntropy())
+}
+
+func TestFileEntropyGenerator(t *testing.T) {
+	dir, err := ioutil.TempDir("", "entropy-")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	data := make([]byte, insolar.EntropySize+1)
+	for i := range data {
+		data[i] = byte(i)
+	}
+	path := filepath.Join(dir, "rng")
+	require.NoError(t, ioutil.WriteFile(path, data, 0600))
+
+	source := NewFileSource(path)
+	defer source.Close()
+	generator := NewFileEntropyGenerator(source)
+
+	entropy, provenance, err := generator.GenerateAuditableEntropy()
+	require.NoError(t, err)
+	assert.Equal(t, data[:insolar.EntropySize], entropy[:])
+	assert.Equal(t, FileType, provenance.Generator)
+	assert.Equal(t, []string{source.Name()}, provenance.Sources)
+
+	_, _, err = generator.GenerateAuditableEntropy()
+	assert.Error(t, err)
+	// regular file isn't reread from the beginning
+	_, _, err = generator.GenerateAuditableEntropy()
+	assert.Error(t, err)
+	assert.NotEqual(t, insolar.Entropy{}, generator.GenerateEntropy())
+}
+
+func TestFileEntropyGenerator_FIFOWriterClosed(t *testing.T) {
+	dir, err := ioutil.TempDir("", "entropy-")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "fifo")
+	require.NoError(t, syscall.Mkfifo(path, 0600))
+
+	source := NewFileSource(path)
+	defer source.Close()
+	generator := NewFileEntropyGenerator(source)
+
+	// writeOnce writes data to FIFO in one session, returned channel is closed when writer is gone
+	writeOnce := func(data []byte) <-chan struct{} {
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			fifo, err := os.OpenFile(path, os.O_WRONLY, 0)
+			if err != nil {
+				return
+			}
+			_, _ = fifo.Write(data)
+			_ = fifo.Close()
+		}()
+		return done
+	}
+
+	data := make([]byte, insolar.EntropySize)
+	data[0] = 1
+	written := writeOnce(data)
+	entropy, _, err := generator.GenerateAuditableEntropy()
+	require.NoError(t, err)
+	assert.Equal(t, data, entropy[:])
+	<-written
+
+	// writer is gone after short write, reader gets EOF
+	<-writeOnce([]byte{1})
+	_, _, err = generator.GenerateAuditableEntropy()
+	assert.Error(t, err)
+
+	// FIFO is reopened on the next read
+	written = writeOnce(data)
+	entropy, _, err = generator.GenerateAuditableEntropy()
+	require.NoError(t, err)
+	assert.Equal(t, data, entropy[:])
+	<-written
+}
+
+func TestSeededEntropyGenerator_IsReproducible(t *testing.T) {
+	first := NewSeededEntropyGenerator(42)
+	second := NewSeededEntropyGenerator(42)
+	other := NewSeededEntropyGenerator(43)
+
+	for i := 0; i < 3; i++ {
+		entropy, provenance, err := first.GenerateAuditableEntropy()
+		require.NoError(t, err)
+		assert.Equal(t, entropy, second.GenerateEntropy())
+		assert.NotEqual(t, entropy, other.GenerateEntropy())
+		assert.Equal(t, SeededType, provenance.Generator)
+		require.NotNil(t, provenance.Seed)
+		assert.Equal(t, int64(42), *provenance.Seed)
+		assert.Equal(t, uint64(i+1), provenance.Sequence)
+	}
+}
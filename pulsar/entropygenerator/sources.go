@@ -0,0 +1,151 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package entropygenerator
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"io"
+	"os"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/sha3"
+)
+
+// Names of local entropy sources.
+const (
+	CryptoSourceName = "crypto"
+	TimeSourceName   = "time"
+	FileSourceName   = "file"
+)
+
+// Source is a source of random bytes. Read fills whole buffer or returns error.
+type Source interface {
+	io.Reader
+	Name() string
+}
+
+type cryptoSource struct{}
+
+// NewCryptoSource creates source reading from crypto/rand.
+func NewCryptoSource() Source {
+	return cryptoSource{}
+}
+
+func (cryptoSource) Name() string {
+	return CryptoSourceName
+}
+
+func (cryptoSource) Read(p []byte) (int, error) {
+	return io.ReadFull(rand.Reader, p)
+}
+
+type timeSource struct{}
+
+// NewTimeSource creates source based on current time and runtime state of the process.
+// It's weak by itself and is intended to be mixed with other sources.
+func NewTimeSource() Source {
+	return timeSource{}
+}
+
+func (timeSource) Name() string {
+	return TimeSourceName
+}
+
+func (timeSource) Read(p []byte) (int, error) {
+	var stats runtime.MemStats
+	runtime.ReadMemStats(&stats)
+
+	values := []uint64{
+		uint64(time.Now().UnixNano()),
+		uint64(os.Getpid()),
+		uint64(runtime.NumGoroutine()),
+		stats.Mallocs,
+		stats.HeapAlloc,
+	}
+	state := make([]byte, 8*len(values))
+	for i, v := range values {
+		binary.BigEndian.PutUint64(state[8*i:], v)
+	}
+	sha3.ShakeSum256(p, state)
+	return len(p), nil
+}
+
+// FileSource reads random bytes from file, e.g. from FIFO or device of hardware RNG.
+// FIFOs and devices are reopened after failures, regular files are read once till the end.
+type FileSource struct {
+	path string
+
+	lock      sync.Mutex
+	file      *os.File
+	exhausted bool
+}
+
+// NewFileSource creates source reading from file by path. File is opened on first read.
+func NewFileSource(path string) *FileSource {
+	return &FileSource{path: path}
+}
+
+// Name returns name of the source with path of the file.
+func (s *FileSource) Name() string {
+	return FileSourceName + ":" + s.path
+}
+
+// Read fills p with bytes from the file.
+func (s *FileSource) Read(p []byte) (int, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.exhausted {
+		return 0, errors.Errorf("file %s is exhausted", s.path)
+	}
+	if s.file == nil {
+		file, err := os.Open(s.path)
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to open entropy file")
+		}
+		s.file = file
+	}
+
+	n, err := io.ReadFull(s.file, p)
+	if err != nil {
+		info, statErr := s.file.Stat()
+		if statErr == nil && info.Mode().IsRegular() {
+			s.exhausted = true
+		}
+		_ = s.file.Close()
+		s.file = nil
+		return n, errors.Wrap(err, "failed to read entropy file")
+	}
+	return n, nil
+}
+
+// Close closes underlying file.
+func (s *FileSource) Close() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.file == nil {
+		return nil
+	}
+	err := s.file.Close()
+	s.file = nil
+	return err
+}
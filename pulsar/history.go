@@ -0,0 +1,226 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package pulsar
+
+import (
+	"context"
+	"net"
+	"net/http"
+
+	"github.com/insolar/rpc/v2"
+	jsonrpc "github.com/insolar/rpc/v2/json2"
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	pulsarstorage "github.com/insolar/insolar/pulsar/storage"
+)
+
+// maxHistoryRange limits number of pulses returned by one range request.
+const maxHistoryRange = 1000
+
+// HistoryPulse is a saved pulse with entropy and signatures of pulsars.
+type HistoryPulse struct {
+	PulseNumber      insolar.PulseNumber `json:"pulseNumber"`
+	PrevPulseNumber  insolar.PulseNumber `json:"prevPulseNumber"`
+	NextPulseNumber  insolar.PulseNumber `json:"nextPulseNumber"`
+	PulseTimestamp   int64               `json:"pulseTimestamp"`
+	EpochPulseNumber int                 `json:"epochPulseNumber"`
+	Entropy          []byte              `json:"entropy"`
+	Signs            []HistorySign       `json:"signs"`
+}
+
+// HistorySign is a confirmation of the pulse from one of pulsars.
+type HistorySign struct {
+	PublicKey       string `json:"publicKey"`
+	ChosenPublicKey string `json:"chosenPublicKey"`
+	Entropy         []byte `json:"entropy"`
+	Signature       []byte `json:"signature"`
+}
+
+func newHistoryPulse(pulse insolar.Pulse) HistoryPulse {
+	res := HistoryPulse{
+		PulseNumber:      pulse.PulseNumber,
+		PrevPulseNumber:  pulse.PrevPulseNumber,
+		NextPulseNumber:  pulse.NextPulseNumber,
+		PulseTimestamp:   pulse.PulseTimestamp,
+		EpochPulseNumber: pulse.EpochPulseNumber,
+		Entropy:          pulse.Entropy[:],
+		Signs:            make([]HistorySign, 0, len(pulse.Signs)),
+	}
+	for key, sign := range pulse.Signs {
+		res.Signs = append(res.Signs, HistorySign{
+			PublicKey:       key,
+			ChosenPublicKey: sign.ChosenPublicKey,
+			Entropy:         sign.Entropy[:],
+			Signature:       sign.Signature,
+		})
+	}
+	return res
+}
+
+// HistoryArgs is arguments that History service accepts.
+type HistoryArgs struct {
+	PulseNumber insolar.PulseNumber `json:"pulseNumber"`
+}
+
+// HistoryRangeArgs is arguments of History service range requests.
+type HistoryRangeArgs struct {
+	From  insolar.PulseNumber `json:"from"`
+	To    insolar.PulseNumber `json:"to"`
+	Limit int                 `json:"limit"`
+}
+
+// HistoryRangeReply is reply for History service range requests.
+type HistoryRangeReply struct {
+	Pulses []HistoryPulse `json:"pulses"`
+}
+
+// HistoryService is a read-only JSON-RPC service that provides access to pulses saved by pulsar.
+type HistoryService struct {
+	storage pulsarstorage.PulsarStorage
+}
+
+// NewHistoryService creates new History service instance.
+func NewHistoryService(storage pulsarstorage.PulsarStorage) *HistoryService {
+	return &HistoryService{storage: storage}
+}
+
+// Get returns pulse by number.
+//
+//   Request structure:
+//   {
+//     "jsonrpc": "2.0",
+//     "method": "pulse.get",
+//     "id": str|int|null
+//     "params": { "pulseNumber": int }
+//   }
+func (s *HistoryService) Get(r *http.Request, args *HistoryArgs, reply *HistoryPulse) error {
+	pulse, err := s.storage.GetPulse(args.PulseNumber)
+	if err != nil {
+		return errors.Wrap(err, "[ Get ] failed to get pulse")
+	}
+	*reply = newHistoryPulse(*pulse)
+	return nil
+}
+
+// Last returns the last pulse.
+func (s *HistoryService) Last(r *http.Request, args *HistoryArgs, reply *HistoryPulse) error {
+	pulse, err := s.storage.GetLastPulse()
+	if err != nil {
+		return errors.Wrap(err, "[ Last ] failed to get pulse")
+	}
+	*reply = newHistoryPulse(*pulse)
+	return nil
+}
+
+// Prev returns pulse preceding provided pulse number.
+func (s *HistoryService) Prev(r *http.Request, args *HistoryArgs, reply *HistoryPulse) error {
+	pulse, err := s.storage.GetPrevPulse(args.PulseNumber)
+	if err != nil {
+		return errors.Wrap(err, "[ Prev ] failed to get pulse")
+	}
+	*reply = newHistoryPulse(*pulse)
+	return nil
+}
+
+// Next returns pulse following provided pulse number.
+func (s *HistoryService) Next(r *http.Request, args *HistoryArgs, reply *HistoryPulse) error {
+	pulse, err := s.storage.GetNextPulse(args.PulseNumber)
+	if err != nil {
+		return errors.Wrap(err, "[ Next ] failed to get pulse")
+	}
+	*reply = newHistoryPulse(*pulse)
+	return nil
+}
+
+// Range returns pulses with numbers in range [from, to] in ascending order.
+// Limit is capped (and defaults) to 1000 pulses, next page starts from the last returned pulse number + 1.
+//
+//   Request structure:
+//   {
+//     "jsonrpc": "2.0",
+//     "method": "pulse.range",
+//     "id": str|int|null
+//     "params": { "from": int, "to": int, "limit": int }
+//   }
+func (s *HistoryService) Range(r *http.Request, args *HistoryRangeArgs, reply *HistoryRangeReply) error {
+	if args.From > args.To {
+		return errors.New("[ Range ] from is greater than to")
+	}
+	limit := args.Limit
+	if limit <= 0 || limit > maxHistoryRange {
+		limit = maxHistoryRange
+	}
+
+	pulses, err := s.storage.GetPulses(args.From, args.To, limit)
+	if err != nil {
+		return errors.Wrap(err, "[ Range ] failed to get pulses")
+	}
+	reply.Pulses = make([]HistoryPulse, 0, len(pulses))
+	for _, pulse := range pulses {
+		reply.Pulses = append(reply.Pulses, newHistoryPulse(pulse))
+	}
+	return nil
+}
+
+// HistoryServer serves History service over HTTP.
+type HistoryServer struct {
+	server *http.Server
+}
+
+// NewHistoryServer creates HTTP server with History service registered as "pulse".
+// If pulsar is provided, its Status service is registered as "status".
+func NewHistoryServer(address string, storage pulsarstorage.PulsarStorage, pulsar *Pulsar) (*HistoryServer, error) {
+	rpcServer := rpc.NewServer()
+	rpcServer.RegisterCodec(jsonrpc.NewCodec(), "application/json")
+	err := rpcServer.RegisterService(NewHistoryService(storage), "pulse")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to register history service")
+	}
+	if pulsar != nil {
+		err = rpcServer.RegisterService(NewStatusService(pulsar), "status")
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to register status service")
+		}
+	}
+
+	router := http.NewServeMux()
+	router.Handle("/api/rpc", rpcServer)
+	return &HistoryServer{
+		server: &http.Server{Addr: address, Handler: router},
+	}, nil
+}
+
+// Start starts listening.
+func (hs *HistoryServer) Start(ctx context.Context) error {
+	listener, err := net.Listen("tcp", hs.server.Addr)
+	if err != nil {
+		return errors.Wrap(err, "failed to start listening")
+	}
+	go func() {
+		if err := hs.server.Serve(listener); err != http.ErrServerClosed {
+			inslogger.FromContext(ctx).Error("history server: Serve() error: ", err)
+		}
+	}()
+	return nil
+}
+
+// Stop stops server.
+func (hs *HistoryServer) Stop(ctx context.Context) error {
+	return hs.server.Shutdown(ctx)
+}
@@ -0,0 +1,91 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package pulsar
+
+import (
+	"testing"
+
+	"github.com/gojuno/minimock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/pulsar/pulsartestutils"
+	pulsarstorage "github.com/insolar/insolar/pulsar/storage"
+)
+
+func TestHistoryService_Get(t *testing.T) {
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	pulse := &insolar.Pulse{
+		PulseNumber:     insolar.FirstPulseNumber + 10,
+		PrevPulseNumber: insolar.FirstPulseNumber,
+		Entropy:         pulsartestutils.MockEntropy,
+		Signs: map[string]insolar.PulseSenderConfirmation{
+			"key": {ChosenPublicKey: "chosen", Signature: []byte{1, 2, 3}},
+		},
+	}
+	storage := pulsartestutils.NewPulsarStorageMock(mc)
+	storage.GetPulseMock.Expect(pulse.PulseNumber).Return(pulse, nil)
+
+	var reply HistoryPulse
+	err := NewHistoryService(storage).Get(nil, &HistoryArgs{PulseNumber: pulse.PulseNumber}, &reply)
+	require.NoError(t, err)
+	assert.Equal(t, pulse.PulseNumber, reply.PulseNumber)
+	assert.Equal(t, pulse.PrevPulseNumber, reply.PrevPulseNumber)
+	assert.Equal(t, pulse.Entropy[:], reply.Entropy)
+	require.Len(t, reply.Signs, 1)
+	assert.Equal(t, "key", reply.Signs[0].PublicKey)
+	assert.Equal(t, "chosen", reply.Signs[0].ChosenPublicKey)
+}
+
+func TestHistoryService_Get_NotFound(t *testing.T) {
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	storage := pulsartestutils.NewPulsarStorageMock(mc)
+	storage.GetPulseMock.Return(nil, pulsarstorage.ErrNotFound)
+
+	var reply HistoryPulse
+	err := NewHistoryService(storage).Get(nil, &HistoryArgs{PulseNumber: insolar.FirstPulseNumber}, &reply)
+	require.Error(t, err)
+}
+
+func TestHistoryService_Range(t *testing.T) {
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	storage := pulsartestutils.NewPulsarStorageMock(mc)
+	storage.GetPulsesMock.Expect(insolar.FirstPulseNumber, insolar.FirstPulseNumber+100, maxHistoryRange).Return(
+		[]insolar.Pulse{{PulseNumber: insolar.FirstPulseNumber}, {PulseNumber: insolar.FirstPulseNumber + 10}}, nil,
+	)
+	service := NewHistoryService(storage)
+
+	var reply HistoryRangeReply
+	err := service.Range(nil, &HistoryRangeArgs{
+		From:  insolar.FirstPulseNumber,
+		To:    insolar.FirstPulseNumber + 100,
+		Limit: maxHistoryRange + 1,
+	}, &reply)
+	require.NoError(t, err)
+	require.Len(t, reply.Pulses, 2)
+	assert.Equal(t, insolar.PulseNumber(insolar.FirstPulseNumber+10), reply.Pulses[1].PulseNumber)
+
+	err = service.Range(nil, &HistoryRangeArgs{From: insolar.FirstPulseNumber + 1, To: insolar.FirstPulseNumber}, &reply)
+	require.Error(t, err)
+}
@@ -23,6 +23,13 @@ import (
 
 var (
 	statPulseGenerated = stats.Int64("pulsar/pulse/generated", "count of generated pulses", stats.UnitDimensionless)
+
+	statPulseDeliveryAcknowledged = stats.Int64("pulsar/pulse/delivery/acknowledged", "count of hosts acknowledged pulses", stats.UnitDimensionless)
+	statPulseDeliveryFailed       = stats.Int64("pulsar/pulse/delivery/failed", "count of hosts failed to receive pulses", stats.UnitDimensionless)
+	statPulseDeliveryNoQuorum     = stats.Int64("pulsar/pulse/delivery/no_quorum", "count of pulses acknowledged by less than quorum of hosts", stats.UnitDimensionless)
+	statPulseDeliveryLost         = stats.Int64("pulsar/pulse/delivery/lost", "count of pulses reached no host", stats.UnitDimensionless)
+	statPulseRedistributed        = stats.Int64("pulsar/pulse/delivery/redistributed", "count of repeated distributions of lost pulses", stats.UnitDimensionless)
+	statPulseDeliveryTime         = stats.Float64("pulsar/pulse/delivery/time", "time of pulse distribution", stats.UnitMilliseconds)
 )
 
 func init() {
@@ -33,6 +40,42 @@ func init() {
 			Measure:     statPulseGenerated,
 			Aggregation: view.Sum(),
 		},
+		&view.View{
+			Name:        statPulseDeliveryAcknowledged.Name(),
+			Description: statPulseDeliveryAcknowledged.Description(),
+			Measure:     statPulseDeliveryAcknowledged,
+			Aggregation: view.Sum(),
+		},
+		&view.View{
+			Name:        statPulseDeliveryFailed.Name(),
+			Description: statPulseDeliveryFailed.Description(),
+			Measure:     statPulseDeliveryFailed,
+			Aggregation: view.Sum(),
+		},
+		&view.View{
+			Name:        statPulseDeliveryNoQuorum.Name(),
+			Description: statPulseDeliveryNoQuorum.Description(),
+			Measure:     statPulseDeliveryNoQuorum,
+			Aggregation: view.Sum(),
+		},
+		&view.View{
+			Name:        statPulseDeliveryLost.Name(),
+			Description: statPulseDeliveryLost.Description(),
+			Measure:     statPulseDeliveryLost,
+			Aggregation: view.Sum(),
+		},
+		&view.View{
+			Name:        statPulseRedistributed.Name(),
+			Description: statPulseRedistributed.Description(),
+			Measure:     statPulseRedistributed,
+			Aggregation: view.Sum(),
+		},
+		&view.View{
+			Name:        statPulseDeliveryTime.Name(),
+			Description: statPulseDeliveryTime.Description(),
+			Measure:     statPulseDeliveryTime,
+			Aggregation: view.Distribution(10, 50, 100, 500, 1000, 2000, 5000, 10000),
+		},
 	)
 	if err != nil {
 		panic(err)
@@ -18,10 +18,13 @@ package pulsar
 
 import (
 	"crypto"
+	"crypto/tls"
 	"net"
 	"net/rpc"
 	"sync"
 
+	"github.com/pkg/errors"
+
 	"github.com/insolar/insolar/configuration"
 )
 
@@ -32,11 +35,13 @@ type RPCClientWrapperFactory interface {
 
 // RPCClientWrapperFactoryImpl is a base impl of the RPCClientWrapperFactory
 type RPCClientWrapperFactoryImpl struct {
+	// TLSConfig is used for connections to neighbours with TLS connection type
+	TLSConfig *tls.Config
 }
 
 // CreateWrapper return new RPCClientWrapper
-func (RPCClientWrapperFactoryImpl) CreateWrapper() RPCClientWrapper {
-	return &RPCClientWrapperImpl{Mutex: &sync.Mutex{}}
+func (factory RPCClientWrapperFactoryImpl) CreateWrapper() RPCClientWrapper {
+	return &RPCClientWrapperImpl{Mutex: &sync.Mutex{}, TLSConfig: factory.TLSConfig}
 }
 
 // RPCClientWrapper describes interface of the wrapper around rpc-client
@@ -64,6 +69,8 @@ type RPCClientWrapper interface {
 type RPCClientWrapperImpl struct {
 	*sync.Mutex
 	*rpc.Client
+
+	TLSConfig *tls.Config
 }
 
 // IsInitialised compares underhood rpc-client with nil
@@ -88,10 +95,22 @@ func (impl *RPCClientWrapperImpl) Unlock() {
 
 // CreateConnection creates connection to an another pulsar
 func (impl *RPCClientWrapperImpl) CreateConnection(connectionType configuration.ConnectionType, connectionAddress string) error {
-	conn, err := net.Dial(connectionType.String(), connectionAddress)
+	if connectionType == configuration.TLS && impl.TLSConfig == nil {
+		return errors.New("tls config isn't provided")
+	}
+
+	conn, err := net.Dial(connectionType.Network(), connectionAddress)
 	if err != nil {
 		return err
 	}
+	if connectionType == configuration.TLS {
+		tlsConn := tls.Client(conn, impl.TLSConfig)
+		if err := tlsConn.Handshake(); err != nil {
+			_ = conn.Close()
+			return errors.Wrap(err, "tls handshake failed")
+		}
+		conn = tlsConn
+	}
 	impl.Client = rpc.NewClient(conn)
 	return nil
 }
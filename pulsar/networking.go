@@ -150,7 +150,8 @@ func (handler *Handler) ReceiveSignatureForEntropy(request *Payload, response *P
 
 // ReceiveEntropy is a handler of call for receiving Entropy from one of the pulsars
 func (handler *Handler) ReceiveEntropy(request *Payload, response *Payload) error {
-	ctx, inslog := inslogger.WithTraceField(context.Background(), fmt.Sprintf("%v_%v", handler.Pulsar.ID, string(handler.Pulsar.ProcessingPulseNumber)))
+	processingPulse := handler.Pulsar.GetProcessingPulseNumber()
+	ctx, inslog := inslogger.WithTraceField(context.Background(), fmt.Sprintf("%v_%v", handler.Pulsar.ID, processingPulse))
 
 	inslog.Infof("[ReceiveEntropy] from %v", request.PublicKey)
 	ok, _, err := handler.isRequestValid(ctx, request)
@@ -162,8 +163,8 @@ func (handler *Handler) ReceiveEntropy(request *Payload, response *Payload) erro
 	}
 
 	requestBody := request.Body.(*EntropyPayload)
-	if requestBody.PulseNumber != handler.Pulsar.ProcessingPulseNumber {
-		return errors.Errorf("processing pulse number - %v is bigger than received one - %v", requestBody.PulseNumber, handler.Pulsar.ProcessingPulseNumber)
+	if requestBody.PulseNumber != processingPulse {
+		return errors.Errorf("processing pulse number - %v is bigger than received one - %v", requestBody.PulseNumber, processingPulse)
 	}
 
 	if btfCell, ok := handler.Pulsar.GetItemFromVector(request.PublicKey); ok {
@@ -190,7 +191,8 @@ func (handler *Handler) ReceiveEntropy(request *Payload, response *Payload) erro
 
 // ReceiveVector is a handler of call for receiving vector of Entropy
 func (handler *Handler) ReceiveVector(request *Payload, response *Payload) error {
-	ctx, inslog := inslogger.WithTraceField(context.Background(), fmt.Sprintf("%v_%v", handler.Pulsar.ID, string(handler.Pulsar.ProcessingPulseNumber)))
+	processingPulse := handler.Pulsar.GetProcessingPulseNumber()
+	ctx, inslog := inslogger.WithTraceField(context.Background(), fmt.Sprintf("%v_%v", handler.Pulsar.ID, processingPulse))
 
 	log.Infof("[ReceiveVector] from %v", request.PublicKey)
 	ok, _, err := handler.isRequestValid(ctx, request)
@@ -207,8 +209,8 @@ func (handler *Handler) ReceiveVector(request *Payload, response *Payload) error
 	}
 
 	requestBody := request.Body.(*VectorPayload)
-	if requestBody.PulseNumber != handler.Pulsar.ProcessingPulseNumber {
-		return errors.Errorf("processing pulse number - %v is bigger than received one - %v", requestBody.PulseNumber, handler.Pulsar.ProcessingPulseNumber)
+	if requestBody.PulseNumber != processingPulse {
+		return errors.Errorf("processing pulse number - %v is bigger than received one - %v", requestBody.PulseNumber, processingPulse)
 	}
 
 	handler.Pulsar.SetBftGridItem(request.PublicKey, requestBody.Vector)
@@ -218,7 +220,8 @@ func (handler *Handler) ReceiveVector(request *Payload, response *Payload) error
 
 // ReceiveChosenSignature is a handler of call with the confirmation signature
 func (handler *Handler) ReceiveChosenSignature(request *Payload, response *Payload) error {
-	ctx, inslog := inslogger.WithTraceField(context.Background(), fmt.Sprintf("%v_%v", handler.Pulsar.ID, string(handler.Pulsar.ProcessingPulseNumber)))
+	processingPulse := handler.Pulsar.GetProcessingPulseNumber()
+	ctx, inslog := inslogger.WithTraceField(context.Background(), fmt.Sprintf("%v_%v", handler.Pulsar.ID, processingPulse))
 
 	log.Infof("[ReceiveChosenSignature] from %v", request.PublicKey)
 	ok, _, err := handler.isRequestValid(ctx, request)
@@ -230,8 +233,8 @@ func (handler *Handler) ReceiveChosenSignature(request *Payload, response *Paylo
 	}
 
 	requestBody := request.Body.(*PulseSenderConfirmationPayload)
-	if requestBody.PulseNumber != handler.Pulsar.ProcessingPulseNumber {
-		return errors.Errorf("processing pulse number - %v is bigger than received one - %v", requestBody.PulseNumber, handler.Pulsar.ProcessingPulseNumber)
+	if requestBody.PulseNumber != processingPulse {
+		return errors.Errorf("processing pulse number - %v is bigger than received one - %v", requestBody.PulseNumber, processingPulse)
 	}
 
 	publicKey, err := handler.Pulsar.KeyProcessor.ImportPublicKeyPEM([]byte(request.PublicKey))
@@ -275,7 +278,8 @@ func (handler *Handler) ReceiveChosenSignature(request *Payload, response *Paylo
 
 // ReceivePulse is a handler of call with the freshest pulse
 func (handler *Handler) ReceivePulse(request *Payload, response *Payload) error {
-	ctx, inslog := inslogger.WithTraceField(context.Background(), fmt.Sprintf("%v_%v", handler.Pulsar.ID, string(handler.Pulsar.ProcessingPulseNumber)))
+	processingPulse := handler.Pulsar.GetProcessingPulseNumber()
+	ctx, inslog := inslogger.WithTraceField(context.Background(), fmt.Sprintf("%v_%v", handler.Pulsar.ID, processingPulse))
 
 	log.Infof("[ReceivePulse] from %v", request.PublicKey)
 	ok, _, err := handler.isRequestValid(ctx, request)
@@ -287,11 +291,11 @@ func (handler *Handler) ReceivePulse(request *Payload, response *Payload) error
 	}
 
 	requestBody := request.Body.(*PulsePayload)
-	if handler.Pulsar.ProcessingPulseNumber != 0 && requestBody.Pulse.PulseNumber != handler.Pulsar.ProcessingPulseNumber {
+	if processingPulse != 0 && requestBody.Pulse.PulseNumber != processingPulse {
 		return errors.Errorf("processing pulse number is not zero and received number is not the same")
 	}
 
-	if handler.Pulsar.ProcessingPulseNumber == 0 && requestBody.Pulse.PulseNumber < handler.Pulsar.GetLastPulse().PulseNumber {
+	if processingPulse == 0 && requestBody.Pulse.PulseNumber < handler.Pulsar.GetLastPulse().PulseNumber {
 		return errors.Errorf("last pulse number - %v is bigger than received one - %v", handler.Pulsar.GetLastPulse().PulseNumber, requestBody.Pulse.PulseNumber)
 	}
 
@@ -307,7 +311,7 @@ func (handler *Handler) ReceivePulse(request *Payload, response *Payload) error
 	}
 
 	handler.Pulsar.SetLastPulse(&requestBody.Pulse)
-	handler.Pulsar.ProcessingPulseNumber = 0
+	handler.Pulsar.SetProcessingPulseNumber(0)
 
 	return nil
 }
@@ -20,6 +20,7 @@ import (
 	"context"
 	"crypto"
 	"encoding/gob"
+	"fmt"
 	"net"
 	"net/rpc"
 	"sync"
@@ -61,6 +62,8 @@ type Pulsar struct {
 	generatedEntropyLock sync.RWMutex
 
 	GeneratedEntropySign []byte
+	// GeneratedEntropyProvenance describes origin of generated entropy
+	GeneratedEntropyProvenance entropygenerator.Provenance
 
 	currentSlotEntropy     *insolar.Entropy
 	currentSlotEntropyLock sync.RWMutex
@@ -70,11 +73,16 @@ type Pulsar struct {
 	currentSlotSenderConfirmationsLock sync.RWMutex
 	CurrentSlotSenderConfirmations     map[string]insolar.PulseSenderConfirmation
 
-	ProcessingPulseNumber insolar.PulseNumber
+	// ProcessingPulseNumber should be changed via SetProcessingPulseNumber, it's read by status service concurrently
+	ProcessingPulseNumber     insolar.PulseNumber
+	processingPulseNumberLock sync.RWMutex
 
 	lastPulseLock sync.RWMutex
 	lastPulse     *insolar.Pulse
 
+	deliveryReportsLock sync.RWMutex
+	deliveryReports     []insolar.PulseDeliveryReport
+
 	ownedBtfRowLock sync.RWMutex
 	ownedBftRow     map[string]*BftCell
 
@@ -106,7 +114,7 @@ func NewPulsar(
 	log.Debug("[NewPulsar]")
 
 	// Listen for incoming connections.
-	listenerImpl, err := listener(configuration.ConnectionType.String(), configuration.MainListenerAddress)
+	listenerImpl, err := listener(configuration.ConnectionType.Network(), configuration.MainListenerAddress)
 	if err != nil {
 		return nil, err
 	}
@@ -290,25 +298,26 @@ func (currentPulsar *Pulsar) StartConsensusProcess(ctx context.Context, pulseNum
 	currentPulsar.StartProcessLock.Lock()
 	logger.Debugf("[After StartProcessLock]")
 
-	if pulseNumber == currentPulsar.ProcessingPulseNumber {
+	processingPulse := currentPulsar.GetProcessingPulseNumber()
+	if pulseNumber == processingPulse {
 		logger.Debugf("[pulseNumber == currentPulsar.ProcessingPulseNumber] return nil")
 		currentPulsar.StartProcessLock.Unlock()
 		return nil
 	}
 
 	logger.Debugf("currentPulsar.StateSwitcher.GetState() > WaitingForStart")
-	if currentPulsar.StateSwitcher.GetState() > WaitingForStart || (currentPulsar.ProcessingPulseNumber != 0 && pulseNumber < currentPulsar.ProcessingPulseNumber) {
+	if currentPulsar.StateSwitcher.GetState() > WaitingForStart || (processingPulse != 0 && pulseNumber < processingPulse) {
 		logger.Debugf("currentPulsar.StartProcessLock.Unlock()")
 		currentPulsar.StartProcessLock.Unlock()
 		err := errors.Errorf(
 			"wrong state status or pulse number, state - %v, received pulse - %v, last pulse - %v, processing pulse - %v",
 			currentPulsar.StateSwitcher.GetState().String(),
 			pulseNumber, currentPulsar.GetLastPulse().PulseNumber,
-			currentPulsar.ProcessingPulseNumber)
+			processingPulse)
 		logger.Error(err)
 		return err
 	}
-	currentPulsar.ProcessingPulseNumber = pulseNumber
+	currentPulsar.SetProcessingPulseNumber(pulseNumber)
 
 	inslog := inslogger.FromContext(ctx)
 
@@ -324,6 +333,23 @@ func (currentPulsar *Pulsar) StartConsensusProcess(ctx context.Context, pulseNum
 		return err
 	}
 	inslog.Debugf("Entropy generated - %v", currentPulsar.GetGeneratedEntropy())
+	provenance := currentPulsar.GeneratedEntropyProvenance
+	err = currentPulsar.Storage.SaveEntropyProvenance(pulseNumber, provenance)
+	if err != nil {
+		err = errors.Wrap(err, "failed to save entropy provenance")
+		currentPulsar.StartProcessLock.Unlock()
+		currentPulsar.StateSwitcher.SwitchToState(ctx, Failed, err)
+		return err
+	}
+	inslog.WithFields(map[string]interface{}{
+		"pulse":              pulseNumber,
+		"entropy":            fmt.Sprintf("%x", currentPulsar.GetGeneratedEntropy()[:]),
+		"entropy_generator":  provenance.Generator,
+		"entropy_sources":    provenance.Sources,
+		"entropy_sequence":   provenance.Sequence,
+		"entropy_seed":       provenance.Seed,
+		"entropy_created_at": provenance.GeneratedAt,
+	}).Info("Entropy provenance")
 	inslog.Debugf("Entropy sign generated - %v", currentPulsar.GeneratedEntropySign)
 
 	currentPulsar.AddItemToVector(currentPulsar.PublicKeyRaw, &BftCell{
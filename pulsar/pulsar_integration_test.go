@@ -62,43 +62,49 @@ func TestTwoPulsars_Handshake(t *testing.T) {
 
 	pcs := platformpolicy.NewPlatformCryptographyScheme()
 
-	firstPulsar, err := NewPulsar(
-		configuration.Pulsar{
-			ConnectionType:      "tcp",
-			MainListenerAddress: ":1639",
-			Neighbours: []configuration.PulsarNodeAddress{
-				{ConnectionType: "tcp", Address: "127.0.0.1:1640", PublicKey: string(parsedSecondPubKey)},
-			},
+	firstConfig := configuration.Pulsar{
+		ConnectionType:      configuration.TLS,
+		MainListenerAddress: ":1639",
+		Neighbours: []configuration.PulsarNodeAddress{
+			{ConnectionType: configuration.TLS, Address: "127.0.0.1:1640", PublicKey: string(parsedSecondPubKey)},
 		},
+	}
+	firstTLSConfig, err := NewTLSConfig(firstPrivateKey, keyProcessor, firstConfig.Neighbours)
+	require.NoError(t, err)
+	firstPulsar, err := NewPulsar(
+		firstConfig,
 		firstCryptoService,
 		pcs,
 		keyProcessor,
 		pulseDistributor,
 		storage,
-		&RPCClientWrapperFactoryImpl{},
+		&RPCClientWrapperFactoryImpl{TLSConfig: firstTLSConfig},
 		pulsartestutils.MockEntropyGenerator{},
 		nil,
-		net.Listen,
+		TLSListener(firstTLSConfig),
 	)
 	require.NoError(t, err)
 
-	secondPulsar, err := NewPulsar(
-		configuration.Pulsar{
-			ConnectionType:      "tcp",
-			MainListenerAddress: ":1640",
-			Neighbours: []configuration.PulsarNodeAddress{
-				{ConnectionType: "tcp", Address: "127.0.0.1:1639", PublicKey: string(parsedFirstPubKey)},
-			},
+	secondConfig := configuration.Pulsar{
+		ConnectionType:      configuration.TLS,
+		MainListenerAddress: ":1640",
+		Neighbours: []configuration.PulsarNodeAddress{
+			{ConnectionType: configuration.TLS, Address: "127.0.0.1:1639", PublicKey: string(parsedFirstPubKey)},
 		},
+	}
+	secondTLSConfig, err := NewTLSConfig(secondPrivateKey, keyProcessor, secondConfig.Neighbours)
+	require.NoError(t, err)
+	secondPulsar, err := NewPulsar(
+		secondConfig,
 		secondCryptoService,
 		pcs,
 		keyProcessor,
 		pulseDistributor,
 		storage,
-		&RPCClientWrapperFactoryImpl{},
+		&RPCClientWrapperFactoryImpl{TLSConfig: secondTLSConfig},
 		pulsartestutils.MockEntropyGenerator{},
 		nil,
-		net.Listen,
+		TLSListener(secondTLSConfig),
 	)
 	require.NoError(t, err)
 
@@ -124,6 +130,7 @@ func TestPulsar_SendPulseToNode(t *testing.T) {
 
 	storage := pulsartestutils.NewPulsarStorageMock(t)
 	storage.GetLastPulseMock.Return(insolar.GenesisPulse, nil)
+	storage.SaveEntropyProvenanceMock.Return(nil)
 	storage.SavePulseFunc = func(p *insolar.Pulse) (r error) { return nil }
 	storage.SetLastPulseFunc = func(p *insolar.Pulse) (r error) { return nil }
 	stateSwitcher := &StateSwitcherImpl{}
@@ -180,6 +187,7 @@ func TestTwoPulsars_Full_Consensus(t *testing.T) {
 	// Arrange
 	storage := pulsartestutils.NewPulsarStorageMock(t)
 	storage.GetLastPulseMock.Return(insolar.GenesisPulse, nil)
+	storage.SaveEntropyProvenanceMock.Return(nil)
 	storage.SavePulseFunc = func(p *insolar.Pulse) (r error) {
 		require.Equal(t, insolar.FirstPulseNumber+1, int(p.PulseNumber))
 		return nil
@@ -219,54 +227,60 @@ func TestTwoPulsars_Full_Consensus(t *testing.T) {
 	pcsFirst := platformpolicy.NewPlatformCryptographyScheme()
 	pcsSecond := platformpolicy.NewPlatformCryptographyScheme()
 
+	firstConfig := configuration.Pulsar{
+		ConnectionType:      configuration.TLS,
+		MainListenerAddress: ":1140",
+		Neighbours: []configuration.PulsarNodeAddress{
+			{ConnectionType: configuration.TLS, Address: "127.0.0.1:1641", PublicKey: string(exportedSecondKey)},
+		},
+		ReceivingSignTimeout:           50,
+		ReceivingNumberTimeout:         50,
+		ReceivingSignsForChosenTimeout: 50,
+		ReceivingVectorTimeout:         50,
+	}
+	firstTLSConfig, err := NewTLSConfig(firstPrivateKey, keyProcessorFirst, firstConfig.Neighbours)
+	require.NoError(t, err)
 	firstStateSwitcher := &StateSwitcherImpl{}
 	firstPulsar, err := NewPulsar(
-		configuration.Pulsar{
-			ConnectionType:      "tcp",
-			MainListenerAddress: ":1140",
-			Neighbours: []configuration.PulsarNodeAddress{
-				{ConnectionType: "tcp", Address: "127.0.0.1:1641", PublicKey: string(exportedSecondKey)},
-			},
-			ReceivingSignTimeout:           50,
-			ReceivingNumberTimeout:         50,
-			ReceivingSignsForChosenTimeout: 50,
-			ReceivingVectorTimeout:         50,
-		},
+		firstConfig,
 		firstCryptoService,
 		pcsFirst,
 		keyProcessorFirst,
 		pulseDistributor,
 		storage,
-		&RPCClientWrapperFactoryImpl{},
+		&RPCClientWrapperFactoryImpl{TLSConfig: firstTLSConfig},
 		&entropygenerator.StandardEntropyGenerator{},
 		firstStateSwitcher,
-		net.Listen,
+		TLSListener(firstTLSConfig),
 	)
 	firstStateSwitcher.setState(WaitingForStart)
 	firstStateSwitcher.SetPulsar(firstPulsar)
 
+	secondConfig := configuration.Pulsar{
+		ConnectionType:      configuration.TLS,
+		MainListenerAddress: ":1641",
+		Neighbours: []configuration.PulsarNodeAddress{
+			{ConnectionType: configuration.TLS, Address: "127.0.0.1:1140", PublicKey: string(exporteFirstKey)},
+		},
+		ReceivingSignTimeout:           50,
+		ReceivingNumberTimeout:         50,
+		ReceivingSignsForChosenTimeout: 50,
+		ReceivingVectorTimeout:         50,
+	}
+	secondTLSConfig, err := NewTLSConfig(secondPrivateKey, keyProcessorSecond, secondConfig.Neighbours)
+	require.NoError(t, err)
 	secondStateSwitcher := &StateSwitcherImpl{}
 	secondPulsar, err := NewPulsar(
-		configuration.Pulsar{
-			ConnectionType:      "tcp",
-			MainListenerAddress: ":1641",
-			Neighbours: []configuration.PulsarNodeAddress{
-				{ConnectionType: "tcp", Address: "127.0.0.1:1140", PublicKey: string(exporteFirstKey)},
-			},
-			ReceivingSignTimeout:           50,
-			ReceivingNumberTimeout:         50,
-			ReceivingSignsForChosenTimeout: 50,
-			ReceivingVectorTimeout:         50,
-		},
+		secondConfig,
 		secondCryptoService,
 		pcsSecond,
 		keyProcessorSecond,
 		pulseDistributor,
 		storage,
-		&RPCClientWrapperFactoryImpl{},
+		&RPCClientWrapperFactoryImpl{TLSConfig: secondTLSConfig},
 		&entropygenerator.StandardEntropyGenerator{},
 		secondStateSwitcher,
-		net.Listen,
+		TLSListener(secondTLSConfig),
 	)
 	secondStateSwitcher.setState(WaitingForStart)
 	secondStateSwitcher.SetPulsar(secondPulsar)
@@ -307,6 +321,7 @@ func TestSevenPulsars_Full_Consensus(t *testing.T) {
 
 	storage := pulsartestutils.NewPulsarStorageMock(t)
 	storage.GetLastPulseMock.Return(insolar.GenesisPulse, nil)
+	storage.SaveEntropyProvenanceMock.Return(nil)
 	storage.SavePulseFunc = func(p *insolar.Pulse) (r error) {
 		require.Equal(t, insolar.FirstPulseNumber+1, int(p.PulseNumber))
 		return nil
@@ -344,7 +359,7 @@ func TestSevenPulsars_Full_Consensus(t *testing.T) {
 	for pulsarIndex := 0; pulsarIndex < 7; pulsarIndex++ {
 		conf := configuration.Configuration{
 			Pulsar: configuration.Pulsar{
-				ConnectionType:                 "tcp",
+				ConnectionType:                 configuration.TLS,
 				MainListenerAddress:            mainAddresses[pulsarIndex],
 				Neighbours:                     []configuration.PulsarNodeAddress{},
 				ReceivingSignTimeout:           100,
@@ -360,12 +375,15 @@ func TestSevenPulsars_Full_Consensus(t *testing.T) {
 			publicKeyBytes, err := keyProcessor.ExportPublicKeyPEM(keyProcessor.ExtractPublicKey(pulsarsPrivateKeys[configIndex]))
 			require.NoError(t, err)
 			conf.Pulsar.Neighbours = append(conf.Pulsar.Neighbours, configuration.PulsarNodeAddress{
-				ConnectionType: "tcp",
+				ConnectionType: configuration.TLS,
 				Address:        mainAddresses[configIndex],
 				PublicKey:      string(publicKeyBytes),
 			})
 		}
 
+		tlsConfig, err := NewTLSConfig(pulsarsPrivateKeys[pulsarIndex], keyProcessor, conf.Pulsar.Neighbours)
+		require.NoError(t, err)
+
 		service := cryptography.NewKeyBoundCryptographyService(pulsarsPrivateKeys[pulsarIndex])
 		scheme := platformpolicy.NewPlatformCryptographyScheme()
 
@@ -377,10 +395,10 @@ func TestSevenPulsars_Full_Consensus(t *testing.T) {
 			keyProcessor,
 			pulseDistributorMock,
 			storage,
-			&RPCClientWrapperFactoryImpl{},
+			&RPCClientWrapperFactoryImpl{TLSConfig: tlsConfig},
 			&entropygenerator.StandardEntropyGenerator{},
 			switcher,
-			net.Listen,
+			TLSListener(tlsConfig),
 		)
 		switcher.setState(WaitingForStart)
 		switcher.SetPulsar(pulsar)
@@ -441,22 +441,55 @@ func TestPulsar_StartConsensusProcess_Success(t *testing.T) {
 	cryptoService := cryptography.NewKeyBoundCryptographyService(privateKey)
 	scheme := platformpolicy.NewPlatformCryptographyScheme()
 
+	expectedPulse := insolar.PulseNumber(123)
+	storage := pulsartestutils.NewPulsarStorageMock(t)
+	storage.SaveEntropyProvenanceFunc = func(pn insolar.PulseNumber, provenance entropygenerator.Provenance) error {
+		require.Equal(t, expectedPulse, pn)
+		require.NotEmpty(t, provenance.Generator)
+		return nil
+	}
+
 	pulsar := &Pulsar{
 		EntropyGenerator:           pulsartestutils.MockEntropyGenerator{},
 		CryptographyService:        cryptoService,
 		PlatformCryptographyScheme: scheme,
+		Storage:                    storage,
 		ownedBftRow:                map[string]*BftCell{},
 	}
 	pulsar.ProcessingPulseNumber = insolar.PulseNumber(120)
 	pulsar.SetLastPulse(&insolar.Pulse{PulseNumber: insolar.PulseNumber(2)})
 	pulsar.StateSwitcher = mockSwitcher
-	expectedPulse := insolar.PulseNumber(123)
 
 	err := pulsar.StartConsensusProcess(ctx, expectedPulse)
 
 	require.NoError(t, err)
 	require.Equal(t, pulsar.ProcessingPulseNumber, expectedPulse)
 	require.Equal(t, uint64(1), mockSwitcher.SwitchToStateCounter)
+	require.Equal(t, uint64(1), storage.SaveEntropyProvenanceCounter)
+}
+
+func TestPulsar_StartConsensusProcess_EntropyFailed(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+
+	mockSwitcher := NewStateSwitcherMock(t)
+	mockSwitcher.GetStateMock.Return(WaitingForStart)
+	mockSwitcher.setStateMock.Expect(GenerateEntropy).Return()
+	mockSwitcher.SwitchToStateFunc = func(ctx context.Context, p State, p1 interface{}) {
+		require.Equal(t, Failed, p)
+	}
+
+	pulsar := &Pulsar{
+		EntropyGenerator: entropygenerator.NewMixingEntropyGenerator(),
+		Storage:          pulsartestutils.NewPulsarStorageMock(t),
+		ownedBftRow:      map[string]*BftCell{},
+	}
+	pulsar.SetLastPulse(&insolar.Pulse{PulseNumber: insolar.PulseNumber(2)})
+	pulsar.StateSwitcher = mockSwitcher
+
+	err := pulsar.StartConsensusProcess(ctx, insolar.PulseNumber(123))
+
+	require.Error(t, err)
+	require.Equal(t, uint64(1), mockSwitcher.SwitchToStateCounter)
 }
 
 func TestPulsar_broadcastSignatureOfEntropy_StateFailed(t *testing.T) {
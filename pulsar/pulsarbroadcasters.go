@@ -34,7 +34,7 @@ func (currentPulsar *Pulsar) broadcastSignatureOfEntropy(ctx context.Context) {
 	}
 
 	payload, err := currentPulsar.preparePayload(&EntropySignaturePayload{
-		PulseNumber:      currentPulsar.ProcessingPulseNumber,
+		PulseNumber:      currentPulsar.GetProcessingPulseNumber(),
 		EntropySignature: currentPulsar.GeneratedEntropySign,
 	})
 	if err != nil {
@@ -64,7 +64,7 @@ func (currentPulsar *Pulsar) broadcastVector(ctx context.Context) {
 		return
 	}
 	payload, err := currentPulsar.preparePayload(&VectorPayload{
-		PulseNumber: currentPulsar.ProcessingPulseNumber,
+		PulseNumber: currentPulsar.GetProcessingPulseNumber(),
 		Vector:      currentPulsar.CreateVectorCopy(),
 	})
 
@@ -93,7 +93,7 @@ func (currentPulsar *Pulsar) broadcastEntropy(ctx context.Context) {
 	}
 
 	payload, err := currentPulsar.preparePayload(&EntropyPayload{
-		PulseNumber: currentPulsar.ProcessingPulseNumber,
+		PulseNumber: currentPulsar.GetProcessingPulseNumber(),
 		Entropy:     *currentPulsar.GetGeneratedEntropy(),
 	})
 	if err != nil {
@@ -188,7 +188,7 @@ func (currentPulsar *Pulsar) sendPulseSign(ctx context.Context) {
 		insolar.PulseSenderConfirmation{
 			Entropy:         *currentPulsar.GetCurrentSlotEntropy(),
 			ChosenPublicKey: currentPulsar.CurrentSlotPulseSender,
-			PulseNumber:     currentPulsar.ProcessingPulseNumber,
+			PulseNumber:     currentPulsar.GetProcessingPulseNumber(),
 		},
 	}
 	hashProvider := currentPulsar.PlatformCryptographyScheme.IntegrityHasher()
@@ -205,7 +205,7 @@ func (currentPulsar *Pulsar) sendPulseSign(ctx context.Context) {
 	}
 	confirmation := PulseSenderConfirmationPayload{
 		insolar.PulseSenderConfirmation{
-			PulseNumber:     currentPulsar.ProcessingPulseNumber,
+			PulseNumber:     currentPulsar.GetProcessingPulseNumber(),
 			ChosenPublicKey: currentPulsar.CurrentSlotPulseSender,
 			Entropy:         *currentPulsar.GetCurrentSlotEntropy(),
 			Signature:       signature.Bytes(),
@@ -240,10 +240,10 @@ func (currentPulsar *Pulsar) sendPulseToNodesAndPulsars(ctx context.Context) {
 
 	currentPulsar.currentSlotSenderConfirmationsLock.RLock()
 	pulseForSending := insolar.Pulse{
-		PulseNumber:      currentPulsar.ProcessingPulseNumber,
+		PulseNumber:      currentPulsar.GetProcessingPulseNumber(),
 		Entropy:          *currentPulsar.GetCurrentSlotEntropy(),
 		Signs:            currentPulsar.CurrentSlotSenderConfirmations,
-		NextPulseNumber:  currentPulsar.ProcessingPulseNumber + insolar.PulseNumber(currentPulsar.Config.NumberDelta),
+		NextPulseNumber:  currentPulsar.GetProcessingPulseNumber() + insolar.PulseNumber(currentPulsar.Config.NumberDelta),
 		PrevPulseNumber:  currentPulsar.lastPulse.PulseNumber,
 		EpochPulseNumber: 1,
 		OriginID:         [16]byte{206, 41, 229, 190, 7, 240, 162, 155, 121, 245, 207, 56, 161, 67, 189, 0},
@@ -254,7 +254,7 @@ func (currentPulsar *Pulsar) sendPulseToNodesAndPulsars(ctx context.Context) {
 	logger.Debug("Start a process of sending pulse")
 	go func() {
 		logger.Debug("Before sending to network")
-		currentPulsar.PulseDistributor.Distribute(ctx, pulseForSending)
+		currentPulsar.distributePulse(ctx, pulseForSending)
 	}()
 	go currentPulsar.sendPulseToPulsars(ctx, pulseForSending)
 
@@ -107,7 +107,7 @@ func (currentPulsar *Pulsar) verify(ctx context.Context) {
 		payload := PulseSenderConfirmationPayload{insolar.PulseSenderConfirmation{
 			ChosenPublicKey: currentPulsar.CurrentSlotPulseSender,
 			Entropy:         *currentPulsar.GetCurrentSlotEntropy(),
-			PulseNumber:     currentPulsar.ProcessingPulseNumber,
+			PulseNumber:     currentPulsar.GetProcessingPulseNumber(),
 		}}
 		hashProvider := currentPulsar.PlatformCryptographyScheme.IntegrityHasher()
 		hash, err := payload.Hash(hashProvider)
@@ -126,7 +126,7 @@ func (currentPulsar *Pulsar) verify(ctx context.Context) {
 			ChosenPublicKey: currentPulsar.CurrentSlotPulseSender,
 			Signature:       signature.Bytes(),
 			Entropy:         *currentPulsar.GetCurrentSlotEntropy(),
-			PulseNumber:     currentPulsar.ProcessingPulseNumber,
+			PulseNumber:     currentPulsar.GetProcessingPulseNumber(),
 		}
 		currentPulsar.currentSlotSenderConfirmationsLock.Unlock()
 
@@ -225,7 +225,7 @@ func (currentPulsar *Pulsar) finalizeBft(ctx context.Context, finalEntropy insol
 		payload := PulseSenderConfirmationPayload{insolar.PulseSenderConfirmation{
 			ChosenPublicKey: currentPulsar.CurrentSlotPulseSender,
 			Entropy:         *currentPulsar.GetCurrentSlotEntropy(),
-			PulseNumber:     currentPulsar.ProcessingPulseNumber,
+			PulseNumber:     currentPulsar.GetProcessingPulseNumber(),
 		}}
 		hashProvider := currentPulsar.PlatformCryptographyScheme.IntegrityHasher()
 		hash, err := payload.Hash(hashProvider)
@@ -244,7 +244,7 @@ func (currentPulsar *Pulsar) finalizeBft(ctx context.Context, finalEntropy insol
 			ChosenPublicKey: currentPulsar.CurrentSlotPulseSender,
 			Signature:       signature.Bytes(),
 			Entropy:         *currentPulsar.GetCurrentSlotEntropy(),
-			PulseNumber:     currentPulsar.ProcessingPulseNumber,
+			PulseNumber:     currentPulsar.GetProcessingPulseNumber(),
 		}
 		currentPulsar.currentSlotSenderConfirmationsLock.Unlock()
 
@@ -23,6 +23,7 @@ import (
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/instrumentation/inslogger"
 	"github.com/insolar/insolar/log"
+	"github.com/insolar/insolar/pulsar/entropygenerator"
 	"github.com/insolar/insolar/utils/entropy"
 	"github.com/pkg/errors"
 )
@@ -88,8 +89,12 @@ func (currentPulsar *Pulsar) clearState() {
 }
 
 func (currentPulsar *Pulsar) generateNewEntropyAndSign() error {
-	e := currentPulsar.EntropyGenerator.GenerateEntropy()
+	e, provenance, err := entropygenerator.GenerateWithProvenance(currentPulsar.EntropyGenerator)
+	if err != nil {
+		return errors.Wrap(err, "failed to generate entropy")
+	}
 	currentPulsar.SetGeneratedEntropy(&e)
+	currentPulsar.GeneratedEntropyProvenance = provenance
 
 	sign, err := currentPulsar.CryptographyService.Sign(currentPulsar.GetGeneratedEntropy()[:])
 	if err != nil {
@@ -168,6 +173,20 @@ func (currentPulsar *Pulsar) SetLastPulse(newPulse *insolar.Pulse) {
 	currentPulsar.lastPulse = newPulse
 }
 
+// GetProcessingPulseNumber returns number of the pulse in process in the thread-safe mode
+func (currentPulsar *Pulsar) GetProcessingPulseNumber() insolar.PulseNumber {
+	currentPulsar.processingPulseNumberLock.RLock()
+	defer currentPulsar.processingPulseNumberLock.RUnlock()
+	return currentPulsar.ProcessingPulseNumber
+}
+
+// SetProcessingPulseNumber sets number of the pulse in process in the thread-safe mode
+func (currentPulsar *Pulsar) SetProcessingPulseNumber(pn insolar.PulseNumber) {
+	currentPulsar.processingPulseNumberLock.Lock()
+	defer currentPulsar.processingPulseNumberLock.Unlock()
+	currentPulsar.ProcessingPulseNumber = pn
+}
+
 // GetCurrentSlotEntropy returns currentSlotEntropy in the thread-safe mode
 func (currentPulsar *Pulsar) GetCurrentSlotEntropy() *insolar.Entropy {
 	currentPulsar.currentSlotEntropyLock.RLock()
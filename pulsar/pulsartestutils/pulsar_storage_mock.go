@@ -11,6 +11,7 @@ import (
 
 	"github.com/gojuno/minimock"
 	insolar "github.com/insolar/insolar/insolar"
+	entropygenerator "github.com/insolar/insolar/pulsar/entropygenerator"
 
 	testify_assert "github.com/stretchr/testify/assert"
 )
@@ -24,304 +25,1240 @@ type PulsarStorageMock struct {
 	ClosePreCounter uint64
 	CloseMock       mPulsarStorageMockClose
 
+	GetEntropyProvenanceFunc       func(p insolar.PulseNumber) (r *entropygenerator.Provenance, r1 error)
+	GetEntropyProvenanceCounter    uint64
+	GetEntropyProvenancePreCounter uint64
+	GetEntropyProvenanceMock       mPulsarStorageMockGetEntropyProvenance
+
 	GetLastPulseFunc       func() (r *insolar.Pulse, r1 error)
 	GetLastPulseCounter    uint64
 	GetLastPulsePreCounter uint64
 	GetLastPulseMock       mPulsarStorageMockGetLastPulse
 
-	SavePulseFunc       func(p *insolar.Pulse) (r error)
-	SavePulseCounter    uint64
-	SavePulsePreCounter uint64
-	SavePulseMock       mPulsarStorageMockSavePulse
+	GetNextPulseFunc       func(p insolar.PulseNumber) (r *insolar.Pulse, r1 error)
+	GetNextPulseCounter    uint64
+	GetNextPulsePreCounter uint64
+	GetNextPulseMock       mPulsarStorageMockGetNextPulse
+
+	GetPrevPulseFunc       func(p insolar.PulseNumber) (r *insolar.Pulse, r1 error)
+	GetPrevPulseCounter    uint64
+	GetPrevPulsePreCounter uint64
+	GetPrevPulseMock       mPulsarStorageMockGetPrevPulse
+
+	GetPulseFunc       func(p insolar.PulseNumber) (r *insolar.Pulse, r1 error)
+	GetPulseCounter    uint64
+	GetPulsePreCounter uint64
+	GetPulseMock       mPulsarStorageMockGetPulse
+
+	GetPulsesFunc       func(p insolar.PulseNumber, p1 insolar.PulseNumber, p2 int) (r []insolar.Pulse, r1 error)
+	GetPulsesCounter    uint64
+	GetPulsesPreCounter uint64
+	GetPulsesMock       mPulsarStorageMockGetPulses
+
+	SaveEntropyProvenanceFunc       func(p insolar.PulseNumber, p1 entropygenerator.Provenance) (r error)
+	SaveEntropyProvenanceCounter    uint64
+	SaveEntropyProvenancePreCounter uint64
+	SaveEntropyProvenanceMock       mPulsarStorageMockSaveEntropyProvenance
+
+	SavePulseFunc       func(p *insolar.Pulse) (r error)
+	SavePulseCounter    uint64
+	SavePulsePreCounter uint64
+	SavePulseMock       mPulsarStorageMockSavePulse
+
+	SetLastPulseFunc       func(p *insolar.Pulse) (r error)
+	SetLastPulseCounter    uint64
+	SetLastPulsePreCounter uint64
+	SetLastPulseMock       mPulsarStorageMockSetLastPulse
+}
+
+//NewPulsarStorageMock returns a mock for github.com/insolar/insolar/pulsar/storage.PulsarStorage
+func NewPulsarStorageMock(t minimock.Tester) *PulsarStorageMock {
+	m := &PulsarStorageMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CloseMock = mPulsarStorageMockClose{mock: m}
+	m.GetEntropyProvenanceMock = mPulsarStorageMockGetEntropyProvenance{mock: m}
+	m.GetLastPulseMock = mPulsarStorageMockGetLastPulse{mock: m}
+	m.GetNextPulseMock = mPulsarStorageMockGetNextPulse{mock: m}
+	m.GetPrevPulseMock = mPulsarStorageMockGetPrevPulse{mock: m}
+	m.GetPulseMock = mPulsarStorageMockGetPulse{mock: m}
+	m.GetPulsesMock = mPulsarStorageMockGetPulses{mock: m}
+	m.SaveEntropyProvenanceMock = mPulsarStorageMockSaveEntropyProvenance{mock: m}
+	m.SavePulseMock = mPulsarStorageMockSavePulse{mock: m}
+	m.SetLastPulseMock = mPulsarStorageMockSetLastPulse{mock: m}
+
+	return m
+}
+
+type mPulsarStorageMockClose struct {
+	mock              *PulsarStorageMock
+	mainExpectation   *PulsarStorageMockCloseExpectation
+	expectationSeries []*PulsarStorageMockCloseExpectation
+}
+
+type PulsarStorageMockCloseExpectation struct {
+	result *PulsarStorageMockCloseResult
+}
+
+type PulsarStorageMockCloseResult struct {
+	r error
+}
+
+//Expect specifies that invocation of PulsarStorage.Close is expected from 1 to Infinity times
+func (m *mPulsarStorageMockClose) Expect() *mPulsarStorageMockClose {
+	m.mock.CloseFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &PulsarStorageMockCloseExpectation{}
+	}
+
+	return m
+}
+
+//Return specifies results of invocation of PulsarStorage.Close
+func (m *mPulsarStorageMockClose) Return(r error) *PulsarStorageMock {
+	m.mock.CloseFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &PulsarStorageMockCloseExpectation{}
+	}
+	m.mainExpectation.result = &PulsarStorageMockCloseResult{r}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of PulsarStorage.Close is expected once
+func (m *mPulsarStorageMockClose) ExpectOnce() *PulsarStorageMockCloseExpectation {
+	m.mock.CloseFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &PulsarStorageMockCloseExpectation{}
+
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *PulsarStorageMockCloseExpectation) Return(r error) {
+	e.result = &PulsarStorageMockCloseResult{r}
+}
+
+//Set uses given function f as a mock of PulsarStorage.Close method
+func (m *mPulsarStorageMockClose) Set(f func() (r error)) *PulsarStorageMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.CloseFunc = f
+	return m.mock
+}
+
+//Close implements github.com/insolar/insolar/pulsar/storage.PulsarStorage interface
+func (m *PulsarStorageMock) Close() (r error) {
+	counter := atomic.AddUint64(&m.ClosePreCounter, 1)
+	defer atomic.AddUint64(&m.CloseCounter, 1)
+
+	if len(m.CloseMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.CloseMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to PulsarStorageMock.Close.")
+			return
+		}
+
+		result := m.CloseMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the PulsarStorageMock.Close")
+			return
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.CloseMock.mainExpectation != nil {
+
+		result := m.CloseMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the PulsarStorageMock.Close")
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.CloseFunc == nil {
+		m.t.Fatalf("Unexpected call to PulsarStorageMock.Close.")
+		return
+	}
+
+	return m.CloseFunc()
+}
+
+//CloseMinimockCounter returns a count of PulsarStorageMock.CloseFunc invocations
+func (m *PulsarStorageMock) CloseMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.CloseCounter)
+}
+
+//CloseMinimockPreCounter returns the value of PulsarStorageMock.Close invocations
+func (m *PulsarStorageMock) CloseMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.ClosePreCounter)
+}
+
+//CloseFinished returns true if mock invocations count is ok
+func (m *PulsarStorageMock) CloseFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.CloseMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.CloseCounter) == uint64(len(m.CloseMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.CloseMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.CloseCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.CloseFunc != nil {
+		return atomic.LoadUint64(&m.CloseCounter) > 0
+	}
+
+	return true
+}
+
+type mPulsarStorageMockGetEntropyProvenance struct {
+	mock              *PulsarStorageMock
+	mainExpectation   *PulsarStorageMockGetEntropyProvenanceExpectation
+	expectationSeries []*PulsarStorageMockGetEntropyProvenanceExpectation
+}
+
+type PulsarStorageMockGetEntropyProvenanceExpectation struct {
+	input  *PulsarStorageMockGetEntropyProvenanceInput
+	result *PulsarStorageMockGetEntropyProvenanceResult
+}
+
+type PulsarStorageMockGetEntropyProvenanceInput struct {
+	p insolar.PulseNumber
+}
+
+type PulsarStorageMockGetEntropyProvenanceResult struct {
+	r  *entropygenerator.Provenance
+	r1 error
+}
+
+//Expect specifies that invocation of PulsarStorage.GetEntropyProvenance is expected from 1 to Infinity times
+func (m *mPulsarStorageMockGetEntropyProvenance) Expect(p insolar.PulseNumber) *mPulsarStorageMockGetEntropyProvenance {
+	m.mock.GetEntropyProvenanceFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &PulsarStorageMockGetEntropyProvenanceExpectation{}
+	}
+	m.mainExpectation.input = &PulsarStorageMockGetEntropyProvenanceInput{p}
+	return m
+}
+
+//Return specifies results of invocation of PulsarStorage.GetEntropyProvenance
+func (m *mPulsarStorageMockGetEntropyProvenance) Return(r *entropygenerator.Provenance, r1 error) *PulsarStorageMock {
+	m.mock.GetEntropyProvenanceFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &PulsarStorageMockGetEntropyProvenanceExpectation{}
+	}
+	m.mainExpectation.result = &PulsarStorageMockGetEntropyProvenanceResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of PulsarStorage.GetEntropyProvenance is expected once
+func (m *mPulsarStorageMockGetEntropyProvenance) ExpectOnce(p insolar.PulseNumber) *PulsarStorageMockGetEntropyProvenanceExpectation {
+	m.mock.GetEntropyProvenanceFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &PulsarStorageMockGetEntropyProvenanceExpectation{}
+	expectation.input = &PulsarStorageMockGetEntropyProvenanceInput{p}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *PulsarStorageMockGetEntropyProvenanceExpectation) Return(r *entropygenerator.Provenance, r1 error) {
+	e.result = &PulsarStorageMockGetEntropyProvenanceResult{r, r1}
+}
+
+//Set uses given function f as a mock of PulsarStorage.GetEntropyProvenance method
+func (m *mPulsarStorageMockGetEntropyProvenance) Set(f func(p insolar.PulseNumber) (r *entropygenerator.Provenance, r1 error)) *PulsarStorageMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.GetEntropyProvenanceFunc = f
+	return m.mock
+}
+
+//GetEntropyProvenance implements github.com/insolar/insolar/pulsar/storage.PulsarStorage interface
+func (m *PulsarStorageMock) GetEntropyProvenance(p insolar.PulseNumber) (r *entropygenerator.Provenance, r1 error) {
+	counter := atomic.AddUint64(&m.GetEntropyProvenancePreCounter, 1)
+	defer atomic.AddUint64(&m.GetEntropyProvenanceCounter, 1)
+
+	if len(m.GetEntropyProvenanceMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.GetEntropyProvenanceMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to PulsarStorageMock.GetEntropyProvenance. %v", p)
+			return
+		}
+
+		input := m.GetEntropyProvenanceMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, PulsarStorageMockGetEntropyProvenanceInput{p}, "PulsarStorage.GetEntropyProvenance got unexpected parameters")
+
+		result := m.GetEntropyProvenanceMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the PulsarStorageMock.GetEntropyProvenance")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.GetEntropyProvenanceMock.mainExpectation != nil {
+
+		input := m.GetEntropyProvenanceMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, PulsarStorageMockGetEntropyProvenanceInput{p}, "PulsarStorage.GetEntropyProvenance got unexpected parameters")
+		}
+
+		result := m.GetEntropyProvenanceMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the PulsarStorageMock.GetEntropyProvenance")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.GetEntropyProvenanceFunc == nil {
+		m.t.Fatalf("Unexpected call to PulsarStorageMock.GetEntropyProvenance. %v", p)
+		return
+	}
+
+	return m.GetEntropyProvenanceFunc(p)
+}
+
+//GetEntropyProvenanceMinimockCounter returns a count of PulsarStorageMock.GetEntropyProvenanceFunc invocations
+func (m *PulsarStorageMock) GetEntropyProvenanceMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.GetEntropyProvenanceCounter)
+}
+
+//GetEntropyProvenanceMinimockPreCounter returns the value of PulsarStorageMock.GetEntropyProvenance invocations
+func (m *PulsarStorageMock) GetEntropyProvenanceMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.GetEntropyProvenancePreCounter)
+}
+
+//GetEntropyProvenanceFinished returns true if mock invocations count is ok
+func (m *PulsarStorageMock) GetEntropyProvenanceFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.GetEntropyProvenanceMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.GetEntropyProvenanceCounter) == uint64(len(m.GetEntropyProvenanceMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.GetEntropyProvenanceMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.GetEntropyProvenanceCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.GetEntropyProvenanceFunc != nil {
+		return atomic.LoadUint64(&m.GetEntropyProvenanceCounter) > 0
+	}
+
+	return true
+}
+
+type mPulsarStorageMockGetLastPulse struct {
+	mock              *PulsarStorageMock
+	mainExpectation   *PulsarStorageMockGetLastPulseExpectation
+	expectationSeries []*PulsarStorageMockGetLastPulseExpectation
+}
+
+type PulsarStorageMockGetLastPulseExpectation struct {
+	result *PulsarStorageMockGetLastPulseResult
+}
+
+type PulsarStorageMockGetLastPulseResult struct {
+	r  *insolar.Pulse
+	r1 error
+}
+
+//Expect specifies that invocation of PulsarStorage.GetLastPulse is expected from 1 to Infinity times
+func (m *mPulsarStorageMockGetLastPulse) Expect() *mPulsarStorageMockGetLastPulse {
+	m.mock.GetLastPulseFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &PulsarStorageMockGetLastPulseExpectation{}
+	}
+
+	return m
+}
+
+//Return specifies results of invocation of PulsarStorage.GetLastPulse
+func (m *mPulsarStorageMockGetLastPulse) Return(r *insolar.Pulse, r1 error) *PulsarStorageMock {
+	m.mock.GetLastPulseFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &PulsarStorageMockGetLastPulseExpectation{}
+	}
+	m.mainExpectation.result = &PulsarStorageMockGetLastPulseResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of PulsarStorage.GetLastPulse is expected once
+func (m *mPulsarStorageMockGetLastPulse) ExpectOnce() *PulsarStorageMockGetLastPulseExpectation {
+	m.mock.GetLastPulseFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &PulsarStorageMockGetLastPulseExpectation{}
+
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *PulsarStorageMockGetLastPulseExpectation) Return(r *insolar.Pulse, r1 error) {
+	e.result = &PulsarStorageMockGetLastPulseResult{r, r1}
+}
+
+//Set uses given function f as a mock of PulsarStorage.GetLastPulse method
+func (m *mPulsarStorageMockGetLastPulse) Set(f func() (r *insolar.Pulse, r1 error)) *PulsarStorageMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.GetLastPulseFunc = f
+	return m.mock
+}
+
+//GetLastPulse implements github.com/insolar/insolar/pulsar/storage.PulsarStorage interface
+func (m *PulsarStorageMock) GetLastPulse() (r *insolar.Pulse, r1 error) {
+	counter := atomic.AddUint64(&m.GetLastPulsePreCounter, 1)
+	defer atomic.AddUint64(&m.GetLastPulseCounter, 1)
+
+	if len(m.GetLastPulseMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.GetLastPulseMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to PulsarStorageMock.GetLastPulse.")
+			return
+		}
+
+		result := m.GetLastPulseMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the PulsarStorageMock.GetLastPulse")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.GetLastPulseMock.mainExpectation != nil {
+
+		result := m.GetLastPulseMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the PulsarStorageMock.GetLastPulse")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.GetLastPulseFunc == nil {
+		m.t.Fatalf("Unexpected call to PulsarStorageMock.GetLastPulse.")
+		return
+	}
+
+	return m.GetLastPulseFunc()
+}
+
+//GetLastPulseMinimockCounter returns a count of PulsarStorageMock.GetLastPulseFunc invocations
+func (m *PulsarStorageMock) GetLastPulseMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.GetLastPulseCounter)
+}
+
+//GetLastPulseMinimockPreCounter returns the value of PulsarStorageMock.GetLastPulse invocations
+func (m *PulsarStorageMock) GetLastPulseMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.GetLastPulsePreCounter)
+}
+
+//GetLastPulseFinished returns true if mock invocations count is ok
+func (m *PulsarStorageMock) GetLastPulseFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.GetLastPulseMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.GetLastPulseCounter) == uint64(len(m.GetLastPulseMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.GetLastPulseMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.GetLastPulseCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.GetLastPulseFunc != nil {
+		return atomic.LoadUint64(&m.GetLastPulseCounter) > 0
+	}
+
+	return true
+}
+
+type mPulsarStorageMockGetNextPulse struct {
+	mock              *PulsarStorageMock
+	mainExpectation   *PulsarStorageMockGetNextPulseExpectation
+	expectationSeries []*PulsarStorageMockGetNextPulseExpectation
+}
+
+type PulsarStorageMockGetNextPulseExpectation struct {
+	input  *PulsarStorageMockGetNextPulseInput
+	result *PulsarStorageMockGetNextPulseResult
+}
+
+type PulsarStorageMockGetNextPulseInput struct {
+	p insolar.PulseNumber
+}
+
+type PulsarStorageMockGetNextPulseResult struct {
+	r  *insolar.Pulse
+	r1 error
+}
+
+//Expect specifies that invocation of PulsarStorage.GetNextPulse is expected from 1 to Infinity times
+func (m *mPulsarStorageMockGetNextPulse) Expect(p insolar.PulseNumber) *mPulsarStorageMockGetNextPulse {
+	m.mock.GetNextPulseFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &PulsarStorageMockGetNextPulseExpectation{}
+	}
+	m.mainExpectation.input = &PulsarStorageMockGetNextPulseInput{p}
+	return m
+}
+
+//Return specifies results of invocation of PulsarStorage.GetNextPulse
+func (m *mPulsarStorageMockGetNextPulse) Return(r *insolar.Pulse, r1 error) *PulsarStorageMock {
+	m.mock.GetNextPulseFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &PulsarStorageMockGetNextPulseExpectation{}
+	}
+	m.mainExpectation.result = &PulsarStorageMockGetNextPulseResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of PulsarStorage.GetNextPulse is expected once
+func (m *mPulsarStorageMockGetNextPulse) ExpectOnce(p insolar.PulseNumber) *PulsarStorageMockGetNextPulseExpectation {
+	m.mock.GetNextPulseFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &PulsarStorageMockGetNextPulseExpectation{}
+	expectation.input = &PulsarStorageMockGetNextPulseInput{p}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *PulsarStorageMockGetNextPulseExpectation) Return(r *insolar.Pulse, r1 error) {
+	e.result = &PulsarStorageMockGetNextPulseResult{r, r1}
+}
+
+//Set uses given function f as a mock of PulsarStorage.GetNextPulse method
+func (m *mPulsarStorageMockGetNextPulse) Set(f func(p insolar.PulseNumber) (r *insolar.Pulse, r1 error)) *PulsarStorageMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.GetNextPulseFunc = f
+	return m.mock
+}
+
+//GetNextPulse implements github.com/insolar/insolar/pulsar/storage.PulsarStorage interface
+func (m *PulsarStorageMock) GetNextPulse(p insolar.PulseNumber) (r *insolar.Pulse, r1 error) {
+	counter := atomic.AddUint64(&m.GetNextPulsePreCounter, 1)
+	defer atomic.AddUint64(&m.GetNextPulseCounter, 1)
+
+	if len(m.GetNextPulseMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.GetNextPulseMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to PulsarStorageMock.GetNextPulse. %v", p)
+			return
+		}
+
+		input := m.GetNextPulseMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, PulsarStorageMockGetNextPulseInput{p}, "PulsarStorage.GetNextPulse got unexpected parameters")
+
+		result := m.GetNextPulseMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the PulsarStorageMock.GetNextPulse")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.GetNextPulseMock.mainExpectation != nil {
+
+		input := m.GetNextPulseMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, PulsarStorageMockGetNextPulseInput{p}, "PulsarStorage.GetNextPulse got unexpected parameters")
+		}
+
+		result := m.GetNextPulseMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the PulsarStorageMock.GetNextPulse")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.GetNextPulseFunc == nil {
+		m.t.Fatalf("Unexpected call to PulsarStorageMock.GetNextPulse. %v", p)
+		return
+	}
+
+	return m.GetNextPulseFunc(p)
+}
+
+//GetNextPulseMinimockCounter returns a count of PulsarStorageMock.GetNextPulseFunc invocations
+func (m *PulsarStorageMock) GetNextPulseMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.GetNextPulseCounter)
+}
+
+//GetNextPulseMinimockPreCounter returns the value of PulsarStorageMock.GetNextPulse invocations
+func (m *PulsarStorageMock) GetNextPulseMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.GetNextPulsePreCounter)
+}
+
+//GetNextPulseFinished returns true if mock invocations count is ok
+func (m *PulsarStorageMock) GetNextPulseFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.GetNextPulseMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.GetNextPulseCounter) == uint64(len(m.GetNextPulseMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.GetNextPulseMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.GetNextPulseCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.GetNextPulseFunc != nil {
+		return atomic.LoadUint64(&m.GetNextPulseCounter) > 0
+	}
+
+	return true
+}
+
+type mPulsarStorageMockGetPrevPulse struct {
+	mock              *PulsarStorageMock
+	mainExpectation   *PulsarStorageMockGetPrevPulseExpectation
+	expectationSeries []*PulsarStorageMockGetPrevPulseExpectation
+}
+
+type PulsarStorageMockGetPrevPulseExpectation struct {
+	input  *PulsarStorageMockGetPrevPulseInput
+	result *PulsarStorageMockGetPrevPulseResult
+}
+
+type PulsarStorageMockGetPrevPulseInput struct {
+	p insolar.PulseNumber
+}
+
+type PulsarStorageMockGetPrevPulseResult struct {
+	r  *insolar.Pulse
+	r1 error
+}
+
+//Expect specifies that invocation of PulsarStorage.GetPrevPulse is expected from 1 to Infinity times
+func (m *mPulsarStorageMockGetPrevPulse) Expect(p insolar.PulseNumber) *mPulsarStorageMockGetPrevPulse {
+	m.mock.GetPrevPulseFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &PulsarStorageMockGetPrevPulseExpectation{}
+	}
+	m.mainExpectation.input = &PulsarStorageMockGetPrevPulseInput{p}
+	return m
+}
+
+//Return specifies results of invocation of PulsarStorage.GetPrevPulse
+func (m *mPulsarStorageMockGetPrevPulse) Return(r *insolar.Pulse, r1 error) *PulsarStorageMock {
+	m.mock.GetPrevPulseFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &PulsarStorageMockGetPrevPulseExpectation{}
+	}
+	m.mainExpectation.result = &PulsarStorageMockGetPrevPulseResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of PulsarStorage.GetPrevPulse is expected once
+func (m *mPulsarStorageMockGetPrevPulse) ExpectOnce(p insolar.PulseNumber) *PulsarStorageMockGetPrevPulseExpectation {
+	m.mock.GetPrevPulseFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &PulsarStorageMockGetPrevPulseExpectation{}
+	expectation.input = &PulsarStorageMockGetPrevPulseInput{p}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *PulsarStorageMockGetPrevPulseExpectation) Return(r *insolar.Pulse, r1 error) {
+	e.result = &PulsarStorageMockGetPrevPulseResult{r, r1}
+}
+
+//Set uses given function f as a mock of PulsarStorage.GetPrevPulse method
+func (m *mPulsarStorageMockGetPrevPulse) Set(f func(p insolar.PulseNumber) (r *insolar.Pulse, r1 error)) *PulsarStorageMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.GetPrevPulseFunc = f
+	return m.mock
+}
+
+//GetPrevPulse implements github.com/insolar/insolar/pulsar/storage.PulsarStorage interface
+func (m *PulsarStorageMock) GetPrevPulse(p insolar.PulseNumber) (r *insolar.Pulse, r1 error) {
+	counter := atomic.AddUint64(&m.GetPrevPulsePreCounter, 1)
+	defer atomic.AddUint64(&m.GetPrevPulseCounter, 1)
+
+	if len(m.GetPrevPulseMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.GetPrevPulseMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to PulsarStorageMock.GetPrevPulse. %v", p)
+			return
+		}
+
+		input := m.GetPrevPulseMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, PulsarStorageMockGetPrevPulseInput{p}, "PulsarStorage.GetPrevPulse got unexpected parameters")
+
+		result := m.GetPrevPulseMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the PulsarStorageMock.GetPrevPulse")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.GetPrevPulseMock.mainExpectation != nil {
+
+		input := m.GetPrevPulseMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, PulsarStorageMockGetPrevPulseInput{p}, "PulsarStorage.GetPrevPulse got unexpected parameters")
+		}
+
+		result := m.GetPrevPulseMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the PulsarStorageMock.GetPrevPulse")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.GetPrevPulseFunc == nil {
+		m.t.Fatalf("Unexpected call to PulsarStorageMock.GetPrevPulse. %v", p)
+		return
+	}
+
+	return m.GetPrevPulseFunc(p)
+}
+
+//GetPrevPulseMinimockCounter returns a count of PulsarStorageMock.GetPrevPulseFunc invocations
+func (m *PulsarStorageMock) GetPrevPulseMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.GetPrevPulseCounter)
+}
+
+//GetPrevPulseMinimockPreCounter returns the value of PulsarStorageMock.GetPrevPulse invocations
+func (m *PulsarStorageMock) GetPrevPulseMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.GetPrevPulsePreCounter)
+}
+
+//GetPrevPulseFinished returns true if mock invocations count is ok
+func (m *PulsarStorageMock) GetPrevPulseFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.GetPrevPulseMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.GetPrevPulseCounter) == uint64(len(m.GetPrevPulseMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.GetPrevPulseMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.GetPrevPulseCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.GetPrevPulseFunc != nil {
+		return atomic.LoadUint64(&m.GetPrevPulseCounter) > 0
+	}
+
+	return true
+}
+
+type mPulsarStorageMockGetPulse struct {
+	mock              *PulsarStorageMock
+	mainExpectation   *PulsarStorageMockGetPulseExpectation
+	expectationSeries []*PulsarStorageMockGetPulseExpectation
+}
+
+type PulsarStorageMockGetPulseExpectation struct {
+	input  *PulsarStorageMockGetPulseInput
+	result *PulsarStorageMockGetPulseResult
+}
+
+type PulsarStorageMockGetPulseInput struct {
+	p insolar.PulseNumber
+}
+
+type PulsarStorageMockGetPulseResult struct {
+	r  *insolar.Pulse
+	r1 error
+}
+
+//Expect specifies that invocation of PulsarStorage.GetPulse is expected from 1 to Infinity times
+func (m *mPulsarStorageMockGetPulse) Expect(p insolar.PulseNumber) *mPulsarStorageMockGetPulse {
+	m.mock.GetPulseFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &PulsarStorageMockGetPulseExpectation{}
+	}
+	m.mainExpectation.input = &PulsarStorageMockGetPulseInput{p}
+	return m
+}
+
+//Return specifies results of invocation of PulsarStorage.GetPulse
+func (m *mPulsarStorageMockGetPulse) Return(r *insolar.Pulse, r1 error) *PulsarStorageMock {
+	m.mock.GetPulseFunc = nil
+	m.expectationSeries = nil
 
-	SetLastPulseFunc       func(p *insolar.Pulse) (r error)
-	SetLastPulseCounter    uint64
-	SetLastPulsePreCounter uint64
-	SetLastPulseMock       mPulsarStorageMockSetLastPulse
+	if m.mainExpectation == nil {
+		m.mainExpectation = &PulsarStorageMockGetPulseExpectation{}
+	}
+	m.mainExpectation.result = &PulsarStorageMockGetPulseResult{r, r1}
+	return m.mock
 }
 
-//NewPulsarStorageMock returns a mock for github.com/insolar/insolar/pulsar/storage.PulsarStorage
-func NewPulsarStorageMock(t minimock.Tester) *PulsarStorageMock {
-	m := &PulsarStorageMock{t: t}
+//ExpectOnce specifies that invocation of PulsarStorage.GetPulse is expected once
+func (m *mPulsarStorageMockGetPulse) ExpectOnce(p insolar.PulseNumber) *PulsarStorageMockGetPulseExpectation {
+	m.mock.GetPulseFunc = nil
+	m.mainExpectation = nil
 
-	if controller, ok := t.(minimock.MockController); ok {
-		controller.RegisterMocker(m)
+	expectation := &PulsarStorageMockGetPulseExpectation{}
+	expectation.input = &PulsarStorageMockGetPulseInput{p}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *PulsarStorageMockGetPulseExpectation) Return(r *insolar.Pulse, r1 error) {
+	e.result = &PulsarStorageMockGetPulseResult{r, r1}
+}
+
+//Set uses given function f as a mock of PulsarStorage.GetPulse method
+func (m *mPulsarStorageMockGetPulse) Set(f func(p insolar.PulseNumber) (r *insolar.Pulse, r1 error)) *PulsarStorageMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.GetPulseFunc = f
+	return m.mock
+}
+
+//GetPulse implements github.com/insolar/insolar/pulsar/storage.PulsarStorage interface
+func (m *PulsarStorageMock) GetPulse(p insolar.PulseNumber) (r *insolar.Pulse, r1 error) {
+	counter := atomic.AddUint64(&m.GetPulsePreCounter, 1)
+	defer atomic.AddUint64(&m.GetPulseCounter, 1)
+
+	if len(m.GetPulseMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.GetPulseMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to PulsarStorageMock.GetPulse. %v", p)
+			return
+		}
+
+		input := m.GetPulseMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, PulsarStorageMockGetPulseInput{p}, "PulsarStorage.GetPulse got unexpected parameters")
+
+		result := m.GetPulseMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the PulsarStorageMock.GetPulse")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
 	}
 
-	m.CloseMock = mPulsarStorageMockClose{mock: m}
-	m.GetLastPulseMock = mPulsarStorageMockGetLastPulse{mock: m}
-	m.SavePulseMock = mPulsarStorageMockSavePulse{mock: m}
-	m.SetLastPulseMock = mPulsarStorageMockSetLastPulse{mock: m}
+	if m.GetPulseMock.mainExpectation != nil {
 
-	return m
+		input := m.GetPulseMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, PulsarStorageMockGetPulseInput{p}, "PulsarStorage.GetPulse got unexpected parameters")
+		}
+
+		result := m.GetPulseMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the PulsarStorageMock.GetPulse")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.GetPulseFunc == nil {
+		m.t.Fatalf("Unexpected call to PulsarStorageMock.GetPulse. %v", p)
+		return
+	}
+
+	return m.GetPulseFunc(p)
 }
 
-type mPulsarStorageMockClose struct {
+//GetPulseMinimockCounter returns a count of PulsarStorageMock.GetPulseFunc invocations
+func (m *PulsarStorageMock) GetPulseMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.GetPulseCounter)
+}
+
+//GetPulseMinimockPreCounter returns the value of PulsarStorageMock.GetPulse invocations
+func (m *PulsarStorageMock) GetPulseMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.GetPulsePreCounter)
+}
+
+//GetPulseFinished returns true if mock invocations count is ok
+func (m *PulsarStorageMock) GetPulseFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.GetPulseMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.GetPulseCounter) == uint64(len(m.GetPulseMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.GetPulseMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.GetPulseCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.GetPulseFunc != nil {
+		return atomic.LoadUint64(&m.GetPulseCounter) > 0
+	}
+
+	return true
+}
+
+type mPulsarStorageMockGetPulses struct {
 	mock              *PulsarStorageMock
-	mainExpectation   *PulsarStorageMockCloseExpectation
-	expectationSeries []*PulsarStorageMockCloseExpectation
+	mainExpectation   *PulsarStorageMockGetPulsesExpectation
+	expectationSeries []*PulsarStorageMockGetPulsesExpectation
 }
 
-type PulsarStorageMockCloseExpectation struct {
-	result *PulsarStorageMockCloseResult
+type PulsarStorageMockGetPulsesExpectation struct {
+	input  *PulsarStorageMockGetPulsesInput
+	result *PulsarStorageMockGetPulsesResult
 }
 
-type PulsarStorageMockCloseResult struct {
-	r error
+type PulsarStorageMockGetPulsesInput struct {
+	p  insolar.PulseNumber
+	p1 insolar.PulseNumber
+	p2 int
 }
 
-//Expect specifies that invocation of PulsarStorage.Close is expected from 1 to Infinity times
-func (m *mPulsarStorageMockClose) Expect() *mPulsarStorageMockClose {
-	m.mock.CloseFunc = nil
+type PulsarStorageMockGetPulsesResult struct {
+	r  []insolar.Pulse
+	r1 error
+}
+
+//Expect specifies that invocation of PulsarStorage.GetPulses is expected from 1 to Infinity times
+func (m *mPulsarStorageMockGetPulses) Expect(p insolar.PulseNumber, p1 insolar.PulseNumber, p2 int) *mPulsarStorageMockGetPulses {
+	m.mock.GetPulsesFunc = nil
 	m.expectationSeries = nil
 
 	if m.mainExpectation == nil {
-		m.mainExpectation = &PulsarStorageMockCloseExpectation{}
+		m.mainExpectation = &PulsarStorageMockGetPulsesExpectation{}
 	}
-
+	m.mainExpectation.input = &PulsarStorageMockGetPulsesInput{p, p1, p2}
 	return m
 }
 
-//Return specifies results of invocation of PulsarStorage.Close
-func (m *mPulsarStorageMockClose) Return(r error) *PulsarStorageMock {
-	m.mock.CloseFunc = nil
+//Return specifies results of invocation of PulsarStorage.GetPulses
+func (m *mPulsarStorageMockGetPulses) Return(r []insolar.Pulse, r1 error) *PulsarStorageMock {
+	m.mock.GetPulsesFunc = nil
 	m.expectationSeries = nil
 
 	if m.mainExpectation == nil {
-		m.mainExpectation = &PulsarStorageMockCloseExpectation{}
+		m.mainExpectation = &PulsarStorageMockGetPulsesExpectation{}
 	}
-	m.mainExpectation.result = &PulsarStorageMockCloseResult{r}
+	m.mainExpectation.result = &PulsarStorageMockGetPulsesResult{r, r1}
 	return m.mock
 }
 
-//ExpectOnce specifies that invocation of PulsarStorage.Close is expected once
-func (m *mPulsarStorageMockClose) ExpectOnce() *PulsarStorageMockCloseExpectation {
-	m.mock.CloseFunc = nil
+//ExpectOnce specifies that invocation of PulsarStorage.GetPulses is expected once
+func (m *mPulsarStorageMockGetPulses) ExpectOnce(p insolar.PulseNumber, p1 insolar.PulseNumber, p2 int) *PulsarStorageMockGetPulsesExpectation {
+	m.mock.GetPulsesFunc = nil
 	m.mainExpectation = nil
 
-	expectation := &PulsarStorageMockCloseExpectation{}
-
+	expectation := &PulsarStorageMockGetPulsesExpectation{}
+	expectation.input = &PulsarStorageMockGetPulsesInput{p, p1, p2}
 	m.expectationSeries = append(m.expectationSeries, expectation)
 	return expectation
 }
 
-func (e *PulsarStorageMockCloseExpectation) Return(r error) {
-	e.result = &PulsarStorageMockCloseResult{r}
+func (e *PulsarStorageMockGetPulsesExpectation) Return(r []insolar.Pulse, r1 error) {
+	e.result = &PulsarStorageMockGetPulsesResult{r, r1}
 }
 
-//Set uses given function f as a mock of PulsarStorage.Close method
-func (m *mPulsarStorageMockClose) Set(f func() (r error)) *PulsarStorageMock {
+//Set uses given function f as a mock of PulsarStorage.GetPulses method
+func (m *mPulsarStorageMockGetPulses) Set(f func(p insolar.PulseNumber, p1 insolar.PulseNumber, p2 int) (r []insolar.Pulse, r1 error)) *PulsarStorageMock {
 	m.mainExpectation = nil
 	m.expectationSeries = nil
 
-	m.mock.CloseFunc = f
+	m.mock.GetPulsesFunc = f
 	return m.mock
 }
 
-//Close implements github.com/insolar/insolar/pulsar/storage.PulsarStorage interface
-func (m *PulsarStorageMock) Close() (r error) {
-	counter := atomic.AddUint64(&m.ClosePreCounter, 1)
-	defer atomic.AddUint64(&m.CloseCounter, 1)
+//GetPulses implements github.com/insolar/insolar/pulsar/storage.PulsarStorage interface
+func (m *PulsarStorageMock) GetPulses(p insolar.PulseNumber, p1 insolar.PulseNumber, p2 int) (r []insolar.Pulse, r1 error) {
+	counter := atomic.AddUint64(&m.GetPulsesPreCounter, 1)
+	defer atomic.AddUint64(&m.GetPulsesCounter, 1)
 
-	if len(m.CloseMock.expectationSeries) > 0 {
-		if counter > uint64(len(m.CloseMock.expectationSeries)) {
-			m.t.Fatalf("Unexpected call to PulsarStorageMock.Close.")
+	if len(m.GetPulsesMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.GetPulsesMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to PulsarStorageMock.GetPulses. %v %v %v", p, p1, p2)
 			return
 		}
 
-		result := m.CloseMock.expectationSeries[counter-1].result
+		input := m.GetPulsesMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, PulsarStorageMockGetPulsesInput{p, p1, p2}, "PulsarStorage.GetPulses got unexpected parameters")
+
+		result := m.GetPulsesMock.expectationSeries[counter-1].result
 		if result == nil {
-			m.t.Fatal("No results are set for the PulsarStorageMock.Close")
+			m.t.Fatal("No results are set for the PulsarStorageMock.GetPulses")
 			return
 		}
 
 		r = result.r
+		r1 = result.r1
 
 		return
 	}
 
-	if m.CloseMock.mainExpectation != nil {
+	if m.GetPulsesMock.mainExpectation != nil {
 
-		result := m.CloseMock.mainExpectation.result
+		input := m.GetPulsesMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, PulsarStorageMockGetPulsesInput{p, p1, p2}, "PulsarStorage.GetPulses got unexpected parameters")
+		}
+
+		result := m.GetPulsesMock.mainExpectation.result
 		if result == nil {
-			m.t.Fatal("No results are set for the PulsarStorageMock.Close")
+			m.t.Fatal("No results are set for the PulsarStorageMock.GetPulses")
 		}
 
 		r = result.r
+		r1 = result.r1
 
 		return
 	}
 
-	if m.CloseFunc == nil {
-		m.t.Fatalf("Unexpected call to PulsarStorageMock.Close.")
+	if m.GetPulsesFunc == nil {
+		m.t.Fatalf("Unexpected call to PulsarStorageMock.GetPulses. %v %v %v", p, p1, p2)
 		return
 	}
 
-	return m.CloseFunc()
+	return m.GetPulsesFunc(p, p1, p2)
 }
 
-//CloseMinimockCounter returns a count of PulsarStorageMock.CloseFunc invocations
-func (m *PulsarStorageMock) CloseMinimockCounter() uint64 {
-	return atomic.LoadUint64(&m.CloseCounter)
+//GetPulsesMinimockCounter returns a count of PulsarStorageMock.GetPulsesFunc invocations
+func (m *PulsarStorageMock) GetPulsesMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.GetPulsesCounter)
 }
 
-//CloseMinimockPreCounter returns the value of PulsarStorageMock.Close invocations
-func (m *PulsarStorageMock) CloseMinimockPreCounter() uint64 {
-	return atomic.LoadUint64(&m.ClosePreCounter)
+//GetPulsesMinimockPreCounter returns the value of PulsarStorageMock.GetPulses invocations
+func (m *PulsarStorageMock) GetPulsesMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.GetPulsesPreCounter)
 }
 
-//CloseFinished returns true if mock invocations count is ok
-func (m *PulsarStorageMock) CloseFinished() bool {
+//GetPulsesFinished returns true if mock invocations count is ok
+func (m *PulsarStorageMock) GetPulsesFinished() bool {
 	// if expectation series were set then invocations count should be equal to expectations count
-	if len(m.CloseMock.expectationSeries) > 0 {
-		return atomic.LoadUint64(&m.CloseCounter) == uint64(len(m.CloseMock.expectationSeries))
+	if len(m.GetPulsesMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.GetPulsesCounter) == uint64(len(m.GetPulsesMock.expectationSeries))
 	}
 
 	// if main expectation was set then invocations count should be greater than zero
-	if m.CloseMock.mainExpectation != nil {
-		return atomic.LoadUint64(&m.CloseCounter) > 0
+	if m.GetPulsesMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.GetPulsesCounter) > 0
 	}
 
 	// if func was set then invocations count should be greater than zero
-	if m.CloseFunc != nil {
-		return atomic.LoadUint64(&m.CloseCounter) > 0
+	if m.GetPulsesFunc != nil {
+		return atomic.LoadUint64(&m.GetPulsesCounter) > 0
 	}
 
 	return true
 }
 
-type mPulsarStorageMockGetLastPulse struct {
+type mPulsarStorageMockSaveEntropyProvenance struct {
 	mock              *PulsarStorageMock
-	mainExpectation   *PulsarStorageMockGetLastPulseExpectation
-	expectationSeries []*PulsarStorageMockGetLastPulseExpectation
+	mainExpectation   *PulsarStorageMockSaveEntropyProvenanceExpectation
+	expectationSeries []*PulsarStorageMockSaveEntropyProvenanceExpectation
 }
 
-type PulsarStorageMockGetLastPulseExpectation struct {
-	result *PulsarStorageMockGetLastPulseResult
+type PulsarStorageMockSaveEntropyProvenanceExpectation struct {
+	input  *PulsarStorageMockSaveEntropyProvenanceInput
+	result *PulsarStorageMockSaveEntropyProvenanceResult
 }
 
-type PulsarStorageMockGetLastPulseResult struct {
-	r  *insolar.Pulse
-	r1 error
+type PulsarStorageMockSaveEntropyProvenanceInput struct {
+	p  insolar.PulseNumber
+	p1 entropygenerator.Provenance
 }
 
-//Expect specifies that invocation of PulsarStorage.GetLastPulse is expected from 1 to Infinity times
-func (m *mPulsarStorageMockGetLastPulse) Expect() *mPulsarStorageMockGetLastPulse {
-	m.mock.GetLastPulseFunc = nil
+type PulsarStorageMockSaveEntropyProvenanceResult struct {
+	r error
+}
+
+//Expect specifies that invocation of PulsarStorage.SaveEntropyProvenance is expected from 1 to Infinity times
+func (m *mPulsarStorageMockSaveEntropyProvenance) Expect(p insolar.PulseNumber, p1 entropygenerator.Provenance) *mPulsarStorageMockSaveEntropyProvenance {
+	m.mock.SaveEntropyProvenanceFunc = nil
 	m.expectationSeries = nil
 
 	if m.mainExpectation == nil {
-		m.mainExpectation = &PulsarStorageMockGetLastPulseExpectation{}
+		m.mainExpectation = &PulsarStorageMockSaveEntropyProvenanceExpectation{}
 	}
-
+	m.mainExpectation.input = &PulsarStorageMockSaveEntropyProvenanceInput{p, p1}
 	return m
 }
 
-//Return specifies results of invocation of PulsarStorage.GetLastPulse
-func (m *mPulsarStorageMockGetLastPulse) Return(r *insolar.Pulse, r1 error) *PulsarStorageMock {
-	m.mock.GetLastPulseFunc = nil
+//Return specifies results of invocation of PulsarStorage.SaveEntropyProvenance
+func (m *mPulsarStorageMockSaveEntropyProvenance) Return(r error) *PulsarStorageMock {
+	m.mock.SaveEntropyProvenanceFunc = nil
 	m.expectationSeries = nil
 
 	if m.mainExpectation == nil {
-		m.mainExpectation = &PulsarStorageMockGetLastPulseExpectation{}
+		m.mainExpectation = &PulsarStorageMockSaveEntropyProvenanceExpectation{}
 	}
-	m.mainExpectation.result = &PulsarStorageMockGetLastPulseResult{r, r1}
+	m.mainExpectation.result = &PulsarStorageMockSaveEntropyProvenanceResult{r}
 	return m.mock
 }
 
-//ExpectOnce specifies that invocation of PulsarStorage.GetLastPulse is expected once
-func (m *mPulsarStorageMockGetLastPulse) ExpectOnce() *PulsarStorageMockGetLastPulseExpectation {
-	m.mock.GetLastPulseFunc = nil
+//ExpectOnce specifies that invocation of PulsarStorage.SaveEntropyProvenance is expected once
+func (m *mPulsarStorageMockSaveEntropyProvenance) ExpectOnce(p insolar.PulseNumber, p1 entropygenerator.Provenance) *PulsarStorageMockSaveEntropyProvenanceExpectation {
+	m.mock.SaveEntropyProvenanceFunc = nil
 	m.mainExpectation = nil
 
-	expectation := &PulsarStorageMockGetLastPulseExpectation{}
-
+	expectation := &PulsarStorageMockSaveEntropyProvenanceExpectation{}
+	expectation.input = &PulsarStorageMockSaveEntropyProvenanceInput{p, p1}
 	m.expectationSeries = append(m.expectationSeries, expectation)
 	return expectation
 }
 
-func (e *PulsarStorageMockGetLastPulseExpectation) Return(r *insolar.Pulse, r1 error) {
-	e.result = &PulsarStorageMockGetLastPulseResult{r, r1}
+func (e *PulsarStorageMockSaveEntropyProvenanceExpectation) Return(r error) {
+	e.result = &PulsarStorageMockSaveEntropyProvenanceResult{r}
 }
 
-//Set uses given function f as a mock of PulsarStorage.GetLastPulse method
-func (m *mPulsarStorageMockGetLastPulse) Set(f func() (r *insolar.Pulse, r1 error)) *PulsarStorageMock {
+//Set uses given function f as a mock of PulsarStorage.SaveEntropyProvenance method
+func (m *mPulsarStorageMockSaveEntropyProvenance) Set(f func(p insolar.PulseNumber, p1 entropygenerator.Provenance) (r error)) *PulsarStorageMock {
 	m.mainExpectation = nil
 	m.expectationSeries = nil
 
-	m.mock.GetLastPulseFunc = f
+	m.mock.SaveEntropyProvenanceFunc = f
 	return m.mock
 }
 
-//GetLastPulse implements github.com/insolar/insolar/pulsar/storage.PulsarStorage interface
-func (m *PulsarStorageMock) GetLastPulse() (r *insolar.Pulse, r1 error) {
-	counter := atomic.AddUint64(&m.GetLastPulsePreCounter, 1)
-	defer atomic.AddUint64(&m.GetLastPulseCounter, 1)
+//SaveEntropyProvenance implements github.com/insolar/insolar/pulsar/storage.PulsarStorage interface
+func (m *PulsarStorageMock) SaveEntropyProvenance(p insolar.PulseNumber, p1 entropygenerator.Provenance) (r error) {
+	counter := atomic.AddUint64(&m.SaveEntropyProvenancePreCounter, 1)
+	defer atomic.AddUint64(&m.SaveEntropyProvenanceCounter, 1)
 
-	if len(m.GetLastPulseMock.expectationSeries) > 0 {
-		if counter > uint64(len(m.GetLastPulseMock.expectationSeries)) {
-			m.t.Fatalf("Unexpected call to PulsarStorageMock.GetLastPulse.")
+	if len(m.SaveEntropyProvenanceMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.SaveEntropyProvenanceMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to PulsarStorageMock.SaveEntropyProvenance. %v %v", p, p1)
 			return
 		}
 
-		result := m.GetLastPulseMock.expectationSeries[counter-1].result
+		input := m.SaveEntropyProvenanceMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, PulsarStorageMockSaveEntropyProvenanceInput{p, p1}, "PulsarStorage.SaveEntropyProvenance got unexpected parameters")
+
+		result := m.SaveEntropyProvenanceMock.expectationSeries[counter-1].result
 		if result == nil {
-			m.t.Fatal("No results are set for the PulsarStorageMock.GetLastPulse")
+			m.t.Fatal("No results are set for the PulsarStorageMock.SaveEntropyProvenance")
 			return
 		}
 
 		r = result.r
-		r1 = result.r1
 
 		return
 	}
 
-	if m.GetLastPulseMock.mainExpectation != nil {
+	if m.SaveEntropyProvenanceMock.mainExpectation != nil {
 
-		result := m.GetLastPulseMock.mainExpectation.result
+		input := m.SaveEntropyProvenanceMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, PulsarStorageMockSaveEntropyProvenanceInput{p, p1}, "PulsarStorage.SaveEntropyProvenance got unexpected parameters")
+		}
+
+		result := m.SaveEntropyProvenanceMock.mainExpectation.result
 		if result == nil {
-			m.t.Fatal("No results are set for the PulsarStorageMock.GetLastPulse")
+			m.t.Fatal("No results are set for the PulsarStorageMock.SaveEntropyProvenance")
 		}
 
 		r = result.r
-		r1 = result.r1
 
 		return
 	}
 
-	if m.GetLastPulseFunc == nil {
-		m.t.Fatalf("Unexpected call to PulsarStorageMock.GetLastPulse.")
+	if m.SaveEntropyProvenanceFunc == nil {
+		m.t.Fatalf("Unexpected call to PulsarStorageMock.SaveEntropyProvenance. %v %v", p, p1)
 		return
 	}
 
-	return m.GetLastPulseFunc()
+	return m.SaveEntropyProvenanceFunc(p, p1)
 }
 
-//GetLastPulseMinimockCounter returns a count of PulsarStorageMock.GetLastPulseFunc invocations
-func (m *PulsarStorageMock) GetLastPulseMinimockCounter() uint64 {
-	return atomic.LoadUint64(&m.GetLastPulseCounter)
+//SaveEntropyProvenanceMinimockCounter returns a count of PulsarStorageMock.SaveEntropyProvenanceFunc invocations
+func (m *PulsarStorageMock) SaveEntropyProvenanceMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.SaveEntropyProvenanceCounter)
 }
 
-//GetLastPulseMinimockPreCounter returns the value of PulsarStorageMock.GetLastPulse invocations
-func (m *PulsarStorageMock) GetLastPulseMinimockPreCounter() uint64 {
-	return atomic.LoadUint64(&m.GetLastPulsePreCounter)
+//SaveEntropyProvenanceMinimockPreCounter returns the value of PulsarStorageMock.SaveEntropyProvenance invocations
+func (m *PulsarStorageMock) SaveEntropyProvenanceMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.SaveEntropyProvenancePreCounter)
 }
 
-//GetLastPulseFinished returns true if mock invocations count is ok
-func (m *PulsarStorageMock) GetLastPulseFinished() bool {
+//SaveEntropyProvenanceFinished returns true if mock invocations count is ok
+func (m *PulsarStorageMock) SaveEntropyProvenanceFinished() bool {
 	// if expectation series were set then invocations count should be equal to expectations count
-	if len(m.GetLastPulseMock.expectationSeries) > 0 {
-		return atomic.LoadUint64(&m.GetLastPulseCounter) == uint64(len(m.GetLastPulseMock.expectationSeries))
+	if len(m.SaveEntropyProvenanceMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.SaveEntropyProvenanceCounter) == uint64(len(m.SaveEntropyProvenanceMock.expectationSeries))
 	}
 
 	// if main expectation was set then invocations count should be greater than zero
-	if m.GetLastPulseMock.mainExpectation != nil {
-		return atomic.LoadUint64(&m.GetLastPulseCounter) > 0
+	if m.SaveEntropyProvenanceMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.SaveEntropyProvenanceCounter) > 0
 	}
 
 	// if func was set then invocations count should be greater than zero
-	if m.GetLastPulseFunc != nil {
-		return atomic.LoadUint64(&m.GetLastPulseCounter) > 0
+	if m.SaveEntropyProvenanceFunc != nil {
+		return atomic.LoadUint64(&m.SaveEntropyProvenanceCounter) > 0
 	}
 
 	return true
@@ -629,10 +1566,32 @@ func (m *PulsarStorageMock) ValidateCallCounters() {
 		m.t.Fatal("Expected call to PulsarStorageMock.Close")
 	}
 
+	if !m.GetEntropyProvenanceFinished() {
+		m.t.Fatal("Expected call to PulsarStorageMock.GetEntropyProvenance")
+	}
 	if !m.GetLastPulseFinished() {
 		m.t.Fatal("Expected call to PulsarStorageMock.GetLastPulse")
 	}
 
+	if !m.GetNextPulseFinished() {
+		m.t.Fatal("Expected call to PulsarStorageMock.GetNextPulse")
+	}
+
+	if !m.GetPrevPulseFinished() {
+		m.t.Fatal("Expected call to PulsarStorageMock.GetPrevPulse")
+	}
+
+	if !m.GetPulseFinished() {
+		m.t.Fatal("Expected call to PulsarStorageMock.GetPulse")
+	}
+
+	if !m.GetPulsesFinished() {
+		m.t.Fatal("Expected call to PulsarStorageMock.GetPulses")
+	}
+
+	if !m.SaveEntropyProvenanceFinished() {
+		m.t.Fatal("Expected call to PulsarStorageMock.SaveEntropyProvenance")
+	}
 	if !m.SavePulseFinished() {
 		m.t.Fatal("Expected call to PulsarStorageMock.SavePulse")
 	}
@@ -662,10 +1621,32 @@ func (m *PulsarStorageMock) MinimockFinish() {
 		m.t.Fatal("Expected call to PulsarStorageMock.Close")
 	}
 
+	if !m.GetEntropyProvenanceFinished() {
+		m.t.Fatal("Expected call to PulsarStorageMock.GetEntropyProvenance")
+	}
 	if !m.GetLastPulseFinished() {
 		m.t.Fatal("Expected call to PulsarStorageMock.GetLastPulse")
 	}
 
+	if !m.GetNextPulseFinished() {
+		m.t.Fatal("Expected call to PulsarStorageMock.GetNextPulse")
+	}
+
+	if !m.GetPrevPulseFinished() {
+		m.t.Fatal("Expected call to PulsarStorageMock.GetPrevPulse")
+	}
+
+	if !m.GetPulseFinished() {
+		m.t.Fatal("Expected call to PulsarStorageMock.GetPulse")
+	}
+
+	if !m.GetPulsesFinished() {
+		m.t.Fatal("Expected call to PulsarStorageMock.GetPulses")
+	}
+
+	if !m.SaveEntropyProvenanceFinished() {
+		m.t.Fatal("Expected call to PulsarStorageMock.SaveEntropyProvenance")
+	}
 	if !m.SavePulseFinished() {
 		m.t.Fatal("Expected call to PulsarStorageMock.SavePulse")
 	}
@@ -689,7 +1670,13 @@ func (m *PulsarStorageMock) MinimockWait(timeout time.Duration) {
 	for {
 		ok := true
 		ok = ok && m.CloseFinished()
+		ok = ok && m.GetEntropyProvenanceFinished()
 		ok = ok && m.GetLastPulseFinished()
+		ok = ok && m.GetNextPulseFinished()
+		ok = ok && m.GetPrevPulseFinished()
+		ok = ok && m.GetPulseFinished()
+		ok = ok && m.GetPulsesFinished()
+		ok = ok && m.SaveEntropyProvenanceFinished()
 		ok = ok && m.SavePulseFinished()
 		ok = ok && m.SetLastPulseFinished()
 
@@ -704,10 +1691,32 @@ func (m *PulsarStorageMock) MinimockWait(timeout time.Duration) {
 				m.t.Error("Expected call to PulsarStorageMock.Close")
 			}
 
+			if !m.GetEntropyProvenanceFinished() {
+				m.t.Error("Expected call to PulsarStorageMock.GetEntropyProvenance")
+			}
 			if !m.GetLastPulseFinished() {
 				m.t.Error("Expected call to PulsarStorageMock.GetLastPulse")
 			}
 
+			if !m.GetNextPulseFinished() {
+				m.t.Error("Expected call to PulsarStorageMock.GetNextPulse")
+			}
+
+			if !m.GetPrevPulseFinished() {
+				m.t.Error("Expected call to PulsarStorageMock.GetPrevPulse")
+			}
+
+			if !m.GetPulseFinished() {
+				m.t.Error("Expected call to PulsarStorageMock.GetPulse")
+			}
+
+			if !m.GetPulsesFinished() {
+				m.t.Error("Expected call to PulsarStorageMock.GetPulses")
+			}
+
+			if !m.SaveEntropyProvenanceFinished() {
+				m.t.Error("Expected call to PulsarStorageMock.SaveEntropyProvenance")
+			}
 			if !m.SavePulseFinished() {
 				m.t.Error("Expected call to PulsarStorageMock.SavePulse")
 			}
@@ -732,10 +1741,32 @@ func (m *PulsarStorageMock) AllMocksCalled() bool {
 		return false
 	}
 
+	if !m.GetEntropyProvenanceFinished() {
+		return false
+	}
 	if !m.GetLastPulseFinished() {
 		return false
 	}
 
+	if !m.GetNextPulseFinished() {
+		return false
+	}
+
+	if !m.GetPrevPulseFinished() {
+		return false
+	}
+
+	if !m.GetPulseFinished() {
+		return false
+	}
+
+	if !m.GetPulsesFinished() {
+		return false
+	}
+
+	if !m.SaveEntropyProvenanceFinished() {
+		return false
+	}
 	if !m.SavePulseFinished() {
 		return false
 	}
@@ -0,0 +1,60 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package pulsar
+
+import (
+	"net/http"
+
+	"github.com/insolar/insolar/insolar"
+)
+
+// StatusArgs is arguments that Status service accepts.
+type StatusArgs struct{}
+
+// StatusReply is a current status of pulsar's state machine.
+type StatusReply struct {
+	State                 string              `json:"state"`
+	ProcessingPulseNumber insolar.PulseNumber `json:"processingPulseNumber"`
+	LastPulseNumber       insolar.PulseNumber `json:"lastPulseNumber"`
+}
+
+// StatusService is a JSON-RPC service which reports state of the pulsar, it's used by test harnesses.
+type StatusService struct {
+	pulsar *Pulsar
+}
+
+// NewStatusService creates new Status service instance.
+func NewStatusService(pulsar *Pulsar) *StatusService {
+	return &StatusService{pulsar: pulsar}
+}
+
+// Get returns current state of the pulsar.
+//
+//   Request structure:
+//   {
+//     "jsonrpc": "2.0",
+//     "method": "status.get",
+//     "id": str|int|null
+//   }
+func (s *StatusService) Get(r *http.Request, args *StatusArgs, reply *StatusReply) error {
+	reply.State = s.pulsar.StateSwitcher.GetState().String()
+	reply.ProcessingPulseNumber = s.pulsar.GetProcessingPulseNumber()
+	if lastPulse := s.pulsar.GetLastPulse(); lastPulse != nil {
+		reply.LastPulseNumber = lastPulse.PulseNumber
+	}
+	return nil
+}
@@ -17,14 +17,35 @@
 package pulsarstorage
 
 import (
+	"github.com/pkg/errors"
+
 	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/pulsar/entropygenerator"
 )
 
+// ErrNotFound is returned when pulse is not saved in storage.
+var ErrNotFound = errors.New("pulse not found")
+
 //go:generate minimock -i github.com/insolar/insolar/pulsar/storage.PulsarStorage -o ../pulsartestutils -s _mock.go
 
 type PulsarStorage interface {
 	GetLastPulse() (*insolar.Pulse, error)
 	SetLastPulse(pulse *insolar.Pulse) error
 	SavePulse(pulse *insolar.Pulse) error
+
+	// GetPulse returns saved pulse by number or ErrNotFound.
+	GetPulse(pn insolar.PulseNumber) (*insolar.Pulse, error)
+	// GetPulses returns up to limit saved pulses with numbers in range [from, to] in ascending order.
+	GetPulses(from, to insolar.PulseNumber, limit int) ([]insolar.Pulse, error)
+	// GetPrevPulse returns saved pulse preceding provided pulse number or ErrNotFound.
+	GetPrevPulse(pn insolar.PulseNumber) (*insolar.Pulse, error)
+	// GetNextPulse returns saved pulse following provided pulse number or ErrNotFound.
+	GetNextPulse(pn insolar.PulseNumber) (*insolar.Pulse, error)
+
+	// SaveEntropyProvenance saves provenance of entropy generated by this pulsar for the pulse.
+	SaveEntropyProvenance(pn insolar.PulseNumber, provenance entropygenerator.Provenance) error
+	// GetEntropyProvenance returns saved provenance of entropy generated for the pulse or ErrNotFound.
+	GetEntropyProvenance(pn insolar.PulseNumber) (*entropygenerator.Provenance, error)
+
 	Close() error
 }
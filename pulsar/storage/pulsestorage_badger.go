@@ -19,19 +19,22 @@ package pulsarstorage
 import (
 	"bytes"
 	"encoding/gob"
+	"math"
 	"path/filepath"
 
 	"github.com/dgraph-io/badger"
 	"github.com/insolar/insolar/configuration"
 	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/pulsar/entropygenerator"
 	"github.com/pkg/errors"
 )
 
 type RecordID string
 
 const (
-	LastPulseRecordID RecordID = "lastPulse"
-	PulseRecordID     RecordID = "pulse"
+	LastPulseRecordID         RecordID = "lastPulse"
+	PulseRecordID             RecordID = "pulse"
+	EntropyProvenanceRecordID RecordID = "entropyProvenance"
 )
 
 // NewDB returns pulsar.storage.db with BadgerDB instance initialized by opts.
@@ -125,9 +128,7 @@ func (storage *BadgerStorageImpl) SavePulse(pulse *insolar.Pulse) error {
 	if err != nil {
 		return err
 	}
-	pulseNumber := pulse.PulseNumber.Bytes()
-	key := []byte(PulseRecordID)
-	key = append(key, pulseNumber...)
+	key := pulseKey(pulse.PulseNumber)
 
 	return storage.db.Update(func(txn *badger.Txn) error {
 		err := txn.Set(key, buffer.Bytes())
@@ -135,6 +136,147 @@ func (storage *BadgerStorageImpl) SavePulse(pulse *insolar.Pulse) error {
 	})
 }
 
+func (storage *BadgerStorageImpl) GetPulse(pn insolar.PulseNumber) (*insolar.Pulse, error) {
+	var pulse *insolar.Pulse
+
+	err := storage.db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get(pulseKey(pn))
+		if err == badger.ErrKeyNotFound {
+			return ErrNotFound
+		}
+		if err != nil {
+			return err
+		}
+		pulse, err = decodePulse(item)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pulse, nil
+}
+
+func (storage *BadgerStorageImpl) GetPulses(from, to insolar.PulseNumber, limit int) ([]insolar.Pulse, error) {
+	var pulses []insolar.Pulse
+
+	err := storage.iterate(from, false, func(pulse *insolar.Pulse) bool {
+		if pulse.PulseNumber > to || (limit > 0 && len(pulses) >= limit) {
+			return false
+		}
+		pulses = append(pulses, *pulse)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pulses, nil
+}
+
+func (storage *BadgerStorageImpl) GetPrevPulse(pn insolar.PulseNumber) (*insolar.Pulse, error) {
+	if pn == 0 {
+		return nil, ErrNotFound
+	}
+	return storage.first(pn-1, true)
+}
+
+func (storage *BadgerStorageImpl) GetNextPulse(pn insolar.PulseNumber) (*insolar.Pulse, error) {
+	if pn == insolar.PulseNumber(math.MaxUint32) {
+		return nil, ErrNotFound
+	}
+	return storage.first(pn+1, false)
+}
+
+func (storage *BadgerStorageImpl) SaveEntropyProvenance(pn insolar.PulseNumber, provenance entropygenerator.Provenance) error {
+	var buffer bytes.Buffer
+	err := gob.NewEncoder(&buffer).Encode(provenance)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode entropy provenance")
+	}
+
+	return storage.db.Update(func(txn *badger.Txn) error {
+		return txn.Set(provenanceKey(pn), buffer.Bytes())
+	})
+}
+
+func (storage *BadgerStorageImpl) GetEntropyProvenance(pn insolar.PulseNumber) (*entropygenerator.Provenance, error) {
+	var provenance entropygenerator.Provenance
+
+	err := storage.db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get(provenanceKey(pn))
+		if err == badger.ErrKeyNotFound {
+			return ErrNotFound
+		}
+		if err != nil {
+			return err
+		}
+		return item.Value(func(val []byte) error {
+			return gob.NewDecoder(bytes.NewReader(val)).Decode(&provenance)
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &provenance, nil
+}
+
+// first returns the first pulse met by iteration from pivot.
+func (storage *BadgerStorageImpl) first(pivot insolar.PulseNumber, reverse bool) (*insolar.Pulse, error) {
+	var found *insolar.Pulse
+	err := storage.iterate(pivot, reverse, func(pulse *insolar.Pulse) bool {
+		found = pulse
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+	if found == nil {
+		return nil, ErrNotFound
+	}
+	return found, nil
+}
+
+// iterate calls handler for saved pulses starting from pivot (or closest to it) until handler returns false.
+func (storage *BadgerStorageImpl) iterate(pivot insolar.PulseNumber, reverse bool, handler func(*insolar.Pulse) bool) error {
+	prefix := []byte(PulseRecordID)
+
+	return storage.db.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.Reverse = reverse
+		it := txn.NewIterator(opts)
+		defer it.Close()
+
+		for it.Seek(pulseKey(pivot)); it.ValidForPrefix(prefix); it.Next() {
+			pulse, err := decodePulse(it.Item())
+			if err != nil {
+				return err
+			}
+			if !handler(pulse) {
+				return nil
+			}
+		}
+		return nil
+	})
+}
+
+func pulseKey(pn insolar.PulseNumber) []byte {
+	return append([]byte(PulseRecordID), pn.Bytes()...)
+}
+
+func provenanceKey(pn insolar.PulseNumber) []byte {
+	return append([]byte(EntropyProvenanceRecordID), pn.Bytes()...)
+}
+
+func decodePulse(item *badger.Item) (*insolar.Pulse, error) {
+	var pulse insolar.Pulse
+	err := item.Value(func(val []byte) error {
+		return gob.NewDecoder(bytes.NewReader(val)).Decode(&pulse)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode pulse")
+	}
+	return &pulse, nil
+}
+
 func (storage *BadgerStorageImpl) Close() error {
 	return storage.db.Close()
 }
@@ -0,0 +1,119 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package pulsarstorage
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/pulsar/entropygenerator"
+)
+
+func TestBadgerStorage_PulseHistory(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pulsar-storage-")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	storage, err := NewStorageBadger(configuration.Pulsar{Storage: configuration.Storage{DataDirectory: dir}}, nil)
+	require.NoError(t, err)
+	defer storage.Close()
+
+	first := insolar.PulseNumber(insolar.FirstPulseNumber)
+	for _, pn := range []insolar.PulseNumber{first + 10, first + 20, first + 30} {
+		err := storage.SavePulse(&insolar.Pulse{PulseNumber: pn, Entropy: insolar.Entropy{byte(pn)}})
+		require.NoError(t, err)
+	}
+
+	t.Run("get", func(t *testing.T) {
+		pulse, err := storage.GetPulse(first + 20)
+		require.NoError(t, err)
+		assert.Equal(t, first+20, pulse.PulseNumber)
+		assert.Equal(t, byte(first+20), pulse.Entropy[0])
+
+		_, err = storage.GetPulse(first + 21)
+		assert.Equal(t, ErrNotFound, err)
+	})
+
+	t.Run("range", func(t *testing.T) {
+		pulses, err := storage.GetPulses(first+1, first+30, 0)
+		require.NoError(t, err)
+		require.Len(t, pulses, 3)
+		assert.Equal(t, first+10, pulses[0].PulseNumber)
+		assert.Equal(t, first+30, pulses[2].PulseNumber)
+
+		pulses, err = storage.GetPulses(first, first+30, 2)
+		require.NoError(t, err)
+		require.Len(t, pulses, 2)
+		assert.Equal(t, first, pulses[0].PulseNumber)
+		assert.Equal(t, first+10, pulses[1].PulseNumber)
+
+		pulses, err = storage.GetPulses(first+31, first+100, 0)
+		require.NoError(t, err)
+		assert.Empty(t, pulses)
+	})
+
+	t.Run("prev and next", func(t *testing.T) {
+		pulse, err := storage.GetPrevPulse(first + 20)
+		require.NoError(t, err)
+		assert.Equal(t, first+10, pulse.PulseNumber)
+
+		pulse, err = storage.GetPrevPulse(first + 25)
+		require.NoError(t, err)
+		assert.Equal(t, first+20, pulse.PulseNumber)
+
+		_, err = storage.GetPrevPulse(first)
+		assert.Equal(t, ErrNotFound, err)
+
+		pulse, err = storage.GetNextPulse(first + 20)
+		require.NoError(t, err)
+		assert.Equal(t, first+30, pulse.PulseNumber)
+
+		_, err = storage.GetNextPulse(first + 30)
+		assert.Equal(t, ErrNotFound, err)
+	})
+}
+
+func TestBadgerStorage_EntropyProvenance(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pulsar-storage-")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	storage, err := NewStorageBadger(configuration.Pulsar{Storage: configuration.Storage{DataDirectory: dir}}, nil)
+	require.NoError(t, err)
+	defer storage.Close()
+
+	pn := insolar.PulseNumber(insolar.FirstPulseNumber + 10)
+	_, err = storage.GetEntropyProvenance(pn)
+	assert.Equal(t, ErrNotFound, err)
+
+	_, expected, err := entropygenerator.NewSeededEntropyGenerator(42).GenerateAuditableEntropy()
+	require.NoError(t, err)
+	require.NoError(t, storage.SaveEntropyProvenance(pn, expected))
+
+	provenance, err := storage.GetEntropyProvenance(pn)
+	require.NoError(t, err)
+	assert.Equal(t, expected.Generator, provenance.Generator)
+	assert.Equal(t, expected.Sequence, provenance.Sequence)
+	assert.Equal(t, expected.Seed, provenance.Seed)
+	assert.True(t, expected.GeneratedAt.Equal(provenance.GeneratedAt))
+}
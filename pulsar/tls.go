@@ -0,0 +1,149 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package pulsar
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+)
+
+// certificateValidity is a validity period of self-signed pulsar certificate.
+// Certificates are not trusted by chain, so period doesn't matter much, peers are checked by pinned keys.
+const certificateValidity = 10 * 365 * 24 * time.Hour
+
+// NewTLSConfig creates TLS config for connections between pulsars.
+// Certificate is self-signed with the pulsar key, so no CA is needed. Both sides of connection
+// present certificates and accept only peers with public keys listed in neighbours.
+func NewTLSConfig(
+	privateKey crypto.PrivateKey,
+	keyProcessor insolar.KeyProcessor,
+	neighbours []configuration.PulsarNodeAddress,
+) (*tls.Config, error) {
+	certificate, err := newCertificate(privateKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create certificate")
+	}
+
+	pinned := map[string]struct{}{}
+	for _, neighbour := range neighbours {
+		if len(neighbour.PublicKey) == 0 {
+			continue
+		}
+		key, err := canonicalPublicKey(keyProcessor, []byte(neighbour.PublicKey))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to import public key of neighbour %s", neighbour.Address)
+		}
+		pinned[key] = struct{}{}
+	}
+
+	verify := func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+		if len(rawCerts) == 0 {
+			return errors.New("peer didn't present certificate")
+		}
+		peerCert, err := x509.ParseCertificate(rawCerts[0])
+		if err != nil {
+			return errors.Wrap(err, "failed to parse peer certificate")
+		}
+		peerKey, ok := peerCert.PublicKey.(*ecdsa.PublicKey)
+		if !ok {
+			return errors.New("peer public key has unsupported type")
+		}
+		exported, err := keyProcessor.ExportPublicKeyPEM(peerKey)
+		if err != nil {
+			return errors.Wrap(err, "failed to export peer public key")
+		}
+		if _, ok := pinned[string(exported)]; !ok {
+			return errors.New("peer public key is not pinned")
+		}
+		return nil
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		MinVersion:   tls.VersionTLS12,
+		ClientAuth:   tls.RequireAnyClientCert,
+		// Certificates are self-signed, chain verification is replaced with key pinning in VerifyPeerCertificate.
+		InsecureSkipVerify:    true, // nolint: gosec
+		VerifyPeerCertificate: verify,
+	}, nil
+}
+
+// TLSListener returns listener constructor for NewPulsar which accepts TLS connections only.
+func TLSListener(config *tls.Config) func(string, string) (net.Listener, error) {
+	return func(network string, address string) (net.Listener, error) {
+		listener, err := net.Listen(network, address)
+		if err != nil {
+			return nil, err
+		}
+		return tls.NewListener(listener, config), nil
+	}
+}
+
+func newCertificate(privateKey crypto.PrivateKey) (tls.Certificate, error) {
+	signer, ok := privateKey.(crypto.Signer)
+	if !ok {
+		return tls.Certificate{}, errors.New("private key can't be used for signing")
+	}
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return tls.Certificate{}, errors.Wrap(err, "failed to generate serial number")
+	}
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: "pulsar"},
+		NotBefore:    now.Add(-time.Hour),
+		NotAfter:     now.Add(certificateValidity),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, signer.Public(), signer)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	return tls.Certificate{
+		Certificate: [][]byte{der},
+		PrivateKey:  signer,
+	}, nil
+}
+
+// canonicalPublicKey re-exports PEM to get rid of formatting differences in configs.
+func canonicalPublicKey(keyProcessor insolar.KeyProcessor, pem []byte) (string, error) {
+	key, err := keyProcessor.ImportPublicKeyPEM(pem)
+	if err != nil {
+		return "", err
+	}
+	exported, err := keyProcessor.ExportPublicKeyPEM(key)
+	if err != nil {
+		return "", err
+	}
+	return string(exported), nil
+}
@@ -0,0 +1,99 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package pulsar
+
+import (
+	"crypto"
+	"crypto/tls"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/platformpolicy"
+)
+
+func newTestTLSConfig(t *testing.T, privateKey crypto.PrivateKey, neighbours ...crypto.PrivateKey) *tls.Config {
+	keyProcessor := platformpolicy.NewKeyProcessor()
+	var addresses []configuration.PulsarNodeAddress
+	for _, neighbour := range neighbours {
+		publicKey, err := keyProcessor.ExportPublicKeyPEM(keyProcessor.ExtractPublicKey(neighbour))
+		require.NoError(t, err)
+		addresses = append(addresses, configuration.PulsarNodeAddress{
+			ConnectionType: configuration.TLS,
+			PublicKey:      string(publicKey),
+		})
+	}
+	config, err := NewTLSConfig(privateKey, keyProcessor, addresses)
+	require.NoError(t, err)
+	return config
+}
+
+func TestTLSConfig_PinnedKeys(t *testing.T) {
+	keyProcessor := platformpolicy.NewKeyProcessor()
+	serverKey, err := keyProcessor.GeneratePrivateKey()
+	require.NoError(t, err)
+	clientKey, err := keyProcessor.GeneratePrivateKey()
+	require.NoError(t, err)
+	strangerKey, err := keyProcessor.GeneratePrivateKey()
+	require.NoError(t, err)
+
+	listener, err := TLSListener(newTestTLSConfig(t, serverKey, clientKey))("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			if conn.(*tls.Conn).Handshake() == nil {
+				_, _ = conn.Write([]byte{1})
+			}
+			_ = conn.Close()
+		}
+	}()
+
+	dial := func(config *tls.Config) error {
+		conn, err := tls.Dial("tcp", listener.Addr().String(), config)
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+		// server verifies client certificate after client finished handshake, so wait for its answer
+		_, err = conn.Read(make([]byte, 1))
+		return err
+	}
+
+	t.Run("pinned peers", func(t *testing.T) {
+		err := dial(newTestTLSConfig(t, clientKey, serverKey))
+		require.NoError(t, err)
+	})
+
+	t.Run("client isn't pinned by server", func(t *testing.T) {
+		err := dial(newTestTLSConfig(t, strangerKey, serverKey))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "tls:")
+	})
+
+	t.Run("server isn't pinned by client", func(t *testing.T) {
+		err := dial(newTestTLSConfig(t, clientKey, strangerKey))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "not pinned")
+	})
+}
@@ -46,8 +46,10 @@ var (
 	defaultJaegerEndPoint            = ""
 	discoveryDataDirectoryTemplate   = withBaseDir("discoverynodes/%d/data")
 	discoveryCertificatePathTemplate = withBaseDir("discoverynodes/certs/discovery_cert_%d.json")
+	discoveryCacheDirectoryTemplate  = withBaseDir("discoverynodes/%d/network_cache")
 	nodeDataDirectoryTemplate        = "nodes/%d/data"
 	nodeCertificatePathTemplate      = "nodes/%d/cert.json"
+	nodeCacheDirectoryTemplate       = "nodes/%d/network_cache"
 	pulsewatcherFileName             = withBaseDir("pulsewatcher.yaml")
 
 	prometheusConfigTmpl = "scripts/prom/server.yml.tmpl"
@@ -162,6 +164,7 @@ func main() {
 		conf.KeysPath = bootstrapConf.DiscoveryKeysDir + fmt.Sprintf(bootstrapConf.KeysNameFormat, nodeIndex)
 		conf.Ledger.Storage.DataDirectory = fmt.Sprintf(discoveryDataDirectoryTemplate, nodeIndex)
 		conf.CertificatePath = fmt.Sprintf(discoveryCertificatePathTemplate, nodeIndex)
+		conf.Service.CacheDirectory = fmt.Sprintf(discoveryCacheDirectoryTemplate, nodeIndex)
 
 		discoveryNodesConfigs = append(discoveryNodesConfigs, conf)
 
@@ -173,6 +176,7 @@ func main() {
 	// process extra nodes
 	nodeDataDirectoryTemplate = filepath.Join(outputDir, nodeDataDirectoryTemplate)
 	nodeCertificatePathTemplate = filepath.Join(outputDir, nodeCertificatePathTemplate)
+	nodeCacheDirectoryTemplate = filepath.Join(outputDir, nodeCacheDirectoryTemplate)
 
 	nodesConfigs := make([]configuration.Configuration, 0, len(bootstrapConf.DiscoveryNodes))
 	for index, node := range bootstrapConf.Nodes {
@@ -203,6 +207,7 @@ func main() {
 		conf.KeysPath = node.KeysFile
 		conf.Ledger.Storage.DataDirectory = fmt.Sprintf(nodeDataDirectoryTemplate, nodeIndex)
 		conf.CertificatePath = fmt.Sprintf(nodeCertificatePathTemplate, nodeIndex)
+		conf.Service.CacheDirectory = fmt.Sprintf(nodeCacheDirectoryTemplate, nodeIndex)
 
 		nodesConfigs = append(nodesConfigs, conf)
 
@@ -26,6 +26,7 @@ import (
 	"github.com/ThreeDotsLabs/watermill/message/infrastructure/gochannel"
 
 	"github.com/insolar/insolar/ledger/heavy/executor"
+	"github.com/insolar/insolar/ledger/heavy/exporter"
 	"github.com/insolar/insolar/log"
 	"github.com/insolar/insolar/server/internal"
 
@@ -38,6 +39,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/insolar/insolar/api"
+	"github.com/insolar/insolar/api/subscription"
 	"github.com/insolar/insolar/certificate"
 	"github.com/insolar/insolar/component"
 	"github.com/insolar/insolar/configuration"
@@ -63,6 +65,7 @@ import (
 	"github.com/insolar/insolar/metrics"
 	"github.com/insolar/insolar/network/nodenetwork"
 	"github.com/insolar/insolar/network/servicenetwork"
+	"github.com/insolar/insolar/network/storage"
 	"github.com/insolar/insolar/network/termination"
 	"github.com/insolar/insolar/platformpolicy"
 )
@@ -126,6 +129,9 @@ func newComponents(ctx context.Context, cfg configuration.Configuration, genesis
 	// Network.
 	var (
 		NetworkService *servicenetwork.ServiceNetwork
+		NetworkDB      *storage.BadgerDB
+		CloudHashes    *storage.CloudHashStorage
+		NetworkPulses  *storage.PulseStorage
 		NodeNetwork    insolar.NodeNetwork
 		Termination    insolar.TerminationHandler
 	)
@@ -139,6 +145,14 @@ func newComponents(ctx context.Context, cfg configuration.Configuration, genesis
 
 		Termination = termination.NewHandler(NetworkService)
 
+		// Network data.
+		NetworkDB, err = storage.NewBadgerDB(cfg.Service)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to open network DB")
+		}
+		CloudHashes = storage.NewCloudHashStorage()
+		NetworkPulses = storage.NewPulseStorage()
+
 		// Node info.
 		NodeNetwork, err = nodenetwork.NewNodeNetwork(cfg.Host.Transport, CertManager.GetCertificate())
 		if err != nil {
@@ -151,7 +165,8 @@ func newComponents(ctx context.Context, cfg configuration.Configuration, genesis
 	var (
 		Requester       insolar.ContractRequester
 		GenesisProvider insolar.GenesisDataProvider
-		API             insolar.APIRunner
+		API             *api.Runner
+		Hub             *subscription.Hub
 	)
 	{
 		var err error
@@ -169,6 +184,8 @@ func newComponents(ctx context.Context, cfg configuration.Configuration, genesis
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to start ApiRunner")
 		}
+
+		Hub = subscription.NewHub()
 	}
 
 	// Storage.
@@ -232,6 +249,7 @@ func newComponents(ctx context.Context, cfg configuration.Configuration, genesis
 		PulseManager insolar.PulseManager
 		Handler      *handler.Handler
 		Genesis      *genesis.Genesis
+		DropHashes   *executor.DropHashes
 	)
 	{
 		records := object.NewRecordDB(DB)
@@ -249,6 +267,7 @@ func newComponents(ctx context.Context, cfg configuration.Configuration, genesis
 		pm.PulseAppender = Pulses
 		pm.PulseAccessor = Pulses
 		pm.JetModifier = jets
+		pm.Notifier = Hub
 		pm.FinalizationKeeper = executor.NewFinalizationKeeperDefault(jetKeeper, Termination, Pulses, cfg.Ledger.LightChainLimit)
 
 		h := handler.New(cfg.Ledger)
@@ -268,6 +287,13 @@ func newComponents(ctx context.Context, cfg configuration.Configuration, genesis
 
 		PulseManager = pm
 		Handler = h
+		DropHashes = executor.NewDropHashes(jetKeeper, jets, drops)
+
+		exp := exporter.NewExporter(cfg.Ledger.Exporter, jetKeeper, Pulses, Pulses, jets, drops, indexes, records)
+		err = API.RegisterService(exporter.NewService(exp), "exporter")
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to register exporter service")
+		}
 
 		artifactManager := &artifact.Scope{
 			PulseNumber:    insolar.FirstPulseNumber,
@@ -309,6 +335,7 @@ func newComponents(ctx context.Context, cfg configuration.Configuration, genesis
 		artifacts.NewClient(WmBus),
 		GenesisProvider,
 		API,
+		Hub,
 		KeyProcessor,
 		Termination,
 		CryptoScheme,
@@ -316,6 +343,9 @@ func newComponents(ctx context.Context, cfg configuration.Configuration, genesis
 		CertManager,
 		NodeNetwork,
 		NetworkService,
+		NetworkDB,
+		CloudHashes,
+		NetworkPulses,
 		pubSub,
 		rules.NewRules(),
 	)
@@ -324,6 +354,8 @@ func newComponents(ctx context.Context, cfg configuration.Configuration, genesis
 		return nil, errors.Wrap(err, "failed to init components")
 	}
 
+	NetworkService.RemoteProcedureRegister(jet.DropHashesProcedure, DropHashes.Procedure)
+
 	if !genesisCfg.Skip {
 		if err := Genesis.Start(ctx); err != nil {
 			logger.Fatalf("genesis failed on heavy with error: %v", err)
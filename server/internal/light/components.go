@@ -25,6 +25,7 @@ import (
 	watermillMsg "github.com/ThreeDotsLabs/watermill/message"
 	"github.com/ThreeDotsLabs/watermill/message/infrastructure/gochannel"
 	"github.com/insolar/insolar/api"
+	"github.com/insolar/insolar/api/subscription"
 	"github.com/insolar/insolar/certificate"
 	"github.com/insolar/insolar/component"
 	"github.com/insolar/insolar/configuration"
@@ -54,6 +55,7 @@ import (
 	"github.com/insolar/insolar/metrics"
 	"github.com/insolar/insolar/network/nodenetwork"
 	"github.com/insolar/insolar/network/servicenetwork"
+	"github.com/insolar/insolar/network/storage"
 	"github.com/insolar/insolar/network/termination"
 	"github.com/insolar/insolar/platformpolicy"
 	"github.com/insolar/insolar/server/internal"
@@ -116,6 +118,9 @@ func newComponents(ctx context.Context, cfg configuration.Configuration) (*compo
 	// Network.
 	var (
 		NetworkService *servicenetwork.ServiceNetwork
+		NetworkDB      *storage.BadgerDB
+		CloudHashes    *storage.CloudHashStorage
+		NetworkPulses  *storage.PulseStorage
 		NodeNetwork    insolar.NodeNetwork
 		Termination    insolar.TerminationHandler
 	)
@@ -129,6 +134,14 @@ func newComponents(ctx context.Context, cfg configuration.Configuration) (*compo
 
 		Termination = termination.NewHandler(NetworkService)
 
+		// Network data.
+		NetworkDB, err = storage.NewBadgerDB(cfg.Service)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to open network DB")
+		}
+		CloudHashes = storage.NewCloudHashStorage()
+		NetworkPulses = storage.NewPulseStorage()
+
 		// Node info.
 		NodeNetwork, err = nodenetwork.NewNodeNetwork(cfg.Host.Transport, CertManager.GetCertificate())
 		if err != nil {
@@ -142,6 +155,7 @@ func newComponents(ctx context.Context, cfg configuration.Configuration) (*compo
 		Requester insolar.ContractRequester
 		Genesis   insolar.GenesisDataProvider
 		API       insolar.APIRunner
+		Hub       *subscription.Hub
 	)
 	{
 		var err error
@@ -159,6 +173,8 @@ func newComponents(ctx context.Context, cfg configuration.Configuration) (*compo
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to start ApiRunner")
 		}
+
+		Hub = subscription.NewHub()
 	}
 
 	// Role calculations.
@@ -214,8 +230,9 @@ func newComponents(ctx context.Context, cfg configuration.Configuration) (*compo
 
 	// Light components.
 	var (
-		PulseManager insolar.PulseManager
-		Handler      *artifactmanager.MessageHandler
+		PulseManager  insolar.PulseManager
+		Handler       *artifactmanager.MessageHandler
+		DropConfirmer *executor.DropConfirmer
 	)
 	{
 		conf := cfg.Ledger
@@ -316,9 +333,11 @@ func newComponents(ctx context.Context, cfg configuration.Configuration) (*compo
 		pm.PulseAccessor = Pulses
 		pm.PulseCalculator = Pulses
 		pm.PulseAppender = Pulses
+		pm.Notifier = Hub
 
 		PulseManager = pm
 		Handler = handler
+		DropConfirmer = executor.NewDropConfirmer(drops)
 	}
 
 	c.cmp.Inject(
@@ -336,6 +355,7 @@ func newComponents(ctx context.Context, cfg configuration.Configuration) (*compo
 		artifacts.NewClient(WmBus),
 		Genesis,
 		API,
+		Hub,
 		KeyProcessor,
 		Termination,
 		CryptoScheme,
@@ -343,6 +363,9 @@ func newComponents(ctx context.Context, cfg configuration.Configuration) (*compo
 		CertManager,
 		NodeNetwork,
 		NetworkService,
+		NetworkDB,
+		CloudHashes,
+		NetworkPulses,
 		pubSub,
 		rules.NewRules(),
 	)
@@ -352,6 +375,8 @@ func newComponents(ctx context.Context, cfg configuration.Configuration) (*compo
 		return nil, errors.Wrap(err, "failed to init components")
 	}
 
+	NetworkService.RemoteProcedureRegister(jet.ConfirmDropsProcedure, DropConfirmer.Procedure)
+
 	c.startWatermill(ctx, logger, pubSub, WmBus, NetworkService.SendMessageHandler, Handler.FlowDispatcher.Process)
 
 	return c, nil
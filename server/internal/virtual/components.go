@@ -27,6 +27,7 @@ import (
 	"github.com/ThreeDotsLabs/watermill/message/infrastructure/gochannel"
 
 	"github.com/insolar/insolar/api"
+	"github.com/insolar/insolar/api/subscription"
 	"github.com/insolar/insolar/certificate"
 	"github.com/insolar/insolar/component"
 	"github.com/insolar/insolar/configuration"
@@ -50,7 +51,9 @@ import (
 	"github.com/insolar/insolar/metrics"
 	"github.com/insolar/insolar/network/nodenetwork"
 	"github.com/insolar/insolar/network/servicenetwork"
+	"github.com/insolar/insolar/network/storage"
 	"github.com/insolar/insolar/network/termination"
+	"github.com/insolar/insolar/network/throttle"
 	"github.com/insolar/insolar/platformpolicy"
 	"github.com/insolar/insolar/server/internal"
 	"github.com/insolar/insolar/version/manager"
@@ -133,6 +136,9 @@ func initComponents(
 
 	terminationHandler := termination.NewHandler(nw)
 
+	networkDB, err := storage.NewBadgerDB(cfg.Service)
+	checkError(ctx, err, "failed to open network DB")
+
 	delegationTokenFactory := delegationtoken.NewDelegationTokenFactory()
 	parcelFactory := messagebus.NewParcelFactory()
 
@@ -155,6 +161,9 @@ func initComponents(
 	pulses := pulse.NewStorageMem()
 	b := bus.NewBus(pubSub, pulses, jc, pcs)
 
+	// Traffic limiter throttles new incoming requests on consensus request.
+	trafficLimiter := throttle.NewLimiter(throttle.DefaultCapacity)
+
 	logicRunner, err := logicrunner.NewLogicRunner(&cfg.LogicRunner, pubSub, b)
 	checkError(ctx, err, "failed to start LogicRunner")
 
@@ -169,13 +178,18 @@ func initComponents(
 		keyProcessor,
 		certManager,
 		logicRunner,
-		logicrunner.NewLogicExecutor(),
+		logicrunner.NewLogicExecutor(cfg.LogicRunner.Budget),
 		logicrunner.NewRequestsExecutor(),
 		logicrunner.NewMachinesManager(),
+		logicrunner.NewValidator(),
 		nodeNetwork,
 		nw,
+		networkDB,
+		storage.NewCloudHashStorage(),
+		storage.NewPulseStorage(),
 		pulsemanager.NewPulseManager(),
 		rules.NewRules(),
+		trafficLimiter,
 	)
 
 	components := []interface{}{
@@ -195,6 +209,7 @@ func initComponents(
 	components = append(components, []interface{}{
 		genesisDataProvider,
 		apiRunner,
+		subscription.NewHub(),
 		metricsHandler,
 		cryptographyService,
 		keyProcessor,
@@ -202,7 +217,7 @@ func initComponents(
 
 	cm.Inject(components...)
 
-	stopper := startWatermill(ctx, logger, pubSub, b, nw.SendMessageHandler, logicRunner.FlowDispatcher.Process, logicRunner.InnerFlowDispatcher.InnerSubscriber)
+	stopper := startWatermill(ctx, logger, pubSub, b, trafficLimiter, nw.SendMessageHandler, logicRunner.FlowDispatcher.Process, logicRunner.InnerFlowDispatcher.InnerSubscriber)
 
 	return &cm, terminationHandler, stopper
 }
@@ -212,6 +227,7 @@ func startWatermill(
 	logger watermill.LoggerAdapter,
 	pubSub message.Subscriber,
 	b *bus.Bus,
+	trafficLimiter *throttle.Limiter,
 	outHandler, inHandler, lrHandler message.HandlerFunc,
 ) func() {
 	inRouter, err := message.NewRouter(message.RouterConfig{}, logger)
@@ -236,6 +252,7 @@ func startWatermill(
 	)
 
 	inRouter.AddMiddleware(
+		trafficLimiter.Middleware(isIncomingRequest),
 		b.IncomingMessageRouter,
 	)
 
@@ -279,3 +296,8 @@ func stopWatermill(ctx context.Context, routers ...io.Closer) func() {
 		}
 	}
 }
+
+// isIncomingRequest checks if message is a new call of contract, replies and other messages aren't throttled.
+func isIncomingRequest(msg *message.Message) bool {
+	return msg.Metadata.Get(bus.MetaType) == insolar.TypeCallMethod.String()
+}
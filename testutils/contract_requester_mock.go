@@ -35,6 +35,11 @@ type ContractRequesterMock struct {
 	CallMethodPreCounter uint64
 	CallMethodMock       mContractRequesterMockCallMethod
 
+	GetRequestResultFunc       func(p context.Context, p1 insolar.Reference) (r *insolar.RequestResult, r1 bool)
+	GetRequestResultCounter    uint64
+	GetRequestResultPreCounter uint64
+	GetRequestResultMock       mContractRequesterMockGetRequestResult
+
 	SendRequestFunc       func(p context.Context, p1 *insolar.Reference, p2 string, p3 []interface{}) (r insolar.Reply, r1 error)
 	SendRequestCounter    uint64
 	SendRequestPreCounter uint64
@@ -44,6 +49,11 @@ type ContractRequesterMock struct {
 	SendRequestWithPulseCounter    uint64
 	SendRequestWithPulsePreCounter uint64
 	SendRequestWithPulseMock       mContractRequesterMockSendRequestWithPulse
+
+	SendRequestWithPulseAsyncFunc       func(p context.Context, p1 *insolar.Reference, p2 string, p3 []interface{}, p4 insolar.PulseNumber) (r *insolar.Reference, r1 error)
+	SendRequestWithPulseAsyncCounter    uint64
+	SendRequestWithPulseAsyncPreCounter uint64
+	SendRequestWithPulseAsyncMock       mContractRequesterMockSendRequestWithPulseAsync
 }
 
 //NewContractRequesterMock returns a mock for github.com/insolar/insolar/insolar.ContractRequester
@@ -57,8 +67,10 @@ func NewContractRequesterMock(t minimock.Tester) *ContractRequesterMock {
 	m.CallMock = mContractRequesterMockCall{mock: m}
 	m.CallConstructorMock = mContractRequesterMockCallConstructor{mock: m}
 	m.CallMethodMock = mContractRequesterMockCallMethod{mock: m}
+	m.GetRequestResultMock = mContractRequesterMockGetRequestResult{mock: m}
 	m.SendRequestMock = mContractRequesterMockSendRequest{mock: m}
 	m.SendRequestWithPulseMock = mContractRequesterMockSendRequestWithPulse{mock: m}
+	m.SendRequestWithPulseAsyncMock = mContractRequesterMockSendRequestWithPulseAsync{mock: m}
 
 	return m
 }
@@ -516,6 +528,157 @@ func (m *ContractRequesterMock) CallMethodFinished() bool {
 	return true
 }
 
+type mContractRequesterMockGetRequestResult struct {
+	mock              *ContractRequesterMock
+	mainExpectation   *ContractRequesterMockGetRequestResultExpectation
+	expectationSeries []*ContractRequesterMockGetRequestResultExpectation
+}
+
+type ContractRequesterMockGetRequestResultExpectation struct {
+	input  *ContractRequesterMockGetRequestResultInput
+	result *ContractRequesterMockGetRequestResultResult
+}
+
+type ContractRequesterMockGetRequestResultInput struct {
+	p  context.Context
+	p1 insolar.Reference
+}
+
+type ContractRequesterMockGetRequestResultResult struct {
+	r  *insolar.RequestResult
+	r1 bool
+}
+
+//Expect specifies that invocation of ContractRequester.GetRequestResult is expected from 1 to Infinity times
+func (m *mContractRequesterMockGetRequestResult) Expect(p context.Context, p1 insolar.Reference) *mContractRequesterMockGetRequestResult {
+	m.mock.GetRequestResultFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ContractRequesterMockGetRequestResultExpectation{}
+	}
+	m.mainExpectation.input = &ContractRequesterMockGetRequestResultInput{p, p1}
+	return m
+}
+
+//Return specifies results of invocation of ContractRequester.GetRequestResult
+func (m *mContractRequesterMockGetRequestResult) Return(r *insolar.RequestResult, r1 bool) *ContractRequesterMock {
+	m.mock.GetRequestResultFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ContractRequesterMockGetRequestResultExpectation{}
+	}
+	m.mainExpectation.result = &ContractRequesterMockGetRequestResultResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of ContractRequester.GetRequestResult is expected once
+func (m *mContractRequesterMockGetRequestResult) ExpectOnce(p context.Context, p1 insolar.Reference) *ContractRequesterMockGetRequestResultExpectation {
+	m.mock.GetRequestResultFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &ContractRequesterMockGetRequestResultExpectation{}
+	expectation.input = &ContractRequesterMockGetRequestResultInput{p, p1}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *ContractRequesterMockGetRequestResultExpectation) Return(r *insolar.RequestResult, r1 bool) {
+	e.result = &ContractRequesterMockGetRequestResultResult{r, r1}
+}
+
+//Set uses given function f as a mock of ContractRequester.GetRequestResult method
+func (m *mContractRequesterMockGetRequestResult) Set(f func(p context.Context, p1 insolar.Reference) (r *insolar.RequestResult, r1 bool)) *ContractRequesterMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.GetRequestResultFunc = f
+	return m.mock
+}
+
+//GetRequestResult implements github.com/insolar/insolar/insolar.ContractRequester interface
+func (m *ContractRequesterMock) GetRequestResult(p context.Context, p1 insolar.Reference) (r *insolar.RequestResult, r1 bool) {
+	counter := atomic.AddUint64(&m.GetRequestResultPreCounter, 1)
+	defer atomic.AddUint64(&m.GetRequestResultCounter, 1)
+
+	if len(m.GetRequestResultMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.GetRequestResultMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to ContractRequesterMock.GetRequestResult. %v %v", p, p1)
+			return
+		}
+
+		input := m.GetRequestResultMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, ContractRequesterMockGetRequestResultInput{p, p1}, "ContractRequester.GetRequestResult got unexpected parameters")
+
+		result := m.GetRequestResultMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the ContractRequesterMock.GetRequestResult")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.GetRequestResultMock.mainExpectation != nil {
+
+		input := m.GetRequestResultMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, ContractRequesterMockGetRequestResultInput{p, p1}, "ContractRequester.GetRequestResult got unexpected parameters")
+		}
+
+		result := m.GetRequestResultMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the ContractRequesterMock.GetRequestResult")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.GetRequestResultFunc == nil {
+		m.t.Fatalf("Unexpected call to ContractRequesterMock.GetRequestResult. %v %v", p, p1)
+		return
+	}
+
+	return m.GetRequestResultFunc(p, p1)
+}
+
+//GetRequestResultMinimockCounter returns a count of ContractRequesterMock.GetRequestResultFunc invocations
+func (m *ContractRequesterMock) GetRequestResultMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.GetRequestResultCounter)
+}
+
+//GetRequestResultMinimockPreCounter returns the value of ContractRequesterMock.GetRequestResult invocations
+func (m *ContractRequesterMock) GetRequestResultMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.GetRequestResultPreCounter)
+}
+
+//GetRequestResultFinished returns true if mock invocations count is ok
+func (m *ContractRequesterMock) GetRequestResultFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.GetRequestResultMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.GetRequestResultCounter) == uint64(len(m.GetRequestResultMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.GetRequestResultMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.GetRequestResultCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.GetRequestResultFunc != nil {
+		return atomic.LoadUint64(&m.GetRequestResultCounter) > 0
+	}
+
+	return true
+}
+
 type mContractRequesterMockSendRequest struct {
 	mock              *ContractRequesterMock
 	mainExpectation   *ContractRequesterMockSendRequestExpectation
@@ -823,6 +986,160 @@ func (m *ContractRequesterMock) SendRequestWithPulseFinished() bool {
 	return true
 }
 
+type mContractRequesterMockSendRequestWithPulseAsync struct {
+	mock              *ContractRequesterMock
+	mainExpectation   *ContractRequesterMockSendRequestWithPulseAsyncExpectation
+	expectationSeries []*ContractRequesterMockSendRequestWithPulseAsyncExpectation
+}
+
+type ContractRequesterMockSendRequestWithPulseAsyncExpectation struct {
+	input  *ContractRequesterMockSendRequestWithPulseAsyncInput
+	result *ContractRequesterMockSendRequestWithPulseAsyncResult
+}
+
+type ContractRequesterMockSendRequestWithPulseAsyncInput struct {
+	p  context.Context
+	p1 *insolar.Reference
+	p2 string
+	p3 []interface{}
+	p4 insolar.PulseNumber
+}
+
+type ContractRequesterMockSendRequestWithPulseAsyncResult struct {
+	r  *insolar.Reference
+	r1 error
+}
+
+//Expect specifies that invocation of ContractRequester.SendRequestWithPulseAsync is expected from 1 to Infinity times
+func (m *mContractRequesterMockSendRequestWithPulseAsync) Expect(p context.Context, p1 *insolar.Reference, p2 string, p3 []interface{}, p4 insolar.PulseNumber) *mContractRequesterMockSendRequestWithPulseAsync {
+	m.mock.SendRequestWithPulseAsyncFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ContractRequesterMockSendRequestWithPulseAsyncExpectation{}
+	}
+	m.mainExpectation.input = &ContractRequesterMockSendRequestWithPulseAsyncInput{p, p1, p2, p3, p4}
+	return m
+}
+
+//Return specifies results of invocation of ContractRequester.SendRequestWithPulseAsync
+func (m *mContractRequesterMockSendRequestWithPulseAsync) Return(r *insolar.Reference, r1 error) *ContractRequesterMock {
+	m.mock.SendRequestWithPulseAsyncFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ContractRequesterMockSendRequestWithPulseAsyncExpectation{}
+	}
+	m.mainExpectation.result = &ContractRequesterMockSendRequestWithPulseAsyncResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of ContractRequester.SendRequestWithPulseAsync is expected once
+func (m *mContractRequesterMockSendRequestWithPulseAsync) ExpectOnce(p context.Context, p1 *insolar.Reference, p2 string, p3 []interface{}, p4 insolar.PulseNumber) *ContractRequesterMockSendRequestWithPulseAsyncExpectation {
+	m.mock.SendRequestWithPulseAsyncFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &ContractRequesterMockSendRequestWithPulseAsyncExpectation{}
+	expectation.input = &ContractRequesterMockSendRequestWithPulseAsyncInput{p, p1, p2, p3, p4}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *ContractRequesterMockSendRequestWithPulseAsyncExpectation) Return(r *insolar.Reference, r1 error) {
+	e.result = &ContractRequesterMockSendRequestWithPulseAsyncResult{r, r1}
+}
+
+//Set uses given function f as a mock of ContractRequester.SendRequestWithPulseAsync method
+func (m *mContractRequesterMockSendRequestWithPulseAsync) Set(f func(p context.Context, p1 *insolar.Reference, p2 string, p3 []interface{}, p4 insolar.PulseNumber) (r *insolar.Reference, r1 error)) *ContractRequesterMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.SendRequestWithPulseAsyncFunc = f
+	return m.mock
+}
+
+//SendRequestWithPulseAsync implements github.com/insolar/insolar/insolar.ContractRequester interface
+func (m *ContractRequesterMock) SendRequestWithPulseAsync(p context.Context, p1 *insolar.Reference, p2 string, p3 []interface{}, p4 insolar.PulseNumber) (r *insolar.Reference, r1 error) {
+	counter := atomic.AddUint64(&m.SendRequestWithPulseAsyncPreCounter, 1)
+	defer atomic.AddUint64(&m.SendRequestWithPulseAsyncCounter, 1)
+
+	if len(m.SendRequestWithPulseAsyncMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.SendRequestWithPulseAsyncMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to ContractRequesterMock.SendRequestWithPulseAsync. %v %v %v %v %v", p, p1, p2, p3, p4)
+			return
+		}
+
+		input := m.SendRequestWithPulseAsyncMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, ContractRequesterMockSendRequestWithPulseAsyncInput{p, p1, p2, p3, p4}, "ContractRequester.SendRequestWithPulseAsync got unexpected parameters")
+
+		result := m.SendRequestWithPulseAsyncMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the ContractRequesterMock.SendRequestWithPulseAsync")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.SendRequestWithPulseAsyncMock.mainExpectation != nil {
+
+		input := m.SendRequestWithPulseAsyncMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, ContractRequesterMockSendRequestWithPulseAsyncInput{p, p1, p2, p3, p4}, "ContractRequester.SendRequestWithPulseAsync got unexpected parameters")
+		}
+
+		result := m.SendRequestWithPulseAsyncMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the ContractRequesterMock.SendRequestWithPulseAsync")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.SendRequestWithPulseAsyncFunc == nil {
+		m.t.Fatalf("Unexpected call to ContractRequesterMock.SendRequestWithPulseAsync. %v %v %v %v %v", p, p1, p2, p3, p4)
+		return
+	}
+
+	return m.SendRequestWithPulseAsyncFunc(p, p1, p2, p3, p4)
+}
+
+//SendRequestWithPulseAsyncMinimockCounter returns a count of ContractRequesterMock.SendRequestWithPulseAsyncFunc invocations
+func (m *ContractRequesterMock) SendRequestWithPulseAsyncMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.SendRequestWithPulseAsyncCounter)
+}
+
+//SendRequestWithPulseAsyncMinimockPreCounter returns the value of ContractRequesterMock.SendRequestWithPulseAsync invocations
+func (m *ContractRequesterMock) SendRequestWithPulseAsyncMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.SendRequestWithPulseAsyncPreCounter)
+}
+
+//SendRequestWithPulseAsyncFinished returns true if mock invocations count is ok
+func (m *ContractRequesterMock) SendRequestWithPulseAsyncFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.SendRequestWithPulseAsyncMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.SendRequestWithPulseAsyncCounter) == uint64(len(m.SendRequestWithPulseAsyncMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.SendRequestWithPulseAsyncMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.SendRequestWithPulseAsyncCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.SendRequestWithPulseAsyncFunc != nil {
+		return atomic.LoadUint64(&m.SendRequestWithPulseAsyncCounter) > 0
+	}
+
+	return true
+}
+
 //ValidateCallCounters checks that all mocked methods of the interface have been called at least once
 //Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
 func (m *ContractRequesterMock) ValidateCallCounters() {
@@ -839,6 +1156,10 @@ func (m *ContractRequesterMock) ValidateCallCounters() {
 		m.t.Fatal("Expected call to ContractRequesterMock.CallMethod")
 	}
 
+	if !m.GetRequestResultFinished() {
+		m.t.Fatal("Expected call to ContractRequesterMock.GetRequestResult")
+	}
+
 	if !m.SendRequestFinished() {
 		m.t.Fatal("Expected call to ContractRequesterMock.SendRequest")
 	}
@@ -847,6 +1168,10 @@ func (m *ContractRequesterMock) ValidateCallCounters() {
 		m.t.Fatal("Expected call to ContractRequesterMock.SendRequestWithPulse")
 	}
 
+	if !m.SendRequestWithPulseAsyncFinished() {
+		m.t.Fatal("Expected call to ContractRequesterMock.SendRequestWithPulseAsync")
+	}
+
 }
 
 //CheckMocksCalled checks that all mocked methods of the interface have been called at least once
@@ -876,6 +1201,10 @@ func (m *ContractRequesterMock) MinimockFinish() {
 		m.t.Fatal("Expected call to ContractRequesterMock.CallMethod")
 	}
 
+	if !m.GetRequestResultFinished() {
+		m.t.Fatal("Expected call to ContractRequesterMock.GetRequestResult")
+	}
+
 	if !m.SendRequestFinished() {
 		m.t.Fatal("Expected call to ContractRequesterMock.SendRequest")
 	}
@@ -884,6 +1213,10 @@ func (m *ContractRequesterMock) MinimockFinish() {
 		m.t.Fatal("Expected call to ContractRequesterMock.SendRequestWithPulse")
 	}
 
+	if !m.SendRequestWithPulseAsyncFinished() {
+		m.t.Fatal("Expected call to ContractRequesterMock.SendRequestWithPulseAsync")
+	}
+
 }
 
 //Wait waits for all mocked methods to be called at least once
@@ -901,8 +1234,10 @@ func (m *ContractRequesterMock) MinimockWait(timeout time.Duration) {
 		ok = ok && m.CallFinished()
 		ok = ok && m.CallConstructorFinished()
 		ok = ok && m.CallMethodFinished()
+		ok = ok && m.GetRequestResultFinished()
 		ok = ok && m.SendRequestFinished()
 		ok = ok && m.SendRequestWithPulseFinished()
+		ok = ok && m.SendRequestWithPulseAsyncFinished()
 
 		if ok {
 			return
@@ -923,6 +1258,10 @@ func (m *ContractRequesterMock) MinimockWait(timeout time.Duration) {
 				m.t.Error("Expected call to ContractRequesterMock.CallMethod")
 			}
 
+			if !m.GetRequestResultFinished() {
+				m.t.Error("Expected call to ContractRequesterMock.GetRequestResult")
+			}
+
 			if !m.SendRequestFinished() {
 				m.t.Error("Expected call to ContractRequesterMock.SendRequest")
 			}
@@ -931,6 +1270,10 @@ func (m *ContractRequesterMock) MinimockWait(timeout time.Duration) {
 				m.t.Error("Expected call to ContractRequesterMock.SendRequestWithPulse")
 			}
 
+			if !m.SendRequestWithPulseAsyncFinished() {
+				m.t.Error("Expected call to ContractRequesterMock.SendRequestWithPulseAsync")
+			}
+
 			m.t.Fatalf("Some mocks were not called on time: %s", timeout)
 			return
 		default:
@@ -955,6 +1298,10 @@ func (m *ContractRequesterMock) AllMocksCalled() bool {
 		return false
 	}
 
+	if !m.GetRequestResultFinished() {
+		return false
+	}
+
 	if !m.SendRequestFinished() {
 		return false
 	}
@@ -963,5 +1310,9 @@ func (m *ContractRequesterMock) AllMocksCalled() bool {
 		return false
 	}
 
+	if !m.SendRequestWithPulseAsyncFinished() {
+		return false
+	}
+
 	return true
 }
@@ -0,0 +1,267 @@
+package network
+
+/*
+DO NOT EDIT!
+This code was generated automatically using github.com/gojuno/minimock v1.9
+The original interface "CloudHashRangeAccessor" can be found in github.com/insolar/insolar/network/storage
+*/
+import (
+	context "context"
+	"sync/atomic"
+	"time"
+
+	"github.com/gojuno/minimock"
+	insolar "github.com/insolar/insolar/insolar"
+	storage "github.com/insolar/insolar/network/storage"
+
+	testify_assert "github.com/stretchr/testify/assert"
+)
+
+//CloudHashRangeAccessorMock implements github.com/insolar/insolar/network/storage.CloudHashRangeAccessor
+type CloudHashRangeAccessorMock struct {
+	t minimock.Tester
+
+	ForRangeFunc       func(p context.Context, p1 insolar.PulseRange) (r []storage.CloudHash, r1 error)
+	ForRangeCounter    uint64
+	ForRangePreCounter uint64
+	ForRangeMock       mCloudHashRangeAccessorMockForRange
+}
+
+//NewCloudHashRangeAccessorMock returns a mock for github.com/insolar/insolar/network/storage.CloudHashRangeAccessor
+func NewCloudHashRangeAccessorMock(t minimock.Tester) *CloudHashRangeAccessorMock {
+	m := &CloudHashRangeAccessorMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ForRangeMock = mCloudHashRangeAccessorMockForRange{mock: m}
+
+	return m
+}
+
+type mCloudHashRangeAccessorMockForRange struct {
+	mock              *CloudHashRangeAccessorMock
+	mainExpectation   *CloudHashRangeAccessorMockForRangeExpectation
+	expectationSeries []*CloudHashRangeAccessorMockForRangeExpectation
+}
+
+type CloudHashRangeAccessorMockForRangeExpectation struct {
+	input  *CloudHashRangeAccessorMockForRangeInput
+	result *CloudHashRangeAccessorMockForRangeResult
+}
+
+type CloudHashRangeAccessorMockForRangeInput struct {
+	p  context.Context
+	p1 insolar.PulseRange
+}
+
+type CloudHashRangeAccessorMockForRangeResult struct {
+	r  []storage.CloudHash
+	r1 error
+}
+
+//Expect specifies that invocation of CloudHashRangeAccessor.ForRange is expected from 1 to Infinity times
+func (m *mCloudHashRangeAccessorMockForRange) Expect(p context.Context, p1 insolar.PulseRange) *mCloudHashRangeAccessorMockForRange {
+	m.mock.ForRangeFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &CloudHashRangeAccessorMockForRangeExpectation{}
+	}
+	m.mainExpectation.input = &CloudHashRangeAccessorMockForRangeInput{p, p1}
+	return m
+}
+
+//Return specifies results of invocation of CloudHashRangeAccessor.ForRange
+func (m *mCloudHashRangeAccessorMockForRange) Return(r []storage.CloudHash, r1 error) *CloudHashRangeAccessorMock {
+	m.mock.ForRangeFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &CloudHashRangeAccessorMockForRangeExpectation{}
+	}
+	m.mainExpectation.result = &CloudHashRangeAccessorMockForRangeResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of CloudHashRangeAccessor.ForRange is expected once
+func (m *mCloudHashRangeAccessorMockForRange) ExpectOnce(p context.Context, p1 insolar.PulseRange) *CloudHashRangeAccessorMockForRangeExpectation {
+	m.mock.ForRangeFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &CloudHashRangeAccessorMockForRangeExpectation{}
+	expectation.input = &CloudHashRangeAccessorMockForRangeInput{p, p1}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *CloudHashRangeAccessorMockForRangeExpectation) Return(r []storage.CloudHash, r1 error) {
+	e.result = &CloudHashRangeAccessorMockForRangeResult{r, r1}
+}
+
+//Set uses given function f as a mock of CloudHashRangeAccessor.ForRange method
+func (m *mCloudHashRangeAccessorMockForRange) Set(f func(p context.Context, p1 insolar.PulseRange) (r []storage.CloudHash, r1 error)) *CloudHashRangeAccessorMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.ForRangeFunc = f
+	return m.mock
+}
+
+//ForRange implements github.com/insolar/insolar/network/storage.CloudHashRangeAccessor interface
+func (m *CloudHashRangeAccessorMock) ForRange(p context.Context, p1 insolar.PulseRange) (r []storage.CloudHash, r1 error) {
+	counter := atomic.AddUint64(&m.ForRangePreCounter, 1)
+	defer atomic.AddUint64(&m.ForRangeCounter, 1)
+
+	if len(m.ForRangeMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.ForRangeMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to CloudHashRangeAccessorMock.ForRange. %v %v", p, p1)
+			return
+		}
+
+		input := m.ForRangeMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, CloudHashRangeAccessorMockForRangeInput{p, p1}, "CloudHashRangeAccessor.ForRange got unexpected parameters")
+
+		result := m.ForRangeMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the CloudHashRangeAccessorMock.ForRange")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.ForRangeMock.mainExpectation != nil {
+
+		input := m.ForRangeMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, CloudHashRangeAccessorMockForRangeInput{p, p1}, "CloudHashRangeAccessor.ForRange got unexpected parameters")
+		}
+
+		result := m.ForRangeMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the CloudHashRangeAccessorMock.ForRange")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.ForRangeFunc == nil {
+		m.t.Fatalf("Unexpected call to CloudHashRangeAccessorMock.ForRange. %v %v", p, p1)
+		return
+	}
+
+	return m.ForRangeFunc(p, p1)
+}
+
+//ForRangeMinimockCounter returns a count of CloudHashRangeAccessorMock.ForRangeFunc invocations
+func (m *CloudHashRangeAccessorMock) ForRangeMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.ForRangeCounter)
+}
+
+//ForRangeMinimockPreCounter returns the value of CloudHashRangeAccessorMock.ForRange invocations
+func (m *CloudHashRangeAccessorMock) ForRangeMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.ForRangePreCounter)
+}
+
+//ForRangeFinished returns true if mock invocations count is ok
+func (m *CloudHashRangeAccessorMock) ForRangeFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.ForRangeMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.ForRangeCounter) == uint64(len(m.ForRangeMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.ForRangeMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.ForRangeCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.ForRangeFunc != nil {
+		return atomic.LoadUint64(&m.ForRangeCounter) > 0
+	}
+
+	return true
+}
+
+//ValidateCallCounters checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *CloudHashRangeAccessorMock) ValidateCallCounters() {
+
+	if !m.ForRangeFinished() {
+		m.t.Fatal("Expected call to CloudHashRangeAccessorMock.ForRange")
+	}
+
+}
+
+//CheckMocksCalled checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *CloudHashRangeAccessorMock) CheckMocksCalled() {
+	m.Finish()
+}
+
+//Finish checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish or use Finish method of minimock.Controller
+func (m *CloudHashRangeAccessorMock) Finish() {
+	m.MinimockFinish()
+}
+
+//MinimockFinish checks that all mocked methods of the interface have been called at least once
+func (m *CloudHashRangeAccessorMock) MinimockFinish() {
+
+	if !m.ForRangeFinished() {
+		m.t.Fatal("Expected call to CloudHashRangeAccessorMock.ForRange")
+	}
+
+}
+
+//Wait waits for all mocked methods to be called at least once
+//Deprecated: please use MinimockWait or use Wait method of minimock.Controller
+func (m *CloudHashRangeAccessorMock) Wait(timeout time.Duration) {
+	m.MinimockWait(timeout)
+}
+
+//MinimockWait waits for all mocked methods to be called at least once
+//this method is called by minimock.Controller
+func (m *CloudHashRangeAccessorMock) MinimockWait(timeout time.Duration) {
+	timeoutCh := time.After(timeout)
+	for {
+		ok := true
+		ok = ok && m.ForRangeFinished()
+
+		if ok {
+			return
+		}
+
+		select {
+		case <-timeoutCh:
+
+			if !m.ForRangeFinished() {
+				m.t.Error("Expected call to CloudHashRangeAccessorMock.ForRange")
+			}
+
+			m.t.Fatalf("Some mocks were not called on time: %s", timeout)
+			return
+		default:
+			time.Sleep(time.Millisecond)
+		}
+	}
+}
+
+//AllMocksCalled returns true if all mocked methods were called before the execution of AllMocksCalled,
+//it can be used with assert/require, i.e. assert.True(mock.AllMocksCalled())
+func (m *CloudHashRangeAccessorMock) AllMocksCalled() bool {
+
+	if !m.ForRangeFinished() {
+		return false
+	}
+
+	return true
+}
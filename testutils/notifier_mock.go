@@ -0,0 +1,534 @@
+package testutils
+
+/*
+DO NOT EDIT!
+This code was generated automatically using github.com/gojuno/minimock v1.9
+The original interface "Notifier" can be found in github.com/insolar/insolar/insolar
+*/
+import (
+	context "context"
+	"sync/atomic"
+	"time"
+
+	"github.com/gojuno/minimock"
+	insolar "github.com/insolar/insolar/insolar"
+
+	testify_assert "github.com/stretchr/testify/assert"
+)
+
+//NotifierMock implements github.com/insolar/insolar/insolar.Notifier
+type NotifierMock struct {
+	t minimock.Tester
+
+	NotifyNetworkStateFunc       func(p context.Context, p1 insolar.NetworkState)
+	NotifyNetworkStateCounter    uint64
+	NotifyNetworkStatePreCounter uint64
+	NotifyNetworkStateMock       mNotifierMockNotifyNetworkState
+
+	NotifyObjectStateFunc       func(p context.Context, p1 insolar.ID, p2 insolar.ID)
+	NotifyObjectStateCounter    uint64
+	NotifyObjectStatePreCounter uint64
+	NotifyObjectStateMock       mNotifierMockNotifyObjectState
+
+	NotifyPulseFunc       func(p context.Context, p1 insolar.Pulse)
+	NotifyPulseCounter    uint64
+	NotifyPulsePreCounter uint64
+	NotifyPulseMock       mNotifierMockNotifyPulse
+}
+
+//NewNotifierMock returns a mock for github.com/insolar/insolar/insolar.Notifier
+func NewNotifierMock(t minimock.Tester) *NotifierMock {
+	m := &NotifierMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.NotifyNetworkStateMock = mNotifierMockNotifyNetworkState{mock: m}
+	m.NotifyObjectStateMock = mNotifierMockNotifyObjectState{mock: m}
+	m.NotifyPulseMock = mNotifierMockNotifyPulse{mock: m}
+
+	return m
+}
+
+type mNotifierMockNotifyNetworkState struct {
+	mock              *NotifierMock
+	mainExpectation   *NotifierMockNotifyNetworkStateExpectation
+	expectationSeries []*NotifierMockNotifyNetworkStateExpectation
+}
+
+type NotifierMockNotifyNetworkStateExpectation struct {
+	input *NotifierMockNotifyNetworkStateInput
+}
+
+type NotifierMockNotifyNetworkStateInput struct {
+	p  context.Context
+	p1 insolar.NetworkState
+}
+
+//Expect specifies that invocation of Notifier.NotifyNetworkState is expected from 1 to Infinity times
+func (m *mNotifierMockNotifyNetworkState) Expect(p context.Context, p1 insolar.NetworkState) *mNotifierMockNotifyNetworkState {
+	m.mock.NotifyNetworkStateFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &NotifierMockNotifyNetworkStateExpectation{}
+	}
+	m.mainExpectation.input = &NotifierMockNotifyNetworkStateInput{p, p1}
+	return m
+}
+
+//Return specifies results of invocation of Notifier.NotifyNetworkState
+func (m *mNotifierMockNotifyNetworkState) Return() *NotifierMock {
+	m.mock.NotifyNetworkStateFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &NotifierMockNotifyNetworkStateExpectation{}
+	}
+
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of Notifier.NotifyNetworkState is expected once
+func (m *mNotifierMockNotifyNetworkState) ExpectOnce(p context.Context, p1 insolar.NetworkState) *NotifierMockNotifyNetworkStateExpectation {
+	m.mock.NotifyNetworkStateFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &NotifierMockNotifyNetworkStateExpectation{}
+	expectation.input = &NotifierMockNotifyNetworkStateInput{p, p1}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+//Set uses given function f as a mock of Notifier.NotifyNetworkState method
+func (m *mNotifierMockNotifyNetworkState) Set(f func(p context.Context, p1 insolar.NetworkState)) *NotifierMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.NotifyNetworkStateFunc = f
+	return m.mock
+}
+
+//NotifyNetworkState implements github.com/insolar/insolar/insolar.Notifier interface
+func (m *NotifierMock) NotifyNetworkState(p context.Context, p1 insolar.NetworkState) {
+	counter := atomic.AddUint64(&m.NotifyNetworkStatePreCounter, 1)
+	defer atomic.AddUint64(&m.NotifyNetworkStateCounter, 1)
+
+	if len(m.NotifyNetworkStateMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.NotifyNetworkStateMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to NotifierMock.NotifyNetworkState. %v %v", p, p1)
+			return
+		}
+
+		input := m.NotifyNetworkStateMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, NotifierMockNotifyNetworkStateInput{p, p1}, "Notifier.NotifyNetworkState got unexpected parameters")
+
+		return
+	}
+
+	if m.NotifyNetworkStateMock.mainExpectation != nil {
+
+		input := m.NotifyNetworkStateMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, NotifierMockNotifyNetworkStateInput{p, p1}, "Notifier.NotifyNetworkState got unexpected parameters")
+		}
+
+		return
+	}
+
+	if m.NotifyNetworkStateFunc == nil {
+		m.t.Fatalf("Unexpected call to NotifierMock.NotifyNetworkState. %v %v", p, p1)
+		return
+	}
+
+	m.NotifyNetworkStateFunc(p, p1)
+}
+
+//NotifyNetworkStateMinimockCounter returns a count of NotifierMock.NotifyNetworkStateFunc invocations
+func (m *NotifierMock) NotifyNetworkStateMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.NotifyNetworkStateCounter)
+}
+
+//NotifyNetworkStateMinimockPreCounter returns the value of NotifierMock.NotifyNetworkState invocations
+func (m *NotifierMock) NotifyNetworkStateMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.NotifyNetworkStatePreCounter)
+}
+
+//NotifyNetworkStateFinished returns true if mock invocations count is ok
+func (m *NotifierMock) NotifyNetworkStateFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.NotifyNetworkStateMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.NotifyNetworkStateCounter) == uint64(len(m.NotifyNetworkStateMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.NotifyNetworkStateMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.NotifyNetworkStateCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.NotifyNetworkStateFunc != nil {
+		return atomic.LoadUint64(&m.NotifyNetworkStateCounter) > 0
+	}
+
+	return true
+}
+
+type mNotifierMockNotifyObjectState struct {
+	mock              *NotifierMock
+	mainExpectation   *NotifierMockNotifyObjectStateExpectation
+	expectationSeries []*NotifierMockNotifyObjectStateExpectation
+}
+
+type NotifierMockNotifyObjectStateExpectation struct {
+	input *NotifierMockNotifyObjectStateInput
+}
+
+type NotifierMockNotifyObjectStateInput struct {
+	p  context.Context
+	p1 insolar.ID
+	p2 insolar.ID
+}
+
+//Expect specifies that invocation of Notifier.NotifyObjectState is expected from 1 to Infinity times
+func (m *mNotifierMockNotifyObjectState) Expect(p context.Context, p1 insolar.ID, p2 insolar.ID) *mNotifierMockNotifyObjectState {
+	m.mock.NotifyObjectStateFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &NotifierMockNotifyObjectStateExpectation{}
+	}
+	m.mainExpectation.input = &NotifierMockNotifyObjectStateInput{p, p1, p2}
+	return m
+}
+
+//Return specifies results of invocation of Notifier.NotifyObjectState
+func (m *mNotifierMockNotifyObjectState) Return() *NotifierMock {
+	m.mock.NotifyObjectStateFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &NotifierMockNotifyObjectStateExpectation{}
+	}
+
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of Notifier.NotifyObjectState is expected once
+func (m *mNotifierMockNotifyObjectState) ExpectOnce(p context.Context, p1 insolar.ID, p2 insolar.ID) *NotifierMockNotifyObjectStateExpectation {
+	m.mock.NotifyObjectStateFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &NotifierMockNotifyObjectStateExpectation{}
+	expectation.input = &NotifierMockNotifyObjectStateInput{p, p1, p2}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+//Set uses given function f as a mock of Notifier.NotifyObjectState method
+func (m *mNotifierMockNotifyObjectState) Set(f func(p context.Context, p1 insolar.ID, p2 insolar.ID)) *NotifierMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.NotifyObjectStateFunc = f
+	return m.mock
+}
+
+//NotifyObjectState implements github.com/insolar/insolar/insolar.Notifier interface
+func (m *NotifierMock) NotifyObjectState(p context.Context, p1 insolar.ID, p2 insolar.ID) {
+	counter := atomic.AddUint64(&m.NotifyObjectStatePreCounter, 1)
+	defer atomic.AddUint64(&m.NotifyObjectStateCounter, 1)
+
+	if len(m.NotifyObjectStateMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.NotifyObjectStateMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to NotifierMock.NotifyObjectState. %v %v %v", p, p1, p2)
+			return
+		}
+
+		input := m.NotifyObjectStateMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, NotifierMockNotifyObjectStateInput{p, p1, p2}, "Notifier.NotifyObjectState got unexpected parameters")
+
+		return
+	}
+
+	if m.NotifyObjectStateMock.mainExpectation != nil {
+
+		input := m.NotifyObjectStateMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, NotifierMockNotifyObjectStateInput{p, p1, p2}, "Notifier.NotifyObjectState got unexpected parameters")
+		}
+
+		return
+	}
+
+	if m.NotifyObjectStateFunc == nil {
+		m.t.Fatalf("Unexpected call to NotifierMock.NotifyObjectState. %v %v %v", p, p1, p2)
+		return
+	}
+
+	m.NotifyObjectStateFunc(p, p1, p2)
+}
+
+//NotifyObjectStateMinimockCounter returns a count of NotifierMock.NotifyObjectStateFunc invocations
+func (m *NotifierMock) NotifyObjectStateMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.NotifyObjectStateCounter)
+}
+
+//NotifyObjectStateMinimockPreCounter returns the value of NotifierMock.NotifyObjectState invocations
+func (m *NotifierMock) NotifyObjectStateMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.NotifyObjectStatePreCounter)
+}
+
+//NotifyObjectStateFinished returns true if mock invocations count is ok
+func (m *NotifierMock) NotifyObjectStateFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.NotifyObjectStateMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.NotifyObjectStateCounter) == uint64(len(m.NotifyObjectStateMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.NotifyObjectStateMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.NotifyObjectStateCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.NotifyObjectStateFunc != nil {
+		return atomic.LoadUint64(&m.NotifyObjectStateCounter) > 0
+	}
+
+	return true
+}
+
+type mNotifierMockNotifyPulse struct {
+	mock              *NotifierMock
+	mainExpectation   *NotifierMockNotifyPulseExpectation
+	expectationSeries []*NotifierMockNotifyPulseExpectation
+}
+
+type NotifierMockNotifyPulseExpectation struct {
+	input *NotifierMockNotifyPulseInput
+}
+
+type NotifierMockNotifyPulseInput struct {
+	p  context.Context
+	p1 insolar.Pulse
+}
+
+//Expect specifies that invocation of Notifier.NotifyPulse is expected from 1 to Infinity times
+func (m *mNotifierMockNotifyPulse) Expect(p context.Context, p1 insolar.Pulse) *mNotifierMockNotifyPulse {
+	m.mock.NotifyPulseFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &NotifierMockNotifyPulseExpectation{}
+	}
+	m.mainExpectation.input = &NotifierMockNotifyPulseInput{p, p1}
+	return m
+}
+
+//Return specifies results of invocation of Notifier.NotifyPulse
+func (m *mNotifierMockNotifyPulse) Return() *NotifierMock {
+	m.mock.NotifyPulseFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &NotifierMockNotifyPulseExpectation{}
+	}
+
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of Notifier.NotifyPulse is expected once
+func (m *mNotifierMockNotifyPulse) ExpectOnce(p context.Context, p1 insolar.Pulse) *NotifierMockNotifyPulseExpectation {
+	m.mock.NotifyPulseFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &NotifierMockNotifyPulseExpectation{}
+	expectation.input = &NotifierMockNotifyPulseInput{p, p1}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+//Set uses given function f as a mock of Notifier.NotifyPulse method
+func (m *mNotifierMockNotifyPulse) Set(f func(p context.Context, p1 insolar.Pulse)) *NotifierMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.NotifyPulseFunc = f
+	return m.mock
+}
+
+//NotifyPulse implements github.com/insolar/insolar/insolar.Notifier interface
+func (m *NotifierMock) NotifyPulse(p context.Context, p1 insolar.Pulse) {
+	counter := atomic.AddUint64(&m.NotifyPulsePreCounter, 1)
+	defer atomic.AddUint64(&m.NotifyPulseCounter, 1)
+
+	if len(m.NotifyPulseMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.NotifyPulseMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to NotifierMock.NotifyPulse. %v %v", p, p1)
+			return
+		}
+
+		input := m.NotifyPulseMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, NotifierMockNotifyPulseInput{p, p1}, "Notifier.NotifyPulse got unexpected parameters")
+
+		return
+	}
+
+	if m.NotifyPulseMock.mainExpectation != nil {
+
+		input := m.NotifyPulseMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, NotifierMockNotifyPulseInput{p, p1}, "Notifier.NotifyPulse got unexpected parameters")
+		}
+
+		return
+	}
+
+	if m.NotifyPulseFunc == nil {
+		m.t.Fatalf("Unexpected call to NotifierMock.NotifyPulse. %v %v", p, p1)
+		return
+	}
+
+	m.NotifyPulseFunc(p, p1)
+}
+
+//NotifyPulseMinimockCounter returns a count of NotifierMock.NotifyPulseFunc invocations
+func (m *NotifierMock) NotifyPulseMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.NotifyPulseCounter)
+}
+
+//NotifyPulseMinimockPreCounter returns the value of NotifierMock.NotifyPulse invocations
+func (m *NotifierMock) NotifyPulseMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.NotifyPulsePreCounter)
+}
+
+//NotifyPulseFinished returns true if mock invocations count is ok
+func (m *NotifierMock) NotifyPulseFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.NotifyPulseMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.NotifyPulseCounter) == uint64(len(m.NotifyPulseMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.NotifyPulseMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.NotifyPulseCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.NotifyPulseFunc != nil {
+		return atomic.LoadUint64(&m.NotifyPulseCounter) > 0
+	}
+
+	return true
+}
+
+//ValidateCallCounters checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *NotifierMock) ValidateCallCounters() {
+
+	if !m.NotifyNetworkStateFinished() {
+		m.t.Fatal("Expected call to NotifierMock.NotifyNetworkState")
+	}
+
+	if !m.NotifyObjectStateFinished() {
+		m.t.Fatal("Expected call to NotifierMock.NotifyObjectState")
+	}
+
+	if !m.NotifyPulseFinished() {
+		m.t.Fatal("Expected call to NotifierMock.NotifyPulse")
+	}
+
+}
+
+//CheckMocksCalled checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *NotifierMock) CheckMocksCalled() {
+	m.Finish()
+}
+
+//Finish checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish or use Finish method of minimock.Controller
+func (m *NotifierMock) Finish() {
+	m.MinimockFinish()
+}
+
+//MinimockFinish checks that all mocked methods of the interface have been called at least once
+func (m *NotifierMock) MinimockFinish() {
+
+	if !m.NotifyNetworkStateFinished() {
+		m.t.Fatal("Expected call to NotifierMock.NotifyNetworkState")
+	}
+
+	if !m.NotifyObjectStateFinished() {
+		m.t.Fatal("Expected call to NotifierMock.NotifyObjectState")
+	}
+
+	if !m.NotifyPulseFinished() {
+		m.t.Fatal("Expected call to NotifierMock.NotifyPulse")
+	}
+
+}
+
+//Wait waits for all mocked methods to be called at least once
+//Deprecated: please use MinimockWait or use Wait method of minimock.Controller
+func (m *NotifierMock) Wait(timeout time.Duration) {
+	m.MinimockWait(timeout)
+}
+
+//MinimockWait waits for all mocked methods to be called at least once
+//this method is called by minimock.Controller
+func (m *NotifierMock) MinimockWait(timeout time.Duration) {
+	timeoutCh := time.After(timeout)
+	for {
+		ok := true
+		ok = ok && m.NotifyNetworkStateFinished()
+		ok = ok && m.NotifyObjectStateFinished()
+		ok = ok && m.NotifyPulseFinished()
+
+		if ok {
+			return
+		}
+
+		select {
+		case <-timeoutCh:
+
+			if !m.NotifyNetworkStateFinished() {
+				m.t.Error("Expected call to NotifierMock.NotifyNetworkState")
+			}
+
+			if !m.NotifyObjectStateFinished() {
+				m.t.Error("Expected call to NotifierMock.NotifyObjectState")
+			}
+
+			if !m.NotifyPulseFinished() {
+				m.t.Error("Expected call to NotifierMock.NotifyPulse")
+			}
+
+			m.t.Fatalf("Some mocks were not called on time: %s", timeout)
+			return
+		default:
+			time.Sleep(time.Millisecond)
+		}
+	}
+}
+
+//AllMocksCalled returns true if all mocked methods were called before the execution of AllMocksCalled,
+//it can be used with assert/require, i.e. assert.True(mock.AllMocksCalled())
+func (m *NotifierMock) AllMocksCalled() bool {
+
+	if !m.NotifyNetworkStateFinished() {
+		return false
+	}
+
+	if !m.NotifyObjectStateFinished() {
+		return false
+	}
+
+	if !m.NotifyPulseFinished() {
+		return false
+	}
+
+	return true
+}
@@ -20,6 +20,16 @@ import (
 type PulseManagerMock struct {
 	t minimock.Tester
 
+	CancelFunc       func(p context.Context)
+	CancelCounter    uint64
+	CancelPreCounter uint64
+	CancelMock       mPulseManagerMockCancel
+
+	PrepareFunc       func(p context.Context, p1 insolar.PulseNumber) (r error)
+	PrepareCounter    uint64
+	PreparePreCounter uint64
+	PrepareMock       mPulseManagerMockPrepare
+
 	SetFunc       func(p context.Context, p1 insolar.Pulse) (r error)
 	SetCounter    uint64
 	SetPreCounter uint64
@@ -34,11 +44,284 @@ func NewPulseManagerMock(t minimock.Tester) *PulseManagerMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.CancelMock = mPulseManagerMockCancel{mock: m}
+	m.PrepareMock = mPulseManagerMockPrepare{mock: m}
 	m.SetMock = mPulseManagerMockSet{mock: m}
 
 	return m
 }
 
+type mPulseManagerMockCancel struct {
+	mock              *PulseManagerMock
+	mainExpectation   *PulseManagerMockCancelExpectation
+	expectationSeries []*PulseManagerMockCancelExpectation
+}
+
+type PulseManagerMockCancelExpectation struct {
+	input *PulseManagerMockCancelInput
+}
+
+type PulseManagerMockCancelInput struct {
+	p context.Context
+}
+
+//Expect specifies that invocation of PulseManager.Cancel is expected from 1 to Infinity times
+func (m *mPulseManagerMockCancel) Expect(p context.Context) *mPulseManagerMockCancel {
+	m.mock.CancelFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &PulseManagerMockCancelExpectation{}
+	}
+	m.mainExpectation.input = &PulseManagerMockCancelInput{p}
+	return m
+}
+
+//Return specifies results of invocation of PulseManager.Cancel
+func (m *mPulseManagerMockCancel) Return() *PulseManagerMock {
+	m.mock.CancelFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &PulseManagerMockCancelExpectation{}
+	}
+
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of PulseManager.Cancel is expected once
+func (m *mPulseManagerMockCancel) ExpectOnce(p context.Context) *PulseManagerMockCancelExpectation {
+	m.mock.CancelFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &PulseManagerMockCancelExpectation{}
+	expectation.input = &PulseManagerMockCancelInput{p}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+//Set uses given function f as a mock of PulseManager.Cancel method
+func (m *mPulseManagerMockCancel) Set(f func(p context.Context)) *PulseManagerMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.CancelFunc = f
+	return m.mock
+}
+
+//Cancel implements github.com/insolar/insolar/insolar.PulseManager interface
+func (m *PulseManagerMock) Cancel(p context.Context) {
+	counter := atomic.AddUint64(&m.CancelPreCounter, 1)
+	defer atomic.AddUint64(&m.CancelCounter, 1)
+
+	if len(m.CancelMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.CancelMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to PulseManagerMock.Cancel. %v", p)
+			return
+		}
+
+		input := m.CancelMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, PulseManagerMockCancelInput{p}, "PulseManager.Cancel got unexpected parameters")
+
+		return
+	}
+
+	if m.CancelMock.mainExpectation != nil {
+
+		input := m.CancelMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, PulseManagerMockCancelInput{p}, "PulseManager.Cancel got unexpected parameters")
+		}
+
+		return
+	}
+
+	if m.CancelFunc == nil {
+		m.t.Fatalf("Unexpected call to PulseManagerMock.Cancel. %v", p)
+		return
+	}
+
+	m.CancelFunc(p)
+}
+
+//CancelMinimockCounter returns a count of PulseManagerMock.CancelFunc invocations
+func (m *PulseManagerMock) CancelMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.CancelCounter)
+}
+
+//CancelMinimockPreCounter returns the value of PulseManagerMock.Cancel invocations
+func (m *PulseManagerMock) CancelMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.CancelPreCounter)
+}
+
+//CancelFinished returns true if mock invocations count is ok
+func (m *PulseManagerMock) CancelFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.CancelMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.CancelCounter) == uint64(len(m.CancelMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.CancelMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.CancelCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.CancelFunc != nil {
+		return atomic.LoadUint64(&m.CancelCounter) > 0
+	}
+
+	return true
+}
+
+type mPulseManagerMockPrepare struct {
+	mock              *PulseManagerMock
+	mainExpectation   *PulseManagerMockPrepareExpectation
+	expectationSeries []*PulseManagerMockPrepareExpectation
+}
+
+type PulseManagerMockPrepareExpectation struct {
+	input  *PulseManagerMockPrepareInput
+	result *PulseManagerMockPrepareResult
+}
+
+type PulseManagerMockPrepareInput struct {
+	p  context.Context
+	p1 insolar.PulseNumber
+}
+
+type PulseManagerMockPrepareResult struct {
+	r error
+}
+
+//Expect specifies that invocation of PulseManager.Prepare is expected from 1 to Infinity times
+func (m *mPulseManagerMockPrepare) Expect(p context.Context, p1 insolar.PulseNumber) *mPulseManagerMockPrepare {
+	m.mock.PrepareFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &PulseManagerMockPrepareExpectation{}
+	}
+	m.mainExpectation.input = &PulseManagerMockPrepareInput{p, p1}
+	return m
+}
+
+//Return specifies results of invocation of PulseManager.Prepare
+func (m *mPulseManagerMockPrepare) Return(r error) *PulseManagerMock {
+	m.mock.PrepareFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &PulseManagerMockPrepareExpectation{}
+	}
+	m.mainExpectation.result = &PulseManagerMockPrepareResult{r}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of PulseManager.Prepare is expected once
+func (m *mPulseManagerMockPrepare) ExpectOnce(p context.Context, p1 insolar.PulseNumber) *PulseManagerMockPrepareExpectation {
+	m.mock.PrepareFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &PulseManagerMockPrepareExpectation{}
+	expectation.input = &PulseManagerMockPrepareInput{p, p1}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *PulseManagerMockPrepareExpectation) Return(r error) {
+	e.result = &PulseManagerMockPrepareResult{r}
+}
+
+//Set uses given function f as a mock of PulseManager.Prepare method
+func (m *mPulseManagerMockPrepare) Set(f func(p context.Context, p1 insolar.PulseNumber) (r error)) *PulseManagerMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.PrepareFunc = f
+	return m.mock
+}
+
+//Prepare implements github.com/insolar/insolar/insolar.PulseManager interface
+func (m *PulseManagerMock) Prepare(p context.Context, p1 insolar.PulseNumber) (r error) {
+	counter := atomic.AddUint64(&m.PreparePreCounter, 1)
+	defer atomic.AddUint64(&m.PrepareCounter, 1)
+
+	if len(m.PrepareMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.PrepareMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to PulseManagerMock.Prepare. %v %v", p, p1)
+			return
+		}
+
+		input := m.PrepareMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, PulseManagerMockPrepareInput{p, p1}, "PulseManager.Prepare got unexpected parameters")
+
+		result := m.PrepareMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the PulseManagerMock.Prepare")
+			return
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.PrepareMock.mainExpectation != nil {
+
+		input := m.PrepareMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, PulseManagerMockPrepareInput{p, p1}, "PulseManager.Prepare got unexpected parameters")
+		}
+
+		result := m.PrepareMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the PulseManagerMock.Prepare")
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.PrepareFunc == nil {
+		m.t.Fatalf("Unexpected call to PulseManagerMock.Prepare. %v %v", p, p1)
+		return
+	}
+
+	return m.PrepareFunc(p, p1)
+}
+
+//PrepareMinimockCounter returns a count of PulseManagerMock.PrepareFunc invocations
+func (m *PulseManagerMock) PrepareMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.PrepareCounter)
+}
+
+//PrepareMinimockPreCounter returns the value of PulseManagerMock.Prepare invocations
+func (m *PulseManagerMock) PrepareMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.PreparePreCounter)
+}
+
+//PrepareFinished returns true if mock invocations count is ok
+func (m *PulseManagerMock) PrepareFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.PrepareMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.PrepareCounter) == uint64(len(m.PrepareMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.PrepareMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.PrepareCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.PrepareFunc != nil {
+		return atomic.LoadUint64(&m.PrepareCounter) > 0
+	}
+
+	return true
+}
+
 type mPulseManagerMockSet struct {
 	mock              *PulseManagerMock
 	mainExpectation   *PulseManagerMockSetExpectation
@@ -191,6 +474,14 @@ func (m *PulseManagerMock) SetFinished() bool {
 //Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
 func (m *PulseManagerMock) ValidateCallCounters() {
 
+	if !m.CancelFinished() {
+		m.t.Fatal("Expected call to PulseManagerMock.Cancel")
+	}
+
+	if !m.PrepareFinished() {
+		m.t.Fatal("Expected call to PulseManagerMock.Prepare")
+	}
+
 	if !m.SetFinished() {
 		m.t.Fatal("Expected call to PulseManagerMock.Set")
 	}
@@ -212,6 +503,14 @@ func (m *PulseManagerMock) Finish() {
 //MinimockFinish checks that all mocked methods of the interface have been called at least once
 func (m *PulseManagerMock) MinimockFinish() {
 
+	if !m.CancelFinished() {
+		m.t.Fatal("Expected call to PulseManagerMock.Cancel")
+	}
+
+	if !m.PrepareFinished() {
+		m.t.Fatal("Expected call to PulseManagerMock.Prepare")
+	}
+
 	if !m.SetFinished() {
 		m.t.Fatal("Expected call to PulseManagerMock.Set")
 	}
@@ -230,6 +529,8 @@ func (m *PulseManagerMock) MinimockWait(timeout time.Duration) {
 	timeoutCh := time.After(timeout)
 	for {
 		ok := true
+		ok = ok && m.CancelFinished()
+		ok = ok && m.PrepareFinished()
 		ok = ok && m.SetFinished()
 
 		if ok {
@@ -239,6 +540,14 @@ func (m *PulseManagerMock) MinimockWait(timeout time.Duration) {
 		select {
 		case <-timeoutCh:
 
+			if !m.CancelFinished() {
+				m.t.Error("Expected call to PulseManagerMock.Cancel")
+			}
+
+			if !m.PrepareFinished() {
+				m.t.Error("Expected call to PulseManagerMock.Prepare")
+			}
+
 			if !m.SetFinished() {
 				m.t.Error("Expected call to PulseManagerMock.Set")
 			}
@@ -255,6 +564,14 @@ func (m *PulseManagerMock) MinimockWait(timeout time.Duration) {
 //it can be used with assert/require, i.e. assert.True(mock.AllMocksCalled())
 func (m *PulseManagerMock) AllMocksCalled() bool {
 
+	if !m.CancelFinished() {
+		return false
+	}
+
+	if !m.PrepareFinished() {
+		return false
+	}
+
 	if !m.SetFinished() {
 		return false
 	}
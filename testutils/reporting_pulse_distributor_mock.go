@@ -0,0 +1,410 @@
+package testutils
+
+/*
+DO NOT EDIT!
+This code was generated automatically using github.com/gojuno/minimock v1.9
+The original interface "ReportingPulseDistributor" can be found in github.com/insolar/insolar/insolar
+*/
+import (
+	context "context"
+	"sync/atomic"
+	"time"
+
+	"github.com/gojuno/minimock"
+	insolar "github.com/insolar/insolar/insolar"
+
+	testify_assert "github.com/stretchr/testify/assert"
+)
+
+//ReportingPulseDistributorMock implements github.com/insolar/insolar/insolar.ReportingPulseDistributor
+type ReportingPulseDistributorMock struct {
+	t minimock.Tester
+
+	DistributeFunc       func(p context.Context, p1 insolar.Pulse)
+	DistributeCounter    uint64
+	DistributePreCounter uint64
+	DistributeMock       mReportingPulseDistributorMockDistribute
+
+	DistributeWithReportFunc       func(p context.Context, p1 insolar.Pulse) (r insolar.PulseDeliveryReport)
+	DistributeWithReportCounter    uint64
+	DistributeWithReportPreCounter uint64
+	DistributeWithReportMock       mReportingPulseDistributorMockDistributeWithReport
+}
+
+//NewReportingPulseDistributorMock returns a mock for github.com/insolar/insolar/insolar.ReportingPulseDistributor
+func NewReportingPulseDistributorMock(t minimock.Tester) *ReportingPulseDistributorMock {
+	m := &ReportingPulseDistributorMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.DistributeMock = mReportingPulseDistributorMockDistribute{mock: m}
+	m.DistributeWithReportMock = mReportingPulseDistributorMockDistributeWithReport{mock: m}
+
+	return m
+}
+
+type mReportingPulseDistributorMockDistribute struct {
+	mock              *ReportingPulseDistributorMock
+	mainExpectation   *ReportingPulseDistributorMockDistributeExpectation
+	expectationSeries []*ReportingPulseDistributorMockDistributeExpectation
+}
+
+type ReportingPulseDistributorMockDistributeExpectation struct {
+	input *ReportingPulseDistributorMockDistributeInput
+}
+
+type ReportingPulseDistributorMockDistributeInput struct {
+	p  context.Context
+	p1 insolar.Pulse
+}
+
+//Expect specifies that invocation of ReportingPulseDistributor.Distribute is expected from 1 to Infinity times
+func (m *mReportingPulseDistributorMockDistribute) Expect(p context.Context, p1 insolar.Pulse) *mReportingPulseDistributorMockDistribute {
+	m.mock.DistributeFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ReportingPulseDistributorMockDistributeExpectation{}
+	}
+	m.mainExpectation.input = &ReportingPulseDistributorMockDistributeInput{p, p1}
+	return m
+}
+
+//Return specifies results of invocation of ReportingPulseDistributor.Distribute
+func (m *mReportingPulseDistributorMockDistribute) Return() *ReportingPulseDistributorMock {
+	m.mock.DistributeFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ReportingPulseDistributorMockDistributeExpectation{}
+	}
+
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of ReportingPulseDistributor.Distribute is expected once
+func (m *mReportingPulseDistributorMockDistribute) ExpectOnce(p context.Context, p1 insolar.Pulse) *ReportingPulseDistributorMockDistributeExpectation {
+	m.mock.DistributeFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &ReportingPulseDistributorMockDistributeExpectation{}
+	expectation.input = &ReportingPulseDistributorMockDistributeInput{p, p1}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+//Set uses given function f as a mock of ReportingPulseDistributor.Distribute method
+func (m *mReportingPulseDistributorMockDistribute) Set(f func(p context.Context, p1 insolar.Pulse)) *ReportingPulseDistributorMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.DistributeFunc = f
+	return m.mock
+}
+
+//Distribute implements github.com/insolar/insolar/insolar.ReportingPulseDistributor interface
+func (m *ReportingPulseDistributorMock) Distribute(p context.Context, p1 insolar.Pulse) {
+	counter := atomic.AddUint64(&m.DistributePreCounter, 1)
+	defer atomic.AddUint64(&m.DistributeCounter, 1)
+
+	if len(m.DistributeMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.DistributeMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to ReportingPulseDistributorMock.Distribute. %v %v", p, p1)
+			return
+		}
+
+		input := m.DistributeMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, ReportingPulseDistributorMockDistributeInput{p, p1}, "ReportingPulseDistributor.Distribute got unexpected parameters")
+
+		return
+	}
+
+	if m.DistributeMock.mainExpectation != nil {
+
+		input := m.DistributeMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, ReportingPulseDistributorMockDistributeInput{p, p1}, "ReportingPulseDistributor.Distribute got unexpected parameters")
+		}
+
+		return
+	}
+
+	if m.DistributeFunc == nil {
+		m.t.Fatalf("Unexpected call to ReportingPulseDistributorMock.Distribute. %v %v", p, p1)
+		return
+	}
+
+	m.DistributeFunc(p, p1)
+}
+
+//DistributeMinimockCounter returns a count of ReportingPulseDistributorMock.DistributeFunc invocations
+func (m *ReportingPulseDistributorMock) DistributeMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.DistributeCounter)
+}
+
+//DistributeMinimockPreCounter returns the value of ReportingPulseDistributorMock.Distribute invocations
+func (m *ReportingPulseDistributorMock) DistributeMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.DistributePreCounter)
+}
+
+//DistributeFinished returns true if mock invocations count is ok
+func (m *ReportingPulseDistributorMock) DistributeFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.DistributeMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.DistributeCounter) == uint64(len(m.DistributeMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.DistributeMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.DistributeCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.DistributeFunc != nil {
+		return atomic.LoadUint64(&m.DistributeCounter) > 0
+	}
+
+	return true
+}
+
+type mReportingPulseDistributorMockDistributeWithReport struct {
+	mock              *ReportingPulseDistributorMock
+	mainExpectation   *ReportingPulseDistributorMockDistributeWithReportExpectation
+	expectationSeries []*ReportingPulseDistributorMockDistributeWithReportExpectation
+}
+
+type ReportingPulseDistributorMockDistributeWithReportExpectation struct {
+	input  *ReportingPulseDistributorMockDistributeWithReportInput
+	result *ReportingPulseDistributorMockDistributeWithReportResult
+}
+
+type ReportingPulseDistributorMockDistributeWithReportInput struct {
+	p  context.Context
+	p1 insolar.Pulse
+}
+
+type ReportingPulseDistributorMockDistributeWithReportResult struct {
+	r insolar.PulseDeliveryReport
+}
+
+//Expect specifies that invocation of ReportingPulseDistributor.DistributeWithReport is expected from 1 to Infinity times
+func (m *mReportingPulseDistributorMockDistributeWithReport) Expect(p context.Context, p1 insolar.Pulse) *mReportingPulseDistributorMockDistributeWithReport {
+	m.mock.DistributeWithReportFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ReportingPulseDistributorMockDistributeWithReportExpectation{}
+	}
+	m.mainExpectation.input = &ReportingPulseDistributorMockDistributeWithReportInput{p, p1}
+	return m
+}
+
+//Return specifies results of invocation of ReportingPulseDistributor.DistributeWithReport
+func (m *mReportingPulseDistributorMockDistributeWithReport) Return(r insolar.PulseDeliveryReport) *ReportingPulseDistributorMock {
+	m.mock.DistributeWithReportFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ReportingPulseDistributorMockDistributeWithReportExpectation{}
+	}
+	m.mainExpectation.result = &ReportingPulseDistributorMockDistributeWithReportResult{r}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of ReportingPulseDistributor.DistributeWithReport is expected once
+func (m *mReportingPulseDistributorMockDistributeWithReport) ExpectOnce(p context.Context, p1 insolar.Pulse) *ReportingPulseDistributorMockDistributeWithReportExpectation {
+	m.mock.DistributeWithReportFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &ReportingPulseDistributorMockDistributeWithReportExpectation{}
+	expectation.input = &ReportingPulseDistributorMockDistributeWithReportInput{p, p1}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *ReportingPulseDistributorMockDistributeWithReportExpectation) Return(r insolar.PulseDeliveryReport) {
+	e.result = &ReportingPulseDistributorMockDistributeWithReportResult{r}
+}
+
+//Set uses given function f as a mock of ReportingPulseDistributor.DistributeWithReport method
+func (m *mReportingPulseDistributorMockDistributeWithReport) Set(f func(p context.Context, p1 insolar.Pulse) (r insolar.PulseDeliveryReport)) *ReportingPulseDistributorMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.DistributeWithReportFunc = f
+	return m.mock
+}
+
+//DistributeWithReport implements github.com/insolar/insolar/insolar.ReportingPulseDistributor interface
+func (m *ReportingPulseDistributorMock) DistributeWithReport(p context.Context, p1 insolar.Pulse) (r insolar.PulseDeliveryReport) {
+	counter := atomic.AddUint64(&m.DistributeWithReportPreCounter, 1)
+	defer atomic.AddUint64(&m.DistributeWithReportCounter, 1)
+
+	if len(m.DistributeWithReportMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.DistributeWithReportMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to ReportingPulseDistributorMock.DistributeWithReport. %v %v", p, p1)
+			return
+		}
+
+		input := m.DistributeWithReportMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, ReportingPulseDistributorMockDistributeWithReportInput{p, p1}, "ReportingPulseDistributor.DistributeWithReport got unexpected parameters")
+
+		result := m.DistributeWithReportMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the ReportingPulseDistributorMock.DistributeWithReport")
+			return
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.DistributeWithReportMock.mainExpectation != nil {
+
+		input := m.DistributeWithReportMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, ReportingPulseDistributorMockDistributeWithReportInput{p, p1}, "ReportingPulseDistributor.DistributeWithReport got unexpected parameters")
+		}
+
+		result := m.DistributeWithReportMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the ReportingPulseDistributorMock.DistributeWithReport")
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.DistributeWithReportFunc == nil {
+		m.t.Fatalf("Unexpected call to ReportingPulseDistributorMock.DistributeWithReport. %v %v", p, p1)
+		return
+	}
+
+	return m.DistributeWithReportFunc(p, p1)
+}
+
+//DistributeWithReportMinimockCounter returns a count of ReportingPulseDistributorMock.DistributeWithReportFunc invocations
+func (m *ReportingPulseDistributorMock) DistributeWithReportMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.DistributeWithReportCounter)
+}
+
+//DistributeWithReportMinimockPreCounter returns the value of ReportingPulseDistributorMock.DistributeWithReport invocations
+func (m *ReportingPulseDistributorMock) DistributeWithReportMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.DistributeWithReportPreCounter)
+}
+
+//DistributeWithReportFinished returns true if mock invocations count is ok
+func (m *ReportingPulseDistributorMock) DistributeWithReportFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.DistributeWithReportMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.DistributeWithReportCounter) == uint64(len(m.DistributeWithReportMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.DistributeWithReportMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.DistributeWithReportCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.DistributeWithReportFunc != nil {
+		return atomic.LoadUint64(&m.DistributeWithReportCounter) > 0
+	}
+
+	return true
+}
+
+//ValidateCallCounters checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *ReportingPulseDistributorMock) ValidateCallCounters() {
+
+	if !m.DistributeFinished() {
+		m.t.Fatal("Expected call to ReportingPulseDistributorMock.Distribute")
+	}
+
+	if !m.DistributeWithReportFinished() {
+		m.t.Fatal("Expected call to ReportingPulseDistributorMock.DistributeWithReport")
+	}
+
+}
+
+//CheckMocksCalled checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *ReportingPulseDistributorMock) CheckMocksCalled() {
+	m.Finish()
+}
+
+//Finish checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish or use Finish method of minimock.Controller
+func (m *ReportingPulseDistributorMock) Finish() {
+	m.MinimockFinish()
+}
+
+//MinimockFinish checks that all mocked methods of the interface have been called at least once
+func (m *ReportingPulseDistributorMock) MinimockFinish() {
+
+	if !m.DistributeFinished() {
+		m.t.Fatal("Expected call to ReportingPulseDistributorMock.Distribute")
+	}
+
+	if !m.DistributeWithReportFinished() {
+		m.t.Fatal("Expected call to ReportingPulseDistributorMock.DistributeWithReport")
+	}
+
+}
+
+//Wait waits for all mocked methods to be called at least once
+//Deprecated: please use MinimockWait or use Wait method of minimock.Controller
+func (m *ReportingPulseDistributorMock) Wait(timeout time.Duration) {
+	m.MinimockWait(timeout)
+}
+
+//MinimockWait waits for all mocked methods to be called at least once
+//this method is called by minimock.Controller
+func (m *ReportingPulseDistributorMock) MinimockWait(timeout time.Duration) {
+	timeoutCh := time.After(timeout)
+	for {
+		ok := true
+		ok = ok && m.DistributeFinished()
+		ok = ok && m.DistributeWithReportFinished()
+
+		if ok {
+			return
+		}
+
+		select {
+		case <-timeoutCh:
+
+			if !m.DistributeFinished() {
+				m.t.Error("Expected call to ReportingPulseDistributorMock.Distribute")
+			}
+
+			if !m.DistributeWithReportFinished() {
+				m.t.Error("Expected call to ReportingPulseDistributorMock.DistributeWithReport")
+			}
+
+			m.t.Fatalf("Some mocks were not called on time: %s", timeout)
+			return
+		default:
+			time.Sleep(time.Millisecond)
+		}
+	}
+}
+
+//AllMocksCalled returns true if all mocked methods were called before the execution of AllMocksCalled,
+//it can be used with assert/require, i.e. assert.True(mock.AllMocksCalled())
+func (m *ReportingPulseDistributorMock) AllMocksCalled() bool {
+
+	if !m.DistributeFinished() {
+		return false
+	}
+
+	if !m.DistributeWithReportFinished() {
+		return false
+	}
+
+	return true
+}
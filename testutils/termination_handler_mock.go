@@ -34,6 +34,16 @@ type TerminationHandlerMock struct {
 	OnLeaveApprovedCounter    uint64
 	OnLeaveApprovedPreCounter uint64
 	OnLeaveApprovedMock       mTerminationHandlerMockOnLeaveApproved
+
+	RequestLeaveFunc       func(p context.Context, p1 insolar.LeaveReason, p2 insolar.PulseNumber) (r <-chan insolar.LeaveApproved)
+	RequestLeaveCounter    uint64
+	RequestLeavePreCounter uint64
+	RequestLeaveMock       mTerminationHandlerMockRequestLeave
+
+	RequiredLeaveFunc       func(p context.Context) (r insolar.LeaveReason, r1 bool)
+	RequiredLeaveCounter    uint64
+	RequiredLeavePreCounter uint64
+	RequiredLeaveMock       mTerminationHandlerMockRequiredLeave
 }
 
 //NewTerminationHandlerMock returns a mock for github.com/insolar/insolar/insolar.TerminationHandler
@@ -47,6 +57,8 @@ func NewTerminationHandlerMock(t minimock.Tester) *TerminationHandlerMock {
 	m.AbortMock = mTerminationHandlerMockAbort{mock: m}
 	m.LeaveMock = mTerminationHandlerMockLeave{mock: m}
 	m.OnLeaveApprovedMock = mTerminationHandlerMockOnLeaveApproved{mock: m}
+	m.RequestLeaveMock = mTerminationHandlerMockRequestLeave{mock: m}
+	m.RequiredLeaveMock = mTerminationHandlerMockRequiredLeave{mock: m}
 
 	return m
 }
@@ -421,6 +433,305 @@ func (m *TerminationHandlerMock) OnLeaveApprovedFinished() bool {
 	return true
 }
 
+type mTerminationHandlerMockRequestLeave struct {
+	mock              *TerminationHandlerMock
+	mainExpectation   *TerminationHandlerMockRequestLeaveExpectation
+	expectationSeries []*TerminationHandlerMockRequestLeaveExpectation
+}
+
+type TerminationHandlerMockRequestLeaveExpectation struct {
+	input  *TerminationHandlerMockRequestLeaveInput
+	result *TerminationHandlerMockRequestLeaveResult
+}
+
+type TerminationHandlerMockRequestLeaveInput struct {
+	p  context.Context
+	p1 insolar.LeaveReason
+	p2 insolar.PulseNumber
+}
+
+type TerminationHandlerMockRequestLeaveResult struct {
+	r <-chan insolar.LeaveApproved
+}
+
+//Expect specifies that invocation of TerminationHandler.RequestLeave is expected from 1 to Infinity times
+func (m *mTerminationHandlerMockRequestLeave) Expect(p context.Context, p1 insolar.LeaveReason, p2 insolar.PulseNumber) *mTerminationHandlerMockRequestLeave {
+	m.mock.RequestLeaveFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &TerminationHandlerMockRequestLeaveExpectation{}
+	}
+	m.mainExpectation.input = &TerminationHandlerMockRequestLeaveInput{p, p1, p2}
+	return m
+}
+
+//Return specifies results of invocation of TerminationHandler.RequestLeave
+func (m *mTerminationHandlerMockRequestLeave) Return(r <-chan insolar.LeaveApproved) *TerminationHandlerMock {
+	m.mock.RequestLeaveFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &TerminationHandlerMockRequestLeaveExpectation{}
+	}
+	m.mainExpectation.result = &TerminationHandlerMockRequestLeaveResult{r}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of TerminationHandler.RequestLeave is expected once
+func (m *mTerminationHandlerMockRequestLeave) ExpectOnce(p context.Context, p1 insolar.LeaveReason, p2 insolar.PulseNumber) *TerminationHandlerMockRequestLeaveExpectation {
+	m.mock.RequestLeaveFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &TerminationHandlerMockRequestLeaveExpectation{}
+	expectation.input = &TerminationHandlerMockRequestLeaveInput{p, p1, p2}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *TerminationHandlerMockRequestLeaveExpectation) Return(r <-chan insolar.LeaveApproved) {
+	e.result = &TerminationHandlerMockRequestLeaveResult{r}
+}
+
+//Set uses given function f as a mock of TerminationHandler.RequestLeave method
+func (m *mTerminationHandlerMockRequestLeave) Set(f func(p context.Context, p1 insolar.LeaveReason, p2 insolar.PulseNumber) (r <-chan insolar.LeaveApproved)) *TerminationHandlerMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.RequestLeaveFunc = f
+	return m.mock
+}
+
+//RequestLeave implements github.com/insolar/insolar/insolar.TerminationHandler interface
+func (m *TerminationHandlerMock) RequestLeave(p context.Context, p1 insolar.LeaveReason, p2 insolar.PulseNumber) (r <-chan insolar.LeaveApproved) {
+	counter := atomic.AddUint64(&m.RequestLeavePreCounter, 1)
+	defer atomic.AddUint64(&m.RequestLeaveCounter, 1)
+
+	if len(m.RequestLeaveMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.RequestLeaveMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to TerminationHandlerMock.RequestLeave. %v %v %v", p, p1, p2)
+			return
+		}
+
+		input := m.RequestLeaveMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, TerminationHandlerMockRequestLeaveInput{p, p1, p2}, "TerminationHandler.RequestLeave got unexpected parameters")
+
+		result := m.RequestLeaveMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the TerminationHandlerMock.RequestLeave")
+			return
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.RequestLeaveMock.mainExpectation != nil {
+
+		input := m.RequestLeaveMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, TerminationHandlerMockRequestLeaveInput{p, p1, p2}, "TerminationHandler.RequestLeave got unexpected parameters")
+		}
+
+		result := m.RequestLeaveMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the TerminationHandlerMock.RequestLeave")
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.RequestLeaveFunc == nil {
+		m.t.Fatalf("Unexpected call to TerminationHandlerMock.RequestLeave. %v %v %v", p, p1, p2)
+		return
+	}
+
+	return m.RequestLeaveFunc(p, p1, p2)
+}
+
+//RequestLeaveMinimockCounter returns a count of TerminationHandlerMock.RequestLeaveFunc invocations
+func (m *TerminationHandlerMock) RequestLeaveMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.RequestLeaveCounter)
+}
+
+//RequestLeaveMinimockPreCounter returns the value of TerminationHandlerMock.RequestLeave invocations
+func (m *TerminationHandlerMock) RequestLeaveMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.RequestLeavePreCounter)
+}
+
+//RequestLeaveFinished returns true if mock invocations count is ok
+func (m *TerminationHandlerMock) RequestLeaveFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.RequestLeaveMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.RequestLeaveCounter) == uint64(len(m.RequestLeaveMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.RequestLeaveMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.RequestLeaveCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.RequestLeaveFunc != nil {
+		return atomic.LoadUint64(&m.RequestLeaveCounter) > 0
+	}
+
+	return true
+}
+
+type mTerminationHandlerMockRequiredLeave struct {
+	mock              *TerminationHandlerMock
+	mainExpectation   *TerminationHandlerMockRequiredLeaveExpectation
+	expectationSeries []*TerminationHandlerMockRequiredLeaveExpectation
+}
+
+type TerminationHandlerMockRequiredLeaveExpectation struct {
+	input  *TerminationHandlerMockRequiredLeaveInput
+	result *TerminationHandlerMockRequiredLeaveResult
+}
+
+type TerminationHandlerMockRequiredLeaveInput struct {
+	p context.Context
+}
+
+type TerminationHandlerMockRequiredLeaveResult struct {
+	r  insolar.LeaveReason
+	r1 bool
+}
+
+//Expect specifies that invocation of TerminationHandler.RequiredLeave is expected from 1 to Infinity times
+func (m *mTerminationHandlerMockRequiredLeave) Expect(p context.Context) *mTerminationHandlerMockRequiredLeave {
+	m.mock.RequiredLeaveFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &TerminationHandlerMockRequiredLeaveExpectation{}
+	}
+	m.mainExpectation.input = &TerminationHandlerMockRequiredLeaveInput{p}
+	return m
+}
+
+//Return specifies results of invocation of TerminationHandler.RequiredLeave
+func (m *mTerminationHandlerMockRequiredLeave) Return(r insolar.LeaveReason, r1 bool) *TerminationHandlerMock {
+	m.mock.RequiredLeaveFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &TerminationHandlerMockRequiredLeaveExpectation{}
+	}
+	m.mainExpectation.result = &TerminationHandlerMockRequiredLeaveResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of TerminationHandler.RequiredLeave is expected once
+func (m *mTerminationHandlerMockRequiredLeave) ExpectOnce(p context.Context) *TerminationHandlerMockRequiredLeaveExpectation {
+	m.mock.RequiredLeaveFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &TerminationHandlerMockRequiredLeaveExpectation{}
+	expectation.input = &TerminationHandlerMockRequiredLeaveInput{p}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *TerminationHandlerMockRequiredLeaveExpectation) Return(r insolar.LeaveReason, r1 bool) {
+	e.result = &TerminationHandlerMockRequiredLeaveResult{r, r1}
+}
+
+//Set uses given function f as a mock of TerminationHandler.RequiredLeave method
+func (m *mTerminationHandlerMockRequiredLeave) Set(f func(p context.Context) (r insolar.LeaveReason, r1 bool)) *TerminationHandlerMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.RequiredLeaveFunc = f
+	return m.mock
+}
+
+//RequiredLeave implements github.com/insolar/insolar/insolar.TerminationHandler interface
+func (m *TerminationHandlerMock) RequiredLeave(p context.Context) (r insolar.LeaveReason, r1 bool) {
+	counter := atomic.AddUint64(&m.RequiredLeavePreCounter, 1)
+	defer atomic.AddUint64(&m.RequiredLeaveCounter, 1)
+
+	if len(m.RequiredLeaveMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.RequiredLeaveMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to TerminationHandlerMock.RequiredLeave. %v", p)
+			return
+		}
+
+		input := m.RequiredLeaveMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, TerminationHandlerMockRequiredLeaveInput{p}, "TerminationHandler.RequiredLeave got unexpected parameters")
+
+		result := m.RequiredLeaveMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the TerminationHandlerMock.RequiredLeave")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.RequiredLeaveMock.mainExpectation != nil {
+
+		input := m.RequiredLeaveMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, TerminationHandlerMockRequiredLeaveInput{p}, "TerminationHandler.RequiredLeave got unexpected parameters")
+		}
+
+		result := m.RequiredLeaveMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the TerminationHandlerMock.RequiredLeave")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.RequiredLeaveFunc == nil {
+		m.t.Fatalf("Unexpected call to TerminationHandlerMock.RequiredLeave. %v", p)
+		return
+	}
+
+	return m.RequiredLeaveFunc(p)
+}
+
+//RequiredLeaveMinimockCounter returns a count of TerminationHandlerMock.RequiredLeaveFunc invocations
+func (m *TerminationHandlerMock) RequiredLeaveMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.RequiredLeaveCounter)
+}
+
+//RequiredLeaveMinimockPreCounter returns the value of TerminationHandlerMock.RequiredLeave invocations
+func (m *TerminationHandlerMock) RequiredLeaveMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.RequiredLeavePreCounter)
+}
+
+//RequiredLeaveFinished returns true if mock invocations count is ok
+func (m *TerminationHandlerMock) RequiredLeaveFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.RequiredLeaveMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.RequiredLeaveCounter) == uint64(len(m.RequiredLeaveMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.RequiredLeaveMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.RequiredLeaveCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.RequiredLeaveFunc != nil {
+		return atomic.LoadUint64(&m.RequiredLeaveCounter) > 0
+	}
+
+	return true
+}
+
 //ValidateCallCounters checks that all mocked methods of the interface have been called at least once
 //Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
 func (m *TerminationHandlerMock) ValidateCallCounters() {
@@ -437,6 +748,14 @@ func (m *TerminationHandlerMock) ValidateCallCounters() {
 		m.t.Fatal("Expected call to TerminationHandlerMock.OnLeaveApproved")
 	}
 
+	if !m.RequestLeaveFinished() {
+		m.t.Fatal("Expected call to TerminationHandlerMock.RequestLeave")
+	}
+
+	if !m.RequiredLeaveFinished() {
+		m.t.Fatal("Expected call to TerminationHandlerMock.RequiredLeave")
+	}
+
 }
 
 //CheckMocksCalled checks that all mocked methods of the interface have been called at least once
@@ -466,6 +785,14 @@ func (m *TerminationHandlerMock) MinimockFinish() {
 		m.t.Fatal("Expected call to TerminationHandlerMock.OnLeaveApproved")
 	}
 
+	if !m.RequestLeaveFinished() {
+		m.t.Fatal("Expected call to TerminationHandlerMock.RequestLeave")
+	}
+
+	if !m.RequiredLeaveFinished() {
+		m.t.Fatal("Expected call to TerminationHandlerMock.RequiredLeave")
+	}
+
 }
 
 //Wait waits for all mocked methods to be called at least once
@@ -483,6 +810,8 @@ func (m *TerminationHandlerMock) MinimockWait(timeout time.Duration) {
 		ok = ok && m.AbortFinished()
 		ok = ok && m.LeaveFinished()
 		ok = ok && m.OnLeaveApprovedFinished()
+		ok = ok && m.RequestLeaveFinished()
+		ok = ok && m.RequiredLeaveFinished()
 
 		if ok {
 			return
@@ -503,6 +832,14 @@ func (m *TerminationHandlerMock) MinimockWait(timeout time.Duration) {
 				m.t.Error("Expected call to TerminationHandlerMock.OnLeaveApproved")
 			}
 
+			if !m.RequestLeaveFinished() {
+				m.t.Error("Expected call to TerminationHandlerMock.RequestLeave")
+			}
+
+			if !m.RequiredLeaveFinished() {
+				m.t.Error("Expected call to TerminationHandlerMock.RequiredLeave")
+			}
+
 			m.t.Fatalf("Some mocks were not called on time: %s", timeout)
 			return
 		default:
@@ -527,5 +864,13 @@ func (m *TerminationHandlerMock) AllMocksCalled() bool {
 		return false
 	}
 
+	if !m.RequestLeaveFinished() {
+		return false
+	}
+
+	if !m.RequiredLeaveFinished() {
+		return false
+	}
+
 	return true
 }